@@ -20,3 +20,9 @@ export PATH=${PROJECT_DIRECTORY}/bin:$PATH
   --swagger_out=logtostderr=true:"${PROJECT_DIRECTORY}/${SWAGGER_DESTINATION}" \
   --doc_out "${PROJECT_DIRECTORY}/docs/api-generated" \
   --doc_opt=markdown,api.md
+
+"${PROJECT_DIRECTORY}/bin/protoc/bin/protoc" "${PROJECT_DIRECTORY}/api/provider/provider.proto" \
+  -I "${PROJECT_DIRECTORY}/api/provider" \
+  --go_out=plugins=grpc:"${PROJECT_DIRECTORY}/" \
+  --doc_out "${PROJECT_DIRECTORY}/docs/api-generated" \
+  --doc_opt=markdown,provider.md
@@ -22,6 +22,7 @@ import (
 	"net"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/soheilhy/cmux"
 	"github.com/spf13/cobra"
@@ -30,8 +31,10 @@ import (
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog"
 	"k8s.io/klog/klogr"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
@@ -40,11 +43,19 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/runtime/signals"
 
 	"github.com/samsung-cnct/cma-ssh/pkg/apis"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
 	"github.com/samsung-cnct/cma-ssh/pkg/apiserver"
 	"github.com/samsung-cnct/cma-ssh/pkg/controller"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/host"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/imageupload"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/maascredentials"
 	"github.com/samsung-cnct/cma-ssh/pkg/controller/machine"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/machineset"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/redfishhost"
 	"github.com/samsung-cnct/cma-ssh/pkg/crd"
 	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/plugin"
+	"github.com/samsung-cnct/cma-ssh/pkg/redfish"
 	"github.com/samsung-cnct/cma-ssh/pkg/webhook"
 )
 
@@ -68,6 +79,27 @@ var (
 // init configures input and output.
 func init() {
 	rootCmd.Flags().Int("port", 9020, "Port to listen on")
+	rootCmd.Flags().Duration("gc-interval", 10*time.Minute, "How often to look for leaked MAAS machines, 0 disables the garbage collector")
+	rootCmd.Flags().Duration("gc-grace-period", 30*time.Minute, "How long a MAAS machine must be orphaned before it is released")
+	rootCmd.Flags().Duration("host-sync-interval", host.DefaultInterval, "How often CnctHost objects are synced with the MAAS machines, 0 disables the sync")
+	rootCmd.Flags().String("image-server-image", imageupload.DefaultServerImage, "Image of the pods serving CnctImageUpload tarballs from persistent volume claims, it must provide busybox httpd")
+	rootCmd.Flags().Duration("deploy-timeout", machine.DefaultDeployOptions.Timeout, "How long MAAS may take to deploy a machine, or an ssh host to be bootstrapped")
+	rootCmd.Flags().Duration("deploy-poll-interval", machine.DefaultDeployOptions.PollInterval, "How often to check the MAAS status of deploying machines")
+	rootCmd.Flags().Int("deploy-retries", machine.DefaultDeployOptions.Retries, "How many times a machine which failed to deploy is replaced before it is marked as errored")
+	rootCmd.Flags().String("provider-plugin", "", "Address, as host:port or unix:///path, of a provider plugin serving the default region instead of MAAS")
+	rootCmd.Flags().Duration("redfish-inventory-interval", redfishhost.DefaultInterval, "How often the inventory of CnctRedfishHost servers is read from their BMC")
+	rootCmd.Flags().String("maas-credentials-secret", "", "Secret, as namespace/name, holding the MAAS apiKey and optionally apiURL and apiVersion. It is reloaded when it changes and takes precedence over the MAAS_API_* environment variables")
+	rootCmd.Flags().String("version-allow-list", "", "ConfigMap, as namespace/name, listing under the versions key the kubernetes versions clusters may be upgraded to. If not set every version with MAAS images is offered")
+	rootCmd.Flags().String("maas-agent-name", maas.DefaultAgentName, "MAAS agent name of the machines allocated by cma-ssh. Only machines with it are released by the garbage collector, so every cma-ssh sharing a MAAS needs its own")
+	rootCmd.Flags().Duration("maas-timeout", maas.DefaultRetryOptions.Timeout, "Deadline of a single MAAS API call")
+	rootCmd.Flags().Int("maas-retries", maas.DefaultRetryOptions.Retries, "How many times a MAAS API call failing with a transient error is retried")
+	rootCmd.Flags().Duration("maas-max-backoff", maas.DefaultRetryOptions.MaxBackoff, "Maximum delay between retries of a MAAS API call")
+	rootCmd.Flags().Float64("maas-qps", maas.DefaultRetryOptions.QPS, "Average number of MAAS API calls per second")
+	rootCmd.Flags().Int("maas-burst", maas.DefaultRetryOptions.Burst, "Number of MAAS API calls which can be made at once")
+	rootCmd.Flags().Int("maas-max-concurrent", maas.DefaultRetryOptions.MaxConcurrent, "Number of MAAS API calls which can be in progress at once")
+	rootCmd.Flags().Duration("maas-image-cache-ttl", maas.DefaultRetryOptions.ImageCacheTTL, "How long MAAS boot resources are cached, 0 disables the cache")
+	rootCmd.Flags().Duration("drift-interval", machine.DefaultDriftOptions.Interval, "How often to check that the MAAS machines of ready machines were not changed outside of cma-ssh, 0 disables the check")
+	rootCmd.Flags().Bool("drift-replace", machine.DefaultDriftOptions.Replace, "Replace drifted machines owned by a machine set instead of only marking them as errored")
 
 	viper.SetEnvPrefix("maas")
 	viper.BindEnv(apiURLKey)
@@ -137,16 +169,198 @@ func operator(cmd *cobra.Command) {
 	apiURL := viper.GetString(apiURLKey)
 	apiVersion := viper.GetString(apiVersionKey)
 	apiKey := viper.GetString(apiKeyKey)
-	maasClient, err := maas.NewClient(&maas.NewClientParams{ApiURL: apiURL, ApiVersion: apiVersion, ApiKey: apiKey})
+	agentName, err := cmd.Flags().GetString("maas-agent-name")
 	if err != nil {
-		klog.Errorf("unable to create MAAS client for machine controller: %q", err)
-		os.Exit(1)
+		klog.Errorf("Could not get maas agent name: %q", err)
+	}
+	retry := maas.DefaultRetryOptions
+	retry.Timeout, err = cmd.Flags().GetDuration("maas-timeout")
+	if err != nil {
+		klog.Errorf("Could not get maas timeout: %q", err)
+	}
+	retry.Retries, err = cmd.Flags().GetInt("maas-retries")
+	if err != nil {
+		klog.Errorf("Could not get maas retries: %q", err)
+	}
+	retry.MaxBackoff, err = cmd.Flags().GetDuration("maas-max-backoff")
+	if err != nil {
+		klog.Errorf("Could not get maas max backoff: %q", err)
 	}
-	err = machine.AddWithActuator(mgr, maasClient)
+	retry.QPS, err = cmd.Flags().GetFloat64("maas-qps")
+	if err != nil {
+		klog.Errorf("Could not get maas qps: %q", err)
+	}
+	retry.Burst, err = cmd.Flags().GetInt("maas-burst")
+	if err != nil {
+		klog.Errorf("Could not get maas burst: %q", err)
+	}
+	retry.MaxConcurrent, err = cmd.Flags().GetInt("maas-max-concurrent")
+	if err != nil {
+		klog.Errorf("Could not get maas max concurrent: %q", err)
+	}
+	retry.ImageCacheTTL, err = cmd.Flags().GetDuration("maas-image-cache-ttl")
+	if err != nil {
+		klog.Errorf("Could not get maas image cache ttl: %q", err)
+	}
+	newProvider := func(params *maas.NewClientParams) (maas.MachineProvider, error) {
+		params.AgentName = agentName
+		client, err := maas.NewClient(params)
+		if err != nil {
+			return nil, err
+		}
+		return maas.NewRetryProvider(client, retry), nil
+	}
+	newPlugin := func(address string) (maas.MachineProvider, error) {
+		client, err := plugin.Dial(address)
+		if err != nil {
+			return nil, err
+		}
+		return maas.NewRetryProvider(client, retry), nil
+	}
+	credentialsSecret, err := cmd.Flags().GetString("maas-credentials-secret")
+	if err != nil {
+		klog.Errorf("Could not get maas credentials secret: %q", err)
+	}
+	providerPlugin, err := cmd.Flags().GetString("provider-plugin")
+	if err != nil {
+		klog.Errorf("Could not get provider plugin: %q", err)
+	}
+	// The MAAS API set in the environment or the credentials secret is the
+	// default region, clusters can use other regions defined by
+	// CnctMaasRegion objects. The default region client is swapped when the
+	// credentials secret changes so it is wrapped only once, keeping the
+	// rate limit and image cache.
+	var defaultProvider maas.MachineProvider
+	if providerPlugin != "" {
+		defaultProvider, err = newPlugin(providerPlugin)
+		if err != nil {
+			klog.Errorf("unable to create provider plugin client: %q", err)
+			os.Exit(1)
+		}
+	} else if apiURL != "" || credentialsSecret != "" {
+		credentials := &maas.ReloadingProvider{}
+		defaultProvider = maas.NewRetryProvider(credentials, retry)
+		if apiURL != "" {
+			client, err := maas.NewClient(&maas.NewClientParams{ApiURL: apiURL, ApiVersion: apiVersion, ApiKey: apiKey, AgentName: agentName})
+			if err != nil {
+				klog.Errorf("unable to create MAAS client from the environment: %q", err)
+				// Without the secret nothing would replace the
+				// missing client, restarting lets the pod recover.
+				if credentialsSecret == "" {
+					os.Exit(1)
+				}
+			} else {
+				credentials.Swap(client)
+			}
+		}
+		if credentialsSecret != "" {
+			namespace, name, err := cache.SplitMetaNamespaceKey(credentialsSecret)
+			if err != nil || namespace == "" {
+				klog.Errorf("invalid maas credentials secret %q, want namespace/name", credentialsSecret)
+				os.Exit(1)
+			}
+			err = maascredentials.Add(mgr, maascredentials.Options{
+				Secret:   types.NamespacedName{Namespace: namespace, Name: name},
+				Defaults: maas.NewClientParams{ApiURL: apiURL, ApiVersion: apiVersion},
+				Provider: credentials,
+				NewProvider: func(params *maas.NewClientParams) (maas.MachineProvider, error) {
+					params.AgentName = agentName
+					return maas.NewClient(params)
+				},
+			})
+			if err != nil {
+				klog.Errorf("unable to register maas credentials controller with the manager: %q", err)
+				os.Exit(1)
+			}
+		}
+	} else {
+		klog.Info("No MAAS API URL or credentials secret set, only CnctMaasRegion regions can be used")
+	}
+	regions := maas.NewRegistry(mgr.GetClient(), defaultProvider, newProvider)
+	regions.NewPlugin = newPlugin
+	regions.NewRedfish = func(name string, region *clusterv1alpha1.RedfishRegion) (maas.MachineProvider, error) {
+		return maas.NewRetryProvider(redfish.NewProvider(mgr.GetClient(), name, *region, redfish.Dial), retry), nil
+	}
+	var deploy machine.DeployOptions
+	deploy.Timeout, err = cmd.Flags().GetDuration("deploy-timeout")
+	if err != nil {
+		klog.Errorf("Could not get deploy timeout: %q", err)
+	}
+	deploy.PollInterval, err = cmd.Flags().GetDuration("deploy-poll-interval")
+	if err != nil {
+		klog.Errorf("Could not get deploy poll interval: %q", err)
+	}
+	deploy.Retries, err = cmd.Flags().GetInt("deploy-retries")
+	if err != nil {
+		klog.Errorf("Could not get deploy retries: %q", err)
+	}
+	var drift machine.DriftOptions
+	drift.Interval, err = cmd.Flags().GetDuration("drift-interval")
+	if err != nil {
+		klog.Errorf("Could not get drift interval: %q", err)
+	}
+	drift.Replace, err = cmd.Flags().GetBool("drift-replace")
+	if err != nil {
+		klog.Errorf("Could not get drift replace: %q", err)
+	}
+	err = machine.AddWithActuator(mgr, regions, deploy, drift)
 	if err != nil {
 		klog.Errorf("unable to register machine controller with the manager: %q", err)
 		os.Exit(1)
 	}
+	err = machineset.AddWithActuator(mgr, regions)
+	if err != nil {
+		klog.Errorf("unable to register machineset controller with the manager: %q", err)
+		os.Exit(1)
+	}
+
+	gcInterval, err := cmd.Flags().GetDuration("gc-interval")
+	if err != nil {
+		klog.Errorf("Could not get gc interval: %q", err)
+	}
+	gcGracePeriod, err := cmd.Flags().GetDuration("gc-grace-period")
+	if err != nil {
+		klog.Errorf("Could not get gc grace period: %q", err)
+	}
+	if gcInterval > 0 {
+		err = machine.AddGarbageCollector(mgr, regions, gcInterval, gcGracePeriod)
+		if err != nil {
+			klog.Errorf("unable to register maas garbage collector with the manager: %q", err)
+			os.Exit(1)
+		}
+	}
+
+	hostSyncInterval, err := cmd.Flags().GetDuration("host-sync-interval")
+	if err != nil {
+		klog.Errorf("Could not get host sync interval: %q", err)
+	}
+	if hostSyncInterval > 0 {
+		err = host.Add(mgr, regions, hostSyncInterval)
+		if err != nil {
+			klog.Errorf("unable to register host sync with the manager: %q", err)
+			os.Exit(1)
+		}
+	}
+
+	imageServerImage, err := cmd.Flags().GetString("image-server-image")
+	if err != nil {
+		klog.Errorf("Could not get image server image: %q", err)
+	}
+	err = imageupload.Add(mgr, regions, imageupload.Options{ServerImage: imageServerImage})
+	if err != nil {
+		klog.Errorf("unable to register image upload controller with the manager: %q", err)
+		os.Exit(1)
+	}
+
+	redfishInterval, err := cmd.Flags().GetDuration("redfish-inventory-interval")
+	if err != nil {
+		klog.Errorf("Could not get redfish inventory interval: %q", err)
+	}
+	err = redfishhost.Add(mgr, redfish.Dial, redfishInterval)
+	if err != nil {
+		klog.Errorf("unable to register redfish host controller with the manager: %q", err)
+		os.Exit(1)
+	}
 
 	klog.Info("setting up webhooks")
 	if err := webhook.AddToManager(mgr); err != nil {
@@ -160,8 +374,21 @@ func operator(cmd *cobra.Command) {
 		klog.Errorf("Could not get port: %q", err)
 	}
 
+	var versionAllowList types.NamespacedName
+	allowList, err := cmd.Flags().GetString("version-allow-list")
+	if err != nil {
+		klog.Errorf("Could not get version allow-list: %q", err)
+	}
+	if allowList != "" {
+		versionAllowList.Namespace, versionAllowList.Name, err = cache.SplitMetaNamespaceKey(allowList)
+		if err != nil || versionAllowList.Namespace == "" {
+			klog.Errorf("invalid version allow-list %q, want namespace/name", allowList)
+			os.Exit(1)
+		}
+	}
+
 	klog.Info("Creating Web Server")
-	tcpMux := createWebServer(&apiserver.ServerOptions{PortNumber: portNumber}, mgr)
+	tcpMux := createWebServer(&apiserver.ServerOptions{PortNumber: portNumber}, mgr, regions, versionAllowList)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -188,14 +415,14 @@ func operator(cmd *cobra.Command) {
 	wg.Wait()
 }
 
-func createWebServer(options *apiserver.ServerOptions, manager manager.Manager) cmux.CMux {
+func createWebServer(options *apiserver.ServerOptions, manager manager.Manager, regions maas.Regions, versionAllowList types.NamespacedName) cmux.CMux {
 	conn, err := net.Listen("tcp", fmt.Sprintf(":%d", options.PortNumber))
 	if err != nil {
 		panic(err)
 	}
 	tcpMux := cmux.New(conn)
 
-	apiServer := apiserver.NewApiServer(manager, tcpMux)
+	apiServer := apiserver.NewApiServer(manager, regions, versionAllowList, tcpMux)
 	apiServer.AddServersToMux(options)
 
 	return apiServer.GetMux()
@@ -230,6 +457,48 @@ func installCrdsIfNotFound(cfg *rest.Config) error {
 	} else if err != nil {
 		return err
 	}
+	_, err = cs.ApiextensionsV1beta1().CustomResourceDefinitions().Get("cnctmaasregions.cluster.cnct.sds.samsung.com", v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if err := createCRD(cs, "/cluster_v1alpha1_cnctmaasregion.yaml"); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	_, err = cs.ApiextensionsV1beta1().CustomResourceDefinitions().Get("cncthosts.cluster.cnct.sds.samsung.com", v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if err := createCRD(cs, "/cluster_v1alpha1_cncthost.yaml"); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	_, err = cs.ApiextensionsV1beta1().CustomResourceDefinitions().Get("cnctimageuploads.cluster.cnct.sds.samsung.com", v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if err := createCRD(cs, "/cluster_v1alpha1_cnctimageupload.yaml"); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	_, err = cs.ApiextensionsV1beta1().CustomResourceDefinitions().Get("cnctsshhosts.cluster.cnct.sds.samsung.com", v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if err := createCRD(cs, "/cluster_v1alpha1_cnctsshhost.yaml"); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	_, err = cs.ApiextensionsV1beta1().CustomResourceDefinitions().Get("cnctredfishhosts.cluster.cnct.sds.samsung.com", v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if err := createCRD(cs, "/cluster_v1alpha1_cnctredfishhost.yaml"); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
 	_, err = cs.ApiextensionsV1beta1().CustomResourceDefinitions().Get("appbundles.addons.cnct.sds.samsung.com",
 		v1.GetOptions{})
 	if errors.IsNotFound(err) {
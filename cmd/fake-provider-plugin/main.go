@@ -0,0 +1,86 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command fake-provider-plugin serves a provider plugin with an in-memory
+// inventory, to run cma-ssh locally with --provider-plugin or a CnctMaasRegion
+// whose spec.plugin points at it. Machines are never really deployed.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"k8s.io/klog"
+
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/provider"
+	"github.com/samsung-cnct/cma-ssh/pkg/plugin/fake"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:9030", "Address to listen on, host:port or unix:///path/to/socket")
+	machines := flag.Int("machines", 3, "Number of machines in the inventory")
+	instanceType := flag.String("instance-type", "standard", "Instance type of the machines")
+	zone := flag.String("zone", "default", "Availability zone of the machines")
+	deployTime := flag.Duration("deploy-time", 30*time.Second, "How long a deployment takes")
+	images := flag.String("images", "os=ubuntu-xenial,k8s=1.13.5,standard", "Images machines can be deployed with, separated by semicolons")
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	var inventory []fake.Machine
+	for i := 1; i <= *machines; i++ {
+		inventory = append(inventory, fake.Machine{
+			Hardware: pb.Hardware{
+				SystemId:     fmt.Sprintf("fake-%d", i),
+				Hostname:     fmt.Sprintf("fake-%d", i),
+				Zone:         *zone,
+				Architecture: "amd64/generic",
+				Tags:         []string{*instanceType},
+				CpuCount:     4,
+				Memory:       8192,
+				Disks:        []*pb.Disk{{Name: "sda", Size: 100}},
+			},
+			IPAddresses: []string{fmt.Sprintf("127.0.1.%d", i)},
+		})
+	}
+	server := fake.New(inventory...)
+	server.DeployTime = *deployTime
+	for _, name := range strings.Split(*images, ";") {
+		if name = strings.TrimSpace(name); name != "" {
+			server.Images = append(server.Images, &pb.Image{Name: name, Architecture: "amd64/generic"})
+		}
+	}
+
+	network, address := "tcp", *listen
+	if strings.HasPrefix(address, "unix://") {
+		network, address = "unix", strings.TrimPrefix(address, "unix://")
+		os.Remove(address)
+	}
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		klog.Fatalf("could not listen on %s: %v", *listen, err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterMachineProviderServer(s, server)
+	klog.Infof("serving %d fake machines on %s", *machines, *listen)
+	if err := s.Serve(listener); err != nil {
+		klog.Fatal(err)
+	}
+}
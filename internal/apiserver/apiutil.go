@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
 	"github.com/samsung-cnct/cma-ssh/pkg/generated/api"
 	"github.com/samsung-cnct/cma-ssh/pkg/util"
 
@@ -32,6 +33,69 @@ func TranslateClusterStatus(crStatus common.ClusterStatusPhase) api.ClusterStatu
 	return clusterStatus
 }
 
+// TranslateMachineConstraints converts api machine constraints to CnctMachine
+// constraints
+func TranslateMachineConstraints(in *api.MachineConstraints) *v1alpha1.MachineConstraints {
+	if in == nil {
+		return nil
+	}
+	out := &v1alpha1.MachineConstraints{
+		MinCPUCount:  int(in.MinCpuCount),
+		MinMemory:    int(in.MinMemory),
+		Architecture: in.Architecture,
+		Zone:         in.Zone,
+		Pool:         in.Pool,
+		Tags:         in.Tags,
+		NotTags:      in.NotTags,
+	}
+	for _, s := range in.Storage {
+		out.Storage = append(out.Storage, v1alpha1.StorageConstraint{
+			Label: s.Label,
+			Size:  int(s.Size),
+			Tags:  s.Tags,
+		})
+	}
+	for _, i := range in.Interfaces {
+		constraint := v1alpha1.InterfaceConstraint{
+			Label:  i.Label,
+			Space:  i.Space,
+			Subnet: i.Subnet,
+			Fabric: i.Fabric,
+		}
+		if i.Vid != 0 {
+			vid := int(i.Vid)
+			constraint.VID = &vid
+		}
+		out.Interfaces = append(out.Interfaces, constraint)
+	}
+	return out
+}
+
+// TranslateControlPlaneEndpoint converts an api control plane endpoint to a
+// CnctCluster control plane endpoint
+func TranslateControlPlaneEndpoint(in *api.ControlPlaneEndpoint) *v1alpha1.ControlPlaneEndpoint {
+	if in == nil || in.Host == "" {
+		return nil
+	}
+	out := &v1alpha1.ControlPlaneEndpoint{Host: in.Host, Port: in.Port}
+	if in.Vip {
+		out.VIP = &v1alpha1.ControlPlaneVIP{Interface: in.VipInterface, VirtualRouterID: in.VirtualRouterId}
+	}
+	return out
+}
+
+// TranslateZoneSpread converts an api zone spread to a CnctMachineSet zone
+// spread
+func TranslateZoneSpread(in *api.ZoneSpread) *v1alpha1.ZoneSpread {
+	if in == nil || in.Policy == "" {
+		return nil
+	}
+	return &v1alpha1.ZoneSpread{
+		Policy: v1alpha1.ZoneSpreadPolicy(in.Policy),
+		Zones:  in.Zones,
+	}
+}
+
 func GetKubeConfig(clusterName string, manager manager.Manager) ([]byte, error) {
 	// get client
 	client := manager.GetClient()
@@ -0,0 +1,211 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog"
+
+	clusterv1alpha "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/machine"
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/api"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+func (s *Server) GetCapacity(ctx context.Context, in *pb.GetCapacityMsg) (*pb.GetCapacityReply, error) {
+	maasClient, err := s.maasRegion(ctx, in.MaasRegion)
+	if err != nil {
+		return nil, err
+	}
+	available, err := maasClient.Available(ctx)
+	if err != nil {
+		klog.Errorf("Could not list available MaaS machines: %q", err)
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	type key struct{ instanceType, zone, pool string }
+	items := map[key]*pb.CapacityItem{}
+	for i := range available {
+		hardware := &available[i]
+		if (in.Zone != "" && in.Zone != hardware.Zone) || (in.Pool != "" && in.Pool != hardware.Pool) {
+			continue
+		}
+		instanceTypes := in.InstanceTypes
+		if len(instanceTypes) == 0 {
+			instanceTypes = hardware.Tags
+		}
+		for _, instanceType := range instanceTypes {
+			if !hardware.HasTag(instanceType) {
+				continue
+			}
+			k := key{instanceType, hardware.Zone, hardware.Pool}
+			item, ok := items[k]
+			if !ok {
+				item = &pb.CapacityItem{InstanceType: k.instanceType, Zone: k.zone, Pool: k.pool}
+				items[k] = item
+			}
+			item.Count++
+			item.Machines = append(item.Machines, &pb.MachineHardware{
+				SystemId:     hardware.SystemID,
+				Hostname:     hardware.Hostname,
+				Architecture: hardware.Architecture,
+				CpuCount:     int32(hardware.CPUCount),
+				Memory:       int32(hardware.Memory),
+				Storage:      int32(hardware.Storage()),
+				Tags:         hardware.Tags,
+			})
+		}
+	}
+
+	reply := &pb.GetCapacityReply{}
+	for _, item := range items {
+		reply.Capacity = append(reply.Capacity, item)
+	}
+	sort.Slice(reply.Capacity, func(i, j int) bool {
+		a, b := reply.Capacity[i], reply.Capacity[j]
+		if a.InstanceType != b.InstanceType {
+			return a.InstanceType < b.InstanceType
+		}
+		if a.Zone != b.Zone {
+			return a.Zone < b.Zone
+		}
+		return a.Pool < b.Pool
+	})
+	return reply, nil
+}
+
+// demand is a number of machines requested for the named control plane or
+// node pool.
+type demand struct {
+	name string
+	maas.Demand
+}
+
+// machineSetDemands returns the demands for count more machines of the
+// machine set. Machines of a machine set pinned to zones are spread across
+// the zones the same way the machine set controller spreads them.
+func machineSetDemands(machineSet *clusterv1alpha.CnctMachineSet, count int) []demand {
+	template := machineSet.Spec.MachineTemplate.Spec
+	spread := machineSet.Spec.ZoneSpread
+	if spread == nil || spread.Policy != clusterv1alpha.PinnedZoneSpread || len(spread.Zones) == 0 {
+		constraints := machine.MaasConstraints(template.Constraints)
+		return []demand{{
+			name:   machineSet.Name,
+			Demand: maas.Demand{InstanceType: template.InstanceType, Constraints: &constraints, Count: count},
+		}}
+	}
+
+	var demands []demand
+	for i, zone := range spread.Zones {
+		zoneCount := count / len(spread.Zones)
+		if i < count%len(spread.Zones) {
+			zoneCount++
+		}
+		constraints := machine.MaasConstraints(template.Constraints)
+		constraints.Zone = zone
+		demands = append(demands, demand{
+			name:   fmt.Sprintf("%s in zone %s", machineSet.Name, zone),
+			Demand: maas.Demand{InstanceType: template.InstanceType, Constraints: &constraints, Count: zoneCount},
+		})
+	}
+	return demands
+}
+
+// maasRegion returns the provider of a MaaS region, the default region if
+// region is empty.
+func (s *Server) maasRegion(ctx context.Context, region string) (maas.MachineProvider, error) {
+	maasClient, err := s.MAAS.Region(ctx, region)
+	if err != nil {
+		klog.Errorf("Could not get MaaS region %q: %q", region, err)
+		if apierrors.IsNotFound(errors.Cause(err)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return maasClient, nil
+}
+
+// preflight checks that MaaS has enough machines available for the demands.
+// It returns a ResourceExhausted status listing what is missing otherwise.
+func (s *Server) preflight(ctx context.Context, region string, demands []demand) error {
+	maasClient, err := s.maasRegion(ctx, region)
+	if err != nil {
+		return err
+	}
+	available, err := maasClient.Available(ctx)
+	if err != nil {
+		klog.Errorf("Could not list available MaaS machines: %q", err)
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	maasDemands := make([]maas.Demand, len(demands))
+	for i := range demands {
+		maasDemands[i] = demands[i].Demand
+	}
+	var missing []string
+	for i, n := range maas.Shortfall(available, maasDemands) {
+		if n > 0 {
+			missing = append(missing, fmt.Sprintf("%s needs %d more %q machine(s)", demands[i].name, n, demands[i].InstanceType))
+		}
+	}
+	if len(missing) > 0 {
+		return status.Errorf(codes.ResourceExhausted, "not enough MaaS machines available: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// createClusterDemands returns the demands of a CreateCluster request.
+func createClusterDemands(in *pb.CreateClusterMsg) []demand {
+	var demands []demand
+	if in.ControlPlaneNodes != nil {
+		count, _ := controlPlaneCount(in)
+		demands = append(demands, controlPlaneDemands("control plane", in.ControlPlaneNodes, count)...)
+	}
+	if in.EtcdNodes != nil {
+		count, _ := etcdCount(in)
+		demands = append(demands, controlPlaneDemands("etcd", in.EtcdNodes, count)...)
+	}
+	for _, machineSetConfig := range in.WorkerNodePools {
+		machineSet := &clusterv1alpha.CnctMachineSet{}
+		machineSet.Name = machineSetConfig.Name
+		machineSet.Spec.MachineTemplate.Spec.InstanceType = machineSetConfig.InstanceType
+		machineSet.Spec.MachineTemplate.Spec.Constraints = TranslateMachineConstraints(machineSetConfig.Constraints)
+		machineSet.Spec.ZoneSpread = TranslateZoneSpread(machineSetConfig.ZoneSpread)
+		demands = append(demands, machineSetDemands(machineSet, int(machineSetConfig.Count))...)
+	}
+	return demands
+}
+
+// controlPlaneDemands returns the demands of count control plane machines,
+// which are spread across the zones in order, see createControlPlaneMachines.
+func controlPlaneDemands(name string, machineConfig *pb.ControlPlaneMachineSpec, count int) []demand {
+	var demands []demand
+	zones := machineConfig.Zones
+	if len(zones) == 0 {
+		zones = []string{""}
+	}
+	for i, zone := range zones {
+		n := count / len(zones)
+		if i < count%len(zones) {
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+		constraints := machine.MaasConstraints(TranslateMachineConstraints(machineConfig.Constraints))
+		if zone != "" {
+			constraints.Zone = zone
+		}
+		demands = append(demands, demand{
+			name:   name,
+			Demand: maas.Demand{InstanceType: machineConfig.InstanceType, Constraints: &constraints, Count: n},
+		})
+	}
+	return demands
+}
@@ -0,0 +1,42 @@
+package apiserver
+
+import (
+	"testing"
+
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/api"
+)
+
+func TestControlPlaneDemands(t *testing.T) {
+	tests := []struct {
+		name  string
+		zones []string
+		count int
+		want  map[string]int
+	}{
+		{name: "no zones", count: 3, want: map[string]int{"": 3}},
+		{name: "fewer zones than machines", zones: []string{"rack-1", "rack-2"}, count: 5, want: map[string]int{"rack-1": 3, "rack-2": 2}},
+		{name: "as many zones as machines", zones: []string{"rack-1", "rack-2", "rack-3"}, count: 3, want: map[string]int{"rack-1": 1, "rack-2": 1, "rack-3": 1}},
+		{name: "more zones than machines", zones: []string{"rack-1", "rack-2", "rack-3"}, count: 2, want: map[string]int{"rack-1": 1, "rack-2": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &pb.ControlPlaneMachineSpec{InstanceType: "standard", Zones: tt.zones}
+			demands := controlPlaneDemands("control plane", spec, tt.count)
+			got := map[string]int{}
+			for _, d := range demands {
+				if d.name != "control plane" || d.InstanceType != "standard" {
+					t.Errorf("demand = %+v", d)
+				}
+				got[d.Constraints.Zone] += d.Count
+			}
+			if len(demands) != len(tt.want) {
+				t.Errorf("controlPlaneDemands() = %d demands, want one per used zone", len(demands))
+			}
+			for zone, count := range tt.want {
+				if got[zone] != count {
+					t.Errorf("machines in zone %q = %d, want %d", zone, got[zone], count)
+				}
+			}
+		})
+	}
+}
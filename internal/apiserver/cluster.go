@@ -1,6 +1,9 @@
 package apiserver
 
 import (
+	"sort"
+	"strings"
+
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,12 +17,32 @@ import (
 	addonsv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/addons/v1alpha1"
 	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
 	v1alpha "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/machine"
 	"github.com/samsung-cnct/cma-ssh/pkg/controller/machineset"
 	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/api"
-	"github.com/samsung-cnct/cma-ssh/pkg/util"
 )
 
 func (s *Server) CreateCluster(ctx context.Context, in *pb.CreateClusterMsg) (*pb.CreateClusterReply, error) {
+	if _, err := controlPlaneCount(in); err != nil {
+		return nil, err
+	}
+	if _, err := etcdCount(in); err != nil {
+		return nil, err
+	}
+	controlPlaneEndpoint := TranslateControlPlaneEndpoint(in.ControlPlaneEndpoint)
+	if err := machine.ValidateControlPlaneEndpoint(controlPlaneEndpoint); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// check that maas can satisfy the request before creating anything
+	if err := s.checkImages(ctx, in.MaasRegion, in.K8SVersion, createClusterImageKinds(in)); err != nil {
+		return nil, err
+	}
+	if in.Preflight {
+		if err := s.preflight(ctx, in.MaasRegion, createClusterDemands(in)); err != nil {
+			return nil, err
+		}
+	}
+
 	// get client
 	client := s.Manager.GetClient()
 
@@ -45,42 +68,37 @@ func (s *Server) CreateCluster(ctx context.Context, in *pb.CreateClusterMsg) (*p
 			},
 		},
 		Spec: v1alpha.ClusterSpec{
-			KubernetesVersion: in.K8SVersion,
+			KubernetesVersion:    in.K8SVersion,
+			MaasRegion:           in.MaasRegion,
+			OSSeries:             in.OsSeries,
+			ControlPlaneEndpoint: controlPlaneEndpoint,
 		},
 	}
+	if in.EtcdNodes != nil {
+		clusterObject.Spec.EtcdTopology = v1alpha.ExternalEtcd
+	}
 	err = client.Create(ctx, clusterObject)
 	if err != nil {
 		klog.Errorf("Failed to create cluster object %s: %q", clusterObject.GetName(), err)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// create control plane machines
-	// TODO (zachpuck): handle count (currently only creates 1 machine)
-	{
-		machineConfig := in.ControlPlaneNodes
-		machineLabels := map[string]string{}
-		for _, label := range machineConfig.Labels {
-			machineLabels[label.Name] = label.Value
-		}
-		machineLabels["controller-tools.k8s.io"] = "1.0"
-
-		machineObject := &v1alpha.CnctMachine{
-			ObjectMeta: metav1.ObjectMeta{
-				GenerateName: "control-plane-",
-				Namespace:    in.Name,
-				Labels:       machineLabels,
-			},
-			Spec: v1alpha.MachineSpec{
-				Roles:        []common.MachineRoles{common.MachineRoleMaster, common.MachineRoleEtcd},
-				InstanceType: machineConfig.InstanceType,
-			},
-		}
+	// create the dedicated etcd machines of an external etcd cluster, the
+	// first one created starts the etcd cluster and the others join it
+	count, _ := etcdCount(in)
+	if err := createControlPlaneMachines(ctx, client, in.Name, in.EtcdNodes, count, "etcd-", []common.MachineRoles{common.MachineRoleEtcd}); err != nil {
+		return nil, err
+	}
 
-		err = client.Create(ctx, machineObject)
-		if err != nil {
-			klog.Errorf("Failed to create control plane machine object %s: %q", machineObject.GetName(), err)
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+	// create control plane machines, the first one created runs kubeadm
+	// init and the others join its control plane
+	roles := []common.MachineRoles{common.MachineRoleMaster, common.MachineRoleEtcd}
+	if in.EtcdNodes != nil {
+		roles = []common.MachineRoles{common.MachineRoleMaster}
+	}
+	count, _ = controlPlaneCount(in)
+	if err := createControlPlaneMachines(ctx, client, in.Name, in.ControlPlaneNodes, count, "control-plane-", roles); err != nil {
+		return nil, err
 	}
 
 	// create worker machineSet(s)
@@ -112,8 +130,11 @@ func (s *Server) CreateCluster(ctx context.Context, in *pb.CreateClusterMsg) (*p
 					Spec: v1alpha.MachineSpec{
 						Roles:        []common.MachineRoles{common.MachineRoleWorker},
 						InstanceType: machineSetConfig.InstanceType,
+						OSSeries:     machineSetConfig.OsSeries,
+						Constraints:  TranslateMachineConstraints(machineSetConfig.Constraints),
 					},
 				},
+				ZoneSpread: TranslateZoneSpread(machineSetConfig.ZoneSpread),
 			},
 		}
 
@@ -143,7 +164,7 @@ func (s *Server) CreateCluster(ctx context.Context, in *pb.CreateClusterMsg) (*p
 		}
 		errAppBundle := client.Create(ctx, appBundleObject)
 		if errAppBundle != nil {
-			klog.Errorf("Failed to create prometheus addons app bundle for cluster", in.Name, errAppBundle)
+			klog.Errorf("Failed to create prometheus addons app bundle for cluster %s: %q", in.Name, errAppBundle)
 		}
 	}
 
@@ -344,13 +365,214 @@ func (s *Server) GetClusterList(ctx context.Context, in *pb.GetClusterListMsg) (
 	}, nil
 }
 
+// GetUpgradeClusterInformation returns the versions the cluster can be
+// upgraded to. A version is offered if it is a valid upgrade of the cluster
+// version, MaaS has an image of it for every instance type of the cluster and
+// it is allowed by the version allow-list.
 func (s *Server) GetUpgradeClusterInformation(ctx context.Context, in *pb.GetUpgradeClusterInformationMsg) (*pb.GetUpgradeClusterInformationReply, error) {
-	// TODO: Do not hard code this list.
+	// get client
+	client := s.Manager.GetClient()
+
+	// get cluster
+	clusterInstance := &v1alpha.CnctCluster{}
+	err := client.Get(
+		ctx,
+		clientlib.ObjectKey{
+			Namespace: in.Name,
+			Name:      in.Name,
+		}, clusterInstance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		klog.Errorf("Could not query for cluster %s: %q", in.Name, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	kinds, err := clusterImageKinds(ctx, client, clusterInstance)
+	if err != nil {
+		klog.Errorf("Could not list the machines of cluster %s: %q", in.Name, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	allowed, err := machine.AllowedVersions(ctx, client, s.VersionAllowList)
+	if err != nil {
+		klog.Errorf("Could not read the version allow-list: %q", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	maasClient, err := s.maasRegion(ctx, clusterInstance.Spec.MaasRegion)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := machine.KubernetesVersions(maasClient, kinds, allowed)
+	if err != nil {
+		klog.Errorf("Could not list MaaS images: %q", err)
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	upgrades, err := machine.UpgradeVersions(clusterInstance.Spec.KubernetesVersion, versions)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
 	return &pb.GetUpgradeClusterInformationReply{
-		Versions: util.KubernetesVersions(),
+		Ok:       len(upgrades) > 0,
+		Versions: upgrades,
 	}, nil
 }
 
+// clusterImageKinds returns the image kinds of the machines and machine sets
+// of the cluster.
+func clusterImageKinds(ctx context.Context, client clientlib.Client, cluster *v1alpha.CnctCluster) ([]machine.ImageKind, error) {
+	var specs []v1alpha.MachineSpec
+	var machines v1alpha.CnctMachineList
+	if err := client.List(ctx, &clientlib.ListOptions{Namespace: cluster.Namespace}, &machines); err != nil {
+		return nil, err
+	}
+	for _, m := range machines.Items {
+		specs = append(specs, m.Spec)
+	}
+	var machineSets v1alpha.CnctMachineSetList
+	if err := client.List(ctx, &clientlib.ListOptions{Namespace: cluster.Namespace}, &machineSets); err != nil {
+		return nil, err
+	}
+	for _, ms := range machineSets.Items {
+		specs = append(specs, ms.Spec.MachineTemplate.Spec)
+	}
+	return imageKinds(cluster.Spec, specs), nil
+}
+
+// createClusterImageKinds returns the image kinds of the machines of a
+// CreateCluster request.
+func createClusterImageKinds(in *pb.CreateClusterMsg) []machine.ImageKind {
+	var specs []v1alpha.MachineSpec
+	for _, machineConfig := range []*pb.ControlPlaneMachineSpec{in.ControlPlaneNodes, in.EtcdNodes} {
+		if machineConfig != nil {
+			specs = append(specs, v1alpha.MachineSpec{InstanceType: machineConfig.InstanceType, OSSeries: machineConfig.OsSeries})
+		}
+	}
+	for _, machineSetConfig := range in.WorkerNodePools {
+		specs = append(specs, v1alpha.MachineSpec{InstanceType: machineSetConfig.InstanceType, OSSeries: machineSetConfig.OsSeries})
+	}
+	return imageKinds(v1alpha.ClusterSpec{OSSeries: in.OsSeries}, specs)
+}
+
+// imageKinds returns the distinct image kinds of the machine specs, sorted.
+// Specs without an instance type are skipped.
+func imageKinds(cluster v1alpha.ClusterSpec, specs []v1alpha.MachineSpec) []machine.ImageKind {
+	found := map[machine.ImageKind]bool{}
+	var kinds []machine.ImageKind
+	for _, spec := range specs {
+		kind := machine.MachineImageKind(cluster, spec)
+		if kind.InstanceType == "" || found[kind] {
+			continue
+		}
+		found[kind] = true
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i].String() < kinds[j].String() })
+	return kinds
+}
+
+// controlPlaneCount returns the number of control plane machines requested,
+// at least one. The stacked etcd of the control plane keeps quorum with a
+// minority of the machines down only if there is an odd number of them.
+func controlPlaneCount(in *pb.CreateClusterMsg) (int, error) {
+	machineConfig := in.ControlPlaneNodes
+	if machineConfig == nil || machineConfig.Count <= 1 {
+		return 1, nil
+	}
+	if machineConfig.Count%2 == 0 && in.EtcdNodes == nil {
+		return 0, status.Errorf(codes.InvalidArgument, "the control plane needs an odd number of machines, got %d", machineConfig.Count)
+	}
+	return int(machineConfig.Count), nil
+}
+
+// etcdCount returns the number of dedicated etcd machines requested, none if
+// etcd is stacked on the control plane. Like the stacked etcd, the external
+// etcd cluster needs an odd number of members.
+func etcdCount(in *pb.CreateClusterMsg) (int, error) {
+	machineConfig := in.EtcdNodes
+	switch {
+	case machineConfig == nil:
+		return 0, nil
+	case machineConfig.Count <= 1:
+		return 1, nil
+	case machineConfig.Count%2 == 0:
+		return 0, status.Errorf(codes.InvalidArgument, "the etcd cluster needs an odd number of machines, got %d", machineConfig.Count)
+	}
+	return int(machineConfig.Count), nil
+}
+
+// createControlPlaneMachines creates count machines with the roles in the
+// namespace, spread across the zones of the spec in order.
+func createControlPlaneMachines(
+	ctx context.Context,
+	client clientlib.Client,
+	namespace string,
+	machineConfig *pb.ControlPlaneMachineSpec,
+	count int,
+	generateName string,
+	roles []common.MachineRoles,
+) error {
+	if machineConfig == nil {
+		machineConfig = &pb.ControlPlaneMachineSpec{}
+	}
+	for i := 0; i < count; i++ {
+		machineLabels := map[string]string{}
+		for _, label := range machineConfig.Labels {
+			machineLabels[label.Name] = label.Value
+		}
+		machineLabels["controller-tools.k8s.io"] = "1.0"
+
+		machineObject := &v1alpha.CnctMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: generateName,
+				Namespace:    namespace,
+				Labels:       machineLabels,
+			},
+			Spec: v1alpha.MachineSpec{
+				Roles:        roles,
+				InstanceType: machineConfig.InstanceType,
+				OSSeries:     machineConfig.OsSeries,
+				Constraints:  TranslateMachineConstraints(machineConfig.Constraints),
+			},
+		}
+		if len(machineConfig.Zones) > 0 {
+			if machineObject.Spec.Constraints == nil {
+				machineObject.Spec.Constraints = &v1alpha.MachineConstraints{}
+			}
+			machineObject.Spec.Constraints.Zone = machineConfig.Zones[i%len(machineConfig.Zones)]
+		}
+
+		if err := client.Create(ctx, machineObject); err != nil {
+			klog.Errorf("Failed to create control plane machine object %s: %q", machineObject.GetName(), err)
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+// checkImages returns an InvalidArgument status if MaaS has no image of the
+// kubernetes version for one of the kinds.
+func (s *Server) checkImages(ctx context.Context, region, k8sVersion string, kinds []machine.ImageKind) error {
+	maasClient, err := s.maasRegion(ctx, region)
+	if err != nil {
+		return err
+	}
+	missing, err := machine.MissingImages(maasClient, k8sVersion, kinds)
+	if err != nil {
+		klog.Errorf("Could not list MaaS images: %q", err)
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if len(missing) > 0 {
+		var names []string
+		for _, kind := range missing {
+			names = append(names, kind.String())
+		}
+		return status.Errorf(codes.InvalidArgument, "there is no matching image in MaaS for k8sVersion=%s: %s", k8sVersion, strings.Join(names, "; "))
+	}
+	return nil
+}
+
 func (s *Server) UpgradeCluster(ctx context.Context, in *pb.UpgradeClusterMsg) (*pb.UpgradeClusterReply, error) {
 	// get client
 	client := s.Manager.GetClient()
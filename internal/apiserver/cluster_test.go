@@ -0,0 +1,144 @@
+package apiserver
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientlib "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	v1alpha "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/api"
+	"github.com/samsung-cnct/cma-ssh/pkg/util/fakeclient"
+)
+
+func TestControlPlaneCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *pb.CreateClusterMsg
+		want    int
+		wantErr bool
+	}{
+		{name: "unset", in: &pb.CreateClusterMsg{}, want: 1},
+		{name: "odd", in: &pb.CreateClusterMsg{ControlPlaneNodes: &pb.ControlPlaneMachineSpec{Count: 3}}, want: 3},
+		{name: "even", in: &pb.CreateClusterMsg{ControlPlaneNodes: &pb.ControlPlaneMachineSpec{Count: 2}}, wantErr: true},
+		{
+			name: "even with external etcd",
+			in:   &pb.CreateClusterMsg{ControlPlaneNodes: &pb.ControlPlaneMachineSpec{Count: 2}, EtcdNodes: &pb.ControlPlaneMachineSpec{Count: 3}},
+			want: 2,
+		},
+		{
+			name: "odd with external etcd",
+			in:   &pb.CreateClusterMsg{ControlPlaneNodes: &pb.ControlPlaneMachineSpec{Count: 5}, EtcdNodes: &pb.ControlPlaneMachineSpec{Count: 3}},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := controlPlaneCount(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("controlPlaneCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("controlPlaneCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEtcdCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *pb.CreateClusterMsg
+		want    int
+		wantErr bool
+	}{
+		{name: "stacked", in: &pb.CreateClusterMsg{ControlPlaneNodes: &pb.ControlPlaneMachineSpec{Count: 3}}, want: 0},
+		{name: "external, count unset", in: &pb.CreateClusterMsg{EtcdNodes: &pb.ControlPlaneMachineSpec{}}, want: 1},
+		{name: "external, odd", in: &pb.CreateClusterMsg{EtcdNodes: &pb.ControlPlaneMachineSpec{Count: 5}}, want: 5},
+		{name: "external, even", in: &pb.CreateClusterMsg{EtcdNodes: &pb.ControlPlaneMachineSpec{Count: 4}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := etcdCount(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("etcdCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("etcdCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// namingClient names the machines created with a generate name, which the
+// fake client does not.
+type namingClient struct {
+	clientlib.Client
+	created int
+}
+
+func (c *namingClient) Create(ctx context.Context, obj runtime.Object) error {
+	if m, ok := obj.(*v1alpha.CnctMachine); ok && m.Name == "" {
+		c.created++
+		m.Name = fmt.Sprintf("%s%d", m.GenerateName, c.created)
+	}
+	return c.Client.Create(ctx, obj)
+}
+
+func TestCreateControlPlaneMachines(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      *pb.ControlPlaneMachineSpec
+		count     int
+		wantZones []string
+	}{
+		{name: "no spec", count: 1, wantZones: []string{""}},
+		{name: "no zones", spec: &pb.ControlPlaneMachineSpec{}, count: 3, wantZones: []string{"", "", ""}},
+		{
+			name:      "fewer zones than machines",
+			spec:      &pb.ControlPlaneMachineSpec{Zones: []string{"rack-1", "rack-2"}},
+			count:     5,
+			wantZones: []string{"rack-1", "rack-2", "rack-1", "rack-2", "rack-1"},
+		},
+		{
+			name:      "more zones than machines",
+			spec:      &pb.ControlPlaneMachineSpec{Zones: []string{"rack-1", "rack-2", "rack-3"}},
+			count:     2,
+			wantZones: []string{"rack-1", "rack-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &namingClient{Client: fakeclient.New()}
+			roles := []common.MachineRoles{common.MachineRoleMaster, common.MachineRoleEtcd}
+			if err := createControlPlaneMachines(context.Background(), client, "cluster", tt.spec, tt.count, "control-plane-", roles); err != nil {
+				t.Fatalf("createControlPlaneMachines() error = %v", err)
+			}
+
+			var machines v1alpha.CnctMachineList
+			if err := client.List(context.Background(), &clientlib.ListOptions{Namespace: "cluster"}, &machines); err != nil {
+				t.Fatal(err)
+			}
+			sort.Slice(machines.Items, func(i, j int) bool { return machines.Items[i].Name < machines.Items[j].Name })
+			var zones []string
+			for _, m := range machines.Items {
+				if !reflect.DeepEqual(m.Spec.Roles, roles) {
+					t.Errorf("machine %s roles = %v, want %v", m.Name, m.Spec.Roles, roles)
+				}
+				zone := ""
+				if m.Spec.Constraints != nil {
+					zone = m.Spec.Constraints.Zone
+				}
+				zones = append(zones, zone)
+			}
+			if !reflect.DeepEqual(zones, tt.wantZones) {
+				t.Errorf("machine zones = %q, want %q", zones, tt.wantZones)
+			}
+		})
+	}
+}
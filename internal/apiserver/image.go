@@ -0,0 +1,54 @@
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/machine"
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/api"
+)
+
+func (s *Server) ListImages(ctx context.Context, in *pb.ListImagesMsg) (*pb.ListImagesReply, error) {
+	maasClient, err := s.maasRegion(ctx, in.MaasRegion)
+	if err != nil {
+		return nil, err
+	}
+	images, invalid, err := machine.ImageCatalog(maasClient)
+	if err != nil {
+		klog.Errorf("Could not list MaaS images: %q", err)
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	reply := &pb.ListImagesReply{}
+	for _, i := range images {
+		reply.Images = append(reply.Images, &pb.ImageItem{
+			OsSeries:     i.OSSeries,
+			K8SVersion:   i.KubernetesVersion,
+			InstanceType: i.InstanceType,
+			Architecture: i.Architecture,
+			Name:         i.Name,
+			Uploaded:     uploadDate(i.Uploaded),
+		})
+	}
+	for _, i := range invalid {
+		reply.InvalidImages = append(reply.InvalidImages, &pb.InvalidImageItem{
+			Name:         i.Name,
+			Architecture: i.Architecture,
+			Uploaded:     uploadDate(i.Uploaded),
+			Reason:       i.Reason,
+		})
+	}
+	return reply, nil
+}
+
+// uploadDate formats the upload day of an image, empty if it is unknown.
+func uploadDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
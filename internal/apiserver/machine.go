@@ -0,0 +1,60 @@
+package apiserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog"
+	clientlib "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/machine"
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/api"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+func (s *Server) RebootMachine(ctx context.Context, in *pb.RebootMachineMsg) (*pb.RebootMachineReply, error) {
+	// get client
+	client := s.Manager.GetClient()
+
+	// get machine
+	machineInstance := &clusterv1alpha.CnctMachine{}
+	err := client.Get(
+		ctx,
+		clientlib.ObjectKey{
+			Namespace: in.ClusterName,
+			Name:      in.MachineName,
+		}, machineInstance)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		klog.Errorf("Could not query for machine %s in cluster %s: %q", in.MachineName, in.ClusterName, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	switch machineInstance.Status.Phase {
+	case common.ProvisioningMachinePhase, common.ReadyMachinePhase:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"machine %s can not be rebooted in phase %q", in.MachineName, machineInstance.Status.Phase)
+	}
+
+	// the machine controller performs the power cycle and removes the annotation
+	annotations := machineInstance.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[machine.PowerActionAnnotation] = string(maas.PowerCycle)
+	machineInstance.SetAnnotations(annotations)
+	err = client.Update(ctx, machineInstance)
+	if err != nil {
+		klog.Errorf("Could not request reboot of machine %s in cluster %s: %q", in.MachineName, in.ClusterName, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RebootMachineReply{Ok: true}, nil
+}
@@ -36,6 +36,16 @@ func (s *Server) AddNodePool(ctx context.Context, in *pb.AddNodePoolMsg) (*pb.Ad
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// check that maas has images for the new pools before creating anything
+	var specs []clusterv1alpha.MachineSpec
+	for _, machineSetConfig := range in.WorkerNodePools {
+		specs = append(specs, clusterv1alpha.MachineSpec{InstanceType: machineSetConfig.InstanceType, OSSeries: machineSetConfig.OsSeries})
+	}
+	err = s.checkImages(ctx, clusterInstance.Spec.MaasRegion, clusterInstance.Spec.KubernetesVersion, imageKinds(clusterInstance.Spec, specs))
+	if err != nil {
+		return nil, err
+	}
+
 	// add worker machineSet(s)
 	for _, machineSetConfig := range in.WorkerNodePools {
 		machineLabels := map[string]string{}
@@ -65,8 +75,11 @@ func (s *Server) AddNodePool(ctx context.Context, in *pb.AddNodePoolMsg) (*pb.Ad
 					Spec: clusterv1alpha.MachineSpec{
 						Roles:        []common.MachineRoles{common.MachineRoleWorker},
 						InstanceType: machineSetConfig.InstanceType,
+						OSSeries:     machineSetConfig.OsSeries,
+						Constraints:  TranslateMachineConstraints(machineSetConfig.Constraints),
 					},
 				},
+				ZoneSpread: TranslateZoneSpread(machineSetConfig.ZoneSpread),
 			},
 		}
 
@@ -153,6 +166,8 @@ func (s *Server) ScaleNodePool(ctx context.Context, in *pb.ScaleNodePoolMsg) (*p
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	var machineSets []*clusterv1alpha.CnctMachineSet
+	var demands []demand
 	for _, nodePool := range in.NodePools {
 		// get machineSet by name
 		machineSet := &clusterv1alpha.CnctMachineSet{}
@@ -169,15 +184,28 @@ func (s *Server) ScaleNodePool(ctx context.Context, in *pb.ScaleNodePoolMsg) (*p
 			klog.Errorf("Could not query for machineSet %s, in cluster %s: %q", nodePool.Name, in.ClusterName, err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		if added := int(nodePool.Count) - machineSet.Spec.Replicas; added > 0 {
+			demands = append(demands, machineSetDemands(machineSet, added)...)
+		}
 
 		// update count
 		machineSet.Spec.Replicas = int(nodePool.Count)
+		machineSets = append(machineSets, machineSet)
+	}
+
+	// check that maas can satisfy the scale up before updating anything
+	if in.Preflight && len(demands) > 0 {
+		if err := s.preflight(ctx, clusterInstance.Spec.MaasRegion, demands); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, machineSet := range machineSets {
 		err = client.Update(ctx, machineSet)
 		if err != nil {
-			klog.Errorf("Could not update machineSet %s count on cluster %s: %q", nodePool.Name, in.ClusterName, err)
+			klog.Errorf("Could not update machineSet %s count on cluster %s: %q", machineSet.Name, in.ClusterName, err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-
 	}
 	return &pb.ScaleNodePoolReply{Ok: true}, nil
 }
@@ -1,9 +1,18 @@
 package apiserver
 
 import (
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
 )
 
 type Server struct {
 	Manager manager.Manager
+	MAAS    maas.Regions
+
+	// VersionAllowList is the ConfigMap listing the kubernetes versions
+	// clusters may be upgraded to. Every version with MaaS images is
+	// allowed if it is not set.
+	VersionAllowList types.NamespacedName
 }
@@ -18,6 +18,9 @@ type MachineStatusPhase string
 
 const (
 
+	// maas is deploying the machine
+	DeployingMachinePhase MachineStatusPhase = "DeployingMachine"
+
 	// resource is creating
 	ProvisioningMachinePhase MachineStatusPhase = "CreatingMachine"
 
@@ -112,6 +115,30 @@ const (
 	DeleteClusterError ClusterStatusError = "DeleteError"
 )
 
+type MachineStatusError string
+
+const (
+	// InvalidConfigurationMachineError indicates that the machine
+	// configuration is invalid.
+	InvalidConfigurationMachineError MachineStatusError = "InvalidConfiguration"
+
+	// CreateMachineError indicates that an error was encountered
+	// when trying to create the machine.
+	CreateMachineError MachineStatusError = "CreateError"
+
+	// DeployMachineError indicates that maas failed to deploy the
+	// machine and no retries are left.
+	DeployMachineError MachineStatusError = "DeployError"
+
+	// MissingMachineError indicates that the MAAS machine backing the
+	// machine has been released or removed outside of cma-ssh.
+	MissingMachineError MachineStatusError = "MissingMachine"
+
+	// DriftedMachineError indicates that the MAAS machine backing the
+	// machine was released, re-deployed or reassigned outside of cma-ssh.
+	DriftedMachineError MachineStatusError = "DriftedMachine"
+)
+
 type MachineRoles string
 
 const (
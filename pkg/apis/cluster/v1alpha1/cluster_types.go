@@ -28,6 +28,70 @@ const ClusterFinalizer = "cnctcluster.cluster.cnct.sds.samsung.com"
 type ClusterSpec struct {
 	// Desired Kubernetes version
 	KubernetesVersion string `json:"kubernetesVersion"`
+
+	// MaasRegion is the name of the CnctMaasRegion the machines of the
+	// cluster are allocated in. The default region configured for the
+	// operator is used if it is not set.
+	// +optional
+	MaasRegion string `json:"maasRegion,omitempty"`
+
+	// OSSeries is the os of the maas images the machines of the cluster are
+	// deployed with, e.g. ubuntu-xenial or ubuntu-bionic. ubuntu-xenial is
+	// used if it is not set.
+	// +optional
+	OSSeries string `json:"osSeries,omitempty"`
+
+	// ControlPlaneEndpoint is the stable address the apiservers of the
+	// masters are reached at. The address of the master which runs kubeadm
+	// init is used if it is not set.
+	// +optional
+	ControlPlaneEndpoint *ControlPlaneEndpoint `json:"controlPlaneEndpoint,omitempty"`
+
+	// EtcdTopology is where the etcd cluster of the control plane runs,
+	// Stacked on the masters by default.
+	// +kubebuilder:validation:Enum=Stacked,External
+	// +optional
+	EtcdTopology EtcdTopology `json:"etcdTopology,omitempty"`
+}
+
+type EtcdTopology string
+
+const (
+	// StackedEtcd runs an etcd member on every master.
+	StackedEtcd EtcdTopology = "Stacked"
+
+	// ExternalEtcd runs the etcd cluster on the dedicated machines with the
+	// etcd role only, the apiservers of the masters are its clients.
+	ExternalEtcd EtcdTopology = "External"
+)
+
+// ControlPlaneEndpoint is the address of a load balancer or a virtual ip in
+// front of the apiservers of the masters.
+type ControlPlaneEndpoint struct {
+	// Host is the ip or dns name of the endpoint, an external load balancer
+	// of the apiservers unless VIP is set.
+	Host string `json:"host"`
+	// Port is the port of the endpoint, 6443 for a load balancer and 8443
+	// for a virtual ip if it is not set.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// VIP makes the masters serve Host as a virtual ip with keepalived, the
+	// haproxy of the master holding it forwards the port to its apiserver.
+	// +optional
+	VIP *ControlPlaneVIP `json:"vip,omitempty"`
+}
+
+// ControlPlaneVIP is the virtual ip of the control plane.
+type ControlPlaneVIP struct {
+	// Interface is the interface the virtual ip is assigned to, the node
+	// interface of the machine or the interface of the default route if it
+	// is not set.
+	// +optional
+	Interface string `json:"interface,omitempty"`
+	// VirtualRouterID is the vrrp router id of the virtual ip, it must be
+	// unique on the network. 51 is used if it is not set.
+	// +optional
+	VirtualRouterID int32 `json:"virtualRouterID,omitempty"`
 }
 
 // ClusterStatus defines the observed state of Cluster
@@ -39,6 +103,15 @@ type ClusterStatus struct {
 	APIEndpoint string `json:"apiendpoint,omitempty"`
 	// Cluster status
 	Phase common.ClusterStatusPhase `json:"phase,omitempty"`
+	// InitMaster is the name of the master machine which runs kubeadm
+	// init, the other masters join its control plane
+	// +optional
+	InitMaster string `json:"initMaster,omitempty"`
+
+	// InitEtcd is the name of the dedicated etcd machine which starts the
+	// external etcd cluster, the other etcd machines join it as members
+	// +optional
+	InitEtcd string `json:"initEtcd,omitempty"`
 }
 
 // APIEndpoint represents a reachable Kubernetes API endpoint.
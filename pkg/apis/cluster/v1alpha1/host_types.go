@@ -0,0 +1,173 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Labels set on every CnctHost so hosts can be selected with label selectors.
+// Each MAAS tag of the host is a label with the HostTagLabelPrefix and an
+// empty value.
+const (
+	HostRegionLabel    = "host.cluster.cnct.sds.samsung.com/maas-region"
+	HostZoneLabel      = "host.cluster.cnct.sds.samsung.com/zone"
+	HostPoolLabel      = "host.cluster.cnct.sds.samsung.com/pool"
+	HostStatusLabel    = "host.cluster.cnct.sds.samsung.com/status"
+	HostClusterLabel   = "host.cluster.cnct.sds.samsung.com/cluster"
+	HostTagLabelPrefix = "tag.host.cluster.cnct.sds.samsung.com/"
+)
+
+// HostStatus mirrors a MAAS machine
+type HostStatus struct {
+	// When the host last changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// MaasRegion is the CnctMaasRegion of the machine, empty for the default
+	// region
+	// +optional
+	MaasRegion string `json:"maasRegion,omitempty"`
+
+	// SystemID is the maas system id
+	SystemID string `json:"systemID"`
+
+	// Hostname is the maas hostname
+	Hostname string `json:"hostname"`
+
+	// Status is the maas status, e.g. Ready or Deployed
+	Status string `json:"status"`
+
+	// PowerState is on, off or unknown
+	// +optional
+	PowerState string `json:"powerState,omitempty"`
+
+	// Zone is the maas zone
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// Pool is the maas resource pool
+	// +optional
+	Pool string `json:"pool,omitempty"`
+
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+
+	// Tags are the maas tags
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// CPUCount is the number of cpu cores
+	CPUCount int `json:"cpuCount"`
+
+	// Memory in MiB
+	Memory int `json:"memory"`
+
+	// Disks are the physical disks
+	// +optional
+	Disks []HostDisk `json:"disks,omitempty"`
+
+	// NICs are the network interfaces
+	// +optional
+	NICs []HostNIC `json:"nics,omitempty"`
+
+	// Owner is set when the machine is allocated by cma-ssh
+	// +optional
+	Owner *HostOwner `json:"owner,omitempty"`
+}
+
+// HostDisk is a physical disk of a host
+type HostDisk struct {
+	// Name of the disk, e.g. sda
+	Name string `json:"name"`
+
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// Size of the disk in GB
+	Size int `json:"size"`
+
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// HostNIC is a network interface of a host
+type HostNIC struct {
+	// Name of the interface, e.g. eth0 or bond0
+	Name string `json:"name"`
+
+	// +optional
+	MACAddress string `json:"macAddress,omitempty"`
+
+	// VLAN id of the interface, 0 if untagged
+	// +optional
+	VLAN int `json:"vlan,omitempty"`
+
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// HostOwner is the CnctMachine a host is allocated to
+type HostOwner struct {
+	// ProviderID the machine was allocated with
+	ProviderID string `json:"providerID"`
+
+	// Namespace and Name of the CnctMachine, empty if the machine was
+	// allocated by cma-ssh but has no CnctMachine
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Cluster is the name of the CnctCluster of the CnctMachine
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctHost is a read-only mirror of a MAAS machine, kept in sync by cma-ssh
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Hostname",type="string",JSONPath=".status.hostname",description="maas hostname"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status",description="maas status"
+// +kubebuilder:printcolumn:name="Power",type="string",JSONPath=".status.powerState",description="power state"
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".status.zone",description="maas zone"
+// +kubebuilder:printcolumn:name="Pool",type="string",JSONPath=".status.pool",description="maas resource pool"
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".status.owner.cluster",description="cluster owning the host"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type CnctHost struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status HostStatus `json:"status,omitempty"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctHostList contains a list of CnctHost
+type CnctHostList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CnctHost `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CnctHost{}, &CnctHostList{})
+}
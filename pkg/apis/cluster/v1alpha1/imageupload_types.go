@@ -0,0 +1,164 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageUploadFinalizer deletes the MAAS boot resource of a CnctImageUpload
+// when it is deleted.
+const ImageUploadFinalizer = "cnctimageupload.cluster.cnct.sds.samsung.com"
+
+// ImageUploadSpec describes a node image to upload to MAAS
+type ImageUploadSpec struct {
+	// OSSeries of the image, e.g. ubuntu-xenial
+	OSSeries string `json:"osSeries"`
+
+	// KubernetesVersion installed in the image
+	KubernetesVersion string `json:"kubernetesVersion"`
+
+	// InstanceType the image is built for
+	InstanceType string `json:"instanceType"`
+
+	// Architecture of the image, amd64/generic if not set
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+
+	// FileType is the format of the image tarball, tgz if not set
+	// +kubebuilder:validation:Enum=tgz,tbz,txz,ddtgz,ddtbz,ddtxz,ddtar,ddbz2,ddgz,ddxz,ddraw
+	// +optional
+	FileType string `json:"fileType,omitempty"`
+
+	// Source of the image tarball
+	Source ImageSource `json:"source"`
+
+	// MaasRegion is the name of the CnctMaasRegion the image is uploaded to.
+	// The default region is used if it is not set.
+	// +optional
+	MaasRegion string `json:"maasRegion,omitempty"`
+}
+
+// ImageSource is where the image tarball is downloaded from. Exactly one of
+// URL or Volume is set.
+type ImageSource struct {
+	// URL of the tarball, http or https
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Volume is a persistent volume claim holding the tarball
+	// +optional
+	Volume *ImageVolumeSource `json:"volume,omitempty"`
+}
+
+// ImageVolumeSource is a tarball on a persistent volume claim
+type ImageVolumeSource struct {
+	// Namespace of the claim
+	Namespace string `json:"namespace"`
+
+	// ClaimName is the name of the claim
+	ClaimName string `json:"claimName"`
+
+	// Path of the tarball in the volume
+	Path string `json:"path"`
+}
+
+// ImageUploadPhase is the state of an upload
+type ImageUploadPhase string
+
+const (
+	// ImageUploadPending is an upload which did not start yet
+	ImageUploadPending ImageUploadPhase = ""
+	// ImageUploadDownloading is an upload whose tarball is downloaded from
+	// its source
+	ImageUploadDownloading ImageUploadPhase = "Downloading"
+	// ImageUploadUploading is an upload whose tarball is uploaded to MAAS
+	ImageUploadUploading ImageUploadPhase = "Uploading"
+	// ImageUploadSyncing is an upload MAAS is processing
+	ImageUploadSyncing ImageUploadPhase = "Syncing"
+	// ImageUploadReady is an image machines can be deployed with
+	ImageUploadReady ImageUploadPhase = "Ready"
+	// ImageUploadFailed is an upload which failed, see Message
+	ImageUploadFailed ImageUploadPhase = "Failed"
+)
+
+// ImageUploadStatus defines the observed state of an upload
+type ImageUploadStatus struct {
+	// When the status last changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// +optional
+	Phase ImageUploadPhase `json:"phase,omitempty"`
+
+	// Message explains why the upload failed
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Name of the boot resource in MAAS
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// BootResourceID is the id of the boot resource in MAAS
+	// +optional
+	BootResourceID int `json:"bootResourceID,omitempty"`
+
+	// Size of the tarball in bytes
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// SHA256 of the tarball
+	// +optional
+	SHA256 string `json:"sha256,omitempty"`
+
+	// Progress of the current phase in percent
+	// +optional
+	Progress int `json:"progress,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctImageUpload uploads a node image to MAAS. Deleting it deletes the image
+// from MAAS.
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.name",description="maas boot resource"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="upload status"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.progress",description="progress of the status in percent"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type CnctImageUpload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageUploadSpec   `json:"spec,omitempty"`
+	Status ImageUploadStatus `json:"status,omitempty"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctImageUploadList contains a list of CnctImageUpload
+type CnctImageUploadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CnctImageUpload `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CnctImageUpload{}, &CnctImageUploadList{})
+}
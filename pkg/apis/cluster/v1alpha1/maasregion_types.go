@@ -0,0 +1,123 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultMaasAPIKeySecretKey is the key of the MAAS API key in a credentials
+// secret if MaasCredentialsSecret.Key is not set.
+const DefaultMaasAPIKeySecretKey = "apiKey"
+
+// MaasRegionSpec defines the API endpoint and credentials of a MAAS region,
+// or the provider plugin serving the region instead of MAAS
+type MaasRegionSpec struct {
+	// APIURL is the url of the MAAS region API, e.g. http://maas:5240/MAAS/
+	// +optional
+	APIURL string `json:"apiURL,omitempty"`
+
+	// APIVersion is the version of the MAAS API, 2.0 if not set
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// CredentialsSecret references the secret holding the MAAS API key
+	// +optional
+	CredentialsSecret MaasCredentialsSecret `json:"credentialsSecret,omitempty"`
+
+	// Plugin, if set, serves the region with a provider plugin instead of
+	// MAAS. APIURL and CredentialsSecret are ignored.
+	// +optional
+	Plugin *ProviderPlugin `json:"plugin,omitempty"`
+
+	// Redfish, if set, serves the region with the CnctRedfishHost servers
+	// of the region instead of MAAS. APIURL and CredentialsSecret are
+	// ignored.
+	// +optional
+	Redfish *RedfishRegion `json:"redfish,omitempty"`
+}
+
+// RedfishRegion configures how the servers of a region are deployed through
+// their BMC
+type RedfishRegion struct {
+	// Images are the images machines can be deployed with. They must boot
+	// with ds=nocloud-net;s=http://<cma-ssh>/redfish/seed/__dmi.system-uuid__/__dmi.chassis-asset-tag__/
+	// on the kernel command line to fetch their cloud-init seed with the
+	// token cma-ssh sets as asset tag of the host.
+	Images []RedfishImage `json:"images"`
+}
+
+// RedfishImage is an image servers can be deployed with
+type RedfishImage struct {
+	// Name of the image, e.g. os=ubuntu-xenial,k8s=1.13.5,standard
+	Name string `json:"name"`
+
+	// URL of the iso inserted as virtual media. It is ignored by hosts
+	// booting from the network.
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// ProviderPlugin is an out-of-process server implementing the MachineProvider
+// gRPC service of api/provider/provider.proto
+type ProviderPlugin struct {
+	// Address of the plugin, host:port or unix:///path/to/socket
+	Address string `json:"address"`
+}
+
+// MaasCredentialsSecret references the secret holding a MAAS API key
+type MaasCredentialsSecret struct {
+	// Name of the secret
+	Name string `json:"name"`
+
+	// Namespace of the secret
+	Namespace string `json:"namespace"`
+
+	// Key of the MAAS API key in the secret, apiKey if not set
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctMaasRegion is the Schema for the cnctmaasregions API
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.apiURL",description="maas api url"
+// +kubebuilder:printcolumn:name="Plugin",type="string",JSONPath=".spec.plugin.address",description="provider plugin address"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type CnctMaasRegion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MaasRegionSpec `json:"spec,omitempty"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctMaasRegionList contains a list of CnctMaasRegion
+type CnctMaasRegionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CnctMaasRegion `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CnctMaasRegion{}, &CnctMaasRegionList{})
+}
@@ -42,6 +42,255 @@ type MachineSpec struct {
 
 	// InstanceType references the type of machine to provision in maas based on cpu, gpu, memory tags
 	InstanceType string `json:"instanceType,omitempty"`
+
+	// OSSeries overrides the os series of the cluster for this machine
+	// +optional
+	OSSeries string `json:"osSeries,omitempty"`
+
+	// Constraints further restrict which maas machines can be allocated
+	// +optional
+	Constraints *MachineConstraints `json:"constraints,omitempty"`
+
+	// Network configures the interfaces of the maas machine before it is
+	// deployed
+	// +optional
+	Network *MachineNetwork `json:"network,omitempty"`
+
+	// Storage configures the disks of the maas machine before it is
+	// deployed
+	// +optional
+	Storage *MachineStorage `json:"storage,omitempty"`
+
+	// SshHost is the name of a CnctSshHost the machine is bootstrapped on
+	// over ssh instead of allocating a maas machine. Constraints, Network
+	// and Storage do not apply to ssh hosts.
+	// +optional
+	SshHost string `json:"sshHost,omitempty"`
+}
+
+// MachineConstraints are the maas allocation constraints of a Machine
+type MachineConstraints struct {
+	// MinCPUCount is the minimum number of cpu cores
+	// +optional
+	MinCPUCount int `json:"minCPUCount,omitempty"`
+
+	// MinMemory is the minimum amount of memory in MiB
+	// +optional
+	MinMemory int `json:"minMemory,omitempty"`
+
+	// Architecture of the machine, e.g. amd64/generic
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+
+	// Zone is the name of the maas availability zone to allocate from
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// Pool is the name of the maas resource pool to allocate from
+	// +optional
+	Pool string `json:"pool,omitempty"`
+
+	// Tags the machine must have in addition to the InstanceType tag
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// NotTags the machine must not have
+	// +optional
+	NotTags []string `json:"notTags,omitempty"`
+
+	// Storage the machine must have. The first entry is used for the root
+	// disk.
+	// +optional
+	Storage []StorageConstraint `json:"storage,omitempty"`
+
+	// Interfaces the machine must have
+	// +optional
+	Interfaces []InterfaceConstraint `json:"interfaces,omitempty"`
+}
+
+// StorageConstraint requires a disk of a minimum size
+type StorageConstraint struct {
+	// Label identifies the disk in the allocation results
+	// +optional
+	Label string `json:"label,omitempty"`
+
+	// Size is the minimum size of the disk in GB
+	Size int `json:"size"`
+
+	// Tags the disk must have, e.g. ssd
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// InterfaceConstraint requires a network interface attached to a network.
+// At least one of Space, Subnet, Fabric or VID must be set.
+type InterfaceConstraint struct {
+	// Label identifies the interface in the allocation results
+	Label string `json:"label"`
+
+	// Space is the name of the maas space the interface is attached to
+	// +optional
+	Space string `json:"space,omitempty"`
+
+	// Subnet is the name or cidr of the subnet the interface is attached to
+	// +optional
+	Subnet string `json:"subnet,omitempty"`
+
+	// Fabric is the name of the fabric the interface is attached to
+	// +optional
+	Fabric string `json:"fabric,omitempty"`
+
+	// VID is the vlan id the interface is attached to
+	// +optional
+	VID *int `json:"vid,omitempty"`
+}
+
+// MachineNetwork is the interfaces layout of a Machine. Interfaces which are
+// not listed keep their maas configuration.
+type MachineNetwork struct {
+	// Bonds to create from physical interfaces
+	// +optional
+	Bonds []NetworkBond `json:"bonds,omitempty"`
+
+	// Interfaces to link to subnets
+	// +optional
+	Interfaces []NetworkInterface `json:"interfaces,omitempty"`
+}
+
+// NetworkBond bonds physical interfaces of the machine
+type NetworkBond struct {
+	// Name of the bond, e.g. bond0
+	Name string `json:"name"`
+
+	// Parents are the names of the physical interfaces to bond
+	Parents []string `json:"parents"`
+
+	// Mode is the bonding mode, e.g. active-backup or 802.3ad. Defaults to
+	// the maas default, balance-rr.
+	// +optional
+	Mode string `json:"mode,omitempty"`
+}
+
+// IPMode is how a network interface gets its address
+type IPMode string
+
+const (
+	// AutoIPMode assigns an address of the subnet when the machine is
+	// deployed
+	AutoIPMode IPMode = "Auto"
+
+	// StaticIPMode assigns the address set in IPAddress
+	StaticIPMode IPMode = "Static"
+
+	// DHCPIPMode configures the interface with dhcp
+	DHCPIPMode IPMode = "DHCP"
+
+	// LinkUpIPMode brings the interface up without an address
+	LinkUpIPMode IPMode = "LinkUp"
+)
+
+// NetworkInterface links a physical interface, bond or vlan interface to a
+// subnet
+type NetworkInterface struct {
+	// Name of the physical interface or bond
+	Name string `json:"name"`
+
+	// VLAN, if set, links the vlan interface with this vlan id on top of
+	// Name instead of Name itself. The vlan interface is created if needed.
+	// +optional
+	VLAN int `json:"vlan,omitempty"`
+
+	// Subnet is the name or cidr of the subnet to link
+	Subnet string `json:"subnet"`
+
+	// Mode is how the interface gets its address, Auto by default
+	// +kubebuilder:validation:Enum=Auto,Static,DHCP,LinkUp
+	// +optional
+	Mode IPMode `json:"mode,omitempty"`
+
+	// IPAddress is the address of the interface in Static mode
+	// +optional
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// NodeIP makes the address of this interface the kubernetes node ip,
+	// the ssh host and, for masters, the api endpoint. At most one
+	// interface can be the node ip.
+	// +optional
+	NodeIP bool `json:"nodeIP,omitempty"`
+}
+
+// StorageProfile is the layout of the root filesystem of a Machine
+type StorageProfile string
+
+const (
+	// FlatStorageProfile puts the root filesystem on a partition of the
+	// boot disk
+	FlatStorageProfile StorageProfile = "Flat"
+
+	// LVMStorageProfile puts the root filesystem on a logical volume of the
+	// boot disk
+	LVMStorageProfile StorageProfile = "LVM"
+
+	// BcacheStorageProfile puts the root filesystem on the boot disk
+	// cached by a free ssd
+	BcacheStorageProfile StorageProfile = "Bcache"
+
+	// RAID1StorageProfile mirrors the root filesystem on the boot disk and a
+	// free disk at least as large
+	RAID1StorageProfile StorageProfile = "RAID1"
+)
+
+// MachineStorage is the storage layout of a Machine
+type MachineStorage struct {
+	// Profile is the layout of the root filesystem, Flat by default
+	// +kubebuilder:validation:Enum=Flat,LVM,Bcache,RAID1
+	// +optional
+	Profile StorageProfile `json:"profile,omitempty"`
+
+	// DedicatedDisks are disks formatted and mounted on their own, e.g. to
+	// keep etcd off the disk container images are written to
+	// +optional
+	DedicatedDisks []DedicatedDisk `json:"dedicatedDisks,omitempty"`
+}
+
+// DedicatedDisk is a disk used for a single mount point
+type DedicatedDisk struct {
+	// MountPoint is /var/lib/containerd or /var/lib/etcd
+	// +kubebuilder:validation:Enum=/var/lib/containerd,/var/lib/etcd
+	MountPoint string `json:"mountPoint"`
+
+	// Name of the disk, e.g. sdb. If not set the smallest free disk with
+	// all the Tags is used.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Tags the disk must have, e.g. ssd
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// BlockDevice is a block device of the maas machine
+type BlockDevice struct {
+	// Name of the device, e.g. sda or md0
+	Name string `json:"name"`
+
+	// Model of a physical disk
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// Size of the device in GB
+	Size int `json:"size"`
+
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// UsedFor describes what maas uses the device for
+	// +optional
+	UsedFor string `json:"usedFor,omitempty"`
+
+	// MountPoints of the device and its partitions
+	// +optional
+	MountPoints []string `json:"mountPoints,omitempty"`
 }
 
 // MachineSshConfigInfo defines the ssh configuration for the physical
@@ -49,7 +298,8 @@ type MachineSpec struct {
 type MachineSshConfigInfo struct {
 	Username string `json:"username,omitempty"`
 
-	// maas machine ip
+	// Host is the node ip of the maas machine or the address of the ssh
+	// host
 	Host string `json:"host,omitempty"`
 
 	Port uint32 `json:"port,omitempty"`
@@ -72,6 +322,47 @@ type MachineStatus struct {
 
 	// SystemId references the maas system id.
 	SystemId string `json:"systemId,omitempty"`
+
+	// Zone is the maas zone of the machine
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// MaasRegion is the CnctMaasRegion the machine was allocated in, empty
+	// for the default region
+	// +optional
+	MaasRegion string `json:"maasRegion,omitempty"`
+
+	// BlockDevices are the block devices of the maas machine as it was
+	// deployed
+	// +optional
+	BlockDevices []BlockDevice `json:"blockDevices,omitempty"`
+
+	// ProviderStatus mirrors the maas status of the machine, e.g. Deploying
+	// +optional
+	ProviderStatus string `json:"providerStatus,omitempty"`
+
+	// ProviderStatusMessage mirrors the maas status message of the machine
+	// +optional
+	ProviderStatusMessage string `json:"providerStatusMessage,omitempty"`
+
+	// DeployStarted is when maas started deploying the machine
+	// +optional
+	DeployStarted *metav1.Time `json:"deployStarted,omitempty"`
+
+	// DeployRetries counts the maas machines released after a failed
+	// deployment
+	// +optional
+	DeployRetries int `json:"deployRetries,omitempty"`
+
+	// In the event that there is a terminal problem reconciling the
+	// machine, both ErrorReason and ErrorMessage will be set. ErrorReason
+	// will be populated with a succinct value suitable for machine
+	// interpretation, while ErrorMessage will contain a more verbose
+	// string suitable for logging and human consumption.
+	// +optional
+	ErrorReason *common.MachineStatusError `json:"errorReason,omitempty"`
+	// +optional
+	ErrorMessage *string `json:"errorMessage,omitempty"`
 }
 
 // +genclient
@@ -34,6 +34,32 @@ type MachineSetSpec struct {
 
 	// MachineTemplate defines the desired state of each instance of Machine
 	MachineTemplate MachineTemplate `json:"machineTemplate,omitempty"`
+
+	// ZoneSpread defines how machines are spread across maas zones. If it
+	// is not set maas chooses the zone of every machine.
+	// +optional
+	ZoneSpread *ZoneSpread `json:"zoneSpread,omitempty"`
+}
+
+type ZoneSpreadPolicy string
+
+const (
+	// BalancedZoneSpread spreads machines evenly across all maas zones
+	BalancedZoneSpread ZoneSpreadPolicy = "Balanced"
+
+	// PinnedZoneSpread spreads machines evenly across the listed zones only
+	PinnedZoneSpread ZoneSpreadPolicy = "Pinned"
+)
+
+// ZoneSpread defines how machines are spread across maas zones
+type ZoneSpread struct {
+	// Policy is either Balanced or Pinned
+	// +kubebuilder:validation:Enum=Balanced,Pinned
+	Policy ZoneSpreadPolicy `json:"policy"`
+
+	// Zones the machines are pinned to. Only used with the Pinned policy.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
 }
 
 type MachineTemplate struct {
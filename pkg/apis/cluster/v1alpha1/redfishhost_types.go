@@ -0,0 +1,230 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedfishBootMethod is how a CnctRedfishHost boots the image it is deployed
+// with.
+type RedfishBootMethod string
+
+const (
+	// RedfishBootVirtualMedia inserts the image as a virtual cd and boots
+	// from it once.
+	RedfishBootVirtualMedia RedfishBootMethod = "VirtualMedia"
+	// RedfishBootPxe boots from the network once. The pxe server must
+	// serve the image.
+	RedfishBootPxe RedfishBootMethod = "Pxe"
+)
+
+// States of a CnctRedfishHost, RedfishHostStatus.State is empty while the host
+// is free.
+const (
+	RedfishHostAllocated = "Allocated"
+	RedfishHostDeploying = "Deploying"
+	RedfishHostDeployed  = "Deployed"
+)
+
+// RedfishHostSpec describes how to drive a server through the Redfish API of
+// its BMC
+type RedfishHostSpec struct {
+	// Endpoint is the url of the BMC, e.g. https://10.0.0.5
+	Endpoint string `json:"endpoint"`
+
+	// SystemPath is the Redfish path of the server, e.g.
+	// /redfish/v1/Systems/1. The only system of the BMC is used if not set.
+	// +optional
+	SystemPath string `json:"systemPath,omitempty"`
+
+	// CredentialsSecret references the secret holding the username and
+	// password of the BMC
+	CredentialsSecret RedfishCredentialsSecret `json:"credentialsSecret"`
+
+	// InsecureSkipVerify disables the verification of the BMC certificate
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+
+	// Region is the name of the CnctMaasRegion, with spec.redfish set, the
+	// host is allocated in
+	Region string `json:"region"`
+
+	// Tags are the instance types the host can be allocated as
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Zone is the availability zone of the host
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// Pool is the resource pool of the host
+	// +optional
+	Pool string `json:"pool,omitempty"`
+
+	// BootMethod is VirtualMedia, the default, or Pxe
+	// +optional
+	BootMethod RedfishBootMethod `json:"bootMethod,omitempty"`
+
+	// IPAddress is the address the host has once deployed. If it is not
+	// set the address the host fetches its cloud-init seed from is used,
+	// provided it is one of the addresses of the inventory NICs if the BMC
+	// reports any.
+	// +optional
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// RedfishCredentialsSecret references the secret holding the username and
+// password of a BMC under the username and password keys
+type RedfishCredentialsSecret struct {
+	// Name of the secret
+	Name string `json:"name"`
+
+	// Namespace of the secret
+	Namespace string `json:"namespace"`
+}
+
+// RedfishHostStatus defines the observed state of a CnctRedfishHost
+type RedfishHostStatus struct {
+	// When the status last changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Inventory is the hardware read from the BMC
+	// +optional
+	Inventory RedfishInventory `json:"inventory,omitempty"`
+
+	// InventoryError is why the inventory could not be read
+	// +optional
+	InventoryError string `json:"inventoryError,omitempty"`
+
+	// State is Allocated, Deploying or Deployed, empty while the host is
+	// free
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// ProviderID is the provider id of the machine the host is allocated
+	// for
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+
+	// Distro is the image the host is deployed with
+	// +optional
+	Distro string `json:"distro,omitempty"`
+
+	// DeployStarted is when the host was booted with the image
+	// +optional
+	DeployStarted *metav1.Time `json:"deployStarted,omitempty"`
+
+	// SeedFetched is when the host fetched its cloud-init seed. The host
+	// is deployed from then on.
+	// +optional
+	SeedFetched *metav1.Time `json:"seedFetched,omitempty"`
+
+	// IPAddress is the address the host fetched its cloud-init seed from
+	// +optional
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// RedfishInventory is the hardware of a CnctRedfishHost
+type RedfishInventory struct {
+	// UUID of the system, it identifies the host when it fetches its
+	// cloud-init seed
+	// +optional
+	UUID string `json:"uuid,omitempty"`
+
+	// +optional
+	Manufacturer string `json:"manufacturer,omitempty"`
+
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// PowerState is On or Off
+	// +optional
+	PowerState string `json:"powerState,omitempty"`
+
+	// CPUCount is the number of cpu cores
+	// +optional
+	CPUCount int `json:"cpuCount,omitempty"`
+
+	// Memory is the amount of memory in MiB
+	// +optional
+	Memory int `json:"memory,omitempty"`
+
+	// Disks are the drives of the system
+	// +optional
+	Disks []RedfishDisk `json:"disks,omitempty"`
+
+	// NICs are the ethernet interfaces of the system
+	// +optional
+	NICs []RedfishNIC `json:"nics,omitempty"`
+}
+
+// RedfishDisk is a drive of a CnctRedfishHost
+type RedfishDisk struct {
+	Name string `json:"name"`
+
+	// Size of the drive in GB
+	Size int `json:"size"`
+}
+
+// RedfishNIC is an ethernet interface of a CnctRedfishHost
+type RedfishNIC struct {
+	Name string `json:"name"`
+
+	// +optional
+	MACAddress string `json:"macAddress,omitempty"`
+
+	// IPAddresses are the IPv4 addresses the BMC reports for the
+	// interface. Most BMCs only know them while the host is running.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctRedfishHost is a server machines are deployed on through the Redfish API
+// of its BMC instead of MAAS
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.endpoint",description="bmc url"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.region",description="region of the host"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="allocation state"
+// +kubebuilder:printcolumn:name="Power",type="string",JSONPath=".status.inventory.powerState",description="power state"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type CnctRedfishHost struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedfishHostSpec   `json:"spec,omitempty"`
+	Status RedfishHostStatus `json:"status,omitempty"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctRedfishHostList contains a list of CnctRedfishHost
+type CnctRedfishHostList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CnctRedfishHost `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CnctRedfishHost{}, &CnctRedfishHostList{})
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultSshPort is the port of a CnctSshHost if SshHostSpec.Port is not set.
+const DefaultSshPort = 22
+
+// SshHostSpec describes how to reach a pre-existing host over ssh
+type SshHostSpec struct {
+	// Address is the ip address or hostname of the host. It is used as the
+	// node ip and, for masters, the api endpoint.
+	Address string `json:"address"`
+
+	// Port of the ssh server, 22 if not set
+	// +optional
+	Port uint32 `json:"port,omitempty"`
+
+	// Username to log in as. It must be root or be allowed to run sudo
+	// without a password.
+	Username string `json:"username"`
+
+	// KeySecret references the secret holding the private key to log in
+	// with
+	KeySecret SshKeySecret `json:"keySecret"`
+
+	// HostKey is the public key of the host in authorized_keys format.
+	// Either it or TrustOnFirstUse must be set.
+	// +optional
+	HostKey string `json:"hostKey,omitempty"`
+
+	// TrustOnFirstUse trusts the key presented on the first connection and
+	// records it in the status if HostKey is not set. The first connection
+	// can not tell the host from an impostor, so this is only safe on a
+	// trusted network.
+	// +optional
+	TrustOnFirstUse bool `json:"trustOnFirstUse,omitempty"`
+}
+
+// SshKeySecret references the secret holding an ssh private key
+type SshKeySecret struct {
+	// Name of the secret
+	Name string `json:"name"`
+
+	// Namespace of the secret
+	Namespace string `json:"namespace"`
+
+	// Key of the private key in the secret, ssh-privatekey if not set
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// SshHostStatus defines the observed state of a CnctSshHost
+type SshHostStatus struct {
+	// When the status last changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// HostKey is the public key the host presented on the first
+	// connection, in authorized_keys format
+	// +optional
+	HostKey string `json:"hostKey,omitempty"`
+
+	// Owner is the CnctMachine the host is claimed by
+	// +optional
+	Owner *HostOwner `json:"owner,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctSshHost is a pre-existing host machines can be bootstrapped on over ssh
+// instead of being allocated from MAAS
+// +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=".spec.address",description="ssh address"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.username",description="ssh user"
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".status.owner.cluster",description="cluster owning the host"
+// +kubebuilder:printcolumn:name="Machine",type="string",JSONPath=".status.owner.name",description="machine owning the host"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type CnctSshHost struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SshHostSpec   `json:"spec,omitempty"`
+	Status SshHostStatus `json:"status,omitempty"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CnctSshHostList contains a list of CnctSshHost
+type CnctSshHostList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CnctSshHost `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CnctSshHost{}, &CnctSshHostList{})
+}
@@ -41,9 +41,40 @@ func (in *APIEndpoint) DeepCopy() *APIEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockDevice) DeepCopyInto(out *BlockDevice) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MountPoints != nil {
+		in, out := &in.MountPoints, &out.MountPoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockDevice.
+func (in *BlockDevice) DeepCopy() *BlockDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
+	if in.ControlPlaneEndpoint != nil {
+		in, out := &in.ControlPlaneEndpoint, &out.ControlPlaneEndpoint
+		*out = new(ControlPlaneEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -82,7 +113,7 @@ func (in *CnctCluster) DeepCopyInto(out *CnctCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -139,27 +170,26 @@ func (in *CnctClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CnctMachine) DeepCopyInto(out *CnctMachine) {
+func (in *CnctHost) DeepCopyInto(out *CnctHost) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMachine.
-func (in *CnctMachine) DeepCopy() *CnctMachine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctHost.
+func (in *CnctHost) DeepCopy() *CnctHost {
 	if in == nil {
 		return nil
 	}
-	out := new(CnctMachine)
+	out := new(CnctHost)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CnctMachine) DeepCopyObject() runtime.Object {
+func (in *CnctHost) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -167,13 +197,13 @@ func (in *CnctMachine) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CnctMachineList) DeepCopyInto(out *CnctMachineList) {
+func (in *CnctHostList) DeepCopyInto(out *CnctHostList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CnctMachine, len(*in))
+		*out = make([]CnctHost, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -181,18 +211,18 @@ func (in *CnctMachineList) DeepCopyInto(out *CnctMachineList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMachineList.
-func (in *CnctMachineList) DeepCopy() *CnctMachineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctHostList.
+func (in *CnctHostList) DeepCopy() *CnctHostList {
 	if in == nil {
 		return nil
 	}
-	out := new(CnctMachineList)
+	out := new(CnctHostList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CnctMachineList) DeepCopyObject() runtime.Object {
+func (in *CnctHostList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -200,7 +230,7 @@ func (in *CnctMachineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CnctMachineSet) DeepCopyInto(out *CnctMachineSet) {
+func (in *CnctImageUpload) DeepCopyInto(out *CnctImageUpload) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -209,18 +239,18 @@ func (in *CnctMachineSet) DeepCopyInto(out *CnctMachineSet) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMachineSet.
-func (in *CnctMachineSet) DeepCopy() *CnctMachineSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctImageUpload.
+func (in *CnctImageUpload) DeepCopy() *CnctImageUpload {
 	if in == nil {
 		return nil
 	}
-	out := new(CnctMachineSet)
+	out := new(CnctImageUpload)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CnctMachineSet) DeepCopyObject() runtime.Object {
+func (in *CnctImageUpload) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -228,13 +258,13 @@ func (in *CnctMachineSet) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CnctMachineSetList) DeepCopyInto(out *CnctMachineSetList) {
+func (in *CnctImageUploadList) DeepCopyInto(out *CnctImageUploadList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CnctMachineSet, len(*in))
+		*out = make([]CnctImageUpload, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -242,18 +272,18 @@ func (in *CnctMachineSetList) DeepCopyInto(out *CnctMachineSetList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMachineSetList.
-func (in *CnctMachineSetList) DeepCopy() *CnctMachineSetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctImageUploadList.
+func (in *CnctImageUploadList) DeepCopy() *CnctImageUploadList {
 	if in == nil {
 		return nil
 	}
-	out := new(CnctMachineSetList)
+	out := new(CnctImageUploadList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CnctMachineSetList) DeepCopyObject() runtime.Object {
+func (in *CnctImageUploadList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -261,137 +291,1193 @@ func (in *CnctMachineSetList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineSetSpec) DeepCopyInto(out *MachineSetSpec) {
+func (in *CnctMaasRegion) DeepCopyInto(out *CnctMaasRegion) {
 	*out = *in
-	in.Selector.DeepCopyInto(&out.Selector)
-	in.MachineTemplate.DeepCopyInto(&out.MachineTemplate)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetSpec.
-func (in *MachineSetSpec) DeepCopy() *MachineSetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMaasRegion.
+func (in *CnctMaasRegion) DeepCopy() *CnctMaasRegion {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineSetSpec)
+	out := new(CnctMaasRegion)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctMaasRegion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineSetStatus) DeepCopyInto(out *MachineSetStatus) {
+func (in *CnctMaasRegionList) DeepCopyInto(out *CnctMaasRegionList) {
 	*out = *in
-	if in.LastUpdated != nil {
-		in, out := &in.LastUpdated, &out.LastUpdated
-		*out = (*in).DeepCopy()
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CnctMaasRegion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ErrorReason != nil {
-		in, out := &in.ErrorReason, &out.ErrorReason
-		*out = new(string)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMaasRegionList.
+func (in *CnctMaasRegionList) DeepCopy() *CnctMaasRegionList {
+	if in == nil {
+		return nil
 	}
-	if in.ErrorMessage != nil {
-		in, out := &in.ErrorMessage, &out.ErrorMessage
-		*out = new(string)
-		**out = **in
+	out := new(CnctMaasRegionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctMaasRegionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CnctMachine) DeepCopyInto(out *CnctMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetStatus.
-func (in *MachineSetStatus) DeepCopy() *MachineSetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMachine.
+func (in *CnctMachine) DeepCopy() *CnctMachine {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineSetStatus)
+	out := new(CnctMachine)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
+func (in *CnctMachineList) DeepCopyInto(out *CnctMachineList) {
 	*out = *in
-	if in.Taints != nil {
-		in, out := &in.Taints, &out.Taints
-		*out = make([]v1.Taint, len(*in))
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CnctMachine, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Roles != nil {
-		in, out := &in.Roles, &out.Roles
-		*out = make([]common.MachineRoles, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMachineList.
+func (in *CnctMachineList) DeepCopy() *CnctMachineList {
+	if in == nil {
+		return nil
 	}
-	if in.ProviderID != nil {
-		in, out := &in.ProviderID, &out.ProviderID
-		*out = new(string)
-		**out = **in
+	out := new(CnctMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CnctMachineSet) DeepCopyInto(out *CnctMachineSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSpec.
-func (in *MachineSpec) DeepCopy() *MachineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMachineSet.
+func (in *CnctMachineSet) DeepCopy() *CnctMachineSet {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineSpec)
+	out := new(CnctMachineSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctMachineSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineSshConfigInfo) DeepCopyInto(out *MachineSshConfigInfo) {
+func (in *CnctMachineSetList) DeepCopyInto(out *CnctMachineSetList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CnctMachineSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSshConfigInfo.
-func (in *MachineSshConfigInfo) DeepCopy() *MachineSshConfigInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctMachineSetList.
+func (in *CnctMachineSetList) DeepCopy() *CnctMachineSetList {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineSshConfigInfo)
+	out := new(CnctMachineSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctMachineSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CnctRedfishHost) DeepCopyInto(out *CnctRedfishHost) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctRedfishHost.
+func (in *CnctRedfishHost) DeepCopy() *CnctRedfishHost {
+	if in == nil {
+		return nil
+	}
+	out := new(CnctRedfishHost)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctRedfishHost) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
+func (in *CnctRedfishHostList) DeepCopyInto(out *CnctRedfishHostList) {
 	*out = *in
-	if in.LastUpdated != nil {
-		in, out := &in.LastUpdated, &out.LastUpdated
-		*out = (*in).DeepCopy()
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CnctRedfishHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out.SshConfig = in.SshConfig
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineStatus.
-func (in *MachineStatus) DeepCopy() *MachineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctRedfishHostList.
+func (in *CnctRedfishHostList) DeepCopy() *CnctRedfishHostList {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineStatus)
+	out := new(CnctRedfishHostList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctRedfishHostList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineTemplate) DeepCopyInto(out *MachineTemplate) {
+func (in *CnctSshHost) DeepCopyInto(out *CnctSshHost) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTemplate.
-func (in *MachineTemplate) DeepCopy() *MachineTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctSshHost.
+func (in *CnctSshHost) DeepCopy() *CnctSshHost {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineTemplate)
+	out := new(CnctSshHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctSshHost) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CnctSshHostList) DeepCopyInto(out *CnctSshHostList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CnctSshHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CnctSshHostList.
+func (in *CnctSshHostList) DeepCopy() *CnctSshHostList {
+	if in == nil {
+		return nil
+	}
+	out := new(CnctSshHostList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CnctSshHostList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneEndpoint) DeepCopyInto(out *ControlPlaneEndpoint) {
+	*out = *in
+	if in.VIP != nil {
+		in, out := &in.VIP, &out.VIP
+		*out = new(ControlPlaneVIP)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneEndpoint.
+func (in *ControlPlaneEndpoint) DeepCopy() *ControlPlaneEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneVIP) DeepCopyInto(out *ControlPlaneVIP) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneVIP.
+func (in *ControlPlaneVIP) DeepCopy() *ControlPlaneVIP {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneVIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DedicatedDisk) DeepCopyInto(out *DedicatedDisk) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedicatedDisk.
+func (in *DedicatedDisk) DeepCopy() *DedicatedDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DedicatedDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDisk) DeepCopyInto(out *HostDisk) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDisk.
+func (in *HostDisk) DeepCopy() *HostDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostNIC) DeepCopyInto(out *HostNIC) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostNIC.
+func (in *HostNIC) DeepCopy() *HostNIC {
+	if in == nil {
+		return nil
+	}
+	out := new(HostNIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostOwner) DeepCopyInto(out *HostOwner) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostOwner.
+func (in *HostOwner) DeepCopy() *HostOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(HostOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostStatus) DeepCopyInto(out *HostStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]HostDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NICs != nil {
+		in, out := &in.NICs, &out.NICs
+		*out = make([]HostNIC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(HostOwner)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostStatus.
+func (in *HostStatus) DeepCopy() *HostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSource) DeepCopyInto(out *ImageSource) {
+	*out = *in
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(ImageVolumeSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSource.
+func (in *ImageSource) DeepCopy() *ImageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageUploadSpec) DeepCopyInto(out *ImageUploadSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUploadSpec.
+func (in *ImageUploadSpec) DeepCopy() *ImageUploadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageUploadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageUploadStatus) DeepCopyInto(out *ImageUploadStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUploadStatus.
+func (in *ImageUploadStatus) DeepCopy() *ImageUploadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageUploadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVolumeSource) DeepCopyInto(out *ImageVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVolumeSource.
+func (in *ImageVolumeSource) DeepCopy() *ImageVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceConstraint) DeepCopyInto(out *InterfaceConstraint) {
+	*out = *in
+	if in.VID != nil {
+		in, out := &in.VID, &out.VID
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceConstraint.
+func (in *InterfaceConstraint) DeepCopy() *InterfaceConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaasCredentialsSecret) DeepCopyInto(out *MaasCredentialsSecret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaasCredentialsSecret.
+func (in *MaasCredentialsSecret) DeepCopy() *MaasCredentialsSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(MaasCredentialsSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaasRegionSpec) DeepCopyInto(out *MaasRegionSpec) {
+	*out = *in
+	out.CredentialsSecret = in.CredentialsSecret
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(ProviderPlugin)
+		**out = **in
+	}
+	if in.Redfish != nil {
+		in, out := &in.Redfish, &out.Redfish
+		*out = new(RedfishRegion)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaasRegionSpec.
+func (in *MaasRegionSpec) DeepCopy() *MaasRegionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaasRegionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineConstraints) DeepCopyInto(out *MachineConstraints) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotTags != nil {
+		in, out := &in.NotTags, &out.NotTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make([]StorageConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]InterfaceConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineConstraints.
+func (in *MachineConstraints) DeepCopy() *MachineConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNetwork) DeepCopyInto(out *MachineNetwork) {
+	*out = *in
+	if in.Bonds != nil {
+		in, out := &in.Bonds, &out.Bonds
+		*out = make([]NetworkBond, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]NetworkInterface, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNetwork.
+func (in *MachineNetwork) DeepCopy() *MachineNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetSpec) DeepCopyInto(out *MachineSetSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.MachineTemplate.DeepCopyInto(&out.MachineTemplate)
+	if in.ZoneSpread != nil {
+		in, out := &in.ZoneSpread, &out.ZoneSpread
+		*out = new(ZoneSpread)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetSpec.
+func (in *MachineSetSpec) DeepCopy() *MachineSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetStatus) DeepCopyInto(out *MachineSetStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.ErrorReason != nil {
+		in, out := &in.ErrorReason, &out.ErrorReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorMessage != nil {
+		in, out := &in.ErrorMessage, &out.ErrorMessage
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetStatus.
+func (in *MachineSetStatus) DeepCopy() *MachineSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
+	*out = *in
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]common.MachineRoles, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProviderID != nil {
+		in, out := &in.ProviderID, &out.ProviderID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = new(MachineConstraints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(MachineNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(MachineStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSpec.
+func (in *MachineSpec) DeepCopy() *MachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSshConfigInfo) DeepCopyInto(out *MachineSshConfigInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSshConfigInfo.
+func (in *MachineSshConfigInfo) DeepCopy() *MachineSshConfigInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSshConfigInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	out.SshConfig = in.SshConfig
+	if in.BlockDevices != nil {
+		in, out := &in.BlockDevices, &out.BlockDevices
+		*out = make([]BlockDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeployStarted != nil {
+		in, out := &in.DeployStarted, &out.DeployStarted
+		*out = (*in).DeepCopy()
+	}
+	if in.ErrorReason != nil {
+		in, out := &in.ErrorReason, &out.ErrorReason
+		*out = new(common.MachineStatusError)
+		**out = **in
+	}
+	if in.ErrorMessage != nil {
+		in, out := &in.ErrorMessage, &out.ErrorMessage
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineStatus.
+func (in *MachineStatus) DeepCopy() *MachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineStorage) DeepCopyInto(out *MachineStorage) {
+	*out = *in
+	if in.DedicatedDisks != nil {
+		in, out := &in.DedicatedDisks, &out.DedicatedDisks
+		*out = make([]DedicatedDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineStorage.
+func (in *MachineStorage) DeepCopy() *MachineStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTemplate) DeepCopyInto(out *MachineTemplate) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTemplate.
+func (in *MachineTemplate) DeepCopy() *MachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkBond) DeepCopyInto(out *NetworkBond) {
+	*out = *in
+	if in.Parents != nil {
+		in, out := &in.Parents, &out.Parents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkBond.
+func (in *NetworkBond) DeepCopy() *NetworkBond {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBond)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderPlugin) DeepCopyInto(out *ProviderPlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderPlugin.
+func (in *ProviderPlugin) DeepCopy() *ProviderPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedfishCredentialsSecret) DeepCopyInto(out *RedfishCredentialsSecret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedfishCredentialsSecret.
+func (in *RedfishCredentialsSecret) DeepCopy() *RedfishCredentialsSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(RedfishCredentialsSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedfishDisk) DeepCopyInto(out *RedfishDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedfishDisk.
+func (in *RedfishDisk) DeepCopy() *RedfishDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(RedfishDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedfishHostSpec) DeepCopyInto(out *RedfishHostSpec) {
+	*out = *in
+	out.CredentialsSecret = in.CredentialsSecret
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedfishHostSpec.
+func (in *RedfishHostSpec) DeepCopy() *RedfishHostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedfishHostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedfishHostStatus) DeepCopyInto(out *RedfishHostStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	in.Inventory.DeepCopyInto(&out.Inventory)
+	if in.DeployStarted != nil {
+		in, out := &in.DeployStarted, &out.DeployStarted
+		*out = (*in).DeepCopy()
+	}
+	if in.SeedFetched != nil {
+		in, out := &in.SeedFetched, &out.SeedFetched
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedfishHostStatus.
+func (in *RedfishHostStatus) DeepCopy() *RedfishHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedfishHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedfishImage) DeepCopyInto(out *RedfishImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedfishImage.
+func (in *RedfishImage) DeepCopy() *RedfishImage {
+	if in == nil {
+		return nil
+	}
+	out := new(RedfishImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedfishInventory) DeepCopyInto(out *RedfishInventory) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]RedfishDisk, len(*in))
+		copy(*out, *in)
+	}
+	if in.NICs != nil {
+		in, out := &in.NICs, &out.NICs
+		*out = make([]RedfishNIC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedfishInventory.
+func (in *RedfishInventory) DeepCopy() *RedfishInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(RedfishInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedfishNIC) DeepCopyInto(out *RedfishNIC) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedfishNIC.
+func (in *RedfishNIC) DeepCopy() *RedfishNIC {
+	if in == nil {
+		return nil
+	}
+	out := new(RedfishNIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedfishRegion) DeepCopyInto(out *RedfishRegion) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]RedfishImage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedfishRegion.
+func (in *RedfishRegion) DeepCopy() *RedfishRegion {
+	if in == nil {
+		return nil
+	}
+	out := new(RedfishRegion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SshHostSpec) DeepCopyInto(out *SshHostSpec) {
+	*out = *in
+	out.KeySecret = in.KeySecret
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SshHostSpec.
+func (in *SshHostSpec) DeepCopy() *SshHostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SshHostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SshHostStatus) DeepCopyInto(out *SshHostStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(HostOwner)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SshHostStatus.
+func (in *SshHostStatus) DeepCopy() *SshHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SshHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SshKeySecret) DeepCopyInto(out *SshKeySecret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SshKeySecret.
+func (in *SshKeySecret) DeepCopy() *SshKeySecret {
+	if in == nil {
+		return nil
+	}
+	out := new(SshKeySecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageConstraint) DeepCopyInto(out *StorageConstraint) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageConstraint.
+func (in *StorageConstraint) DeepCopy() *StorageConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSpread) DeepCopyInto(out *ZoneSpread) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSpread.
+func (in *ZoneSpread) DeepCopy() *ZoneSpread {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSpread)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -8,12 +8,15 @@ import (
 
 	"github.com/samsung-cnct/cma-ssh/internal/apiserver"
 	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/api"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/redfish"
 	"github.com/samsung-cnct/cma-ssh/pkg/ui/website"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/soheilhy/cmux"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
@@ -23,8 +26,10 @@ type ServerOptions struct {
 }
 
 type ApiServer struct {
-	Manager manager.Manager
-	TcpMux  cmux.CMux
+	Manager          manager.Manager
+	MAAS             maas.Regions
+	VersionAllowList types.NamespacedName
+	TcpMux           cmux.CMux
 }
 
 type MuxApiServer interface {
@@ -32,8 +37,8 @@ type MuxApiServer interface {
 	GetMux() cmux.CMux
 }
 
-func NewApiServer(manager manager.Manager, tcpMux cmux.CMux) MuxApiServer {
-	return &ApiServer{Manager: manager, TcpMux: tcpMux}
+func NewApiServer(manager manager.Manager, regions maas.Regions, versionAllowList types.NamespacedName, tcpMux cmux.CMux) MuxApiServer {
+	return &ApiServer{Manager: manager, MAAS: regions, VersionAllowList: versionAllowList, TcpMux: tcpMux}
 }
 
 func (r *ApiServer) AddServersToMux(options *ServerOptions) {
@@ -69,6 +74,7 @@ func (r *ApiServer) addRestAndWebsite(tcpMux cmux.CMux, grpcPortNumber int) {
 		router := http.NewServeMux()
 		website.AddWebsiteHandles(router)
 		r.addgRPCRestGateway(router, grpcPortNumber)
+		router.Handle(redfish.SeedPath, redfish.NewSeedHandler(r.Manager.GetClient()))
 		httpServer := http.Server{
 			Handler: router,
 		}
@@ -92,7 +98,7 @@ func (r *ApiServer) addgRPCRestGateway(router *http.ServeMux, grpcPortNumber int
 }
 
 func (r *ApiServer) newgRPCServiceServer() *apiserver.Server {
-	return &apiserver.Server{Manager: r.Manager}
+	return &apiserver.Server{Manager: r.Manager, MAAS: r.MAAS, VersionAllowList: r.VersionAllowList}
 }
 
 // allowCORS allows Cross Origin Resource Sharing from any origin.
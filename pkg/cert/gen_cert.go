@@ -110,20 +110,35 @@ func NewCABundle() (*CABundle, error) {
 	}
 	kubeconfigPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: kubeconfigDer})
 
+	// the service account key pair is shared by the apiservers of all
+	// masters so that tokens signed by one are accepted by the others
+	serviceAccountKey, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create service account key")
+	}
+	serviceAccountKeyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(serviceAccountKey)})
+	serviceAccountDer, err := x509.MarshalPKIXPublicKey(serviceAccountKey.Public())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal service account public key")
+	}
+	serviceAccountPem := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: serviceAccountDer})
+
 	// https://github.com/kelseyhightower/kubernetes-the-hard-way/blob/master/docs/04-certificate-authority.md
 	// https://kubernetes.io/docs/reference/setup-tools/kubeadm/kubeadm-init/#custom-certificates
 	// https://kubernetes.io/docs/setup/certificates/#configure-certificates-manually
 	return &CABundle{
-		Root:          rootPem,
-		RootKey:       rootKeyPem,
-		K8s:           k8sPem,
-		K8sKey:        k8sKeyPem,
-		Etcd:          etcdPem,
-		EtcdKey:       etcdKeyPem,
-		FrontProxy:    k8sFrontProxyPem,
-		FrontProxyKey: k8sFrontProxyKeyPem,
-		K8sClient:     kubeconfigPem,
-		K8sClientKey:  kubeconfigKeyPem,
+		Root:              rootPem,
+		RootKey:           rootKeyPem,
+		K8s:               k8sPem,
+		K8sKey:            k8sKeyPem,
+		Etcd:              etcdPem,
+		EtcdKey:           etcdKeyPem,
+		FrontProxy:        k8sFrontProxyPem,
+		FrontProxyKey:     k8sFrontProxyKeyPem,
+		K8sClient:         kubeconfigPem,
+		K8sClientKey:      kubeconfigKeyPem,
+		ServiceAccount:    serviceAccountPem,
+		ServiceAccountKey: serviceAccountKeyPem,
 	}, nil
 }
 
@@ -134,19 +149,25 @@ type CABundle struct {
 	Etcd, EtcdKey             []byte
 	FrontProxy, FrontProxyKey []byte
 	K8sClient, K8sClientKey   []byte
+	// ServiceAccount is the public key verifying service account tokens,
+	// it is missing from the bundles of clusters created before it was
+	// added.
+	ServiceAccount, ServiceAccountKey []byte
 }
 
 const (
-	mapKeyRoot          = "root.crt"
-	mapKeyRootKey       = "root.key"
-	mapKeyK8s           = "ca.crt"
-	mapKeyK8sKey        = "ca.key"
-	mapKeyEtcd          = "etcd.crt"
-	mapKeyEtcdKey       = "etcd.key"
-	mapKeyFrontProxy    = "front-proxy.crt"
-	mapKeyFrontProxyKey = "front-proxy.key"
-	mapKeyK8sClient     = "k8s-client.crt"
-	mapKeyK8sClientKey  = "k8s-client.key"
+	mapKeyRoot              = "root.crt"
+	mapKeyRootKey           = "root.key"
+	mapKeyK8s               = "ca.crt"
+	mapKeyK8sKey            = "ca.key"
+	mapKeyEtcd              = "etcd.crt"
+	mapKeyEtcdKey           = "etcd.key"
+	mapKeyFrontProxy        = "front-proxy.crt"
+	mapKeyFrontProxyKey     = "front-proxy.key"
+	mapKeyK8sClient         = "k8s-client.crt"
+	mapKeyK8sClientKey      = "k8s-client.key"
+	mapKeyServiceAccount    = "sa.pub"
+	mapKeyServiceAccountKey = "sa.key"
 )
 
 func (c *CABundle) Set(key string, value []byte) {
@@ -171,6 +192,10 @@ func (c *CABundle) Set(key string, value []byte) {
 		c.K8sClient = value
 	case mapKeyK8sClientKey:
 		c.K8sClientKey = value
+	case mapKeyServiceAccount:
+		c.ServiceAccount = value
+	case mapKeyServiceAccountKey:
+		c.ServiceAccountKey = value
 	}
 }
 
@@ -195,6 +220,8 @@ func CABundleFromMap(m map[string][]byte) (*CABundle, error) {
 		}
 		bundle.Set(key, val)
 	}
+	bundle.Set(mapKeyServiceAccount, m[mapKeyServiceAccount])
+	bundle.Set(mapKeyServiceAccountKey, m[mapKeyServiceAccountKey])
 	return bundle, nil
 }
 
@@ -209,17 +236,25 @@ func (c CABundle) MergeWithMap(m map[string][]byte) {
 	m[mapKeyFrontProxyKey] = c.FrontProxyKey
 	m[mapKeyK8sClient] = c.K8sClient
 	m[mapKeyK8sClientKey] = c.K8sClientKey
+	if c.ServiceAccount != nil {
+		m[mapKeyServiceAccount] = c.ServiceAccount
+		m[mapKeyServiceAccountKey] = c.ServiceAccountKey
+	}
 }
 
-func (c CABundle) ToTar() (string, error) {
-	var tarball bytes.Buffer
-	enc := base64.NewEncoder(base64.StdEncoding, &tarball)
-	tw := tar.NewWriter(enc)
-	var files = []struct {
-		Name string
-		Body []byte
-		Mode int64
-	}{
+// TarFile is a file of a certificate tarball, its name is relative to
+// /etc/kubernetes/pki.
+type TarFile struct {
+	Name string
+	Body []byte
+	Mode int64
+}
+
+// ToTar returns the base64 encoded tarball of the certificates kubeadm
+// expects in /etc/kubernetes/pki, shared by every master of the cluster, and
+// of the extra files.
+func (c CABundle) ToTar(extra ...TarFile) (string, error) {
+	files := []TarFile{
 		{Name: "etcd/ca.crt", Body: c.Etcd, Mode: 0644},
 		{Name: "etcd/ca.key", Body: c.EtcdKey, Mode: 0600},
 		{Name: "ca.crt", Body: c.K8s, Mode: 0644},
@@ -227,6 +262,28 @@ func (c CABundle) ToTar() (string, error) {
 		{Name: "front-proxy-ca.crt", Body: c.FrontProxy, Mode: 0644},
 		{Name: "front-proxy-ca.key", Body: c.FrontProxyKey, Mode: 0600},
 	}
+	if c.ServiceAccount != nil {
+		files = append(files,
+			TarFile{Name: "sa.pub", Body: c.ServiceAccount, Mode: 0644},
+			TarFile{Name: "sa.key", Body: c.ServiceAccountKey, Mode: 0600},
+		)
+	}
+	return writeTar(append(files, extra...))
+}
+
+// EtcdToTar returns the base64 encoded tarball of the etcd CA, which is all
+// the dedicated etcd machines of a cluster need to sign their certificates.
+func (c CABundle) EtcdToTar() (string, error) {
+	return writeTar([]TarFile{
+		{Name: "etcd/ca.crt", Body: c.Etcd, Mode: 0644},
+		{Name: "etcd/ca.key", Body: c.EtcdKey, Mode: 0600},
+	})
+}
+
+func writeTar(files []TarFile) (string, error) {
+	var tarball bytes.Buffer
+	enc := base64.NewEncoder(base64.StdEncoding, &tarball)
+	tw := tar.NewWriter(enc)
 	hdr := tar.Header{
 		Name:     "etcd/",
 		Mode:     0755,
@@ -260,6 +317,44 @@ func (c CABundle) ToTar() (string, error) {
 	return tarball.String(), nil
 }
 
+// EtcdClientCert returns a new client certificate and key signed by the etcd
+// CA, e.g. for the apiservers using an external etcd cluster.
+func (c CABundle) EtcdClientCert(commonName string, organization []string) (certPem, keyPem []byte, err error) {
+	caBlock, _ := pem.Decode(c.Etcd)
+	if caBlock == nil {
+		return nil, nil, errors.New("could not decode etcd ca certificate")
+	}
+	ca, err := x509.ParseCertificate(caBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse etcd ca certificate")
+	}
+	caKeyBlock, _ := pem.Decode(c.EtcdKey)
+	if caKeyBlock == nil {
+		return nil, nil, errors.New("could not decode etcd ca key")
+	}
+	caKey, err := x509.ParsePKCS1PrivateKey(caKeyBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse etcd ca key")
+	}
+
+	template, err := FromCertTemplate(commonName, organization, nil, false, true)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not create etcd client cert")
+	}
+	template.IsCA = false
+	key, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not create etcd client key")
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, key.Public(), caKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not create der bytes for etcd client cert")
+	}
+	certPem = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return certPem, keyPem, nil
+}
+
 func (c *CABundle) Kubeconfig(name, apiserverAddress string) ([]byte, error) {
 	clusterName := name
 	userName := "kubernetes-admin"
@@ -213,6 +213,17 @@ func (r *ReconcileCluster) Reconcile(request reconcile.Request) (reconcile.Resul
 			return reconcile.Result{}, errors.Wrap(err, "could not get service list")
 		}
 		if len(serviceList.Items) > 0 {
+			ready, members := externalEtcdQuorum(machines)
+			if cluster.Spec.EtcdTopology != clusterv1alpha1.ExternalEtcd {
+				ready, members, err = etcdQuorum(clientset, machines)
+				if err != nil {
+					return reconcile.Result{}, err
+				}
+			}
+			if ready < members/2+1 {
+				log.Info("waiting for etcd quorum", "cluster", cluster.Name, "ready", ready, "members", members)
+				return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
+			}
 			cluster.Status.Phase = common.RunningClusterPhase
 			if err := r.Update(context.Background(), cluster); err != nil {
 				return reconcile.Result{}, errors.Wrap(err, "could not update cluster status")
@@ -225,6 +236,61 @@ func (r *ReconcileCluster) Reconcile(request reconcile.Request) (reconcile.Resul
 	return reconcile.Result{}, err
 }
 
+// etcdQuorum returns the number of ready etcd members of the cluster and the
+// number of members expected. Every master runs a stacked etcd member as a
+// static pod, so a master machine being deleted is not expected to.
+func etcdQuorum(clientset kubernetes.Interface, machines []clusterv1alpha1.CnctMachine) (ready, members int, err error) {
+	for _, machine := range machines {
+		if !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for _, role := range machine.Spec.Roles {
+			if role == common.MachineRoleMaster {
+				members++
+				break
+			}
+		}
+	}
+	pods, err := clientset.CoreV1().
+		Pods(metav1.NamespaceSystem).
+		List(metav1.ListOptions{LabelSelector: "component=etcd"})
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not list etcd pods")
+	}
+	for _, pod := range pods.Items {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				ready++
+			}
+		}
+	}
+	return ready, members, nil
+}
+
+// externalEtcdQuorum returns the number of ready dedicated etcd machines and
+// the number of members expected, the machines with the etcd role only run
+// the members of an external etcd cluster.
+func externalEtcdQuorum(machines []clusterv1alpha1.CnctMachine) (ready, members int) {
+	for _, machine := range machines {
+		if !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		var etcd, master bool
+		for _, role := range machine.Spec.Roles {
+			etcd = etcd || role == common.MachineRoleEtcd
+			master = master || role == common.MachineRoleMaster
+		}
+		if !etcd || master {
+			continue
+		}
+		members++
+		if machine.Status.Phase == common.ReadyMachinePhase {
+			ready++
+		}
+	}
+	return ready, members
+}
+
 func createClusterSecrets(k8sClient client.Client, cluster *clusterv1alpha1.CnctCluster) error {
 	bundle, err := cert.NewCABundle()
 	if err != nil {
@@ -0,0 +1,277 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package host keeps a CnctHost object in sync with every MAAS machine.
+package host
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+var log = logf.Log.WithName("host sync")
+
+// DefaultInterval is how often hosts are synced by default.
+const DefaultInterval = 5 * time.Minute
+
+// Add adds the host sync to the Manager. Every interval a CnctHost is created
+// or updated for each machine of every MAAS region, and the CnctHost objects
+// of machines removed from MAAS are deleted. The hosts are read-only, changes
+// made to them are overwritten by the next sync.
+func Add(mgr manager.Manager, regions maas.Regions, interval time.Duration) error {
+	return mgr.Add(newSyncer(mgr.GetClient(), regions, interval))
+}
+
+type syncer struct {
+	client.Client
+	regions  maas.Regions
+	interval time.Duration
+	now      func() time.Time
+}
+
+func newSyncer(k8sClient client.Client, regions maas.Regions, interval time.Duration) *syncer {
+	return &syncer{
+		Client:   k8sClient,
+		regions:  regions,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start implements manager.Runnable.
+func (s *syncer) Start(stop <-chan struct{}) error {
+	wait.Until(func() {
+		if err := s.sync(); err != nil {
+			log.Error(err, "host sync failed")
+		}
+	}, s.interval, stop)
+	return nil
+}
+
+// sync mirrors the MAAS machines of every region to CnctHost objects. The
+// hosts of a region which can not be listed are left as they are.
+// +kubebuilder:rbac:groups=cluster.cnct.sds.samsung.com,resources=cncthosts,verbs=get;list;watch;create;update;patch;delete
+func (s *syncer) sync() error {
+	regions, err := s.regions.Names(context.Background())
+	if err != nil {
+		return err
+	}
+	owners, err := s.owners()
+	if err != nil {
+		return err
+	}
+	var existing clusterv1alpha1.CnctHostList
+	if err := s.List(context.Background(), &client.ListOptions{}, &existing); err != nil {
+		return err
+	}
+	current := map[string]*clusterv1alpha1.CnctHost{}
+	for i := range existing.Items {
+		current[existing.Items[i].Name] = &existing.Items[i]
+	}
+
+	// keep holds the hosts which are not deleted: the synced hosts and the
+	// hosts of regions which could not be listed.
+	keep := map[string]bool{}
+	for _, region := range regions {
+		maasClient, err := s.regions.Region(context.Background(), region)
+		var hosts []maas.Host
+		if err == nil {
+			hosts, err = maasClient.Hosts(context.Background())
+		}
+		if err != nil {
+			log.Error(err, "could not list maas machines", "region", region)
+			for name, host := range current {
+				if host.Status.MaasRegion == region {
+					keep[name] = true
+				}
+			}
+			continue
+		}
+		for i := range hosts {
+			desired := newHost(region, &hosts[i], owners)
+			keep[desired.Name] = true
+			if err := s.apply(current[desired.Name], desired); err != nil {
+				log.Error(err, "could not sync host", "host", desired.Name)
+			}
+		}
+	}
+
+	for name, host := range current {
+		if keep[name] {
+			continue
+		}
+		log.Info("deleting host of removed maas machine", "host", name)
+		if err := s.Delete(context.Background(), host); err != nil && !apierrors.IsNotFound(err) {
+			log.Error(err, "could not delete host", "host", name)
+		}
+	}
+	return nil
+}
+
+// owners returns the owner of each ProviderID used by a CnctMachine.
+func (s *syncer) owners() (map[string]clusterv1alpha1.HostOwner, error) {
+	var clusters clusterv1alpha1.CnctClusterList
+	if err := s.List(context.Background(), &client.ListOptions{}, &clusters); err != nil {
+		return nil, err
+	}
+	// There is one cluster per namespace.
+	clusterNames := map[string]string{}
+	for _, cluster := range clusters.Items {
+		clusterNames[cluster.Namespace] = cluster.Name
+	}
+
+	var machines clusterv1alpha1.CnctMachineList
+	if err := s.List(context.Background(), &client.ListOptions{}, &machines); err != nil {
+		return nil, err
+	}
+	owners := map[string]clusterv1alpha1.HostOwner{}
+	for _, m := range machines.Items {
+		if m.Spec.ProviderID == nil {
+			continue
+		}
+		owners[*m.Spec.ProviderID] = clusterv1alpha1.HostOwner{
+			ProviderID: *m.Spec.ProviderID,
+			Namespace:  m.Namespace,
+			Name:       m.Name,
+			Cluster:    clusterNames[m.Namespace],
+		}
+	}
+	return owners, nil
+}
+
+// apply creates the desired host or updates the current host if it differs.
+func (s *syncer) apply(current, desired *clusterv1alpha1.CnctHost) error {
+	now := &metav1.Time{Time: s.now()}
+	if current == nil {
+		log.Info("creating host", "host", desired.Name)
+		desired.Status.LastUpdated = now
+		return s.Create(context.Background(), desired)
+	}
+	desired.Status.LastUpdated = current.Status.LastUpdated
+	if apiequality.Semantic.DeepEqual(current.Labels, desired.Labels) &&
+		apiequality.Semantic.DeepEqual(current.Status, desired.Status) {
+		return nil
+	}
+	current.Labels = desired.Labels
+	current.Status = desired.Status
+	current.Status.LastUpdated = now
+	return s.Update(context.Background(), current)
+}
+
+// HostName returns the name of the CnctHost of a MAAS machine. Machines of
+// the default region are named by their system id, the machines of other
+// regions are prefixed by the region.
+func HostName(region, systemID string) string {
+	if region == "" {
+		return strings.ToLower(systemID)
+	}
+	return region + "." + strings.ToLower(systemID)
+}
+
+func newHost(region string, h *maas.Host, owners map[string]clusterv1alpha1.HostOwner) *clusterv1alpha1.CnctHost {
+	host := &clusterv1alpha1.CnctHost{
+		ObjectMeta: metav1.ObjectMeta{Name: HostName(region, h.SystemID)},
+		Status: clusterv1alpha1.HostStatus{
+			MaasRegion:   region,
+			SystemID:     h.SystemID,
+			Hostname:     h.Hostname,
+			Status:       h.Status,
+			PowerState:   h.PowerState,
+			Zone:         h.Zone,
+			Pool:         h.Pool,
+			Architecture: h.Architecture,
+			Tags:         h.Tags,
+			CPUCount:     h.CPUCount,
+			Memory:       h.Memory,
+		},
+	}
+	for _, d := range h.Disks {
+		host.Status.Disks = append(host.Status.Disks, clusterv1alpha1.HostDisk{
+			Name:  d.Name,
+			Model: d.Model,
+			Size:  d.Size,
+			Tags:  d.Tags,
+		})
+	}
+	for _, n := range h.NICs {
+		host.Status.NICs = append(host.Status.NICs, clusterv1alpha1.HostNIC{
+			Name:        n.Name,
+			MACAddress:  n.MACAddress,
+			VLAN:        n.VLAN,
+			IPAddresses: n.IPAddresses,
+		})
+	}
+	if h.ProviderID != "" {
+		owner, ok := owners[h.ProviderID]
+		if !ok {
+			owner = clusterv1alpha1.HostOwner{ProviderID: h.ProviderID}
+		}
+		host.Status.Owner = &owner
+	}
+	host.Labels = hostLabels(&host.Status)
+	return host
+}
+
+func hostLabels(status *clusterv1alpha1.HostStatus) map[string]string {
+	labels := map[string]string{}
+	set := func(key, value string) {
+		if value = labelValue(value); value != "" {
+			labels[key] = value
+		}
+	}
+	set(clusterv1alpha1.HostRegionLabel, status.MaasRegion)
+	set(clusterv1alpha1.HostZoneLabel, status.Zone)
+	set(clusterv1alpha1.HostPoolLabel, status.Pool)
+	set(clusterv1alpha1.HostStatusLabel, status.Status)
+	if status.Owner != nil {
+		set(clusterv1alpha1.HostClusterLabel, status.Owner.Cluster)
+	}
+	for _, tag := range status.Tags {
+		key := clusterv1alpha1.HostTagLabelPrefix + tag
+		if len(validation.IsQualifiedName(key)) == 0 {
+			labels[key] = ""
+		}
+	}
+	return labels
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// labelValue turns a MAAS name into a label value, e.g. "Failed deployment"
+// becomes "Failed-deployment". Values which can not be turned into a label
+// value are dropped.
+func labelValue(value string) string {
+	value = invalidLabelChars.ReplaceAllString(value, "-")
+	value = strings.Trim(value, "-_.")
+	if len(validation.IsValidLabelValue(value)) != 0 {
+		return ""
+	}
+	return value
+}
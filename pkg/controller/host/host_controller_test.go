@@ -0,0 +1,125 @@
+package host
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+	"github.com/samsung-cnct/cma-ssh/pkg/util/fakeclient"
+)
+
+// testRegions serves the providers by region name.
+type testRegions map[string]maas.MachineProvider
+
+func (r testRegions) Region(ctx context.Context, name string) (maas.MachineProvider, error) {
+	p, ok := r[name]
+	if !ok {
+		return nil, fmt.Errorf("maas region %s not found", name)
+	}
+	return p, nil
+}
+
+func (r testRegions) Names(ctx context.Context) ([]string, error) {
+	return []string{"", "region-2"}, nil
+}
+
+func getHost(t *testing.T, k8sClient client.Client, name string) *clusterv1alpha1.CnctHost {
+	var host clusterv1alpha1.CnctHost
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Name: name}, &host); err != nil {
+		t.Fatalf("get host %s: %v", name, err)
+	}
+	return &host
+}
+
+func Test_syncer_sync(t *testing.T) {
+	providerID := "cluster-master"
+	k8sClient := fakeclient.New(
+		&clusterv1alpha1.CnctCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "cluster-ns"}},
+		&clusterv1alpha1.CnctMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "master", Namespace: "cluster-ns"},
+			Spec:       clusterv1alpha1.MachineSpec{ProviderID: &providerID},
+		},
+		// the machine of this host was removed from maas
+		&clusterv1alpha1.CnctHost{ObjectMeta: metav1.ObjectMeta{Name: "gone"}},
+	)
+	provider := fake.New(
+		fake.Machine{
+			SystemID:   "abc123",
+			Hostname:   "node-1",
+			Zone:       "rack 1",
+			Pool:       "default",
+			Tags:       []string{"gpu", "invalid tag"},
+			CPUCount:   8,
+			Memory:     16384,
+			Disks:      []maas.Disk{{Name: "sda", Size: 500, Tags: []string{"ssd"}}},
+			Interfaces: []maas.InterfaceAddresses{{Name: "eth0", IPAddresses: []string{"10.0.0.10"}}},
+			Allocated:  true,
+			Deployed:   true,
+			ProviderID: providerID,
+		},
+		fake.Machine{SystemID: "def456", Hostname: "node-2"},
+	)
+	region2 := fake.New(fake.Machine{SystemID: "ghi789", Hostname: "node-3"})
+	s := newSyncer(k8sClient, testRegions{"": provider, "region-2": region2}, time.Minute)
+
+	if err := s.sync(); err != nil {
+		t.Fatalf("sync() error = %v", err)
+	}
+
+	host := getHost(t, k8sClient, "abc123")
+	if host.Status.Hostname != "node-1" || host.Status.Status != maas.StatusDeployed || host.Status.CPUCount != 8 ||
+		len(host.Status.Disks) != 1 || len(host.Status.NICs) != 1 || host.Status.NICs[0].IPAddresses[0] != "10.0.0.10" {
+		t.Errorf("host status = %+v", host.Status)
+	}
+	want := clusterv1alpha1.HostOwner{ProviderID: providerID, Namespace: "cluster-ns", Name: "master", Cluster: "cluster"}
+	if host.Status.Owner == nil || *host.Status.Owner != want {
+		t.Errorf("host owner = %+v, want %+v", host.Status.Owner, want)
+	}
+	wantLabels := map[string]string{
+		clusterv1alpha1.HostZoneLabel:              "rack-1",
+		clusterv1alpha1.HostPoolLabel:              "default",
+		clusterv1alpha1.HostStatusLabel:            "Deployed",
+		clusterv1alpha1.HostClusterLabel:           "cluster",
+		clusterv1alpha1.HostTagLabelPrefix + "gpu": "",
+	}
+	if len(host.Labels) != len(wantLabels) {
+		t.Errorf("host labels = %v, want %v", host.Labels, wantLabels)
+	}
+	for key, value := range wantLabels {
+		if got, ok := host.Labels[key]; !ok || got != value {
+			t.Errorf("host label %s = %q, want %q", key, got, value)
+		}
+	}
+
+	if host := getHost(t, k8sClient, "def456"); host.Status.Status != maas.StatusReady || host.Status.Owner != nil {
+		t.Errorf("ready host status = %+v", host.Status)
+	}
+	if host := getHost(t, k8sClient, "region-2.ghi789"); host.Status.MaasRegion != "region-2" {
+		t.Errorf("region-2 host region = %q", host.Status.MaasRegion)
+	}
+	var gone clusterv1alpha1.CnctHost
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Name: "gone"}, &gone); err == nil {
+		t.Errorf("host of a removed machine was not deleted")
+	}
+
+	// the hosts of a region which can not be listed are kept
+	region2.HostsError = errors.New("region-2 is down")
+	if err := provider.Delete(context.Background(), &maas.DeleteRequest{SystemID: "abc123"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.sync(); err != nil {
+		t.Fatalf("sync() error = %v", err)
+	}
+	getHost(t, k8sClient, "region-2.ghi789")
+	if host := getHost(t, k8sClient, "abc123"); host.Status.Owner != nil || host.Labels[clusterv1alpha1.HostClusterLabel] != "" {
+		t.Errorf("released host still has an owner: %+v", host.Status.Owner)
+	}
+}
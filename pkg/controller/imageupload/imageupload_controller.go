@@ -0,0 +1,335 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package imageupload uploads the node images of CnctImageUpload objects to
+// MAAS as boot resources.
+package imageupload
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/controller/machine"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/util"
+)
+
+var log = logf.Log.WithName("CnctImageUpload-controller")
+
+const (
+	// DefaultArchitecture is the architecture of images which do not set one.
+	DefaultArchitecture = "amd64/generic"
+	// DefaultFileType is the file type of images which do not set one.
+	DefaultFileType = "tgz"
+	// DefaultServerImage is the image of the pods serving tarballs from
+	// persistent volume claims.
+	DefaultServerImage = "busybox:1.30"
+
+	// progressInterval is how often the status of a running upload is
+	// updated.
+	progressInterval = 10 * time.Second
+	// syncInterval is how often MAAS is asked whether it processed an
+	// uploaded image.
+	syncInterval = 30 * time.Second
+)
+
+// Options configures the image uploads.
+type Options struct {
+	// ServerImage is the image of the pods serving tarballs from persistent
+	// volume claims. It must provide busybox httpd.
+	ServerImage string
+}
+
+func (o Options) withDefaults() Options {
+	if o.ServerImage == "" {
+		o.ServerImage = DefaultServerImage
+	}
+	return o
+}
+
+// Add creates a new CnctImageUpload controller and adds it to the Manager.
+func Add(mgr manager.Manager, regions maas.Regions, options Options) error {
+	return add(mgr, newReconciler(mgr.GetClient(), mgr.GetRecorder("ImageUploadController"), regions, options))
+}
+
+func newReconciler(k8sClient client.Client, recorder record.EventRecorder, regions maas.Regions, options Options) *ReconcileImageUpload {
+	return &ReconcileImageUpload{
+		Client:        k8sClient,
+		EventRecorder: recorder,
+		MAAS:          regions,
+		options:       options.withDefaults(),
+		uploads:       map[types.UID]*upload{},
+	}
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	c, err := controller.New("imageupload-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Kind{Type: &clusterv1alpha1.CnctImageUpload{}}, &handler.EnqueueRequestForObject{})
+}
+
+var _ reconcile.Reconciler = &ReconcileImageUpload{}
+
+// ReconcileImageUpload reconciles CnctImageUpload objects. The tarballs are
+// downloaded and uploaded in the background, Reconcile copies the progress
+// of the running uploads to their status.
+type ReconcileImageUpload struct {
+	client.Client
+	record.EventRecorder
+	MAAS    maas.Regions
+	options Options
+
+	mu      sync.Mutex
+	uploads map[types.UID]*upload
+}
+
+// Reconcile starts the upload of new CnctImageUpload objects, follows them
+// until MAAS processed the image and deletes the image from MAAS when the
+// object is deleted.
+// +kubebuilder:rbac:groups=cluster.cnct.sds.samsung.com,resources=cnctimageuploads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cluster.cnct.sds.samsung.com,resources=cnctmaasregions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+func (r *ReconcileImageUpload) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	ctx := context.Background()
+	var image clusterv1alpha1.CnctImageUpload
+	if err := r.Get(ctx, request.NamespacedName, &image); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !image.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, r.retire(ctx, &image)
+	}
+	if !util.ContainsString(image.Finalizers, clusterv1alpha1.ImageUploadFinalizer) {
+		image.Finalizers = append(image.Finalizers, clusterv1alpha1.ImageUploadFinalizer)
+		if err := r.Update(ctx, &image); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	status := image.Status
+	var result reconcile.Result
+	var err error
+	switch image.Status.Phase {
+	case clusterv1alpha1.ImageUploadReady, clusterv1alpha1.ImageUploadFailed:
+		return reconcile.Result{}, nil
+	case clusterv1alpha1.ImageUploadSyncing:
+		result, err = r.checkSync(ctx, &image)
+	default:
+		result, err = r.follow(ctx, &image)
+	}
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if image.Status != status {
+		now := metav1.Now()
+		image.Status.LastUpdated = &now
+		if err := r.Update(ctx, &image); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if phase := image.Status.Phase; phase == clusterv1alpha1.ImageUploadSyncing || phase == clusterv1alpha1.ImageUploadFailed {
+		// the outcome of the upload is recorded, it is not needed anymore
+		r.forget(image.UID)
+	}
+	return result, nil
+}
+
+// follow starts the upload of the image unless it is running, and copies its
+// progress to the status.
+func (r *ReconcileImageUpload) follow(ctx context.Context, image *clusterv1alpha1.CnctImageUpload) (reconcile.Result, error) {
+	if err := Validate(&image.Spec); err != nil {
+		r.fail(image, fmt.Sprintf("invalid image upload: %v", err))
+		return reconcile.Result{}, nil
+	}
+	u, err := r.upload(ctx, image)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	state := u.state()
+	image.Status.Phase = state.phase
+	image.Status.Name = ImageName(&image.Spec)
+	image.Status.Size = state.size
+	image.Status.SHA256 = state.sha256
+	image.Status.Progress = state.progress()
+	if !state.finished {
+		return reconcile.Result{RequeueAfter: progressInterval}, nil
+	}
+	if state.err != nil {
+		r.fail(image, state.err.Error())
+		return reconcile.Result{}, nil
+	}
+	image.Status.Phase = clusterv1alpha1.ImageUploadSyncing
+	image.Status.BootResourceID = state.resource.ID
+	image.Status.Progress = 0
+	r.Eventf(image, "Normal", "Uploaded", "uploaded %s to maas as boot resource %d", image.Status.Name, state.resource.ID)
+	return reconcile.Result{RequeueAfter: syncInterval}, nil
+}
+
+// upload returns the running upload of the image, starting it if needed. An
+// upload interrupted by a restart of the operator starts over.
+func (r *ReconcileImageUpload) upload(ctx context.Context, image *clusterv1alpha1.CnctImageUpload) (*upload, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if u, ok := r.uploads[image.UID]; ok {
+		return u, nil
+	}
+
+	provider, err := r.MAAS.Region(ctx, image.Spec.MaasRegion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get maas region %q", image.Spec.MaasRegion)
+	}
+	uploadCtx, cancel := context.WithCancel(context.Background())
+	u := &upload{cancel: cancel}
+	r.uploads[image.UID] = u
+	log.Info("starting image upload", "image", image.Name, "name", ImageName(&image.Spec))
+	go r.run(uploadCtx, u, image.DeepCopy(), provider)
+	return u, nil
+}
+
+// checkSync moves an uploaded image to the ready phase once MAAS processed
+// it.
+func (r *ReconcileImageUpload) checkSync(ctx context.Context, image *clusterv1alpha1.CnctImageUpload) (reconcile.Result, error) {
+	provider, err := r.MAAS.Region(ctx, image.Spec.MaasRegion)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	state, err := provider.ImageState(ctx, image.Status.BootResourceID)
+	if err == maas.ErrImageNotFound {
+		r.fail(image, fmt.Sprintf("boot resource %d was deleted from maas", image.Status.BootResourceID))
+		return reconcile.Result{}, nil
+	}
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !state.Complete {
+		image.Status.Progress = int(state.Progress)
+		return reconcile.Result{RequeueAfter: syncInterval}, nil
+	}
+	image.Status.Phase = clusterv1alpha1.ImageUploadReady
+	image.Status.Progress = 100
+	r.Eventf(image, "Normal", "Ready", "boot resource %d is ready", image.Status.BootResourceID)
+	return reconcile.Result{}, nil
+}
+
+// retire stops a running upload and deletes the boot resource of a deleted
+// image.
+func (r *ReconcileImageUpload) retire(ctx context.Context, image *clusterv1alpha1.CnctImageUpload) error {
+	if !util.ContainsString(image.Finalizers, clusterv1alpha1.ImageUploadFinalizer) {
+		return nil
+	}
+	r.mu.Lock()
+	if u, ok := r.uploads[image.UID]; ok {
+		u.cancel()
+	}
+	r.mu.Unlock()
+	r.forget(image.UID)
+
+	if image.Status.BootResourceID != 0 {
+		provider, err := r.MAAS.Region(ctx, image.Spec.MaasRegion)
+		if err != nil {
+			return err
+		}
+		if err := provider.DeleteImage(ctx, image.Status.BootResourceID); err != nil {
+			return err
+		}
+		log.Info("deleted boot resource", "image", image.Name, "id", image.Status.BootResourceID)
+	}
+	image.Finalizers = util.RemoveString(image.Finalizers, clusterv1alpha1.ImageUploadFinalizer)
+	return r.Update(ctx, image)
+}
+
+// forget drops a finished or cancelled upload.
+func (r *ReconcileImageUpload) forget(uid types.UID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.uploads, uid)
+}
+
+func (r *ReconcileImageUpload) fail(image *clusterv1alpha1.CnctImageUpload, message string) {
+	log.Info("image upload failed", "image", image.Name, "reason", message)
+	image.Status.Phase = clusterv1alpha1.ImageUploadFailed
+	image.Status.Message = message
+	image.Status.Progress = 0
+	r.Event(image, "Warning", "UploadFailed", message)
+}
+
+// ImageName returns the name of the boot resource of an upload.
+func ImageName(spec *clusterv1alpha1.ImageUploadSpec) string {
+	return machine.ImageName(spec.OSSeries, spec.KubernetesVersion, spec.InstanceType)
+}
+
+// Validate checks that the upload names an image and has exactly one source.
+func Validate(spec *clusterv1alpha1.ImageUploadSpec) error {
+	for _, f := range []struct{ field, value string }{
+		{"osSeries", spec.OSSeries},
+		{"kubernetesVersion", spec.KubernetesVersion},
+		{"instanceType", spec.InstanceType},
+	} {
+		field, value := f.field, f.value
+		if value == "" {
+			return fmt.Errorf("%s is required", field)
+		}
+		if strings.ContainsAny(value, ",=") {
+			return fmt.Errorf("%s %q can not contain ',' or '='", field, value)
+		}
+	}
+
+	source := spec.Source
+	switch {
+	case source.URL != "" && source.Volume != nil:
+		return errors.New("only one of source url and volume can be set")
+	case source.URL != "":
+		u, err := url.Parse(source.URL)
+		if err != nil {
+			return errors.Wrap(err, "invalid source url")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("source url %q is not http or https", source.URL)
+		}
+	case source.Volume != nil:
+		if source.Volume.Namespace == "" || source.Volume.ClaimName == "" || source.Volume.Path == "" {
+			return errors.New("source volume needs a namespace, claimName and path")
+		}
+	default:
+		return errors.New("one of source url and volume is required")
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package imageupload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+	"github.com/samsung-cnct/cma-ssh/pkg/util/fakeclient"
+)
+
+const testContent = "image tarball"
+
+func testServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/xenial-1.13.5.tgz" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(testContent))
+	}))
+}
+
+func checksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func testImage(url string) *clusterv1alpha1.CnctImageUpload {
+	return &clusterv1alpha1.CnctImageUpload{
+		ObjectMeta: metav1.ObjectMeta{Name: "xenial-1.13.5", UID: types.UID("xenial-1.13.5")},
+		Spec: clusterv1alpha1.ImageUploadSpec{
+			OSSeries:          "ubuntu-xenial",
+			KubernetesVersion: "1.13.5",
+			InstanceType:      "standard",
+			Source:            clusterv1alpha1.ImageSource{URL: url},
+		},
+	}
+}
+
+// reconcileUntil reconciles the image until its phase is not one of the
+// running phases.
+func reconcileUntil(t *testing.T, r *ReconcileImageUpload, name string) *clusterv1alpha1.CnctImageUpload {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: name}}
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		if _, err := r.Reconcile(request); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		var image clusterv1alpha1.CnctImageUpload
+		if err := r.Get(context.Background(), request.NamespacedName, &image); err != nil {
+			t.Fatal(err)
+		}
+		switch image.Status.Phase {
+		case clusterv1alpha1.ImageUploadPending, clusterv1alpha1.ImageUploadDownloading, clusterv1alpha1.ImageUploadUploading:
+		default:
+			return &image
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("image is still %q", image.Status.Phase)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestReconcile_upload(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+	k8sClient := fakeclient.New(testImage(server.URL + "/xenial-1.13.5.tgz"))
+	provider := fake.New()
+	r := newReconciler(k8sClient, record.NewFakeRecorder(10), maas.SingleRegion{Provider: provider}, Options{})
+
+	image := reconcileUntil(t, r, "xenial-1.13.5")
+	if image.Status.Phase != clusterv1alpha1.ImageUploadSyncing {
+		t.Fatalf("phase = %q, want %q: %s", image.Status.Phase, clusterv1alpha1.ImageUploadSyncing, image.Status.Message)
+	}
+	if image.Status.SHA256 != checksum(testContent) || image.Status.Size != int64(len(testContent)) {
+		t.Errorf("status size = %d, sha256 = %s", image.Status.Size, image.Status.SHA256)
+	}
+	if image.Status.Name != "os=ubuntu-xenial,k8s=1.13.5,type=standard" {
+		t.Errorf("status name = %q", image.Status.Name)
+	}
+	if content, ok := provider.Upload(image.Status.BootResourceID); !ok || string(content) != testContent {
+		t.Errorf("uploaded content = %q, %t", content, ok)
+	}
+	resources, _ := provider.ListImages(context.Background())
+	if len(resources) != 1 || resources[0].Name != image.Status.Name || resources[0].Architecture != DefaultArchitecture {
+		t.Errorf("boot resources = %+v", resources)
+	}
+
+	provider.SetImageState(image.Status.BootResourceID, maas.ImageState{Progress: 40})
+	image = reconcileUntil(t, r, "xenial-1.13.5")
+	if image.Status.Phase != clusterv1alpha1.ImageUploadSyncing || image.Status.Progress != 40 {
+		t.Errorf("phase = %q, progress = %d, want syncing at 40%%", image.Status.Phase, image.Status.Progress)
+	}
+
+	provider.SetImageState(image.Status.BootResourceID, maas.ImageState{Complete: true, Progress: 100})
+	image = reconcileUntil(t, r, "xenial-1.13.5")
+	if image.Status.Phase != clusterv1alpha1.ImageUploadReady {
+		t.Errorf("phase = %q, want %q", image.Status.Phase, clusterv1alpha1.ImageUploadReady)
+	}
+}
+
+func TestReconcile_failed(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+	tests := []struct {
+		name        string
+		url         string
+		uploadError error
+		wantMessage string
+	}{
+		{name: "invalid", url: "ftp://images/xenial-1.13.5.tgz", wantMessage: "is not http or https"},
+		{name: "download", url: server.URL + "/missing.tgz", wantMessage: "404 Not Found"},
+		{name: "upload", url: server.URL + "/xenial-1.13.5.tgz", uploadError: maas.ErrImageNotFound, wantMessage: "boot resource not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := fakeclient.New(testImage(tt.url))
+			provider := fake.New()
+			provider.UploadImageError = tt.uploadError
+			r := newReconciler(k8sClient, record.NewFakeRecorder(10), maas.SingleRegion{Provider: provider}, Options{})
+
+			image := reconcileUntil(t, r, "xenial-1.13.5")
+			if image.Status.Phase != clusterv1alpha1.ImageUploadFailed || !strings.Contains(image.Status.Message, tt.wantMessage) {
+				t.Errorf("phase = %q, message = %q, want failed with %q", image.Status.Phase, image.Status.Message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestReconcile_retire(t *testing.T) {
+	provider := fake.New()
+	resource, err := provider.UploadImage(context.Background(), &maas.UploadImageRequest{
+		Name:    "os=ubuntu-xenial,k8s=1.13.5,type=standard",
+		Size:    int64(len(testContent)),
+		SHA256:  checksum(testContent),
+		Content: strings.NewReader(testContent),
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := metav1.Now()
+	image := testImage("http://images/xenial-1.13.5.tgz")
+	image.DeletionTimestamp = &now
+	image.Finalizers = []string{clusterv1alpha1.ImageUploadFinalizer}
+	image.Status.Phase = clusterv1alpha1.ImageUploadReady
+	image.Status.BootResourceID = resource.ID
+	k8sClient := fakeclient.New(image)
+	r := newReconciler(k8sClient, record.NewFakeRecorder(10), maas.SingleRegion{Provider: provider}, Options{})
+
+	if _, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: image.Name}}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if resources, _ := provider.ListImages(context.Background()); len(resources) != 0 {
+		t.Errorf("boot resources = %+v, want the image deleted", resources)
+	}
+	var got clusterv1alpha1.CnctImageUpload
+	if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: image.Name}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Finalizers) != 0 {
+		t.Errorf("finalizers = %v, want none", got.Finalizers)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(spec *clusterv1alpha1.ImageUploadSpec)
+		wantErr bool
+	}{
+		{name: "url", modify: func(spec *clusterv1alpha1.ImageUploadSpec) {}},
+		{name: "volume", modify: func(spec *clusterv1alpha1.ImageUploadSpec) {
+			spec.Source = clusterv1alpha1.ImageSource{Volume: &clusterv1alpha1.ImageVolumeSource{Namespace: "images", ClaimName: "images", Path: "xenial.tgz"}}
+		}},
+		{name: "no os series", modify: func(spec *clusterv1alpha1.ImageUploadSpec) { spec.OSSeries = "" }, wantErr: true},
+		{name: "separator in version", modify: func(spec *clusterv1alpha1.ImageUploadSpec) { spec.KubernetesVersion = "1.13.5,gpu" }, wantErr: true},
+		{name: "no source", modify: func(spec *clusterv1alpha1.ImageUploadSpec) { spec.Source.URL = "" }, wantErr: true},
+		{name: "two sources", modify: func(spec *clusterv1alpha1.ImageUploadSpec) {
+			spec.Source.Volume = &clusterv1alpha1.ImageVolumeSource{Namespace: "images", ClaimName: "images", Path: "xenial.tgz"}
+		}, wantErr: true},
+		{name: "incomplete volume", modify: func(spec *clusterv1alpha1.ImageUploadSpec) {
+			spec.Source = clusterv1alpha1.ImageSource{Volume: &clusterv1alpha1.ImageVolumeSource{ClaimName: "images"}}
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := testImage("https://images/xenial-1.13.5.tgz").Spec
+			tt.modify(&spec)
+			if err := Validate(&spec); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
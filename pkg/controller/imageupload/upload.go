@@ -0,0 +1,266 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageupload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+const (
+	// serverPort is the port the pods serving volumes listen on.
+	serverPort = 8080
+	// serverMountPath is where the volume is mounted in the serving pods.
+	serverMountPath = "/image"
+	// serverTimeout is how long a serving pod has to start.
+	serverTimeout = 5 * time.Minute
+)
+
+// upload is an upload running in the background.
+type upload struct {
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+	s  uploadState
+}
+
+// uploadState is the progress of an upload.
+type uploadState struct {
+	phase clusterv1alpha1.ImageUploadPhase
+	// size of the tarball, -1 while it is downloaded from a source which
+	// does not tell
+	size int64
+	// done is the number of bytes downloaded or uploaded in the phase
+	done   int64
+	sha256 string
+
+	finished bool
+	resource *maas.BootResource
+	err      error
+}
+
+// progress returns the progress of the phase in percent.
+func (s uploadState) progress() int {
+	if s.size <= 0 {
+		return 0
+	}
+	return int(s.done * 100 / s.size)
+}
+
+func (u *upload) state() uploadState {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.s
+}
+
+func (u *upload) update(fn func(s *uploadState)) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	fn(&u.s)
+}
+
+// progressWriter counts the bytes written through it.
+type progressWriter struct {
+	u *upload
+}
+
+func (w progressWriter) Write(p []byte) (int, error) {
+	w.u.update(func(s *uploadState) { s.done += int64(len(p)) })
+	return len(p), nil
+}
+
+// run downloads the tarball of the image to a temporary file and uploads it
+// to MAAS.
+func (r *ReconcileImageUpload) run(ctx context.Context, u *upload, image *clusterv1alpha1.CnctImageUpload, provider maas.MachineProvider) {
+	resource, err := r.transfer(ctx, u, image, provider)
+	if err != nil {
+		log.Error(err, "image upload failed", "image", image.Name)
+	}
+	u.update(func(s *uploadState) {
+		s.finished = true
+		s.resource = resource
+		s.err = err
+	})
+}
+
+func (r *ReconcileImageUpload) transfer(ctx context.Context, u *upload, image *clusterv1alpha1.CnctImageUpload, provider maas.MachineProvider) (*maas.BootResource, error) {
+	u.update(func(s *uploadState) {
+		s.phase = clusterv1alpha1.ImageUploadDownloading
+		s.size = -1
+	})
+	sourceURL := image.Spec.Source.URL
+	if volume := image.Spec.Source.Volume; volume != nil {
+		var stop func()
+		var err error
+		sourceURL, stop, err = r.serveVolume(ctx, image)
+		if err != nil {
+			return nil, err
+		}
+		defer stop()
+	}
+
+	file, err := ioutil.TempFile("", "cma-ssh-image-")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create a temporary file for the image")
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+	size, sum, err := download(ctx, u, sourceURL, file)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	u.update(func(s *uploadState) {
+		s.phase = clusterv1alpha1.ImageUploadUploading
+		s.size = size
+		s.done = 0
+		s.sha256 = sum
+	})
+	request := &maas.UploadImageRequest{
+		Name:         ImageName(&image.Spec),
+		Architecture: image.Spec.Architecture,
+		FileType:     image.Spec.FileType,
+		Size:         size,
+		SHA256:       sum,
+		Content:      file,
+	}
+	if request.Architecture == "" {
+		request.Architecture = DefaultArchitecture
+	}
+	if request.FileType == "" {
+		request.FileType = DefaultFileType
+	}
+	return provider.UploadImage(ctx, request, func(uploaded int64) {
+		u.update(func(s *uploadState) { s.done = uploaded })
+	})
+}
+
+// download copies the tarball at sourceURL to file and returns its size and
+// sha256.
+func download(ctx context.Context, u *upload, sourceURL string, file io.Writer) (int64, string, error) {
+	request, err := http.NewRequest(http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	response, err := http.DefaultClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "could not download %s", sourceURL)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return 0, "", fmt.Errorf("could not download %s: %s", sourceURL, response.Status)
+	}
+	u.update(func(s *uploadState) { s.size = response.ContentLength })
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash, progressWriter{u}), response.Body)
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "could not download %s", sourceURL)
+	}
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// serveVolume starts a pod serving the volume of the image over http and
+// returns the url of the tarball and a function deleting the pod. The pod is
+// owned by the CnctImageUpload so it is garbage collected if the operator
+// stops before deleting it.
+func (r *ReconcileImageUpload) serveVolume(ctx context.Context, image *clusterv1alpha1.CnctImageUpload) (string, func(), error) {
+	volume := image.Spec.Source.Volume
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      image.Name + "-image-server",
+			Namespace: volume.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(image, clusterv1alpha1.SchemeGroupVersion.WithKind("CnctImageUpload")),
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:    "server",
+				Image:   r.options.ServerImage,
+				Command: []string{"httpd", "-f", "-p", fmt.Sprint(serverPort), "-h", serverMountPath},
+				Ports:   []corev1.ContainerPort{{ContainerPort: serverPort}},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "image",
+					MountPath: serverMountPath,
+					ReadOnly:  true,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "image",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: volume.ClaimName,
+						ReadOnly:  true,
+					},
+				},
+			}},
+		},
+	}
+	if err := r.Create(ctx, pod); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", nil, errors.Wrapf(err, "could not create pod serving volume %s/%s", volume.Namespace, volume.ClaimName)
+	}
+	stop := func() {
+		if err := r.Delete(context.Background(), pod); err != nil && !apierrors.IsNotFound(err) {
+			log.Error(err, "could not delete image server pod", "pod", pod.Name)
+		}
+	}
+
+	key := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+	err := wait.PollImmediate(2*time.Second, serverTimeout, func() (bool, error) {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if err := r.Get(ctx, key, pod); err != nil {
+			return false, err
+		}
+		if pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded {
+			return false, fmt.Errorf("pod serving volume %s/%s stopped", volume.Namespace, volume.ClaimName)
+		}
+		return pod.Status.Phase == corev1.PodRunning && pod.Status.PodIP != "", nil
+	})
+	if err != nil {
+		stop()
+		return "", nil, errors.Wrapf(err, "pod serving volume %s/%s is not running", volume.Namespace, volume.ClaimName)
+	}
+	return fmt.Sprintf("http://%s:%d%s", pod.Status.PodIP, serverPort, path.Join("/", volume.Path)), stop, nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maascredentials
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+var log = logf.Log.WithName("maas credentials controller")
+
+const (
+	// APIURLKey is the key of the MAAS api url in the credentials secret.
+	APIURLKey = "apiURL"
+	// APIVersionKey is the key of the MAAS api version in the credentials
+	// secret.
+	APIVersionKey = "apiVersion"
+
+	// retryInterval is how often credentials which could not be loaded are
+	// tried again, since MAAS may only have been unreachable.
+	retryInterval = time.Minute
+)
+
+// Options configure the MAAS credentials controller.
+type Options struct {
+	// Secret is the secret holding the MAAS credentials.
+	Secret types.NamespacedName
+	// Defaults holds the api url and version used when the secret does not
+	// set them.
+	Defaults maas.NewClientParams
+	// Provider is swapped to a provider using the credentials every time
+	// the secret changes.
+	Provider *maas.ReloadingProvider
+	// NewProvider creates the provider using the credentials.
+	NewProvider maas.NewProviderFunc
+}
+
+// Add creates a new MAAS credentials Controller and adds it to the Manager.
+// The controller loads the MAAS credentials from a secret and reloads them
+// whenever the secret changes, so the api key can be rotated without
+// restarting cma-ssh.
+func Add(mgr manager.Manager, options Options) error {
+	r := newReconciler(mgr, options)
+
+	c, err := controller.New("maascredentials-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the credentials secret only
+	isSecret := func(meta metav1.Object) bool {
+		return meta.GetNamespace() == options.Secret.Namespace && meta.GetName() == options.Secret.Name
+	}
+	return c.Watch(
+		&source.Kind{Type: &corev1.Secret{}},
+		&handler.EnqueueRequestForObject{},
+		predicate.Funcs{
+			CreateFunc:  func(e event.CreateEvent) bool { return isSecret(e.Meta) },
+			UpdateFunc:  func(e event.UpdateEvent) bool { return isSecret(e.MetaNew) },
+			DeleteFunc:  func(e event.DeleteEvent) bool { return isSecret(e.Meta) },
+			GenericFunc: func(e event.GenericEvent) bool { return isSecret(e.Meta) },
+		},
+	)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager, options Options) *ReconcileCredentials {
+	return &ReconcileCredentials{
+		Client:        mgr.GetClient(),
+		EventRecorder: mgr.GetRecorder("MaasCredentialsController"),
+		options:       options,
+	}
+}
+
+var _ reconcile.Reconciler = &ReconcileCredentials{}
+
+// ReconcileCredentials loads the MAAS credentials from a secret
+type ReconcileCredentials struct {
+	client.Client
+	record.EventRecorder
+	options Options
+
+	// loaded is the resource version of the secret the current credentials
+	// were loaded from.
+	loaded string
+}
+
+// Reconcile loads the credentials of the secret, checks them against MAAS and
+// swaps them into the provider. Invalid credentials are reported with an
+// event on the secret and the previous credentials are kept.
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch
+func (r *ReconcileCredentials) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	var secret corev1.Secret
+	if err := r.Get(context.Background(), request.NamespacedName, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("maas credentials secret not found, keeping the current credentials", "secret", request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if secret.ResourceVersion == r.loaded {
+		return reconcile.Result{}, nil
+	}
+
+	provider, err := r.load(&secret)
+	if err != nil {
+		log.Error(err, "could not load maas credentials", "secret", request.NamespacedName)
+		r.Eventf(&secret, corev1.EventTypeWarning, "InvalidCredentials", "could not load maas credentials: %v", err)
+		return reconcile.Result{RequeueAfter: retryInterval}, nil
+	}
+	r.options.Provider.Swap(provider)
+	r.loaded = secret.ResourceVersion
+	log.Info("loaded maas credentials", "secret", request.NamespacedName)
+	r.Event(&secret, corev1.EventTypeNormal, "CredentialsLoaded", "loaded maas credentials")
+	return reconcile.Result{}, nil
+}
+
+// load creates a provider with the credentials of the secret and makes an
+// authenticated call to check that MAAS accepts them.
+func (r *ReconcileCredentials) load(secret *corev1.Secret) (maas.MachineProvider, error) {
+	params := r.options.Defaults
+	if url, ok := secret.Data[APIURLKey]; ok {
+		params.ApiURL = string(url)
+	}
+	if version, ok := secret.Data[APIVersionKey]; ok {
+		params.ApiVersion = string(version)
+	}
+	apiKey, ok := secret.Data[clusterv1alpha1.DefaultMaasAPIKeySecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret has no %s", clusterv1alpha1.DefaultMaasAPIKeySecretKey)
+	}
+	params.ApiKey = string(apiKey)
+	if params.ApiURL == "" {
+		return nil, fmt.Errorf("secret has no %s and no default maas api url is set", APIURLKey)
+	}
+
+	provider, err := r.options.NewProvider(&params)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := provider.Zones(context.Background()); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
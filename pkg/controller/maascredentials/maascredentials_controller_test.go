@@ -0,0 +1,114 @@
+package maascredentials
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	maasfake "github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+)
+
+var secretName = types.NamespacedName{Namespace: "cma-ssh", Name: "maas-credentials"}
+
+func testReconciler(secret *corev1.Secret, newProvider maas.NewProviderFunc) *ReconcileCredentials {
+	return &ReconcileCredentials{
+		Client:        fakeclient.NewFakeClient(secret),
+		EventRecorder: record.NewFakeRecorder(10),
+		options: Options{
+			Secret:      secretName,
+			Defaults:    maas.NewClientParams{ApiURL: "http://maas/MAAS", ApiVersion: "2.0"},
+			Provider:    &maas.ReloadingProvider{},
+			NewProvider: newProvider,
+		},
+	}
+}
+
+func testSecret(apiKey, resourceVersion string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretName.Namespace, Name: secretName.Name, ResourceVersion: resourceVersion},
+		Data:       map[string][]byte{"apiKey": []byte(apiKey)},
+	}
+}
+
+func TestReconcileCredentials_Reconcile(t *testing.T) {
+	providers := map[string]*maasfake.Provider{
+		"valid":    maasfake.New(maasfake.Machine{SystemID: "a", Zone: "valid"}),
+		"rotated":  maasfake.New(maasfake.Machine{SystemID: "a", Zone: "rotated"}),
+		"rejected": {ZonesError: errors.New("401 unauthorized")},
+	}
+	var params []maas.NewClientParams
+	newProvider := func(p *maas.NewClientParams) (maas.MachineProvider, error) {
+		params = append(params, *p)
+		provider, ok := providers[p.ApiKey]
+		if !ok {
+			return nil, errors.New("invalid api key")
+		}
+		return provider, nil
+	}
+	r := testReconciler(testSecret("valid", "1"), newProvider)
+	request := reconcile.Request{NamespacedName: secretName}
+	zone := func() string {
+		zones, err := r.options.Provider.Zones(context.Background())
+		if err != nil {
+			return err.Error()
+		}
+		return zones[0]
+	}
+
+	if got := zone(); got != maas.ErrNoCredentials.Error() {
+		t.Fatalf("zone before load = %q", got)
+	}
+	if _, err := r.Reconcile(request); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if got := zone(); got != "valid" {
+		t.Errorf("zone = %q, want the valid credentials", got)
+	}
+	if params[0].ApiURL != "http://maas/MAAS" || params[0].ApiVersion != "2.0" {
+		t.Errorf("params = %+v, want the default api url and version", params[0])
+	}
+
+	// invalid keys keep the loaded credentials
+	for key, version := range map[string]string{"invalid": "2", "rejected": "3"} {
+		if err := r.Update(context.Background(), testSecret(key, version)); err != nil {
+			t.Fatal(err)
+		}
+		result, err := r.Reconcile(request)
+		if err != nil || result.RequeueAfter != retryInterval {
+			t.Errorf("Reconcile(%s) = %v, %v, want a retry", key, result, err)
+		}
+		if got := zone(); got != "valid" {
+			t.Errorf("zone = %q after %s credentials, want the valid credentials", got, key)
+		}
+	}
+
+	if err := r.Update(context.Background(), testSecret("rotated", "4")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reconcile(request); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if got := zone(); got != "rotated" {
+		t.Errorf("zone = %q, want the rotated credentials", got)
+	}
+
+	events := r.EventRecorder.(*record.FakeRecorder).Events
+	var warnings int
+	for len(events) > 0 {
+		if strings.HasPrefix(<-events, corev1.EventTypeWarning) {
+			warnings++
+		}
+	}
+	if warnings != 2 {
+		t.Errorf("got %d warning events, want 2", warnings)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/record"
@@ -25,6 +26,7 @@ import (
 	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
 	"github.com/samsung-cnct/cma-ssh/pkg/cert"
 	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/ssh"
 	"github.com/samsung-cnct/cma-ssh/pkg/util"
 )
 
@@ -48,17 +50,6 @@ func (e unrecoverableError) Error() string {
 	return e.reason
 }
 
-// releaseError signals that the controller must release a machine that has been
-// allocated.
-type releaseError struct {
-	systemID string
-	err      error
-}
-
-func (e releaseError) Error() string {
-	return e.err.Error()
-}
-
 type clientEventer interface {
 	client.Client
 	record.EventRecorder
@@ -66,7 +57,8 @@ type clientEventer interface {
 
 type creator struct {
 	k8sClient  clientEventer
-	maasClient *maas.Client
+	regions    maas.Regions
+	maasClient maas.MachineProvider
 	machine    *clusterv1alpha1.CnctMachine
 	err        error
 
@@ -78,39 +70,100 @@ type creator struct {
 	token          string
 	createRequest  maas.CreateRequest
 	createResponse maas.CreateResponse
+	// joinControlPlane is set for the masters joining the control plane of
+	// the master which ran kubeadm init.
+	joinControlPlane bool
+	// isEtcd is set for the dedicated etcd machines, joinEtcd for those
+	// joining the etcd cluster of the init etcd machine.
+	isEtcd   bool
+	joinEtcd bool
+	// etcdEndpoints are the client urls of the ready etcd machines.
+	etcdEndpoints []string
+	// nodeIP is the address of the deployed machine used as node ip, ssh
+	// host and api endpoint.
+	nodeIP string
+
+	// dialer and sshHost are set for machines bootstrapped on ssh hosts
+	dialer  ssh.Dialer
+	sshHost clusterv1alpha1.CnctSshHost
 }
 
-func create(k8sClient clientEventer, maasClient *maas.Client, machine *clusterv1alpha1.CnctMachine) error {
-	log.Info("checking if machine is master")
-	var isMaster bool
-	for _, v := range machine.Spec.Roles {
-		if v == common.MachineRoleMaster {
-			isMaster = true
-			break
-		}
+func create(k8sClient clientEventer, regions maas.Regions, machine *clusterv1alpha1.CnctMachine) error {
+	c := &creator{k8sClient: k8sClient, regions: regions, machine: machine}
+	c.isMaster = isMaster(machine)
+	c.isEtcd = isEtcdMachine(machine)
+	c.getCluster()
+	c.electInitMaster()
+	c.electInitEtcd()
+	c.checkEtcdMembers()
+	c.getMaasClient()
+	c.getSecret()
+	c.createClientsetFromSecret()
+	c.checkIfTokenExists()
+	c.createToken()
+	c.checkApiserverAddress()
+	c.setProviderID()
+	c.prepareMaasRequest()
+	c.doMaasCreate()
+	c.markDeploying()
+	return c.err
+}
+
+// initsControlPlane reports whether the machine runs kubeadm init, as opposed
+// to a worker or a master joining the control plane.
+func (c *creator) initsControlPlane() bool {
+	return c.isMaster && !c.joinControlPlane
+}
+
+// joinsKubernetes reports whether the machine joins the kubernetes cluster
+// with a bootstrap token, as opposed to the init master and the etcd machines.
+func (c *creator) joinsKubernetes() bool {
+	return !c.initsControlPlane() && !c.isEtcd
+}
+
+// joinsControlPlane reports whether the master joins the control plane of
+// another master. Masters of clusters which got their api endpoint before the
+// init master was recorded join as well.
+func joinsControlPlane(cluster *clusterv1alpha1.CnctCluster, machine *clusterv1alpha1.CnctMachine) bool {
+	if !isMaster(machine) {
+		return false
 	}
-	c := &creator{k8sClient: k8sClient, maasClient: maasClient, machine: machine}
-	c.isMaster = isMaster
-	if isMaster {
-		c.getCluster()
-		c.getSecret()
-		c.prepareMaasRequest()
-		c.doMaasCreate()
-		c.createKubeconfig()
-		c.updateCluster()
-		c.updateMachine()
-	} else {
-		c.getCluster()
-		c.getSecret()
-		c.createClientsetFromSecret()
-		c.checkIfTokenExists()
-		c.createToken()
-		c.checkApiserverAddress()
-		c.prepareMaasRequest()
-		c.doMaasCreate()
-		c.updateMachine()
+	initMaster := cluster.Status.InitMaster
+	return initMaster != machine.Name && (initMaster != "" || cluster.Status.APIEndpoint != "")
+}
+
+// electInitMaster records the first master created on the cluster as the one
+// running kubeadm init. A master deleted before the control plane came up is
+// replaced by the next master created. The update fails on a conflict when
+// another master was elected concurrently and the request is retried.
+func (c *creator) electInitMaster() {
+	if c.err != nil || !c.isMaster {
+		return
 	}
-	return c.err
+
+	status := c.cluster.Status
+	if status.InitMaster != c.machine.Name && status.APIEndpoint == "" {
+		elect := status.InitMaster == ""
+		if !elect {
+			var initMaster clusterv1alpha1.CnctMachine
+			err := c.k8sClient.Get(context.Background(), client.ObjectKey{Namespace: c.machine.Namespace, Name: status.InitMaster}, &initMaster)
+			if err != nil && !apierrors.IsNotFound(err) {
+				c.err = err
+				return
+			}
+			elect = apierrors.IsNotFound(err) || !initMaster.DeletionTimestamp.IsZero()
+		}
+		if elect {
+			log.Info("electing init master", "machine", c.machine.Name)
+			c.cluster.Status.InitMaster = c.machine.Name
+			c.cluster.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+			if err := c.k8sClient.Update(context.Background(), &c.cluster); err != nil {
+				c.err = errors.Wrap(err, "could not record init master")
+				return
+			}
+		}
+	}
+	c.joinControlPlane = joinsControlPlane(&c.cluster, c.machine)
 }
 
 func (c *creator) getCluster() {
@@ -137,6 +190,31 @@ func (c *creator) getCluster() {
 	c.cluster = clusters.Items[0]
 }
 
+// getMaasClient gets the provider of the maas region of the cluster.
+func (c *creator) getMaasClient() {
+	if c.err != nil {
+		return
+	}
+
+	c.maasClient, c.err = c.regions.Region(context.Background(), c.cluster.Spec.MaasRegion)
+}
+
+// setProviderID generates the ProviderID of the machine and persists it before
+// anything is allocated in MAAS. The ProviderID is recorded on the MAAS machine
+// during allocation so that if we fail before markDeploying the machine is
+// adopted on the next reconcile instead of leaked. Since the initial sync of
+// the informer reconciles every machine this also covers operator restarts.
+func (c *creator) setProviderID() {
+	if c.err != nil || c.machine.Spec.ProviderID != nil {
+		return
+	}
+
+	log.Info("setting machine provider id")
+	providerID := string(uuid.NewUUID())
+	c.machine.Spec.ProviderID = &providerID
+	c.err = c.k8sClient.Update(context.Background(), c.machine)
+}
+
 func (c *creator) getSecret() {
 	if c.err != nil {
 		return
@@ -146,7 +224,7 @@ func (c *creator) getSecret() {
 }
 
 func (c *creator) createClientsetFromSecret() {
-	if c.err != nil || c.isMaster {
+	if c.err != nil || !c.joinsKubernetes() {
 		return
 	}
 
@@ -170,7 +248,7 @@ func (c *creator) createClientsetFromSecret() {
 }
 
 func (c *creator) checkIfTokenExists() {
-	if c.err != nil || c.isMaster {
+	if c.err != nil || !c.joinsKubernetes() {
 		return
 	}
 	log.Info("checking for existing tokens on managed cluster")
@@ -205,7 +283,7 @@ func (c *creator) checkIfTokenExists() {
 }
 
 func (c *creator) createToken() {
-	if c.err != nil || c.isMaster || c.token != "" {
+	if c.err != nil || !c.joinsKubernetes() || c.token != "" {
 		return
 	}
 
@@ -250,8 +328,12 @@ func createBootstrapToken(clientset *kubernetes.Clientset, tokenID, tokenSecret
 	return nil
 }
 
+// checkApiserverAddress waits for the api endpoint of the cluster. Masters
+// join the control plane one at a time: the stacked etcd member added by a
+// join leaves etcd without quorum until it has started, so a second join in
+// the meantime can lose the quorum.
 func (c *creator) checkApiserverAddress() {
-	if c.err != nil || c.isMaster {
+	if c.err != nil || !c.joinsKubernetes() {
 		return
 	}
 
@@ -261,6 +343,24 @@ func (c *creator) checkApiserverAddress() {
 		c.err = notReadyError(fmt.Sprintf("%s cluster APIEndpoint is not set", c.cluster.Name))
 		return
 	}
+	if !c.joinControlPlane {
+		return
+	}
+
+	var machines clusterv1alpha1.CnctMachineList
+	if err := c.k8sClient.List(context.Background(), &client.ListOptions{Namespace: c.machine.Namespace}, &machines); err != nil {
+		c.err = errors.Wrap(err, "could not list machines")
+		return
+	}
+	for _, m := range machines.Items {
+		if m.Name == c.machine.Name || !isMaster(&m) || !m.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if provisioned(&m) && m.Status.Phase != common.ReadyMachinePhase {
+			c.err = notReadyError(fmt.Sprintf("master %s is joining the control plane", m.Name))
+			return
+		}
+	}
 }
 
 func (c *creator) getNodeLabels() string {
@@ -281,33 +381,251 @@ func (c *creator) prepareMaasRequest() {
 	}
 
 	log.Info("preparing maas request")
+	network := MaasNetwork(c.machine.Spec.Network)
+	if err := network.Validate(); err != nil {
+		c.err = unrecoverableError{reason: fmt.Sprintf("invalid machine network: %v", err)}
+		return
+	}
+	storage := MaasStorage(c.machine.Spec.Storage)
+	if err := storage.Validate(); err != nil {
+		c.err = unrecoverableError{reason: fmt.Sprintf("invalid machine storage: %v", err)}
+		return
+	}
 	var bundle *cert.CABundle
 	bundle, c.err = cert.CABundleFromMap(c.secret.Data)
 	if c.err != nil {
 		return
 	}
 	var userdata string
-	if c.isMaster {
-		userdata, c.err = masterUserdata(c, bundle)
-	} else {
-		userdata, c.err = workerUserdata(c, bundle)
-	}
-	// TODO: ProviderID should be unique. One way to ensure this is to generate
-	// a UUID. Cf. k8s.io/apimachinery/pkg/util/uuid
-	providerID := fmt.Sprintf("%s-%s", c.cluster.Name, c.machine.Name)
-	distro := getImage(c.maasClient, "ubuntu-xenial", c.cluster.Spec.KubernetesVersion, c.machine.Spec.InstanceType)
+	userdata, c.err = c.userdata(bundle)
+	kind := MachineImageKind(c.cluster.Spec, c.machine.Spec)
+	distro, err := getImage(c.maasClient, kind.OSSeries, c.cluster.Spec.KubernetesVersion, kind.InstanceType)
+	if err != nil {
+		c.err = notReadyError(fmt.Sprintf("could not list MaaS images: %v", err))
+		return
+	}
 	if distro == "" {
-		c.err = unrecoverableError{reason: fmt.Sprintf("there is no matching image in MaaS: osVersion=%s, k8sVersion=%s, instanceType=%s", "ubuntu-xenial", c.cluster.Spec.KubernetesVersion, c.machine.Spec.InstanceType)}
+		c.err = unrecoverableError{reason: fmt.Sprintf("there is no matching image in MaaS: osSeries=%s, k8sVersion=%s, instanceType=%s", kind.OSSeries, c.cluster.Spec.KubernetesVersion, kind.InstanceType)}
+		return
+	}
+	constraints := MaasConstraints(c.machine.Spec.Constraints)
+	if err := constraints.Validate(); err != nil {
+		c.err = unrecoverableError{reason: fmt.Sprintf("invalid machine constraints: %v", err)}
 		return
 	}
 	c.createRequest = maas.CreateRequest{
-		ProviderID:   providerID,
+		ProviderID:   *c.machine.Spec.ProviderID,
 		Distro:       distro,
 		Userdata:     userdata,
 		InstanceType: c.machine.Spec.InstanceType,
+		Constraints:  constraints,
+		Network:      network,
+		Storage:      storage,
 	}
 }
 
+// MaasConstraints converts the constraints of a machine spec to the MAAS
+// allocation constraints.
+func MaasConstraints(in *clusterv1alpha1.MachineConstraints) maas.Constraints {
+	if in == nil {
+		return maas.Constraints{}
+	}
+	out := maas.Constraints{
+		MinCPUCount:  in.MinCPUCount,
+		MinMemory:    in.MinMemory,
+		Architecture: in.Architecture,
+		Zone:         in.Zone,
+		Pool:         in.Pool,
+		Tags:         in.Tags,
+		NotTags:      in.NotTags,
+	}
+	for _, s := range in.Storage {
+		out.Storage = append(out.Storage, maas.StorageConstraint{
+			Label: s.Label,
+			Size:  s.Size,
+			Tags:  s.Tags,
+		})
+	}
+	for _, i := range in.Interfaces {
+		out.Interfaces = append(out.Interfaces, maas.InterfaceConstraint{
+			Label:  i.Label,
+			Space:  i.Space,
+			Subnet: i.Subnet,
+			Fabric: i.Fabric,
+			VID:    i.VID,
+		})
+	}
+	return out
+}
+
+// MaasNetwork converts the network of a machine spec to the MAAS network.
+func MaasNetwork(in *clusterv1alpha1.MachineNetwork) *maas.Network {
+	if in == nil {
+		return nil
+	}
+	out := &maas.Network{}
+	for _, b := range in.Bonds {
+		out.Bonds = append(out.Bonds, maas.Bond{
+			Name:    b.Name,
+			Parents: b.Parents,
+			Mode:    b.Mode,
+		})
+	}
+	for _, i := range in.Interfaces {
+		mode := maas.IPMode(i.Mode)
+		switch i.Mode {
+		case clusterv1alpha1.AutoIPMode:
+			mode = maas.IPModeAuto
+		case clusterv1alpha1.StaticIPMode:
+			mode = maas.IPModeStatic
+		case clusterv1alpha1.DHCPIPMode:
+			mode = maas.IPModeDHCP
+		case clusterv1alpha1.LinkUpIPMode:
+			mode = maas.IPModeLinkUp
+		}
+		out.Interfaces = append(out.Interfaces, maas.Interface{
+			Name:      i.Name,
+			VLAN:      i.VLAN,
+			Subnet:    i.Subnet,
+			Mode:      mode,
+			IPAddress: i.IPAddress,
+			NodeIP:    i.NodeIP,
+		})
+	}
+	return out
+}
+
+// MaasStorage converts the storage of a machine spec to the MAAS storage
+// layout.
+func MaasStorage(in *clusterv1alpha1.MachineStorage) *maas.Storage {
+	if in == nil {
+		return nil
+	}
+	profile := maas.StorageProfile(in.Profile)
+	switch in.Profile {
+	case clusterv1alpha1.FlatStorageProfile:
+		profile = maas.StorageFlat
+	case clusterv1alpha1.LVMStorageProfile:
+		profile = maas.StorageLVM
+	case clusterv1alpha1.BcacheStorageProfile:
+		profile = maas.StorageBcache
+	case clusterv1alpha1.RAID1StorageProfile:
+		profile = maas.StorageRAID1
+	}
+	out := &maas.Storage{Profile: profile}
+	for _, d := range in.DedicatedDisks {
+		out.DedicatedDisks = append(out.DedicatedDisks, maas.DedicatedDisk{
+			MountPoint: d.MountPoint,
+			Name:       d.Name,
+			Tags:       d.Tags,
+		})
+	}
+	return out
+}
+
+// statusBlockDevices converts the MAAS block devices to the machine status.
+func statusBlockDevices(in []maas.BlockDevice) []clusterv1alpha1.BlockDevice {
+	var out []clusterv1alpha1.BlockDevice
+	for _, d := range in {
+		out = append(out, clusterv1alpha1.BlockDevice{
+			Name:        d.Name,
+			Model:       d.Model,
+			Size:        d.Size,
+			Tags:        d.Tags,
+			UsedFor:     d.UsedFor,
+			MountPoints: d.MountPoints,
+		})
+	}
+	return out
+}
+
+// nodeIPPlaceholder is replaced by the address of the node interface when
+// the address is only known once the machine has booted.
+const nodeIPPlaceholder = "__NODE_IP__"
+
+// nodeIPTmplText defines the userdata setting the node ip of the kubelet and,
+// on masters, the advertise address of the apiserver. NodeIP is either the
+// static address of the node interface or nodeIPPlaceholder, which node-ip.sh
+// replaces with the address of NodeInterface before kubeadm runs. Without a
+// NodeInterface node-ip.sh replaces it with the source address of the default
+// route, the address kubeadm advertises by default.
+const nodeIPTmplText = `
+{{- define "nodeIPScript" }}
+{{- if .ResolveNodeIP }}
+ - owner: root:root
+   path: /var/tmp/node-ip.sh
+   permissions: '0755'
+   content: |
+     #!/bin/sh
+     if [ -n "$1" ]; then
+       ip=$(ip -4 -o addr show dev "$1" scope global | awk '{split($4, a, "/"); print a[1]; exit}')
+     else
+       ip=$(ip -4 route get 1.1.1.1 | awk '{for (i = 1; i < NF; i++) if ($i == "src") {print $(i + 1); exit}}')
+     fi
+     if [ -z "$ip" ]; then
+       echo "could not find the node ip${1:+ of interface $1}" >&2
+       exit 1
+     fi
+     sed -i "s/` + nodeIPPlaceholder + `/$ip/g" "$2"
+{{- end }}
+{{- end }}
+{{- define "nodeIPArg" }}
+{{- if .NodeIP }}
+         node-ip: {{ .NodeIP }}
+{{- end }}
+{{- end }}
+{{- define "nodeIPRun" }}
+{{- if .ResolveNodeIP }}
+ - [ sh, -c, "/var/tmp/node-ip.sh {{ with .NodeInterface }}{{ . }}{{ else }}''{{ end }} {{ .Config }}" ]
+{{- end }}
+{{- end }}
+`
+
+// nodeIPUserdata returns the node ip and, if the address is assigned when the
+// machine boots, the name of the node interface.
+func nodeIPUserdata(machine *clusterv1alpha1.CnctMachine) (nodeIP, nodeInterface string) {
+	i := MaasNetwork(machine.Spec.Network).NodeInterface()
+	if i == nil {
+		return "", ""
+	}
+	if i.Mode == maas.IPModeStatic {
+		return i.IPAddress, ""
+	}
+	return nodeIPPlaceholder, i.InterfaceName()
+}
+
+// userdata returns the cloud-config bootstrapping the machine with kubeadm.
+func (c *creator) userdata(bundle *cert.CABundle) (string, error) {
+	if err := ValidateControlPlaneEndpoint(c.cluster.Spec.ControlPlaneEndpoint); err != nil {
+		return "", unrecoverableError{reason: fmt.Sprintf("invalid cluster control plane endpoint: %v", err)}
+	}
+	switch {
+	case c.isEtcd:
+		return etcdUserdata(c, bundle)
+	case c.joinControlPlane:
+		return joinMasterUserdata(c, bundle)
+	case c.isMaster:
+		return masterUserdata(c, bundle)
+	default:
+		return workerUserdata(c, bundle)
+	}
+}
+
+// nodeIPData is the node ip of the machine as used by nodeIPTmplText.
+type nodeIPData struct {
+	NodeIP        string
+	NodeInterface string
+	ResolveNodeIP bool
+	Config        string
+}
+
+func newNodeIPData(machine *clusterv1alpha1.CnctMachine, config string) nodeIPData {
+	data := nodeIPData{Config: config}
+	data.NodeIP, data.NodeInterface = nodeIPUserdata(machine)
+	data.ResolveNodeIP = data.NodeIP == nodeIPPlaceholder
+	return data
+}
+
 const masterUserdataTmplText = `#cloud-config
 write_files:
  - encoding: b64
@@ -315,48 +633,84 @@ write_files:
    owner: root:root
    path: /etc/kubernetes/pki/certs.tar
    permissions: '0600'
+{{- template "nodeIPScript" . }}
+{{- template "vipFiles" . }}
  - owner: root:root
    path: /var/tmp/masterconfig.yaml
    permissions: '0644'
    content: |
      apiVersion: kubeadm.k8s.io/v1beta1
      kind: InitConfiguration
+{{- if .NodeIP }}
+     localAPIEndpoint:
+       advertiseAddress: {{ .NodeIP }}
+{{- end }}
      nodeRegistration:
        kubeletExtraArgs:
          node-labels: {{ .NodeLabels }}
+{{- template "nodeIPArg" . }}
      ---
      apiVersion: kubeadm.k8s.io/v1beta1
      kind: ClusterConfiguration
+     controlPlaneEndpoint: {{ .ControlPlaneEndpoint }}
+{{- template "etcdExternal" . }}
      networking:
        podSubnet: "10.244.0.0/16"
+{{- template "etcdSyncFiles" . }}
 
 runcmd:
  - [ sh, -c, "swapoff -a" ]
  - [ sh, -c, "sed -ri.bak '/ swap / s/^(.*)$/#\\1/g' /etc/fstab" ]
  - [ sh, -c, "tar xf /etc/kubernetes/pki/certs.tar -C /etc/kubernetes/pki" ]
- - [ sh, -c, "kubeadm init --node-name {{ .Name }}  --config /var/tmp/masterconfig.yaml" ]
+{{- template "nodeIPRun" . }}
+{{- template "vipRun" . }}
+ - [ sh, -c, "kubeadm init --node-name {{ .Name }}  --config /var/tmp/masterconfig.yaml{{ template "vipPreflight" . }}" ]
  - [ sh, -c, "kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f https://raw.githubusercontent.com/coreos/flannel/master/Documentation/kube-flannel.yml" ]
  - [ sh, -c, "kubectl --kubeconfig /etc/kubernetes/admin.conf taint node {{ .Name }} node-role.kubernetes.io/master:NoSchedule-" ]
+{{- template "etcdSyncRun" . }}
 
 output : { all : '| tee -a /var/log/cloud-init-output.log' }
 `
 
-var masterUserdataTmpl = template.Must(template.New("master").Parse(masterUserdataTmplText))
+var masterUserdataTmpl = template.Must(template.Must(template.Must(template.Must(template.New("master").Parse(nodeIPTmplText)).Parse(vipTmplText)).Parse(etcdTmplText)).Parse(masterUserdataTmplText))
 
+// masterUserdata returns the userdata of the master running kubeadm init. The
+// control plane endpoint is the one of the cluster spec or else the address of
+// the master so that the masters joining later can reach it, it is resolved on
+// boot if it is not static. With an external etcd the master is a client of
+// the ready etcd machines, and of those added later, see etcdTmplText.
 func masterUserdata(c *creator, bundle *cert.CABundle) (string, error) {
-	caTar, err := bundle.ToTar()
+	caTar, err := c.masterTar(bundle)
 	if err != nil {
 		return "", err
 	}
 	var userdata strings.Builder
 	data := struct {
-		Name       string
-		Tar        string
-		NodeLabels string
+		nodeIPData
+		Name                 string
+		Tar                  string
+		NodeLabels           string
+		ControlPlaneEndpoint string
+		VIP                  *vipData
+		EtcdEndpoints        []string
+		ExternalEtcd         bool
 	}{
-		Name:       c.machine.Name,
-		Tar:        caTar,
-		NodeLabels: c.getNodeLabels(),
+		nodeIPData:           newNodeIPData(c.machine, "/var/tmp/masterconfig.yaml"),
+		Name:                 c.machine.Name,
+		Tar:                  caTar,
+		NodeLabels:           c.getNodeLabels(),
+		ControlPlaneEndpoint: controlPlaneEndpoint(c.cluster.Spec),
+		VIP:                  newVIPData(c.cluster.Spec, c.machine),
+		EtcdEndpoints:        c.etcdEndpoints,
+		ExternalEtcd:         c.externalEtcd(),
+	}
+	switch {
+	case data.ControlPlaneEndpoint != "":
+	case data.NodeIP == "":
+		data.ResolveNodeIP = true
+		data.ControlPlaneEndpoint = nodeIPPlaceholder + ":6443"
+	default:
+		data.ControlPlaneEndpoint = data.NodeIP + ":6443"
 	}
 	if err := masterUserdataTmpl.Execute(&userdata, data); err != nil {
 		return "", err
@@ -364,8 +718,116 @@ func masterUserdata(c *creator, bundle *cert.CABundle) (string, error) {
 	return userdata.String(), nil
 }
 
+const joinMasterUserdataTmplText = `#cloud-config
+write_files:
+ - encoding: b64
+   content: {{ .Tar }}
+   owner: root:root
+   path: /etc/kubernetes/pki/certs.tar
+   permissions: '0600'
+{{- template "nodeIPScript" . }}
+{{- template "vipFiles" . }}
+ - owner: root:root
+   path: /var/tmp/masterconfig.yaml
+   permissions: '0644'
+   content: |
+     apiVersion: kubeadm.k8s.io/v1beta1
+     kind: JoinConfiguration
+     discovery:
+       bootstrapToken:
+         apiServerEndpoint: {{ .APIEndpoint }}
+         token: {{ .Token }}
+         caCertHashes:
+         - {{ .CertHash }}
+       tlsBootstrapToken: {{ .Token }}
+{{- if .NodeIP }}
+     controlPlane:
+       localAPIEndpoint:
+         advertiseAddress: {{ .NodeIP }}
+{{- else }}
+     controlPlane: {}
+{{- end }}
+     nodeRegistration:
+       kubeletExtraArgs:
+         node-labels: {{ .NodeLabels }}
+{{- template "nodeIPArg" . }}
+{{- template "etcdSyncFiles" . }}
+
+runcmd:
+ - [ sh, -c, "swapoff -a" ]
+ - [ sh, -c, "sed -ri.bak '/ swap / s/^(.*)$/#\\1/g' /etc/fstab" ]
+ - [ sh, -c, "tar xf /etc/kubernetes/pki/certs.tar -C /etc/kubernetes/pki" ]
+{{- template "nodeIPRun" . }}
+{{- template "vipRun" . }}
+ - [ sh, -c, "kubeadm join --node-name {{ .Name }} --config /var/tmp/masterconfig.yaml{{ template "vipPreflight" . }}" ]
+ - [ sh, -c, "kubectl --kubeconfig /etc/kubernetes/admin.conf taint node {{ .Name }} node-role.kubernetes.io/master:NoSchedule-" ]
+{{- template "etcdSyncRun" . }}
+
+output : { all : '| tee -a /var/log/cloud-init-output.log' }
+`
+
+var joinMasterUserdataTmpl = template.Must(template.Must(template.Must(template.Must(template.New("joinMaster").Parse(nodeIPTmplText)).Parse(vipTmplText)).Parse(etcdTmplText)).Parse(joinMasterUserdataTmplText))
+
+// masterTar returns the certificates of a master, with the etcd client
+// certificate of its apiserver if the etcd is external.
+func (c *creator) masterTar(bundle *cert.CABundle) (string, error) {
+	if !c.externalEtcd() {
+		return bundle.ToTar()
+	}
+	files, err := etcdClientFiles(bundle)
+	if err != nil {
+		return "", err
+	}
+	return bundle.ToTar(files...)
+}
+
+// joinMasterUserdata returns the userdata of a master joining the control
+// plane of the init master. The controlPlane section of the JoinConfiguration
+// is the equivalent of kubeadm join --control-plane, the master runs an
+// apiserver and a member of the stacked etcd with the shared certificates.
+func joinMasterUserdata(c *creator, bundle *cert.CABundle) (string, error) {
+	if bundle.ServiceAccount == nil {
+		return "", unrecoverableError{reason: "the cert bundle of the cluster has no service account key, masters cannot join its control plane"}
+	}
+	caTar, err := c.masterTar(bundle)
+	if err != nil {
+		return "", err
+	}
+	caHash, err := caCertHash(bundle)
+	if err != nil {
+		return "", err
+	}
+	var userdata strings.Builder
+	data := struct {
+		nodeIPData
+		Name         string
+		Tar          string
+		Token        string
+		CertHash     string
+		APIEndpoint  string
+		NodeLabels   string
+		VIP          *vipData
+		ExternalEtcd bool
+	}{
+		nodeIPData:   newNodeIPData(c.machine, "/var/tmp/masterconfig.yaml"),
+		Name:         c.machine.Name,
+		Tar:          caTar,
+		Token:        c.token,
+		CertHash:     caHash,
+		APIEndpoint:  c.cluster.Status.APIEndpoint,
+		NodeLabels:   c.getNodeLabels(),
+		VIP:          newVIPData(c.cluster.Spec, c.machine),
+		ExternalEtcd: c.externalEtcd(),
+	}
+	if err := joinMasterUserdataTmpl.Execute(&userdata, data); err != nil {
+		return "", err
+	}
+	return userdata.String(), nil
+}
+
 const workerUserdataTmplText = `#cloud-config
 write_files:
+{{- template "nodeIPScript" . }}
  - owner: root:root
    path: /var/tmp/workerconfig.yaml
    permissions: '0644'
@@ -383,33 +845,46 @@ write_files:
      nodeRegistration:
        kubeletExtraArgs:
          node-labels: {{ .NodeLabels }}
+{{- template "nodeIPArg" . }}
 
 runcmd:
  - [ sh, -c, "swapoff -a" ]
  - [ sh, -c, "sed -ri.bak '/ swap / s/^(.*)$/#\\1/g' /etc/fstab" ]
+{{- template "nodeIPRun" . }}
  - [ sh, -c, "kubeadm join --node-name {{ .Name }} --config /var/tmp/workerconfig.yaml" ]
 
 output : { all : '| tee -a /var/log/cloud-init-output.log' }
 `
 
-var workerUserdataTmpl = template.Must(template.New("worker").Parse(workerUserdataTmplText))
+var workerUserdataTmpl = template.Must(template.Must(template.New("worker").Parse(nodeIPTmplText)).Parse(workerUserdataTmplText))
 
-func workerUserdata(c *creator, bundle *cert.CABundle) (string, error) {
+// caCertHash returns the hash of the public key of the cluster CA which
+// kubeadm join uses to verify the apiserver.
+func caCertHash(bundle *cert.CABundle) (string, error) {
 	certBlock, _ := pem.Decode(bundle.K8s)
 	certificate, err := x509.ParseCertificate(certBlock.Bytes)
 	if err != nil {
 		return "", errors.Wrap(err, "could not parse k8s certificate for public key")
 	}
 	hash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
-	caHash := fmt.Sprintf("sha256:%x", hash)
+	return fmt.Sprintf("sha256:%x", hash), nil
+}
+
+func workerUserdata(c *creator, bundle *cert.CABundle) (string, error) {
+	caHash, err := caCertHash(bundle)
+	if err != nil {
+		return "", err
+	}
 	var buf strings.Builder
 	data := struct {
+		nodeIPData
 		Name        string
 		Token       string
 		CertHash    string
 		APIEndpoint string
 		NodeLabels  string
 	}{
+		nodeIPData:  newNodeIPData(c.machine, "/var/tmp/workerconfig.yaml"),
 		Name:        c.machine.Name,
 		Token:       c.token,
 		CertHash:    caHash,
@@ -433,23 +908,57 @@ func (c *creator) doMaasCreate() {
 		c.err = err
 		return
 	}
+	c.createResponse = *createResponse
+}
 
-	if len(createResponse.IPAddresses) == 0 {
-		log.Info("machine ip is nil, releasing", "maas create response", createResponse)
-		c.err = c.maasClient.Delete(
-			context.Background(),
-			&maas.DeleteRequest{
-				ProviderID: createResponse.ProviderID,
-				SystemID:   createResponse.SystemID,
-			},
-		)
+// markDeploying records the MAAS machine on the machine object. MAAS deploys
+// the machine asynchronously, handleDeploying waits for it to finish.
+func (c *creator) markDeploying() {
+	if c.err != nil {
 		return
 	}
-	c.createResponse = *createResponse
+
+	// Add the finalizer so the MAAS machine is released with the object
+	if !util.ContainsString(c.machine.Finalizers, clusterv1alpha1.MachineFinalizer) {
+		log.Info("adding finalizer to machine")
+		c.machine.Finalizers = append(c.machine.Finalizers, clusterv1alpha1.MachineFinalizer)
+	}
+
+	log.Info("update machine status to deploying")
+	c.machine.Status.Phase = common.DeployingMachinePhase
+	c.machine.Status.KubernetesVersion = c.cluster.Spec.KubernetesVersion
+	c.machine.Status.SystemId = c.createResponse.SystemID
+	c.machine.Status.Zone = c.createResponse.Zone
+	c.machine.Status.MaasRegion = c.cluster.Spec.MaasRegion
+	c.machine.Status.BlockDevices = statusBlockDevices(c.createResponse.BlockDevices)
+	c.machine.Status.ProviderStatus = maas.StatusDeploying
+	c.machine.Status.ProviderStatusMessage = ""
+	c.machine.Status.DeployStarted = &metav1.Time{Time: time.Now()}
+	c.machine.Status.LastUpdated = c.machine.Status.DeployStarted
+	if c.machine.ObjectMeta.Annotations == nil {
+		c.machine.ObjectMeta.Annotations = map[string]string{}
+	}
+	c.machine.ObjectMeta.Annotations["maas-system-id"] = c.createResponse.SystemID
+	c.machine.ObjectMeta.Annotations["maas-hostname"] = c.createResponse.Hostname
+
+	// If this update fails the allocated machine is adopted on the next
+	// reconcile using the ProviderID.
+	err := c.k8sClient.Update(context.Background(), c.machine)
+	if err != nil {
+		c.err = errors.Wrap(err, "could not update machine")
+		return
+	}
+
+	c.k8sClient.Event(
+		c.machine,
+		corev1.EventTypeNormal,
+		"ResourceStateChange",
+		"set Finalizer and started maas deployment",
+	)
 }
 
 func (c *creator) createKubeconfig() {
-	if c.err != nil || !c.isMaster {
+	if c.err != nil || !c.initsControlPlane() {
 		return
 	}
 
@@ -461,7 +970,7 @@ func (c *creator) createKubeconfig() {
 	}
 
 	log.Info("create kubeconfig")
-	kubeconfig, err := bundle.Kubeconfig(c.cluster.Name, "https://"+c.createResponse.IPAddresses[0]+":6443")
+	kubeconfig, err := bundle.Kubeconfig(c.cluster.Name, "https://"+c.apiEndpoint())
 	if err != nil {
 		c.err = err
 		return
@@ -477,30 +986,26 @@ func (c *creator) updateMachine() {
 		return
 	}
 
-	// Add the finalizer
-	if !util.ContainsString(c.machine.Finalizers, clusterv1alpha1.MachineFinalizer) {
-		log.Info("adding finalizer to machine")
-		c.machine.Finalizers = append(c.machine.Finalizers, clusterv1alpha1.MachineFinalizer)
-	}
-
-	log.Info("update machine status to ready")
-	// update status to "creating"
+	log.Info("update machine status to provisioning")
 	c.machine.Status.Phase = common.ProvisioningMachinePhase
-	c.machine.Status.KubernetesVersion = c.cluster.Spec.KubernetesVersion
 	c.machine.Status.SystemId = c.createResponse.SystemID
-	c.machine.Status.SshConfig.Host = c.createResponse.IPAddresses[0]
+	c.machine.Status.Zone = c.createResponse.Zone
+	c.machine.Status.SshConfig.Host = c.nodeIP
+	c.machine.Status.LastUpdated = &metav1.Time{Time: time.Now()}
 	// Check if machine object has existing annotations
 	if c.machine.ObjectMeta.Annotations == nil {
 		c.machine.ObjectMeta.Annotations = map[string]string{}
 	}
 	// TODO: (zachpuck) Move these Annotations to Status
-	c.machine.ObjectMeta.Annotations["maas-ip"] = c.createResponse.IPAddresses[0]
-	c.machine.ObjectMeta.Annotations["maas-system-id"] = c.createResponse.SystemID
-	c.machine.ObjectMeta.Annotations["maas-hostname"] = c.createResponse.Hostname
+	c.machine.ObjectMeta.Annotations["maas-ip"] = c.nodeIP
+	if c.createResponse.SystemID != "" {
+		c.machine.ObjectMeta.Annotations["maas-system-id"] = c.createResponse.SystemID
+		c.machine.ObjectMeta.Annotations["maas-hostname"] = c.createResponse.Hostname
+	}
 
 	err := c.k8sClient.Update(context.Background(), c.machine)
 	if err != nil {
-		c.err = releaseError{systemID: c.createResponse.SystemID, err: err}
+		c.err = errors.Wrap(err, "could not update machine")
 		return
 	}
 
@@ -508,12 +1013,22 @@ func (c *creator) updateMachine() {
 		c.machine,
 		corev1.EventTypeNormal,
 		"ResourceStateChange",
-		"set Finalizer and OwnerReferences",
+		"deployment finished",
 	)
 }
 
+// apiEndpoint returns the address the apiservers of the cluster are reached
+// at, the control plane endpoint of the cluster or else the address of the
+// init master.
+func (c *creator) apiEndpoint() string {
+	if endpoint := controlPlaneEndpoint(c.cluster.Spec); endpoint != "" {
+		return endpoint
+	}
+	return c.nodeIP + ":6443"
+}
+
 func (c *creator) updateCluster() {
-	if c.err != nil || !c.isMaster {
+	if c.err != nil || !c.initsControlPlane() {
 		return
 	}
 
@@ -529,14 +1044,15 @@ func (c *creator) updateCluster() {
 		&fresh,
 	)
 	if err != nil {
-		c.err = releaseError{err: err, systemID: c.createResponse.SystemID}
+		c.err = errors.Wrap(err, "could not get cluster")
+		return
 	}
 
-	fresh.Status.APIEndpoint = c.createResponse.IPAddresses[0] + ":6443"
+	fresh.Status.APIEndpoint = c.apiEndpoint()
 	fresh.Status.LastUpdated = &metav1.Time{Time: time.Now()}
 	err = c.k8sClient.Update(context.Background(), &fresh)
 	if err != nil {
-		c.err = releaseError{err: err, systemID: c.createResponse.SystemID}
+		c.err = errors.Wrap(err, "could not update cluster")
 		return
 	}
 
@@ -0,0 +1,596 @@
+package machine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis"
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/cert"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+)
+
+func init() {
+	if err := apis.AddToScheme(scheme.Scheme); err != nil {
+		panic(err)
+	}
+}
+
+type fakeClientEventer struct {
+	client.Client
+	*record.FakeRecorder
+}
+
+func newFakeClientEventer(objs ...runtime.Object) *fakeClientEventer {
+	return &fakeClientEventer{
+		Client:       fakeclient.NewFakeClient(objs...),
+		FakeRecorder: record.NewFakeRecorder(100),
+	}
+}
+
+func testCluster() *clusterv1alpha1.CnctCluster {
+	return &clusterv1alpha1.CnctCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "cluster"},
+		Spec:       clusterv1alpha1.ClusterSpec{KubernetesVersion: "1.13.5"},
+	}
+}
+
+func testSecret(t *testing.T) *corev1.Secret {
+	bundle, err := cert.NewCABundle()
+	if err != nil {
+		t.Fatalf("could not create ca bundle: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-private-key", Namespace: "cluster"},
+		Data:       map[string][]byte{},
+	}
+	bundle.MergeWithMap(secret.Data)
+	return secret
+}
+
+func testMaster() *clusterv1alpha1.CnctMachine {
+	return &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "master", Namespace: "cluster"},
+		Spec: clusterv1alpha1.MachineSpec{
+			Roles:        []common.MachineRoles{common.MachineRoleMaster, common.MachineRoleEtcd},
+			InstanceType: "standard",
+		},
+	}
+}
+
+func testProvider() *fake.Provider {
+	p := fake.New(fake.Machine{
+		SystemID:    "abc123",
+		Hostname:    "node-1",
+		Tags:        []string{"standard"},
+		IPAddresses: []string{"10.0.0.10"},
+	})
+	p.AddBootResource(maas.BootResource{Name: "os=ubuntu-xenial,k8s=1.13.5,standard", Type: "Uploaded"})
+	return p
+}
+
+func Test_creator_master(t *testing.T) {
+	machine := testMaster()
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	provider := testProvider()
+
+	if err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine); err != nil {
+		t.Fatalf("create() error = %v", err)
+	}
+
+	m, _ := provider.Machine("abc123")
+	if !m.Deploying {
+		t.Errorf("maas machine is not deploying")
+	}
+	if m.Distro != "os=ubuntu-xenial,k8s=1.13.5,standard" {
+		t.Errorf("maas machine deployed with distro %q", m.Distro)
+	}
+
+	var got clusterv1alpha1.CnctMachine
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "master"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Phase != common.DeployingMachinePhase {
+		t.Errorf("machine phase = %q, want %q", got.Status.Phase, common.DeployingMachinePhase)
+	}
+	if got.Status.SystemId != "abc123" {
+		t.Errorf("machine system id = %q, want %q", got.Status.SystemId, "abc123")
+	}
+	if got.Status.DeployStarted == nil {
+		t.Errorf("machine deploy start time is not set")
+	}
+	if got.Spec.ProviderID == nil || *got.Spec.ProviderID != m.ProviderID {
+		t.Errorf("machine provider id = %v, want %q", got.Spec.ProviderID, m.ProviderID)
+	}
+	if !strings.Contains(m.Userdata, "controlPlaneEndpoint: "+nodeIPPlaceholder+":6443") {
+		t.Errorf("userdata does not set the control plane endpoint:\n%s", m.Userdata)
+	}
+
+	var cluster clusterv1alpha1.CnctCluster
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "cluster"}, &cluster); err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Status.InitMaster != "master" {
+		t.Errorf("cluster init master = %q, want master", cluster.Status.InitMaster)
+	}
+}
+
+func Test_creator_adopt(t *testing.T) {
+	providerID := "2e8a2b3c-6d0f-11e9-a923-1681be663d3e"
+	machine := testMaster()
+	machine.Spec.ProviderID = &providerID
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	provider := testProvider()
+	provider.AddMachine(fake.Machine{
+		SystemID:    "def456",
+		Tags:        []string{"standard"},
+		IPAddresses: []string{"10.0.0.11"},
+		Allocated:   true,
+		Deployed:    true,
+		ProviderID:  providerID,
+	})
+
+	c := &creator{k8sClient: k8sClient, maasClient: provider, machine: machine, isMaster: true}
+	c.getCluster()
+	c.getSecret()
+	c.setProviderID()
+	c.prepareMaasRequest()
+	c.doMaasCreate()
+	c.markDeploying()
+	if c.err != nil {
+		t.Fatalf("creator error = %v", c.err)
+	}
+
+	if m, _ := provider.Machine("abc123"); m.Allocated {
+		t.Errorf("a second maas machine was allocated")
+	}
+	if machine.Status.SystemId != "def456" {
+		t.Errorf("machine system id = %q, want %q", machine.Status.SystemId, "def456")
+	}
+	if *machine.Spec.ProviderID != providerID {
+		t.Errorf("machine provider id = %q, want %q", *machine.Spec.ProviderID, providerID)
+	}
+}
+
+func Test_create_deployFailure(t *testing.T) {
+	machine := testMaster()
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	provider := testProvider()
+	provider.DeployError = errors.New("deploy failed")
+
+	if err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine); err == nil {
+		t.Fatal("create() expected an error")
+	}
+
+	m, _ := provider.Machine("abc123")
+	if m.Allocated || m.Deployed {
+		t.Errorf("maas machine was not released after failed deploy: %+v", m)
+	}
+	if machine.Status.Phase != "" {
+		t.Errorf("machine phase = %q, want it unset", machine.Status.Phase)
+	}
+}
+
+func Test_create_noImage(t *testing.T) {
+	machine := testMaster()
+	machine.Spec.InstanceType = "gpu"
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	provider := testProvider()
+
+	err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine)
+	if _, ok := err.(unrecoverableError); !ok {
+		t.Fatalf("create() error = %v, want unrecoverableError", err)
+	}
+}
+
+func Test_create_listImagesFailed(t *testing.T) {
+	machine := testMaster()
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	provider := testProvider()
+	provider.ListImagesError = errors.New("maas is unavailable")
+
+	err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine)
+	if _, ok := err.(notReadyError); !ok {
+		t.Fatalf("create() error = %v, want notReadyError", err)
+	}
+	if machine.Status.Phase == common.ErrorMachinePhase {
+		t.Errorf("machine phase = %q, want it retried", machine.Status.Phase)
+	}
+}
+
+func Test_handleDelete_release(t *testing.T) {
+	machine := testMaster()
+	machine.Finalizers = []string{clusterv1alpha1.MachineFinalizer}
+	machine.Status.Phase = common.DeletingMachinePhase
+	machine.Status.SystemId = "abc123"
+	k8sClient := newFakeClientEventer(machine)
+	provider := fake.New(fake.Machine{SystemID: "abc123", Allocated: true, Deployed: true})
+	r := &ReconcileMachine{Client: k8sClient, EventRecorder: k8sClient, MAAS: maas.SingleRegion{Provider: provider}}
+
+	if err := r.handleDelete(machine); err != nil {
+		t.Fatalf("handleDelete() error = %v", err)
+	}
+
+	m, _ := provider.Machine("abc123")
+	if m.Allocated {
+		t.Errorf("maas machine was not released")
+	}
+	var got clusterv1alpha1.CnctMachine
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "master"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Finalizers) != 0 {
+		t.Errorf("machine finalizers = %v, want none", got.Finalizers)
+	}
+}
+
+func Test_handleDelete_releaseByProviderID(t *testing.T) {
+	providerID := "2e8a2b3c-6d0f-11e9-a923-1681be663d3e"
+	machine := testMaster()
+	machine.Finalizers = []string{clusterv1alpha1.MachineFinalizer}
+	machine.Spec.ProviderID = &providerID
+	machine.Status.Phase = common.DeletingMachinePhase
+	k8sClient := newFakeClientEventer(machine)
+	provider := fake.New(fake.Machine{SystemID: "abc123", Allocated: true, ProviderID: providerID})
+	r := &ReconcileMachine{Client: k8sClient, EventRecorder: k8sClient, MAAS: maas.SingleRegion{Provider: provider}}
+
+	if err := r.handleDelete(machine); err != nil {
+		t.Fatalf("handleDelete() error = %v", err)
+	}
+
+	if m, _ := provider.Machine("abc123"); m.Allocated {
+		t.Errorf("maas machine was not released")
+	}
+}
+
+func Test_handleDelete_releaseFailed(t *testing.T) {
+	machine := testMaster()
+	machine.Finalizers = []string{clusterv1alpha1.MachineFinalizer}
+	machine.Status.Phase = common.DeletingMachinePhase
+	machine.Status.SystemId = "abc123"
+	k8sClient := newFakeClientEventer(machine)
+	provider := fake.New(fake.Machine{SystemID: "abc123", Allocated: true, Deployed: true})
+	provider.ReleaseError = errors.New("maas is unavailable")
+	r := &ReconcileMachine{Client: k8sClient, EventRecorder: k8sClient, MAAS: maas.SingleRegion{Provider: provider}}
+
+	if err := r.handleDelete(machine); err == nil {
+		t.Fatalf("handleDelete() error = nil, want the release error")
+	}
+
+	var got clusterv1alpha1.CnctMachine
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "master"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Finalizers) != 1 {
+		t.Errorf("machine finalizers = %v, want the finalizer kept until the machine is released", got.Finalizers)
+	}
+}
+
+func Test_creator_network(t *testing.T) {
+	tests := []struct {
+		name      string
+		iface     clusterv1alpha1.NetworkInterface
+		want      []string
+		wantNoArg bool
+	}{
+		{
+			name:  "static",
+			iface: clusterv1alpha1.NetworkInterface{Name: "eth1", Subnet: "10.0.0.0/24", Mode: clusterv1alpha1.StaticIPMode, IPAddress: "10.0.0.5", NodeIP: true},
+			want:  []string{"advertiseAddress: 10.0.0.5", "node-ip: 10.0.0.5"},
+		},
+		{
+			name:  "auto",
+			iface: clusterv1alpha1.NetworkInterface{Name: "bond0", VLAN: 100, Subnet: "10.0.0.0/24", NodeIP: true},
+			want:  []string{"node-ip: " + nodeIPPlaceholder, "/var/tmp/node-ip.sh bond0.100 /var/tmp/masterconfig.yaml"},
+		},
+		{
+			name:      "not the node ip",
+			iface:     clusterv1alpha1.NetworkInterface{Name: "eth1", Subnet: "10.0.0.0/24"},
+			wantNoArg: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine := testMaster()
+			machine.Spec.Network = &clusterv1alpha1.MachineNetwork{Interfaces: []clusterv1alpha1.NetworkInterface{tt.iface}}
+			k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+			provider := testProvider()
+
+			if err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine); err != nil {
+				t.Fatalf("create() error = %v", err)
+			}
+			m, _ := provider.Machine("abc123")
+			if m.Network == nil || len(m.Network.Interfaces) != 1 || m.Network.Interfaces[0].VLAN != tt.iface.VLAN {
+				t.Errorf("maas machine network = %+v", m.Network)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(m.Userdata, want) {
+					t.Errorf("userdata does not contain %q:\n%s", want, m.Userdata)
+				}
+			}
+			if tt.wantNoArg && strings.Contains(m.Userdata, "node-ip: ") {
+				t.Errorf("userdata sets the node ip:\n%s", m.Userdata)
+			}
+		})
+	}
+}
+
+func Test_create_invalidNetwork(t *testing.T) {
+	machine := testMaster()
+	machine.Spec.Network = &clusterv1alpha1.MachineNetwork{Interfaces: []clusterv1alpha1.NetworkInterface{
+		{Name: "eth1", Subnet: "10.0.0.0/24", Mode: clusterv1alpha1.StaticIPMode},
+	}}
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+
+	err := create(k8sClient, maas.SingleRegion{Provider: testProvider()}, machine)
+	if _, ok := err.(unrecoverableError); !ok {
+		t.Fatalf("create() error = %v, want unrecoverableError", err)
+	}
+}
+
+func Test_creator_storage(t *testing.T) {
+	machine := testMaster()
+	machine.Spec.Storage = &clusterv1alpha1.MachineStorage{
+		Profile: clusterv1alpha1.LVMStorageProfile,
+		DedicatedDisks: []clusterv1alpha1.DedicatedDisk{
+			{MountPoint: "/var/lib/etcd", Name: "sdb"},
+		},
+	}
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	provider := fake.New(fake.Machine{
+		SystemID:    "abc123",
+		Hostname:    "node-1",
+		Tags:        []string{"standard"},
+		IPAddresses: []string{"10.0.0.10"},
+		BlockDevices: []maas.BlockDevice{
+			{Name: "sda", Size: 500, MountPoints: []string{"/boot/efi", "/"}},
+			{Name: "sdb", Size: 200, Tags: []string{"ssd"}},
+		},
+	})
+	provider.AddBootResource(maas.BootResource{Name: "os=ubuntu-xenial,k8s=1.13.5,standard", Type: "Uploaded"})
+
+	if err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine); err != nil {
+		t.Fatalf("create() error = %v", err)
+	}
+	m, _ := provider.Machine("abc123")
+	if m.Storage == nil || m.Storage.Profile != maas.StorageLVM || len(m.Storage.DedicatedDisks) != 1 {
+		t.Errorf("maas machine storage = %+v", m.Storage)
+	}
+
+	var got clusterv1alpha1.CnctMachine
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "master"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	devices := got.Status.BlockDevices
+	if len(devices) != 2 || devices[1].Name != "sdb" || len(devices[1].MountPoints) != 1 || devices[1].MountPoints[0] != "/var/lib/etcd" {
+		t.Errorf("machine block devices = %+v", devices)
+	}
+}
+
+func Test_create_invalidStorage(t *testing.T) {
+	machine := testMaster()
+	machine.Spec.Storage = &clusterv1alpha1.MachineStorage{
+		DedicatedDisks: []clusterv1alpha1.DedicatedDisk{{MountPoint: "/home"}},
+	}
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+
+	err := create(k8sClient, maas.SingleRegion{Provider: testProvider()}, machine)
+	if _, ok := err.(unrecoverableError); !ok {
+		t.Fatalf("create() error = %v, want unrecoverableError", err)
+	}
+}
+
+func Test_creator_osSeries(t *testing.T) {
+	tests := []struct {
+		name          string
+		clusterSeries string
+		machineSeries string
+		wantDistro    string
+	}{
+		{name: "default", wantDistro: "os=ubuntu-xenial,k8s=1.13.5,standard"},
+		{name: "cluster", clusterSeries: "ubuntu-bionic", wantDistro: "os=ubuntu-bionic,k8s=1.13.5,standard"},
+		{name: "machine override", clusterSeries: "ubuntu-bionic", machineSeries: "ubuntu-xenial", wantDistro: "os=ubuntu-xenial,k8s=1.13.5,standard"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := testCluster()
+			cluster.Spec.OSSeries = tt.clusterSeries
+			machine := testMaster()
+			machine.Spec.OSSeries = tt.machineSeries
+			k8sClient := newFakeClientEventer(cluster, testSecret(t), machine)
+			provider := testProvider()
+			provider.AddBootResource(maas.BootResource{Name: "os=ubuntu-bionic,k8s=1.13.5,standard", Type: "Uploaded"})
+
+			if err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine); err != nil {
+				t.Fatalf("create() error = %v", err)
+			}
+			m, _ := provider.Machine("abc123")
+			if m.Distro != tt.wantDistro {
+				t.Errorf("maas machine deployed with distro %q, want %q", m.Distro, tt.wantDistro)
+			}
+		})
+	}
+}
+
+func Test_create_noOSSeriesImage(t *testing.T) {
+	cluster := testCluster()
+	cluster.Spec.OSSeries = "ubuntu-bionic"
+	machine := testMaster()
+	k8sClient := newFakeClientEventer(cluster, testSecret(t), machine)
+	provider := testProvider()
+
+	err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine)
+	if _, ok := err.(unrecoverableError); !ok {
+		t.Fatalf("create() error = %v, want unrecoverableError", err)
+	}
+	if m, _ := provider.Machine("abc123"); m.Allocated {
+		t.Errorf("maas machine was allocated without a matching image")
+	}
+}
+
+func Test_electInitMaster(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      clusterv1alpha1.ClusterStatus
+		others      []runtime.Object
+		wantInit    string
+		wantJoining bool
+	}{
+		{name: "first master", wantInit: "master"},
+		{name: "elected", status: clusterv1alpha1.ClusterStatus{InitMaster: "master"}, wantInit: "master"},
+		{
+			name:        "other master elected",
+			status:      clusterv1alpha1.ClusterStatus{InitMaster: "other"},
+			others:      []runtime.Object{&clusterv1alpha1.CnctMachine{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "cluster"}}},
+			wantInit:    "other",
+			wantJoining: true,
+		},
+		{name: "elected master deleted", status: clusterv1alpha1.ClusterStatus{InitMaster: "other"}, wantInit: "master"},
+		{
+			name:        "control plane up",
+			status:      clusterv1alpha1.ClusterStatus{InitMaster: "other", APIEndpoint: "10.0.0.10:6443"},
+			wantInit:    "other",
+			wantJoining: true,
+		},
+		{
+			name:        "legacy cluster",
+			status:      clusterv1alpha1.ClusterStatus{APIEndpoint: "10.0.0.10:6443"},
+			wantJoining: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := testCluster()
+			cluster.Status = tt.status
+			machine := testMaster()
+			k8sClient := newFakeClientEventer(append(tt.others, cluster, machine)...)
+
+			c := &creator{k8sClient: k8sClient, machine: machine, isMaster: true}
+			c.getCluster()
+			c.electInitMaster()
+			if c.err != nil {
+				t.Fatalf("electInitMaster() error = %v", c.err)
+			}
+			if c.joinControlPlane != tt.wantJoining {
+				t.Errorf("joinControlPlane = %v, want %v", c.joinControlPlane, tt.wantJoining)
+			}
+			var got clusterv1alpha1.CnctCluster
+			if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "cluster"}, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Status.InitMaster != tt.wantInit {
+				t.Errorf("init master = %q, want %q", got.Status.InitMaster, tt.wantInit)
+			}
+		})
+	}
+}
+
+func Test_create_joiningMasterWaitsForAPIEndpoint(t *testing.T) {
+	cluster := testCluster()
+	cluster.Status.InitMaster = "other"
+	other := testMaster()
+	other.Name = "other"
+	machine := testMaster()
+	k8sClient := newFakeClientEventer(cluster, testSecret(t), other, machine)
+	provider := testProvider()
+
+	err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine)
+	if _, ok := err.(notReadyError); !ok {
+		t.Fatalf("create() error = %v, want notReadyError", err)
+	}
+	if m, _ := provider.Machine("abc123"); m.Allocated {
+		t.Errorf("maas machine was allocated before the control plane is up")
+	}
+}
+
+func Test_checkApiserverAddress_joiningMasters(t *testing.T) {
+	master := func(name string, phase common.MachineStatusPhase, systemID string) *clusterv1alpha1.CnctMachine {
+		m := testMaster()
+		m.Name = name
+		m.Status.Phase = phase
+		m.Status.SystemId = systemID
+		return m
+	}
+	tests := []struct {
+		name        string
+		second      *clusterv1alpha1.CnctMachine
+		wantWaiting bool
+	}{
+		{name: "second master joining", second: master("master-2", common.DeployingMachinePhase, "def456"), wantWaiting: true},
+		{name: "second master provisioning", second: master("master-2", common.ProvisioningMachinePhase, "def456"), wantWaiting: true},
+		{name: "second master joined", second: master("master-2", common.ReadyMachinePhase, "def456")},
+		{name: "second master waiting too", second: master("master-2", "", "")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := testCluster()
+			cluster.Status.InitMaster = "master"
+			cluster.Status.APIEndpoint = "10.0.0.10:6443"
+			machine := master("master-3", "", "")
+			k8sClient := newFakeClientEventer(cluster, master("master", common.ReadyMachinePhase, "abc123"), tt.second, machine)
+
+			c := &creator{k8sClient: k8sClient, machine: machine, cluster: *cluster, isMaster: true, joinControlPlane: true}
+			c.checkApiserverAddress()
+			if _, waiting := c.err.(notReadyError); waiting != tt.wantWaiting {
+				t.Errorf("checkApiserverAddress() error = %v, want waiting %v", c.err, tt.wantWaiting)
+			}
+			if !tt.wantWaiting && c.err != nil {
+				t.Errorf("checkApiserverAddress() error = %v", c.err)
+			}
+		})
+	}
+}
+
+func Test_joinMasterUserdata(t *testing.T) {
+	cluster := testCluster()
+	cluster.Status.APIEndpoint = "10.0.0.10:6443"
+	machine := testMaster()
+	machine.Name = "master-2"
+	machine.Spec.Network = &clusterv1alpha1.MachineNetwork{Interfaces: []clusterv1alpha1.NetworkInterface{
+		{Name: "eth1", Subnet: "10.0.0.0/24", Mode: clusterv1alpha1.StaticIPMode, IPAddress: "10.0.0.11", NodeIP: true},
+	}}
+	c := &creator{machine: machine, cluster: *cluster, isMaster: true, joinControlPlane: true, token: "abcdef.0123456789abcdef"}
+	bundle, err := cert.CABundleFromMap(testSecret(t).Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userdata, err := c.userdata(bundle)
+	if err != nil {
+		t.Fatalf("userdata() error = %v", err)
+	}
+	for _, want := range []string{
+		"path: /etc/kubernetes/pki/certs.tar",
+		"kind: JoinConfiguration",
+		"apiServerEndpoint: 10.0.0.10:6443",
+		"token: abcdef.0123456789abcdef",
+		"controlPlane:\n       localAPIEndpoint:\n         advertiseAddress: 10.0.0.11",
+		"kubeadm join --node-name master-2 --config /var/tmp/masterconfig.yaml",
+	} {
+		if !strings.Contains(userdata, want) {
+			t.Errorf("userdata does not contain %q:\n%s", want, userdata)
+		}
+	}
+	if strings.Contains(userdata, "kubeadm init") {
+		t.Errorf("userdata of a joining master runs kubeadm init:\n%s", userdata)
+	}
+
+	bundle.ServiceAccount, bundle.ServiceAccountKey = nil, nil
+	if _, err := c.userdata(bundle); err == nil {
+		t.Errorf("userdata() without a service account key succeeded")
+	} else if _, ok := err.(unrecoverableError); !ok {
+		t.Errorf("userdata() error = %v, want unrecoverableError", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
 	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
 	"github.com/samsung-cnct/cma-ssh/pkg/maas"
 	"github.com/samsung-cnct/cma-ssh/pkg/util"
@@ -44,7 +45,23 @@ func (r *ReconcileMachine) handleDelete(
 
 	// If the machine does not have a system id yet then it has not been
 	// acquired or deployed in maas so we can just delete it.
-	if machine.Status.SystemId == "" || !cluster.DeletionTimestamp.IsZero() {
+	if !provisioned(machine) || !cluster.DeletionTimestamp.IsZero() {
+		if err := deleteMachine(r, machine); err != nil {
+			return errors.Wrap(err, "could not delete machine object")
+		}
+		return nil
+	}
+
+	// Etcd machines are not nodes of the cluster, their member is removed
+	// from the external etcd cluster instead of draining them, and from the
+	// etcd servers of the apiservers.
+	if isEtcdMachine(machine) {
+		if err := r.removeEtcdMember(machine); err != nil {
+			return err
+		}
+		if err := r.syncEtcdEndpoints(machine.Namespace); err != nil {
+			return err
+		}
 		if err := deleteMachine(r, machine); err != nil {
 			return errors.Wrap(err, "could not delete machine object")
 		}
@@ -202,10 +219,30 @@ func (r *ReconcileMachine) handleDelete(
 }
 
 func deleteMachine(r *ReconcileMachine, machine *clusterv1alpha1.CnctMachine) error {
+	// The machine may have been allocated even if the system id was never
+	// recorded so release by provider id as well.
+	var providerID string
+	if machine.Spec.ProviderID != nil {
+		providerID = *machine.Spec.ProviderID
+	}
 	systemID := machine.Status.SystemId
-	if systemID != "" {
-		if err := r.MAASClient.Delete(context.Background(), &maas.DeleteRequest{SystemID: systemID}); err != nil {
-			return errors.Wrapf(err, "could not delete machine %s with system id %q", machine.Name, *machine.Spec.ProviderID)
+	if machine.Status.ErrorReason != nil && *machine.Status.ErrorReason == common.DriftedMachineError {
+		// The system id may now belong to someone else so only release
+		// what is still allocated for the provider id.
+		systemID = ""
+	}
+	if isSshMachine(machine) {
+		if err := r.releaseSshHost(machine); err != nil {
+			return err
+		}
+	} else if systemID != "" || providerID != "" {
+		maasClient, err := r.maasClient(machine)
+		if err != nil {
+			return err
+		}
+		request := &maas.DeleteRequest{ProviderID: providerID, SystemID: systemID}
+		if err := maasClient.Delete(context.Background(), request); err != nil {
+			return errors.Wrapf(err, "could not delete machine %s with provider id %q and system id %q", machine.Name, providerID, systemID)
 		}
 	}
 
@@ -0,0 +1,209 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// DeployOptions configure how the machine controller waits for MAAS to deploy
+// machines.
+type DeployOptions struct {
+	// Timeout is how long MAAS may take to deploy a machine, or an ssh host
+	// to be bootstrapped, before the deployment is considered failed.
+	Timeout time.Duration
+	// PollInterval is how often the MAAS status of a deploying machine, or
+	// the bootstrap of an ssh host, is checked.
+	PollInterval time.Duration
+	// Retries is how many times a machine which failed to deploy is
+	// released and replaced before the CnctMachine is moved to the error
+	// phase.
+	Retries int
+}
+
+// DefaultDeployOptions are used for the fields of DeployOptions which are not
+// set.
+var DefaultDeployOptions = DeployOptions{
+	Timeout:      30 * time.Minute,
+	PollInterval: 15 * time.Second,
+	Retries:      2,
+}
+
+func (o DeployOptions) withDefaults() DeployOptions {
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultDeployOptions.Timeout
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = DefaultDeployOptions.PollInterval
+	}
+	if o.Retries < 0 {
+		o.Retries = 0
+	}
+	return o
+}
+
+// handleDeploying polls the MAAS status of a deploying machine and mirrors it
+// to the machine status. Once the machine is deployed the machine moves on to
+// provisioning. A failed deployment is handled by deployFailed.
+func (r *ReconcileMachine) handleDeploying(machine *clusterv1alpha1.CnctMachine) (reconcile.Result, error) {
+	if isSshMachine(machine) {
+		return r.handleBootstrapping(machine)
+	}
+	log.Info("checking maas deployment", "machine", machine.Name, "systemID", machine.Status.SystemId)
+	request := &maas.StatusRequest{SystemID: machine.Status.SystemId}
+	if machine.Spec.ProviderID != nil {
+		request.ProviderID = *machine.Spec.ProviderID
+	}
+	maasClient, err := r.maasClient(machine)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	status, err := maasClient.Status(context.Background(), request)
+	if err == maas.ErrMachineNotFound {
+		// There is nothing left to release and the system id may already
+		// be allocated to someone else, the machine is created again with
+		// a new provider id.
+		message := fmt.Sprintf("maas machine %s was released during deployment", machine.Status.SystemId)
+		machine.Spec.ProviderID = nil
+		return reconcile.Result{}, r.retryDeploy(machine, message)
+	} else if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "could not get maas machine status")
+	}
+
+	changed := machine.Status.ProviderStatus != status.Status ||
+		machine.Status.ProviderStatusMessage != status.StatusMessage
+	machine.Status.ProviderStatus = status.Status
+	machine.Status.ProviderStatusMessage = status.StatusMessage
+
+	switch status.Status {
+	case maas.StatusDeployed:
+		nodeIP, err := nodeAddress(machine, status)
+		if err != nil {
+			return reconcile.Result{}, r.deployFailed(machine, err.Error())
+		}
+		return reconcile.Result{}, deployed(r, maasClient, machine, status, nodeIP)
+	case maas.StatusFailedDeployment:
+		return reconcile.Result{}, r.deployFailed(machine, fmt.Sprintf("maas failed to deploy machine %s: %s", status.SystemID, status.StatusMessage))
+	case maas.StatusAllocated, maas.StatusDeploying:
+	default:
+		return reconcile.Result{}, r.deployFailed(machine, fmt.Sprintf("maas machine %s is %s during deployment", status.SystemID, status.Status))
+	}
+
+	if machine.Status.DeployStarted != nil && time.Since(machine.Status.DeployStarted.Time) > r.deploy.Timeout {
+		return reconcile.Result{}, r.deployFailed(machine, fmt.Sprintf("maas did not deploy machine %s within %s", status.SystemID, r.deploy.Timeout))
+	}
+
+	if changed {
+		log.Info("maas deployment status changed", "machine", machine.Name, "status", status.Status, "message", status.StatusMessage)
+		machine.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+		if err := r.Update(context.Background(), machine); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{RequeueAfter: r.deploy.PollInterval}, nil
+}
+
+// nodeAddress returns the address of a deployed machine used as node ip, ssh
+// host and api endpoint. It is the address of the node interface of the
+// machine network if there is one, otherwise the first address of the machine.
+func nodeAddress(machine *clusterv1alpha1.CnctMachine, status *maas.Machine) (string, error) {
+	if i := MaasNetwork(machine.Spec.Network).NodeInterface(); i != nil {
+		if i.Mode == maas.IPModeStatic {
+			return i.IPAddress, nil
+		}
+		if address := status.InterfaceAddress(i.InterfaceName()); address != "" {
+			return address, nil
+		}
+		return "", fmt.Errorf("maas machine %s has no ip address on node interface %s", status.SystemID, i.InterfaceName())
+	}
+	if len(status.IPAddresses) == 0 {
+		return "", fmt.Errorf("maas machine %s has no ip address", status.SystemID)
+	}
+	return status.IPAddresses[0], nil
+}
+
+// deployed finishes the creation of a machine once MAAS has deployed it.
+func deployed(
+	k8sClient clientEventer,
+	maasClient maas.MachineProvider,
+	machine *clusterv1alpha1.CnctMachine,
+	status *maas.Machine,
+	nodeIP string,
+) error {
+	c := &creator{k8sClient: k8sClient, maasClient: maasClient, machine: machine, nodeIP: nodeIP}
+	c.isMaster = isMaster(machine)
+	c.createResponse = maas.CreateResponse{
+		ProviderID:  status.ProviderID,
+		IPAddresses: status.IPAddresses,
+		SystemID:    status.SystemID,
+		Hostname:    status.Hostname,
+		Zone:        status.Zone,
+	}
+	c.getCluster()
+	c.joinControlPlane = joinsControlPlane(&c.cluster, machine)
+	c.getSecret()
+	c.createKubeconfig()
+	c.updateCluster()
+	c.updateMachine()
+	return c.err
+}
+
+// deployFailed releases a machine which MAAS failed to deploy. The machine is
+// created again with another MAAS machine until the retries are used up, then
+// it is moved to the error phase.
+func (r *ReconcileMachine) deployFailed(machine *clusterv1alpha1.CnctMachine, message string) error {
+	log.Info("maas deployment failed", "machine", machine.Name, "message", message)
+	request := &maas.DeleteRequest{SystemID: machine.Status.SystemId}
+	if machine.Spec.ProviderID != nil {
+		request.ProviderID = *machine.Spec.ProviderID
+	}
+	maasClient, err := r.maasClient(machine)
+	if err != nil {
+		return err
+	}
+	if err := maasClient.Delete(context.Background(), request); err != nil {
+		return errors.Wrapf(err, "could not release machine %s after failed deployment", machine.Status.SystemId)
+	}
+	return r.retryDeploy(machine, message)
+}
+
+// retryDeploy clears the MAAS machine of a machine whose deployment failed so
+// that it is created again, or moves it to the error phase once the retries
+// are used up.
+func (r *ReconcileMachine) retryDeploy(machine *clusterv1alpha1.CnctMachine, message string) error {
+	machine.Status.SystemId = ""
+	machine.Status.Zone = ""
+	machine.Status.DeployStarted = nil
+	machine.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+	if machine.Status.DeployRetries < r.deploy.Retries {
+		machine.Status.DeployRetries++
+		machine.Status.Phase = ""
+		r.Eventf(machine, corev1.EventTypeWarning, "DeployFailed",
+			"%s, retrying with another machine (%d/%d)", message, machine.Status.DeployRetries, r.deploy.Retries)
+	} else {
+		reason := common.DeployMachineError
+		machine.Status.Phase = common.ErrorMachinePhase
+		machine.Status.ErrorReason = &reason
+		machine.Status.ErrorMessage = &message
+		r.Event(machine, corev1.EventTypeWarning, "DeployFailed", message)
+	}
+	return r.Update(context.Background(), machine)
+}
+
+func isMaster(machine *clusterv1alpha1.CnctMachine) bool {
+	for _, v := range machine.Spec.Roles {
+		if v == common.MachineRoleMaster {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,257 @@
+package machine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+)
+
+// testDeploying returns a worker machine whose maas machine abc123 is being
+// deployed. Workers are used since finishing a master deployment creates a
+// kubeconfig.
+func testDeploying(started time.Time) (*clusterv1alpha1.CnctMachine, *fake.Provider) {
+	providerID := "2e8a2b3c-6d0f-11e9-a923-1681be663d3e"
+	machine := &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "cluster"},
+		Spec: clusterv1alpha1.MachineSpec{
+			Roles:        []common.MachineRoles{common.MachineRoleWorker},
+			InstanceType: "standard",
+			ProviderID:   &providerID,
+		},
+		Status: clusterv1alpha1.MachineStatus{
+			Phase:         common.DeployingMachinePhase,
+			SystemId:      "abc123",
+			DeployStarted: &metav1.Time{Time: started},
+		},
+	}
+	provider := fake.New(fake.Machine{
+		SystemID:    "abc123",
+		Hostname:    "node-1",
+		IPAddresses: []string{"10.0.0.10"},
+		Allocated:   true,
+		Deploying:   true,
+		ProviderID:  providerID,
+	})
+	return machine, provider
+}
+
+func newTestReconciler(k8sClient *fakeClientEventer, provider maas.MachineProvider) *ReconcileMachine {
+	return &ReconcileMachine{
+		Client:        k8sClient,
+		EventRecorder: k8sClient,
+		MAAS:          maas.SingleRegion{Provider: provider},
+		deploy:        DeployOptions{Retries: 1}.withDefaults(),
+	}
+}
+
+func getMachine(t *testing.T, k8sClient client.Client, name string) *clusterv1alpha1.CnctMachine {
+	var got clusterv1alpha1.CnctMachine
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: name}, &got); err != nil {
+		t.Fatal(err)
+	}
+	return &got
+}
+
+func Test_handleDeploying_inProgress(t *testing.T) {
+	machine, provider := testDeploying(time.Now())
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	r := newTestReconciler(k8sClient, provider)
+
+	result, err := r.handleDeploying(machine)
+	if err != nil {
+		t.Fatalf("handleDeploying() error = %v", err)
+	}
+	if result.RequeueAfter != r.deploy.PollInterval {
+		t.Errorf("handleDeploying() requeue after = %v, want %v", result.RequeueAfter, r.deploy.PollInterval)
+	}
+	got := getMachine(t, k8sClient, "worker")
+	if got.Status.Phase != common.DeployingMachinePhase {
+		t.Errorf("machine phase = %q, want %q", got.Status.Phase, common.DeployingMachinePhase)
+	}
+	if got.Status.ProviderStatus != maas.StatusDeploying {
+		t.Errorf("machine provider status = %q, want %q", got.Status.ProviderStatus, maas.StatusDeploying)
+	}
+}
+
+func Test_handleDeploying_deployed(t *testing.T) {
+	machine, provider := testDeploying(time.Now())
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	r := newTestReconciler(k8sClient, provider)
+	provider.CompleteDeploy("abc123")
+
+	if _, err := r.handleDeploying(machine); err != nil {
+		t.Fatalf("handleDeploying() error = %v", err)
+	}
+	got := getMachine(t, k8sClient, "worker")
+	if got.Status.Phase != common.ProvisioningMachinePhase {
+		t.Errorf("machine phase = %q, want %q", got.Status.Phase, common.ProvisioningMachinePhase)
+	}
+	if got.Status.ProviderStatus != maas.StatusDeployed {
+		t.Errorf("machine provider status = %q, want %q", got.Status.ProviderStatus, maas.StatusDeployed)
+	}
+	if got.Status.SshConfig.Host != "10.0.0.10" {
+		t.Errorf("machine host = %q, want %q", got.Status.SshConfig.Host, "10.0.0.10")
+	}
+}
+
+func Test_handleDeploying_joiningMasterDeployed(t *testing.T) {
+	machine, provider := testDeploying(time.Now())
+	machine.Spec.Roles = []common.MachineRoles{common.MachineRoleMaster, common.MachineRoleEtcd}
+	cluster := testCluster()
+	cluster.Status.InitMaster = "master"
+	cluster.Status.APIEndpoint = "10.0.0.5:6443"
+	k8sClient := newFakeClientEventer(cluster, testSecret(t), machine)
+	r := newTestReconciler(k8sClient, provider)
+	provider.CompleteDeploy("abc123")
+
+	if _, err := r.handleDeploying(machine); err != nil {
+		t.Fatalf("handleDeploying() error = %v", err)
+	}
+	if got := getMachine(t, k8sClient, "worker"); got.Status.Phase != common.ProvisioningMachinePhase {
+		t.Errorf("machine phase = %q, want %q", got.Status.Phase, common.ProvisioningMachinePhase)
+	}
+	var gotCluster clusterv1alpha1.CnctCluster
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "cluster"}, &gotCluster); err != nil {
+		t.Fatal(err)
+	}
+	if gotCluster.Status.APIEndpoint != "10.0.0.5:6443" {
+		t.Errorf("cluster api endpoint = %q, want the endpoint of the init master", gotCluster.Status.APIEndpoint)
+	}
+	var gotSecret corev1.Secret
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "cluster-private-key"}, &gotSecret); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := gotSecret.Data[corev1.ServiceAccountKubeconfigKey]; ok {
+		t.Errorf("joining master created the kubeconfig")
+	}
+}
+
+func Test_handleDeploying_failed(t *testing.T) {
+	tests := []struct {
+		name      string
+		started   time.Time
+		fail      bool
+		retries   int
+		wantPhase common.MachineStatusPhase
+	}{
+		{name: "retry", fail: true, started: time.Now(), wantPhase: ""},
+		{name: "no retries left", fail: true, started: time.Now(), retries: 1, wantPhase: common.ErrorMachinePhase},
+		{name: "timeout", started: time.Now().Add(-time.Hour), wantPhase: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine, provider := testDeploying(tt.started)
+			machine.Status.DeployRetries = tt.retries
+			k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+			r := newTestReconciler(k8sClient, provider)
+			if tt.fail {
+				provider.FailDeploy("abc123", "curtin failed")
+			}
+
+			if _, err := r.handleDeploying(machine); err != nil {
+				t.Fatalf("handleDeploying() error = %v", err)
+			}
+			if m, _ := provider.Machine("abc123"); m.Allocated {
+				t.Errorf("maas machine was not released")
+			}
+			got := getMachine(t, k8sClient, "worker")
+			if got.Status.Phase != tt.wantPhase {
+				t.Errorf("machine phase = %q, want %q", got.Status.Phase, tt.wantPhase)
+			}
+			if got.Status.SystemId != "" {
+				t.Errorf("machine system id = %q, want it unset", got.Status.SystemId)
+			}
+			if tt.wantPhase == common.ErrorMachinePhase {
+				if got.Status.ErrorReason == nil || *got.Status.ErrorReason != common.DeployMachineError {
+					t.Errorf("machine error reason = %v, want %q", got.Status.ErrorReason, common.DeployMachineError)
+				}
+			} else if got.Status.DeployRetries != tt.retries+1 {
+				t.Errorf("machine deploy retries = %d, want %d", got.Status.DeployRetries, tt.retries+1)
+			}
+		})
+	}
+}
+
+func Test_handleDeploying_released(t *testing.T) {
+	machine, _ := testDeploying(time.Now())
+	// the maas machine was released and allocated to someone else
+	provider := fake.New(fake.Machine{SystemID: "abc123", Allocated: true, Deploying: true, ProviderID: "other"})
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	r := newTestReconciler(k8sClient, provider)
+
+	if _, err := r.handleDeploying(machine); err != nil {
+		t.Fatalf("handleDeploying() error = %v", err)
+	}
+	if m, _ := provider.Machine("abc123"); !m.Allocated || m.ProviderID != "other" {
+		t.Errorf("maas machine of someone else was released")
+	}
+	got := getMachine(t, k8sClient, "worker")
+	if got.Status.Phase != "" || got.Status.SystemId != "" || got.Spec.ProviderID != nil {
+		t.Errorf("machine phase = %q, system id = %q, provider id = %v, want them unset",
+			got.Status.Phase, got.Status.SystemId, got.Spec.ProviderID)
+	}
+	if got.Status.DeployRetries != 1 {
+		t.Errorf("machine deploy retries = %d, want 1", got.Status.DeployRetries)
+	}
+}
+
+func Test_nodeAddress(t *testing.T) {
+	status := &maas.Machine{
+		SystemID:    "abc123",
+		IPAddresses: []string{"192.168.0.10", "10.0.0.10"},
+		Interfaces: []maas.InterfaceAddresses{
+			{Name: "eth0", IPAddresses: []string{"192.168.0.10"}},
+			{Name: "bond0.100", IPAddresses: []string{"10.0.0.10"}},
+		},
+	}
+	tests := []struct {
+		name    string
+		network *clusterv1alpha1.MachineNetwork
+		want    string
+		wantErr bool
+	}{
+		{name: "no network", want: "192.168.0.10"},
+		{
+			name: "auto node interface",
+			network: &clusterv1alpha1.MachineNetwork{Interfaces: []clusterv1alpha1.NetworkInterface{
+				{Name: "bond0", VLAN: 100, Subnet: "10.0.0.0/24", NodeIP: true},
+			}},
+			want: "10.0.0.10",
+		},
+		{
+			name: "static node interface",
+			network: &clusterv1alpha1.MachineNetwork{Interfaces: []clusterv1alpha1.NetworkInterface{
+				{Name: "eth1", Subnet: "172.16.0.0/24", Mode: clusterv1alpha1.StaticIPMode, IPAddress: "172.16.0.5", NodeIP: true},
+			}},
+			want: "172.16.0.5",
+		},
+		{
+			name: "node interface without address",
+			network: &clusterv1alpha1.MachineNetwork{Interfaces: []clusterv1alpha1.NetworkInterface{
+				{Name: "eth1", Subnet: "172.16.0.0/24", NodeIP: true},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine := &clusterv1alpha1.CnctMachine{Spec: clusterv1alpha1.MachineSpec{Network: tt.network}}
+			got, err := nodeAddress(machine, status)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("nodeAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("nodeAddress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
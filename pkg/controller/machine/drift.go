@@ -0,0 +1,93 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// machineSetKind is the kind of the controller owner of machines created by a
+// machine set.
+const machineSetKind = "CnctMachineSet"
+
+// DriftOptions configure how the machine controller detects ready machines
+// whose MAAS machine was changed outside of cma-ssh.
+type DriftOptions struct {
+	// Interval is how often the MAAS machine of a ready machine is
+	// checked. Zero disables the drift check.
+	Interval time.Duration
+	// Replace deletes drifted machines owned by a machine set so that the
+	// machine set creates a replacement. Other drifted machines are always
+	// moved to the error phase.
+	Replace bool
+}
+
+// DefaultDriftOptions are the drift options used by cma-ssh.
+var DefaultDriftOptions = DriftOptions{
+	Interval: 5 * time.Minute,
+}
+
+// checkDrift compares a ready machine with its MAAS machine. The MAAS machine
+// has drifted when it was released, is no longer deployed or the provider id
+// now resolves to another system id.
+func (r *ReconcileMachine) checkDrift(machine *clusterv1alpha1.CnctMachine) (reconcile.Result, error) {
+	if r.drift.Interval <= 0 || machine.Spec.ProviderID == nil || machine.Status.SystemId == "" {
+		return reconcile.Result{}, nil
+	}
+
+	request := &maas.StatusRequest{ProviderID: *machine.Spec.ProviderID}
+	maasClient, err := r.maasClient(machine)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	m, err := maasClient.Status(context.Background(), request)
+	var message string
+	switch {
+	case err == maas.ErrMachineNotFound:
+		message = fmt.Sprintf("maas machine %s was released", machine.Status.SystemId)
+	case err != nil:
+		return reconcile.Result{}, errors.Wrapf(err, "could not get status of maas machine %s", machine.Status.SystemId)
+	case m.SystemID != machine.Status.SystemId:
+		message = fmt.Sprintf("maas machine %s was reassigned, provider id now belongs to %s", machine.Status.SystemId, m.SystemID)
+	case m.Status != maas.StatusDeployed:
+		message = fmt.Sprintf("maas machine %s was re-deployed, status is %q", machine.Status.SystemId, m.Status)
+	default:
+		return reconcile.Result{RequeueAfter: r.drift.Interval}, nil
+	}
+	return reconcile.Result{}, r.drifted(machine, message)
+}
+
+// drifted moves a drifted machine to the error phase. Drifted machines owned
+// by a machine set are deleted as well when Replace is set. Deleting a drifted
+// machine only releases MAAS machines still allocated for its provider id.
+func (r *ReconcileMachine) drifted(machine *clusterv1alpha1.CnctMachine, message string) error {
+	log.Info("maas machine drifted", "machine", machine.Name, "message", message)
+	reason := common.DriftedMachineError
+	machine.Status.Phase = common.ErrorMachinePhase
+	machine.Status.ErrorReason = &reason
+	machine.Status.ErrorMessage = &message
+	machine.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+	if err := r.Update(context.Background(), machine); err != nil {
+		return errors.Wrap(err, "could not update drifted machine")
+	}
+
+	owner := metav1.GetControllerOf(machine)
+	if !r.drift.Replace || owner == nil || owner.Kind != machineSetKind {
+		r.Event(machine, corev1.EventTypeWarning, "Drifted", message)
+		return nil
+	}
+	r.Eventf(machine, corev1.EventTypeWarning, "Drifted", "%s, replacing machine", message)
+	if err := r.Delete(context.Background(), machine); err != nil {
+		return errors.Wrap(err, "could not delete drifted machine")
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package machine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+)
+
+const driftProviderID = "2e8a2b3c-6d0f-11e9-a923-1681be663d3e"
+
+func testReady() *clusterv1alpha1.CnctMachine {
+	providerID := driftProviderID
+	return &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "cluster"},
+		Spec: clusterv1alpha1.MachineSpec{
+			Roles:        []common.MachineRoles{common.MachineRoleWorker},
+			InstanceType: "standard",
+			ProviderID:   &providerID,
+		},
+		Status: clusterv1alpha1.MachineStatus{
+			Phase:    common.ReadyMachinePhase,
+			SystemId: "abc123",
+		},
+	}
+}
+
+func Test_checkDrift(t *testing.T) {
+	tests := []struct {
+		name      string
+		machines  []fake.Machine
+		wantPhase common.MachineStatusPhase
+	}{
+		{
+			name:      "in sync",
+			machines:  []fake.Machine{{SystemID: "abc123", ProviderID: driftProviderID, Allocated: true, Deployed: true}},
+			wantPhase: common.ReadyMachinePhase,
+		},
+		{
+			name:      "released",
+			machines:  []fake.Machine{{SystemID: "abc123"}},
+			wantPhase: common.ErrorMachinePhase,
+		},
+		{
+			name:      "re-deployed",
+			machines:  []fake.Machine{{SystemID: "abc123", ProviderID: driftProviderID, Allocated: true, Deploying: true}},
+			wantPhase: common.ErrorMachinePhase,
+		},
+		{
+			name: "reassigned",
+			machines: []fake.Machine{
+				{SystemID: "abc123", ProviderID: "someone-else", Allocated: true, Deployed: true},
+				{SystemID: "def456", ProviderID: driftProviderID, Allocated: true, Deployed: true},
+			},
+			wantPhase: common.ErrorMachinePhase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine := testReady()
+			k8sClient := newFakeClientEventer(machine)
+			r := newTestReconciler(k8sClient, fake.New(tt.machines...))
+			r.drift = DriftOptions{Interval: time.Minute}
+
+			result, err := r.checkDrift(machine)
+			if err != nil {
+				t.Fatalf("checkDrift() error = %v", err)
+			}
+			got := getMachine(t, k8sClient, "worker")
+			if got.Status.Phase != tt.wantPhase {
+				t.Errorf("machine phase = %q, want %q", got.Status.Phase, tt.wantPhase)
+			}
+			if tt.wantPhase == common.ReadyMachinePhase {
+				if result.RequeueAfter != time.Minute {
+					t.Errorf("checkDrift() requeue after = %v, want %v", result.RequeueAfter, time.Minute)
+				}
+				return
+			}
+			if got.Status.ErrorReason == nil || *got.Status.ErrorReason != common.DriftedMachineError {
+				t.Errorf("machine error reason = %v, want %q", got.Status.ErrorReason, common.DriftedMachineError)
+			}
+		})
+	}
+}
+
+func Test_checkDrift_disabled(t *testing.T) {
+	machine := testReady()
+	k8sClient := newFakeClientEventer(machine)
+	provider := fake.New()
+	provider.StatusError = errNotCalled
+	r := newTestReconciler(k8sClient, provider)
+
+	result, err := r.checkDrift(machine)
+	if err != nil {
+		t.Fatalf("checkDrift() error = %v", err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("checkDrift() requeue after = %v, want 0", result.RequeueAfter)
+	}
+}
+
+func Test_checkDrift_replace(t *testing.T) {
+	machine := testReady()
+	machineSet := &clusterv1alpha1.CnctMachineSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "workers", Namespace: "cluster", UID: "1234"},
+	}
+	machine.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(machineSet, clusterv1alpha1.SchemeGroupVersion.WithKind(machineSetKind)),
+	}
+	k8sClient := newFakeClientEventer(machine)
+	r := newTestReconciler(k8sClient, fake.New(fake.Machine{SystemID: "abc123"}))
+	r.drift = DriftOptions{Interval: time.Minute, Replace: true}
+
+	if _, err := r.checkDrift(machine); err != nil {
+		t.Fatalf("checkDrift() error = %v", err)
+	}
+	var got clusterv1alpha1.CnctMachine
+	err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "worker"}, &got)
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("drifted machine was not deleted, get error = %v", err)
+	}
+}
+
+func Test_deleteMachine_drifted(t *testing.T) {
+	machine := testReady()
+	reason := common.DriftedMachineError
+	machine.Status.Phase = common.ErrorMachinePhase
+	machine.Status.ErrorReason = &reason
+	k8sClient := newFakeClientEventer(machine)
+	provider := fake.New(fake.Machine{SystemID: "abc123", ProviderID: "someone-else", Allocated: true, Deployed: true})
+	r := newTestReconciler(k8sClient, provider)
+
+	if err := deleteMachine(r, machine); err != nil {
+		t.Fatalf("deleteMachine() error = %v", err)
+	}
+	if m, _ := provider.Machine("abc123"); !m.Allocated {
+		t.Errorf("maas machine reassigned to someone else was released")
+	}
+}
@@ -0,0 +1,253 @@
+package machine
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+)
+
+const (
+	// DefaultEndpointPort is the port of a control plane endpoint served by
+	// an external load balancer, the port of the apiservers.
+	DefaultEndpointPort = 6443
+	// DefaultVIPPort is the port haproxy serves a control plane virtual ip
+	// at on the masters, the apiservers already listen on 6443.
+	DefaultVIPPort = 8443
+	// DefaultVirtualRouterID is the vrrp router id of a control plane
+	// virtual ip which does not set one.
+	DefaultVirtualRouterID = 51
+
+	keepalivedImage = "osixia/keepalived:2.0.17"
+	haproxyImage    = "haproxy:1.9-alpine"
+
+	// vipInterfacePlaceholder is replaced by the interface of the default
+	// route when the interface of the virtual ip is only known once the
+	// machine has booted.
+	vipInterfacePlaceholder = "__VIP_INTERFACE__"
+)
+
+// ValidateControlPlaneEndpoint checks the control plane endpoint of a cluster
+// spec, nil is valid.
+func ValidateControlPlaneEndpoint(endpoint *clusterv1alpha1.ControlPlaneEndpoint) error {
+	if endpoint == nil {
+		return nil
+	}
+	if endpoint.Host == "" {
+		return fmt.Errorf("control plane endpoint has no host")
+	}
+	if endpoint.Port < 0 || endpoint.Port > 65535 {
+		return fmt.Errorf("control plane endpoint port %d is out of range", endpoint.Port)
+	}
+	if vip := endpoint.VIP; vip != nil {
+		if net.ParseIP(endpoint.Host) == nil {
+			return fmt.Errorf("control plane virtual ip %q is not an ip address", endpoint.Host)
+		}
+		if vip.VirtualRouterID < 0 || vip.VirtualRouterID > 255 {
+			return fmt.Errorf("control plane virtual router id %d is out of range", vip.VirtualRouterID)
+		}
+		if endpoint.Port == 6443 {
+			return fmt.Errorf("control plane virtual ip port 6443 is used by the apiservers")
+		}
+	}
+	return nil
+}
+
+// controlPlaneEndpoint returns the host:port of the control plane endpoint of
+// the cluster, "" if it is not set.
+func controlPlaneEndpoint(spec clusterv1alpha1.ClusterSpec) string {
+	endpoint := spec.ControlPlaneEndpoint
+	if endpoint == nil {
+		return ""
+	}
+	return net.JoinHostPort(endpoint.Host, strconv.Itoa(int(controlPlaneEndpointPort(endpoint))))
+}
+
+func controlPlaneEndpointPort(endpoint *clusterv1alpha1.ControlPlaneEndpoint) int32 {
+	switch {
+	case endpoint.Port != 0:
+		return endpoint.Port
+	case endpoint.VIP != nil:
+		return DefaultVIPPort
+	default:
+		return DefaultEndpointPort
+	}
+}
+
+// vipData is the control plane virtual ip of a master as used by vipTmplText.
+type vipData struct {
+	Address          string
+	Port             int32
+	Interface        string
+	ResolveInterface bool
+	VirtualRouterID  int32
+	KeepalivedImage  string
+	HaproxyImage     string
+}
+
+// newVIPData returns the virtual ip served by the master, nil if the cluster
+// does not use one. The interface of the virtual ip defaults to the node
+// interface of the machine.
+func newVIPData(spec clusterv1alpha1.ClusterSpec, machine *clusterv1alpha1.CnctMachine) *vipData {
+	endpoint := spec.ControlPlaneEndpoint
+	if endpoint == nil || endpoint.VIP == nil {
+		return nil
+	}
+	data := &vipData{
+		Address:         endpoint.Host,
+		Port:            controlPlaneEndpointPort(endpoint),
+		Interface:       endpoint.VIP.Interface,
+		VirtualRouterID: endpoint.VIP.VirtualRouterID,
+		KeepalivedImage: keepalivedImage,
+		HaproxyImage:    haproxyImage,
+	}
+	if data.VirtualRouterID == 0 {
+		data.VirtualRouterID = DefaultVirtualRouterID
+	}
+	if data.Interface == "" {
+		if i := MaasNetwork(machine.Spec.Network).NodeInterface(); i != nil {
+			data.Interface = i.InterfaceName()
+		}
+	}
+	if data.Interface == "" {
+		data.Interface = vipInterfacePlaceholder
+		data.ResolveInterface = true
+	}
+	return data
+}
+
+// vipTmplText defines the userdata of the masters serving the control plane
+// virtual ip, following the keepalived and haproxy static pods of the kubeadm
+// high availability guide. keepalived holds the virtual ip on a master whose
+// apiserver is healthy, haproxy forwards the port of the virtual ip to the
+// apiserver of the master. The static pods are written before kubeadm runs,
+// so its check for an empty manifests directory is skipped.
+const vipTmplText = `
+{{- define "vipFiles" }}
+{{- with .VIP }}
+ - owner: root:root
+   path: /etc/keepalived/keepalived.conf
+   permissions: '0644'
+   content: |
+     vrrp_script check_apiserver {
+       script "/etc/keepalived/check_apiserver.sh"
+       interval 3
+       weight -2
+       fall 10
+       rise 2
+     }
+     vrrp_instance control_plane {
+       state BACKUP
+       interface {{ .Interface }}
+       virtual_router_id {{ .VirtualRouterID }}
+       priority 100
+       virtual_ipaddress {
+         {{ .Address }}
+       }
+       track_script {
+         check_apiserver
+       }
+     }
+ - owner: root:root
+   path: /etc/keepalived/check_apiserver.sh
+   permissions: '0755'
+   content: |
+     #!/bin/sh
+     curl --silent --max-time 2 --insecure https://localhost:{{ .Port }}/healthz -o /dev/null || exit 1
+     if ip addr | grep -q " {{ .Address }}/"; then
+       curl --silent --max-time 2 --insecure https://{{ .Address }}:{{ .Port }}/healthz -o /dev/null || exit 1
+     fi
+ - owner: root:root
+   path: /etc/haproxy/haproxy.cfg
+   permissions: '0644'
+   content: |
+     defaults
+       mode tcp
+       timeout connect 10s
+       timeout client 1h
+       timeout server 1h
+     frontend apiserver
+       bind *:{{ .Port }}
+       default_backend apiserver
+     backend apiserver
+       option httpchk GET /healthz
+       http-check expect status 200
+       server apiserver 127.0.0.1:6443 check check-ssl verify none
+ - owner: root:root
+   path: /etc/kubernetes/manifests/keepalived.yaml
+   permissions: '0644'
+   content: |
+     apiVersion: v1
+     kind: Pod
+     metadata:
+       name: keepalived
+       namespace: kube-system
+     spec:
+       hostNetwork: true
+       containers:
+       - name: keepalived
+         image: {{ .KeepalivedImage }}
+         securityContext:
+           capabilities:
+             add: [NET_ADMIN, NET_BROADCAST, NET_RAW]
+         volumeMounts:
+         - name: config
+           mountPath: /usr/local/etc/keepalived/keepalived.conf
+         - name: check
+           mountPath: /etc/keepalived/check_apiserver.sh
+       volumes:
+       - name: config
+         hostPath:
+           path: /etc/keepalived/keepalived.conf
+       - name: check
+         hostPath:
+           path: /etc/keepalived/check_apiserver.sh
+ - owner: root:root
+   path: /etc/kubernetes/manifests/haproxy.yaml
+   permissions: '0644'
+   content: |
+     apiVersion: v1
+     kind: Pod
+     metadata:
+       name: haproxy
+       namespace: kube-system
+     spec:
+       hostNetwork: true
+       containers:
+       - name: haproxy
+         image: {{ .HaproxyImage }}
+         volumeMounts:
+         - name: config
+           mountPath: /usr/local/etc/haproxy/haproxy.cfg
+           readOnly: true
+       volumes:
+       - name: config
+         hostPath:
+           path: /etc/haproxy/haproxy.cfg
+{{- if .ResolveInterface }}
+ - owner: root:root
+   path: /var/tmp/vip-interface.sh
+   permissions: '0755'
+   content: |
+     #!/bin/sh
+     iface=$(ip -4 route show default | awk '{for (i = 1; i < NF; i++) if ($i == "dev") {print $(i + 1); exit}}')
+     if [ -z "$iface" ]; then
+       echo "could not find the interface of the default route" >&2
+       exit 1
+     fi
+     sed -i "s/` + vipInterfacePlaceholder + `/$iface/g" /etc/keepalived/keepalived.conf
+{{- end }}
+{{- end }}
+{{- end }}
+{{- define "vipRun" }}
+{{- with .VIP }}
+{{- if .ResolveInterface }}
+ - [ sh, -c, "/var/tmp/vip-interface.sh" ]
+{{- end }}
+{{- end }}
+{{- end }}
+{{- define "vipPreflight" }}
+{{- if .VIP }} --ignore-preflight-errors=DirAvailable--etc-kubernetes-manifests{{ end }}
+{{- end }}
+`
@@ -0,0 +1,143 @@
+package machine
+
+import (
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/cert"
+)
+
+func TestValidateControlPlaneEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint *clusterv1alpha1.ControlPlaneEndpoint
+		wantErr  bool
+	}{
+		{name: "unset"},
+		{name: "load balancer", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "api.example.com"}},
+		{name: "vip", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "10.0.0.100", VIP: &clusterv1alpha1.ControlPlaneVIP{}}},
+		{name: "no host", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Port: 443}, wantErr: true},
+		{name: "port", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "api.example.com", Port: 70000}, wantErr: true},
+		{name: "vip name", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "api.example.com", VIP: &clusterv1alpha1.ControlPlaneVIP{}}, wantErr: true},
+		{name: "vip apiserver port", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "10.0.0.100", Port: 6443, VIP: &clusterv1alpha1.ControlPlaneVIP{}}, wantErr: true},
+		{name: "router id", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "10.0.0.100", VIP: &clusterv1alpha1.ControlPlaneVIP{VirtualRouterID: 256}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateControlPlaneEndpoint(tt.endpoint); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateControlPlaneEndpoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_creator_apiEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint *clusterv1alpha1.ControlPlaneEndpoint
+		want     string
+	}{
+		{name: "init master", want: "10.0.0.10:6443"},
+		{name: "load balancer", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "api.example.com"}, want: "api.example.com:6443"},
+		{name: "load balancer port", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "api.example.com", Port: 443}, want: "api.example.com:443"},
+		{name: "vip", endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "10.0.0.100", VIP: &clusterv1alpha1.ControlPlaneVIP{}}, want: "10.0.0.100:8443"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := testCluster()
+			cluster.Spec.ControlPlaneEndpoint = tt.endpoint
+			c := &creator{cluster: *cluster, nodeIP: "10.0.0.10"}
+			if got := c.apiEndpoint(); got != tt.want {
+				t.Errorf("apiEndpoint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userdata_controlPlaneEndpoint(t *testing.T) {
+	bundle, err := cert.CABundleFromMap(testSecret(t).Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		endpoint   *clusterv1alpha1.ControlPlaneEndpoint
+		join       bool
+		want       []string
+		wantNoVIP  bool
+		wantNoFlag bool
+	}{
+		{
+			name:       "load balancer",
+			endpoint:   &clusterv1alpha1.ControlPlaneEndpoint{Host: "api.example.com"},
+			want:       []string{"controlPlaneEndpoint: api.example.com:6443"},
+			wantNoVIP:  true,
+			wantNoFlag: true,
+		},
+		{
+			name:     "vip",
+			endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "10.0.0.100", VIP: &clusterv1alpha1.ControlPlaneVIP{VirtualRouterID: 60}},
+			want: []string{
+				"controlPlaneEndpoint: 10.0.0.100:8443",
+				"path: /etc/kubernetes/manifests/keepalived.yaml",
+				"path: /etc/kubernetes/manifests/haproxy.yaml",
+				"interface " + vipInterfacePlaceholder,
+				"virtual_router_id 60",
+				"bind *:8443",
+				"/var/tmp/vip-interface.sh",
+				"--config /var/tmp/masterconfig.yaml --ignore-preflight-errors=DirAvailable--etc-kubernetes-manifests",
+			},
+		},
+		{
+			name:     "vip joining master",
+			endpoint: &clusterv1alpha1.ControlPlaneEndpoint{Host: "10.0.0.100", VIP: &clusterv1alpha1.ControlPlaneVIP{Interface: "eth1"}},
+			join:     true,
+			want: []string{
+				"apiServerEndpoint: 10.0.0.100:8443",
+				"interface eth1",
+				"virtual_router_id 51",
+				"kubeadm join --node-name master --config /var/tmp/masterconfig.yaml --ignore-preflight-errors=DirAvailable--etc-kubernetes-manifests",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := testCluster()
+			cluster.Spec.ControlPlaneEndpoint = tt.endpoint
+			cluster.Status.APIEndpoint = controlPlaneEndpoint(cluster.Spec)
+			c := &creator{machine: testMaster(), cluster: *cluster, isMaster: true, joinControlPlane: tt.join, token: "abcdef.0123456789abcdef"}
+
+			userdata, err := c.userdata(bundle)
+			if err != nil {
+				t.Fatalf("userdata() error = %v", err)
+			}
+			var config cloudConfig
+			if err := yaml.Unmarshal([]byte(userdata), &config); err != nil {
+				t.Fatalf("userdata is not valid yaml: %v\n%s", err, userdata)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(userdata, want) {
+					t.Errorf("userdata does not contain %q:\n%s", want, userdata)
+				}
+			}
+			if tt.wantNoVIP && strings.Contains(userdata, "keepalived") {
+				t.Errorf("userdata serves a virtual ip:\n%s", userdata)
+			}
+			if tt.wantNoFlag && strings.Contains(userdata, "--ignore-preflight-errors") {
+				t.Errorf("userdata skips preflight checks:\n%s", userdata)
+			}
+		})
+	}
+
+	cluster := testCluster()
+	cluster.Spec.ControlPlaneEndpoint = &clusterv1alpha1.ControlPlaneEndpoint{Host: "api.example.com", VIP: &clusterv1alpha1.ControlPlaneVIP{}}
+	c := &creator{machine: testMaster(), cluster: *cluster, isMaster: true}
+	if _, err := c.userdata(bundle); err == nil {
+		t.Errorf("userdata() with an invalid endpoint succeeded")
+	} else if _, ok := err.(unrecoverableError); !ok {
+		t.Errorf("userdata() error = %v, want unrecoverableError", err)
+	}
+}
@@ -0,0 +1,567 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/cert"
+	"github.com/samsung-cnct/cma-ssh/pkg/etcd"
+)
+
+// etcdClientName is the common name of the client certificate the operator
+// manages the external etcd clusters with.
+const etcdClientName = "cma-ssh-etcd-client"
+
+// etcdInitialClusterPlaceholder is replaced by the members of the external
+// etcd cluster when a machine joins it, see etcdJoinScript.
+const etcdInitialClusterPlaceholder = "__ETCD_INITIAL_CLUSTER__"
+
+// isEtcdMachine reports whether the machine is a dedicated member of an
+// external etcd cluster. Masters with the etcd role run a stacked member.
+func isEtcdMachine(machine *clusterv1alpha1.CnctMachine) bool {
+	if isMaster(machine) {
+		return false
+	}
+	for _, v := range machine.Spec.Roles {
+		if v == common.MachineRoleEtcd {
+			return true
+		}
+	}
+	return false
+}
+
+// etcdAddress returns the address the etcd member of the machine is reached
+// at, "" before the machine is provisioned.
+func etcdAddress(machine *clusterv1alpha1.CnctMachine) string {
+	return machine.Status.SshConfig.Host
+}
+
+func etcdClientURL(address string) string {
+	return "https://" + net.JoinHostPort(address, strconv.Itoa(etcd.ClientPort))
+}
+
+func etcdPeerURL(address string) string {
+	return "https://" + net.JoinHostPort(address, strconv.Itoa(etcd.PeerPort))
+}
+
+// etcdMachines returns the dedicated etcd machines of the cluster in the
+// namespace which are not being deleted, except the machine itself.
+func etcdMachines(k8sClient client.Client, namespace, except string) ([]clusterv1alpha1.CnctMachine, error) {
+	var machines clusterv1alpha1.CnctMachineList
+	if err := k8sClient.List(context.Background(), &client.ListOptions{Namespace: namespace}, &machines); err != nil {
+		return nil, errors.Wrap(err, "could not list machines")
+	}
+	var out []clusterv1alpha1.CnctMachine
+	for _, m := range machines.Items {
+		if m.Name != except && isEtcdMachine(&m) && m.DeletionTimestamp.IsZero() {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// readyEtcdEndpoints returns the client urls of the ready etcd machines.
+func readyEtcdEndpoints(machines []clusterv1alpha1.CnctMachine) []string {
+	var endpoints []string
+	for _, m := range machines {
+		if m.Status.Phase == common.ReadyMachinePhase && etcdAddress(&m) != "" {
+			endpoints = append(endpoints, etcdClientURL(etcdAddress(&m)))
+		}
+	}
+	return endpoints
+}
+
+// electInitEtcd records the first etcd machine created on the cluster as the
+// one starting the external etcd cluster, like electInitMaster. The init
+// etcd machine is only replaced while no other etcd machine has been
+// provisioned, those are members of the cluster it started.
+func (c *creator) electInitEtcd() {
+	if c.err != nil || !c.isEtcd {
+		return
+	}
+
+	status := c.cluster.Status
+	if status.InitEtcd != c.machine.Name {
+		elect := status.InitEtcd == ""
+		if !elect {
+			var initEtcd clusterv1alpha1.CnctMachine
+			err := c.k8sClient.Get(context.Background(), client.ObjectKey{Namespace: c.machine.Namespace, Name: status.InitEtcd}, &initEtcd)
+			if err != nil && !apierrors.IsNotFound(err) {
+				c.err = err
+				return
+			}
+			if apierrors.IsNotFound(err) || !initEtcd.DeletionTimestamp.IsZero() {
+				others, err := etcdMachines(c.k8sClient, c.machine.Namespace, c.machine.Name)
+				if err != nil {
+					c.err = err
+					return
+				}
+				elect = true
+				for _, m := range others {
+					if m.Name != status.InitEtcd && provisioned(&m) {
+						elect = false
+					}
+				}
+			}
+		}
+		if elect {
+			log.Info("electing init etcd machine", "machine", c.machine.Name)
+			c.cluster.Status.InitEtcd = c.machine.Name
+			c.cluster.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+			if err := c.k8sClient.Update(context.Background(), &c.cluster); err != nil {
+				c.err = errors.Wrap(err, "could not record init etcd machine")
+				return
+			}
+		}
+	}
+	c.joinEtcd = c.cluster.Status.InitEtcd != c.machine.Name
+}
+
+// checkEtcdMembers waits for the etcd machines this machine depends on. An
+// etcd machine joins the members of the ready etcd machines one at a time,
+// etcd loses quorum while more than one added member has not started. The
+// init master of an external etcd cluster waits for every etcd machine to be
+// ready so that its apiserver starts with all of them, later changes are
+// propagated by syncEtcdEndpoints.
+func (c *creator) checkEtcdMembers() {
+	if c.err != nil || !(c.joinEtcd || c.externalEtcd() && c.initsControlPlane()) {
+		return
+	}
+
+	log.Info("checking etcd machines")
+	others, err := etcdMachines(c.k8sClient, c.machine.Namespace, c.machine.Name)
+	if err != nil {
+		c.err = err
+		return
+	}
+	c.etcdEndpoints = readyEtcdEndpoints(others)
+	if c.joinEtcd {
+		for _, m := range others {
+			if provisioned(&m) && m.Status.Phase != common.ReadyMachinePhase {
+				c.err = notReadyError(fmt.Sprintf("etcd machine %s is joining the etcd cluster", m.Name))
+				return
+			}
+		}
+		if len(c.etcdEndpoints) == 0 {
+			c.err = notReadyError("no etcd machine is ready to join")
+		}
+		return
+	}
+	if members := len(others); members == 0 || len(c.etcdEndpoints) < members {
+		c.err = notReadyError(fmt.Sprintf("%d of %d etcd machines are ready", len(c.etcdEndpoints), members))
+	}
+}
+
+// externalEtcd reports whether the masters of the cluster use the etcd
+// cluster of the dedicated etcd machines.
+func (c *creator) externalEtcd() bool {
+	return c.cluster.Spec.EtcdTopology == clusterv1alpha1.ExternalEtcd
+}
+
+// etcdClientFiles returns the client certificate of the apiserver of a master
+// for the external etcd cluster, kubeadm does not create it when the etcd is
+// external.
+func etcdClientFiles(bundle *cert.CABundle) ([]cert.TarFile, error) {
+	certPem, keyPem, err := bundle.EtcdClientCert("kube-apiserver-etcd-client", []string{"system:masters"})
+	if err != nil {
+		return nil, err
+	}
+	return []cert.TarFile{
+		{Name: "apiserver-etcd-client.crt", Body: certPem, Mode: 0644},
+		{Name: "apiserver-etcd-client.key", Body: keyPem, Mode: 0600},
+	}, nil
+}
+
+// etcdTmplText defines the ClusterConfiguration of the masters using the
+// external etcd cluster, and the timer keeping the etcd servers of their
+// apiserver in sync with the endpoints cma-ssh writes to the kubeadm-config
+// ConfigMap when etcd members are added or removed.
+const etcdTmplText = `
+{{- define "etcdExternal" }}
+{{- with .EtcdEndpoints }}
+     etcd:
+       external:
+         endpoints:
+{{- range . }}
+         - {{ . }}
+{{- end }}
+         caFile: /etc/kubernetes/pki/etcd/ca.crt
+         certFile: /etc/kubernetes/pki/apiserver-etcd-client.crt
+         keyFile: /etc/kubernetes/pki/apiserver-etcd-client.key
+{{- end }}
+{{- end }}
+
+{{- define "etcdSyncFiles" }}
+{{- if .ExternalEtcd }}
+ - owner: root:root
+   path: /usr/local/bin/etcd-servers-sync.py
+   permissions: '0755'
+   content: |
+     #!/usr/bin/env python3
+     # sets the etcd servers of the apiserver to the external etcd endpoints
+     # of the kubeadm ClusterConfiguration, which cma-ssh keeps up to date
+     import os, re, subprocess, yaml
+     MANIFEST = '/etc/kubernetes/manifests/kube-apiserver.yaml'
+     # the kubelet would run a temporary file in the manifest directory
+     TMP = '/etc/kubernetes/kube-apiserver.yaml.tmp'
+     config = yaml.safe_load(subprocess.check_output(['kubectl', '--kubeconfig', '/etc/kubernetes/admin.conf',
+         '-n', 'kube-system', 'get', 'configmap', '` + kubeadmConfigMap + `', '-o', 'jsonpath={.data.ClusterConfiguration}']))
+     endpoints = config['etcd']['external']['endpoints']
+     manifest = open(MANIFEST).read()
+     synced = re.sub(r'--etcd-servers=\S+', '--etcd-servers=' + ','.join(endpoints), manifest)
+     if endpoints and synced != manifest:
+         open(TMP, 'w').write(synced)
+         os.replace(TMP, MANIFEST)
+ - owner: root:root
+   path: /etc/systemd/system/etcd-servers-sync.service
+   permissions: '0644'
+   content: |
+     [Unit]
+     Description=Set the etcd servers of the apiserver to the etcd members
+     [Service]
+     Type=oneshot
+     ExecStart=/usr/local/bin/etcd-servers-sync.py
+ - owner: root:root
+   path: /etc/systemd/system/etcd-servers-sync.timer
+   permissions: '0644'
+   content: |
+     [Timer]
+     OnBootSec=1min
+     OnUnitActiveSec=1min
+     [Install]
+     WantedBy=timers.target
+{{- end }}
+{{- end }}
+
+{{- define "etcdSyncRun" }}
+{{- if .ExternalEtcd }}
+ - [ sh, -c, "systemctl daemon-reload && systemctl enable --now etcd-servers-sync.timer" ]
+{{- end }}
+{{- end }}
+`
+
+// etcdUserdataTmplText follows the external etcd guide of kubeadm: the kubelet
+// only runs the static pods of the machine, kubeadm creates the etcd
+// certificates with the etcd CA of the cluster and the static pod of the
+// member. A joining machine adds itself to the etcd cluster first.
+const etcdUserdataTmplText = `#cloud-config
+write_files:
+ - encoding: b64
+   content: {{ .Tar }}
+   owner: root:root
+   path: /etc/kubernetes/pki/certs.tar
+   permissions: '0600'
+{{- template "nodeIPScript" . }}
+ - owner: root:root
+   path: /etc/systemd/system/kubelet.service.d/20-etcd-service-manager.conf
+   permissions: '0644'
+   content: |
+     [Service]
+     ExecStart=
+     ExecStart=/usr/bin/kubelet --address=127.0.0.1 --pod-manifest-path=/etc/kubernetes/manifests
+     Restart=always
+ - owner: root:root
+   path: /var/tmp/etcdconfig.yaml
+   permissions: '0644'
+   content: |
+     apiVersion: kubeadm.k8s.io/v1beta1
+     kind: InitConfiguration
+     localAPIEndpoint:
+       advertiseAddress: {{ .NodeIP }}
+     nodeRegistration:
+       name: {{ .Name }}
+     ---
+     apiVersion: kubeadm.k8s.io/v1beta1
+     kind: ClusterConfiguration
+     etcd:
+       local:
+         serverCertSANs:
+         - {{ .NodeIP }}
+         peerCertSANs:
+         - {{ .NodeIP }}
+{{- if .Endpoints }}
+         extraArgs:
+           initial-cluster: ` + etcdInitialClusterPlaceholder + `
+           initial-cluster-state: existing
+ - owner: root:root
+   path: /var/tmp/etcd-join.py
+   permissions: '0755'
+   content: |
+     #!/usr/bin/env python3
+     # adds the machine to the etcd cluster and sets the initial cluster
+     import json, re, ssl, sys, time, urllib.error, urllib.request
+     NAME = '{{ .Name }}'
+     ENDPOINTS = [{{ range .Endpoints }}'{{ . }}', {{ end }}]
+     PKI = '/etc/kubernetes/pki/etcd/'
+     CONFIG = '/var/tmp/etcdconfig.yaml'
+     config = open(CONFIG).read()
+     peer = 'https://%s:2380' % re.search(r'advertiseAddress: (\S+)', config).group(1)
+     ctx = ssl.create_default_context(cafile=PKI + 'ca.crt')
+     ctx.load_cert_chain(PKI + 'healthcheck-client.crt', PKI + 'healthcheck-client.key')
+     def call(path, body):
+         for endpoint in ENDPOINTS:
+             for prefix in ('/v3', '/v3beta', '/v3alpha'):
+                 request = urllib.request.Request(endpoint + prefix + path, json.dumps(body).encode(), {'Content-Type': 'application/json'})
+                 try:
+                     with urllib.request.urlopen(request, context=ctx, timeout=10) as response:
+                         return json.loads(response.read().decode())
+                 except urllib.error.HTTPError as e:
+                     if e.code != 404:
+                         break
+                 except OSError:
+                     break
+         raise RuntimeError('no etcd endpoint answered ' + path)
+     for attempt in range(60):
+         try:
+             members = call('/cluster/member/list', {}).get('members', [])
+             mine = [m for m in members if peer in m.get('peerURLs', [])]
+             if not mine:
+                 if [m for m in members if not m.get('name')]:
+                     raise RuntimeError('another member has not started yet')
+                 call('/cluster/member/add', {'peerURLs': [peer]})
+                 continue
+             initial = ['%s=%s' % (m['name'], url) for m in members if m.get('name') and m not in mine for url in m.get('peerURLs', [])]
+             initial.append('%s=%s' % (NAME, peer))
+             open(CONFIG, 'w').write(config.replace('` + etcdInitialClusterPlaceholder + `', ','.join(initial)))
+             sys.exit(0)
+         except (OSError, RuntimeError, ValueError) as e:
+             print(e, file=sys.stderr)
+             time.sleep(10)
+     sys.exit(1)
+{{- end }}
+
+runcmd:
+ - [ sh, -c, "swapoff -a" ]
+ - [ sh, -c, "sed -ri.bak '/ swap / s/^(.*)$/#\\1/g' /etc/fstab" ]
+ - [ sh, -c, "tar xf /etc/kubernetes/pki/certs.tar -C /etc/kubernetes/pki" ]
+{{- template "nodeIPRun" . }}
+ - [ sh, -c, "systemctl daemon-reload && systemctl restart kubelet" ]
+ - [ sh, -c, "kubeadm init phase certs etcd-server --config /var/tmp/etcdconfig.yaml" ]
+ - [ sh, -c, "kubeadm init phase certs etcd-peer --config /var/tmp/etcdconfig.yaml" ]
+ - [ sh, -c, "kubeadm init phase certs etcd-healthcheck-client --config /var/tmp/etcdconfig.yaml" ]
+{{- if .Endpoints }}
+ - [ sh, -c, "python3 /var/tmp/etcd-join.py" ]
+{{- end }}
+ - [ sh, -c, "kubeadm init phase etcd local --config /var/tmp/etcdconfig.yaml" ]
+
+output : { all : '| tee -a /var/log/cloud-init-output.log' }
+`
+
+var etcdUserdataTmpl = template.Must(template.Must(template.New("etcd").Parse(nodeIPTmplText)).Parse(etcdUserdataTmplText))
+
+// etcdUserdata returns the userdata of a dedicated etcd machine. The init etcd
+// machine starts a new etcd cluster, the others join the members at the
+// endpoints of the ready etcd machines. The address of the member is resolved
+// on boot if it is not static.
+func etcdUserdata(c *creator, bundle *cert.CABundle) (string, error) {
+	caTar, err := bundle.EtcdToTar()
+	if err != nil {
+		return "", err
+	}
+	var userdata strings.Builder
+	data := struct {
+		nodeIPData
+		Name      string
+		Tar       string
+		Endpoints []string
+	}{
+		nodeIPData: newNodeIPData(c.machine, "/var/tmp/etcdconfig.yaml"),
+		Name:       c.machine.Name,
+		Tar:        caTar,
+	}
+	if c.joinEtcd {
+		data.Endpoints = c.etcdEndpoints
+	}
+	if data.NodeIP == "" {
+		data.NodeIP = nodeIPPlaceholder
+		data.ResolveNodeIP = true
+	}
+	if err := etcdUserdataTmpl.Execute(&userdata, data); err != nil {
+		return "", err
+	}
+	return userdata.String(), nil
+}
+
+// kubeadmConfigMap is the ConfigMap in kube-system holding the
+// ClusterConfiguration kubeadm join and upgrade read.
+const kubeadmConfigMap = "kubeadm-config"
+
+// syncEtcdEndpoints writes the endpoints of the ready etcd machines of the
+// namespace to the ClusterConfiguration of the cluster, after an etcd member
+// was added or removed. The joining masters copy the endpoints from there
+// and every master sets the etcd servers of its apiserver to them, see
+// etcdTmplText. Nothing is written before the control plane is initialized.
+func (r *ReconcileMachine) syncEtcdEndpoints(namespace string) error {
+	machines, err := etcdMachines(r, namespace, "")
+	if err != nil {
+		return err
+	}
+	endpoints := readyEtcdEndpoints(machines)
+	if len(endpoints) == 0 {
+		return nil
+	}
+	clientset, err := clusterClientset(r, namespace)
+	if err != nil || clientset == nil {
+		return err
+	}
+	return setKubeadmEtcdEndpoints(clientset.CoreV1().ConfigMaps("kube-system"), endpoints)
+}
+
+// clusterClientset returns a clientset of the cluster in the namespace, or nil
+// if the cluster has no kubeconfig yet.
+func clusterClientset(k8sClient client.Client, namespace string) (kubernetes.Interface, error) {
+	var secret corev1.Secret
+	err := k8sClient.Get(context.Background(), client.ObjectKey{Name: "cluster-private-key", Namespace: namespace}, &secret)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "could not get cluster secret")
+	}
+	configData := secret.Data[corev1.ServiceAccountKubeconfigKey]
+	if len(configData) == 0 {
+		return nil, nil
+	}
+	config, err := clientcmd.NewClientConfigFromBytes(configData)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create new client config from secret")
+	}
+	restConfig, err := config.ClientConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create rest client config")
+	}
+	return kubernetes.NewForConfig(restConfig)
+}
+
+// setKubeadmEtcdEndpoints replaces the external etcd endpoints of the
+// ClusterConfiguration in the kubeadm-config ConfigMap if they differ.
+func setKubeadmEtcdEndpoints(configMaps typedcorev1.ConfigMapInterface, endpoints []string) error {
+	configMap, err := configMaps.Get(kubeadmConfigMap, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "could not get kubeadm config")
+	}
+	var config map[string]interface{}
+	if err := yaml.Unmarshal([]byte(configMap.Data["ClusterConfiguration"]), &config); err != nil {
+		return errors.Wrap(err, "could not parse the kubeadm cluster configuration")
+	}
+	etcdConfig, _ := config["etcd"].(map[string]interface{})
+	external, ok := etcdConfig["external"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("the kubeadm cluster configuration has no external etcd")
+	}
+	current, _ := external["endpoints"].([]interface{})
+	if len(current) == len(endpoints) {
+		same := true
+		for i := range current {
+			same = same && current[i] == endpoints[i]
+		}
+		if same {
+			return nil
+		}
+	}
+	log.Info("updating etcd endpoints of the kubeadm config", "endpoints", endpoints)
+	external["endpoints"] = endpoints
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	configMap.Data["ClusterConfiguration"] = string(data)
+	if _, err := configMaps.Update(configMap); err != nil {
+		return errors.Wrap(err, "could not update kubeadm config")
+	}
+	return nil
+}
+
+// dialEtcd connects to the external etcd cluster of the namespace with a
+// client certificate signed by the etcd CA of the cluster.
+func dialEtcd(k8sClient client.Client, dialer etcd.Dialer, namespace string, endpoints []string) (etcd.Client, error) {
+	var secret corev1.Secret
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Name: "cluster-private-key", Namespace: namespace}, &secret); err != nil {
+		return nil, errors.Wrap(err, "could not get cluster secret")
+	}
+	bundle, err := cert.CABundleFromMap(secret.Data)
+	if err != nil {
+		return nil, err
+	}
+	certPem, keyPem, err := bundle.EtcdClientCert(etcdClientName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return dialer.Dial(&etcd.Config{Endpoints: endpoints, CA: bundle.Etcd, Cert: certPem, Key: keyPem})
+}
+
+// handleEtcdWaitingForReady marks a provisioned etcd machine ready once its
+// member serves clients. Etcd machines do not join the kubernetes cluster, so
+// there is no node to wait for.
+func (r *ReconcileMachine) handleEtcdWaitingForReady(machine *clusterv1alpha1.CnctMachine) error {
+	endpoint := etcdClientURL(etcdAddress(machine))
+	etcdClient, err := dialEtcd(r, r.Etcd, machine.Namespace, []string{endpoint})
+	if err != nil {
+		return err
+	}
+	if _, err := etcdClient.Status(context.Background(), endpoint); err != nil {
+		return notReadyError(fmt.Sprintf("etcd member of machine %s is not ready: %v", machine.Name, err))
+	}
+	machine.Status.Phase = common.ReadyMachinePhase
+	return r.Update(context.Background(), machine)
+}
+
+// removeEtcdMember removes the member of a deleted etcd machine from the
+// external etcd cluster through the other etcd machines, so the cluster does
+// not count it for its quorum. The last etcd machine has no one to remove it
+// from.
+func (r *ReconcileMachine) removeEtcdMember(machine *clusterv1alpha1.CnctMachine) error {
+	others, err := etcdMachines(r, machine.Namespace, machine.Name)
+	if err != nil {
+		return err
+	}
+	var endpoints []string
+	for _, m := range others {
+		if etcdAddress(&m) != "" {
+			endpoints = append(endpoints, etcdClientURL(etcdAddress(&m)))
+		}
+	}
+	if len(endpoints) == 0 || etcdAddress(machine) == "" {
+		return nil
+	}
+	etcdClient, err := dialEtcd(r, r.Etcd, machine.Namespace, endpoints)
+	if err != nil {
+		return err
+	}
+	members, err := etcdClient.MemberList(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "could not list etcd members")
+	}
+	peerURL := etcdPeerURL(etcdAddress(machine))
+	for _, member := range members {
+		for _, u := range member.PeerURLs {
+			if u != peerURL {
+				continue
+			}
+			log.Info("removing etcd member", "machine", machine.Name, "member", member.ID.String())
+			if err := etcdClient.MemberRemove(context.Background(), member.ID); err != nil {
+				return errors.Wrapf(err, "could not remove etcd member %s of machine %s", member.ID, machine.Name)
+			}
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,466 @@
+package machine
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/cert"
+	"github.com/samsung-cnct/cma-ssh/pkg/etcd"
+	etcdfake "github.com/samsung-cnct/cma-ssh/pkg/etcd/fake"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// testEtcd returns a dedicated etcd machine, it is provisioned if it has an
+// address.
+func testEtcd(name string, phase common.MachineStatusPhase, address string) *clusterv1alpha1.CnctMachine {
+	machine := &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "cluster"},
+		Spec: clusterv1alpha1.MachineSpec{
+			Roles:        []common.MachineRoles{common.MachineRoleEtcd},
+			InstanceType: "standard",
+		},
+		Status: clusterv1alpha1.MachineStatus{Phase: phase},
+	}
+	if address != "" {
+		machine.Status.SystemId = "system-" + name
+		machine.Status.SshConfig.Host = address
+	}
+	return machine
+}
+
+func Test_isEtcdMachine(t *testing.T) {
+	if isEtcdMachine(testMaster()) {
+		t.Errorf("isEtcdMachine() of a stacked master = true")
+	}
+	if !isEtcdMachine(testEtcd("etcd", "", "")) {
+		t.Errorf("isEtcdMachine() of an etcd machine = false")
+	}
+}
+
+func Test_electInitEtcd(t *testing.T) {
+	tests := []struct {
+		name        string
+		initEtcd    string
+		others      []runtime.Object
+		wantInit    string
+		wantJoining bool
+	}{
+		{name: "first etcd machine", wantInit: "etcd"},
+		{name: "elected", initEtcd: "etcd", wantInit: "etcd"},
+		{
+			name:        "other etcd machine elected",
+			initEtcd:    "other",
+			others:      []runtime.Object{testEtcd("other", "", "")},
+			wantInit:    "other",
+			wantJoining: true,
+		},
+		{name: "elected etcd machine deleted", initEtcd: "other", wantInit: "etcd"},
+		{
+			name:        "etcd cluster started",
+			initEtcd:    "other",
+			others:      []runtime.Object{testEtcd("member", common.ReadyMachinePhase, "10.0.0.11")},
+			wantInit:    "other",
+			wantJoining: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := testCluster()
+			cluster.Status.InitEtcd = tt.initEtcd
+			machine := testEtcd("etcd", "", "")
+			k8sClient := newFakeClientEventer(append(tt.others, cluster, machine)...)
+
+			c := &creator{k8sClient: k8sClient, machine: machine, isEtcd: true}
+			c.getCluster()
+			c.electInitEtcd()
+			if c.err != nil {
+				t.Fatalf("electInitEtcd() error = %v", c.err)
+			}
+			if c.joinEtcd != tt.wantJoining {
+				t.Errorf("joinEtcd = %v, want %v", c.joinEtcd, tt.wantJoining)
+			}
+			var got clusterv1alpha1.CnctCluster
+			if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "cluster"}, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Status.InitEtcd != tt.wantInit {
+				t.Errorf("init etcd = %q, want %q", got.Status.InitEtcd, tt.wantInit)
+			}
+		})
+	}
+}
+
+func Test_checkEtcdMembers(t *testing.T) {
+	tests := []struct {
+		name          string
+		master        bool
+		others        []runtime.Object
+		wantEndpoints []string
+		wantNotReady  bool
+	}{
+		{
+			name:          "joining etcd machine",
+			others:        []runtime.Object{testEtcd("init", common.ReadyMachinePhase, "10.0.0.11")},
+			wantEndpoints: []string{"https://10.0.0.11:2379"},
+		},
+		{
+			name:         "joining etcd machine, no ready member",
+			others:       []runtime.Object{testEtcd("init", common.DeployingMachinePhase, "10.0.0.11")},
+			wantNotReady: true,
+		},
+		{
+			name: "joining etcd machine, other joining",
+			others: []runtime.Object{
+				testEtcd("init", common.ReadyMachinePhase, "10.0.0.11"),
+				testEtcd("other", common.ProvisioningMachinePhase, "10.0.0.12"),
+			},
+			wantNotReady: true,
+		},
+		{
+			name:   "init master, all ready",
+			master: true,
+			others: []runtime.Object{
+				testEtcd("etcd-a", common.ReadyMachinePhase, "10.0.0.11"),
+				testEtcd("etcd-b", common.ReadyMachinePhase, "10.0.0.12"),
+				testEtcd("etcd-c", common.ReadyMachinePhase, "10.0.0.13"),
+			},
+			wantEndpoints: []string{"https://10.0.0.11:2379", "https://10.0.0.12:2379", "https://10.0.0.13:2379"},
+		},
+		{
+			// the endpoints of the apiservers are not updated later
+			name:   "init master, quorum",
+			master: true,
+			others: []runtime.Object{
+				testEtcd("etcd-a", common.ReadyMachinePhase, "10.0.0.11"),
+				testEtcd("etcd-b", common.ReadyMachinePhase, "10.0.0.12"),
+				testEtcd("etcd-c", common.ProvisioningMachinePhase, "10.0.0.13"),
+			},
+			wantNotReady: true,
+		},
+		{
+			name:   "init master, no quorum",
+			master: true,
+			others: []runtime.Object{
+				testEtcd("etcd-a", common.ReadyMachinePhase, "10.0.0.11"),
+				testEtcd("etcd-b", common.ProvisioningMachinePhase, "10.0.0.12"),
+				testEtcd("etcd-c", "", ""),
+			},
+			wantNotReady: true,
+		},
+		{name: "init master, no etcd machines", master: true, wantNotReady: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := testCluster()
+			cluster.Spec.EtcdTopology = clusterv1alpha1.ExternalEtcd
+			machine := testEtcd("etcd", "", "")
+			c := &creator{machine: machine, cluster: *cluster, isEtcd: true, joinEtcd: true}
+			if tt.master {
+				machine = testMaster()
+				c = &creator{machine: machine, cluster: *cluster, isMaster: true}
+			}
+			c.k8sClient = newFakeClientEventer(append(tt.others, cluster, machine)...)
+
+			c.checkEtcdMembers()
+			if _, ok := c.err.(notReadyError); ok != tt.wantNotReady {
+				t.Fatalf("checkEtcdMembers() error = %v, want not ready %v", c.err, tt.wantNotReady)
+			}
+			if !tt.wantNotReady && !reflect.DeepEqual(c.etcdEndpoints, tt.wantEndpoints) {
+				t.Errorf("etcd endpoints = %v, want %v", c.etcdEndpoints, tt.wantEndpoints)
+			}
+		})
+	}
+}
+
+func Test_create_initEtcd(t *testing.T) {
+	cluster := testCluster()
+	cluster.Spec.EtcdTopology = clusterv1alpha1.ExternalEtcd
+	machine := testEtcd("etcd", "", "")
+	// the secret has no kubeconfig, etcd machines do not need a join token
+	k8sClient := newFakeClientEventer(cluster, testSecret(t), machine)
+	provider := testProvider()
+
+	if err := create(k8sClient, maas.SingleRegion{Provider: provider}, machine); err != nil {
+		t.Fatalf("create() error = %v", err)
+	}
+	m, _ := provider.Machine("abc123")
+	if !m.Deploying {
+		t.Errorf("maas machine is not deploying")
+	}
+	for _, want := range []string{
+		"path: /etc/systemd/system/kubelet.service.d/20-etcd-service-manager.conf",
+		"advertiseAddress: " + nodeIPPlaceholder,
+		"/var/tmp/node-ip.sh '' /var/tmp/etcdconfig.yaml",
+		"kubeadm init phase certs etcd-server --config /var/tmp/etcdconfig.yaml",
+		"kubeadm init phase etcd local --config /var/tmp/etcdconfig.yaml",
+	} {
+		if !strings.Contains(m.Userdata, want) {
+			t.Errorf("userdata does not contain %q:\n%s", want, m.Userdata)
+		}
+	}
+	if strings.Contains(m.Userdata, "etcd-join.py") || strings.Contains(m.Userdata, "kubeadm join") {
+		t.Errorf("init etcd machine joins a cluster:\n%s", m.Userdata)
+	}
+	var got clusterv1alpha1.CnctCluster
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "cluster"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.InitEtcd != "etcd" {
+		t.Errorf("cluster init etcd = %q, want etcd", got.Status.InitEtcd)
+	}
+}
+
+func Test_etcdUserdata_join(t *testing.T) {
+	bundle, err := cert.CABundleFromMap(testSecret(t).Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &creator{
+		machine:       testEtcd("etcd", "", ""),
+		cluster:       *testCluster(),
+		isEtcd:        true,
+		joinEtcd:      true,
+		etcdEndpoints: []string{"https://10.0.0.11:2379", "https://10.0.0.12:2379"},
+	}
+	userdata, err := c.userdata(bundle)
+	if err != nil {
+		t.Fatalf("userdata() error = %v", err)
+	}
+	var config cloudConfig
+	if err := yaml.Unmarshal([]byte(userdata), &config); err != nil {
+		t.Fatalf("userdata is not valid yaml: %v\n%s", err, userdata)
+	}
+	for _, want := range []string{
+		"initial-cluster: " + etcdInitialClusterPlaceholder,
+		"initial-cluster-state: existing",
+		"ENDPOINTS = ['https://10.0.0.11:2379', 'https://10.0.0.12:2379', ]",
+		"NAME = 'etcd'",
+	} {
+		if !strings.Contains(userdata, want) {
+			t.Errorf("userdata does not contain %q:\n%s", want, userdata)
+		}
+	}
+	var runs []string
+	for _, cmd := range config.RunCmd {
+		runs = append(runs, cmd[len(cmd)-1])
+	}
+	join, local := -1, -1
+	for i, run := range runs {
+		switch run {
+		case "python3 /var/tmp/etcd-join.py":
+			join = i
+		case "kubeadm init phase etcd local --config /var/tmp/etcdconfig.yaml":
+			local = i
+		}
+	}
+	if join < 0 || local < join {
+		t.Errorf("etcd machine does not join before starting its member: %v", runs)
+	}
+}
+
+func Test_masterUserdata_externalEtcd(t *testing.T) {
+	bundle, err := cert.CABundleFromMap(testSecret(t).Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster := testCluster()
+	cluster.Spec.EtcdTopology = clusterv1alpha1.ExternalEtcd
+	c := &creator{
+		machine:       testMaster(),
+		cluster:       *cluster,
+		isMaster:      true,
+		etcdEndpoints: []string{"https://10.0.0.11:2379", "https://10.0.0.12:2379"},
+	}
+	userdata, err := c.userdata(bundle)
+	if err != nil {
+		t.Fatalf("userdata() error = %v", err)
+	}
+	if err := yaml.Unmarshal([]byte(userdata), &cloudConfig{}); err != nil {
+		t.Fatalf("userdata is not valid yaml: %v\n%s", err, userdata)
+	}
+	want := `
+     etcd:
+       external:
+         endpoints:
+         - https://10.0.0.11:2379
+         - https://10.0.0.12:2379
+         caFile: /etc/kubernetes/pki/etcd/ca.crt
+         certFile: /etc/kubernetes/pki/apiserver-etcd-client.crt
+         keyFile: /etc/kubernetes/pki/apiserver-etcd-client.key
+     networking:`
+	if !strings.Contains(userdata, want) {
+		t.Errorf("userdata does not configure the external etcd:\n%s", userdata)
+	}
+	if !strings.Contains(userdata, "systemctl enable --now etcd-servers-sync.timer") {
+		t.Errorf("userdata does not sync the etcd servers of the apiserver:\n%s", userdata)
+	}
+
+	c.joinControlPlane = true
+	c.cluster.Status.APIEndpoint = "10.0.0.1:6443"
+	userdata, err = c.userdata(bundle)
+	if err != nil {
+		t.Fatalf("userdata() of a joining master error = %v", err)
+	}
+	if err := yaml.Unmarshal([]byte(userdata), &cloudConfig{}); err != nil {
+		t.Fatalf("userdata of a joining master is not valid yaml: %v\n%s", err, userdata)
+	}
+	if !strings.Contains(userdata, "systemctl enable --now etcd-servers-sync.timer") {
+		t.Errorf("joining master does not sync the etcd servers of the apiserver:\n%s", userdata)
+	}
+
+	tar, err := c.masterTar(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stacked, err := bundle.ToTar()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tar) <= len(stacked) {
+		t.Errorf("master certs do not include the etcd client certificate")
+	}
+}
+
+func Test_handleEtcdWaitingForReady(t *testing.T) {
+	machine := testEtcd("etcd", common.ProvisioningMachinePhase, "10.0.0.11")
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine)
+	cluster := etcdfake.New()
+	cluster.Down = map[string]bool{"https://10.0.0.11:2379": true}
+	r := newTestReconciler(k8sClient, testProvider())
+	r.Etcd = cluster
+
+	if err := r.handleEtcdWaitingForReady(machine); err == nil {
+		t.Fatalf("handleEtcdWaitingForReady() of a down member succeeded")
+	} else if _, ok := err.(notReadyError); !ok {
+		t.Fatalf("handleEtcdWaitingForReady() error = %v, want notReadyError", err)
+	}
+	if got := cluster.Config().Endpoints; !reflect.DeepEqual(got, []string{"https://10.0.0.11:2379"}) {
+		t.Errorf("etcd endpoints = %v", got)
+	}
+
+	cluster.Down = nil
+	if err := r.handleEtcdWaitingForReady(machine); err != nil {
+		t.Fatalf("handleEtcdWaitingForReady() error = %v", err)
+	}
+	if got := getMachine(t, k8sClient, "etcd"); got.Status.Phase != common.ReadyMachinePhase {
+		t.Errorf("machine phase = %q, want %q", got.Status.Phase, common.ReadyMachinePhase)
+	}
+}
+
+func Test_handleDelete_etcdMember(t *testing.T) {
+	machine := testEtcd("etcd", common.DeletingMachinePhase, "10.0.0.11")
+	machine.Status.SystemId = "abc123"
+	machine.Finalizers = []string{clusterv1alpha1.MachineFinalizer}
+	other := testEtcd("other", common.ReadyMachinePhase, "10.0.0.12")
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine, other)
+	cluster := etcdfake.New(
+		etcd.Member{ID: 1, Name: "etcd", PeerURLs: []string{"https://10.0.0.11:2380"}},
+		etcd.Member{ID: 2, Name: "other", PeerURLs: []string{"https://10.0.0.12:2380"}},
+	)
+	r := newTestReconciler(k8sClient, testProvider())
+	r.Etcd = cluster
+
+	if err := r.handleDelete(machine); err != nil {
+		t.Fatalf("handleDelete() error = %v", err)
+	}
+	if got := cluster.Removed(); !reflect.DeepEqual(got, []etcd.MemberID{1}) {
+		t.Errorf("removed etcd members %v, want [1]", got)
+	}
+	if got := cluster.Config().Endpoints; !reflect.DeepEqual(got, []string{"https://10.0.0.12:2379"}) {
+		t.Errorf("etcd endpoints = %v, want the other member", got)
+	}
+	if got := getMachine(t, k8sClient, "etcd"); len(got.Finalizers) != 0 {
+		t.Errorf("machine finalizers = %v, want none", got.Finalizers)
+	}
+}
+
+// stubConfigMaps holds the kubeadm-config ConfigMap, if set, and records the
+// updates.
+type stubConfigMaps struct {
+	typedcorev1.ConfigMapInterface
+	configMap *corev1.ConfigMap
+	updated   []*corev1.ConfigMap
+}
+
+func (s *stubConfigMaps) Get(name string, options metav1.GetOptions) (*corev1.ConfigMap, error) {
+	if s.configMap == nil || s.configMap.Name != name {
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), name)
+	}
+	return s.configMap.DeepCopy(), nil
+}
+
+func (s *stubConfigMaps) Update(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	s.updated = append(s.updated, configMap)
+	s.configMap = configMap
+	return configMap, nil
+}
+
+func Test_setKubeadmEtcdEndpoints(t *testing.T) {
+	clusterConfiguration := `apiVersion: kubeadm.k8s.io/v1beta1
+kind: ClusterConfiguration
+controlPlaneEndpoint: 10.0.0.1:6443
+etcd:
+  external:
+    caFile: /etc/kubernetes/pki/etcd/ca.crt
+    certFile: /etc/kubernetes/pki/apiserver-etcd-client.crt
+    endpoints:
+    - https://10.0.0.11:2379
+    - https://10.0.0.12:2379
+    keyFile: /etc/kubernetes/pki/apiserver-etcd-client.key
+`
+	configMaps := &stubConfigMaps{configMap: &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: kubeadmConfigMap, Namespace: "kube-system"},
+		Data:       map[string]string{"ClusterConfiguration": clusterConfiguration},
+	}}
+
+	if err := setKubeadmEtcdEndpoints(configMaps, []string{"https://10.0.0.11:2379", "https://10.0.0.12:2379"}); err != nil {
+		t.Fatalf("setKubeadmEtcdEndpoints() error = %v", err)
+	}
+	if len(configMaps.updated) != 0 {
+		t.Errorf("unchanged endpoints were updated")
+	}
+
+	// 10.0.0.12 was replaced by 10.0.0.13
+	endpoints := []string{"https://10.0.0.11:2379", "https://10.0.0.13:2379"}
+	if err := setKubeadmEtcdEndpoints(configMaps, endpoints); err != nil {
+		t.Fatalf("setKubeadmEtcdEndpoints() error = %v", err)
+	}
+	if len(configMaps.updated) != 1 {
+		t.Fatalf("endpoints were updated %d times, want once", len(configMaps.updated))
+	}
+	var config struct {
+		ControlPlaneEndpoint string `json:"controlPlaneEndpoint"`
+		Etcd                 struct {
+			External struct {
+				Endpoints []string `json:"endpoints"`
+				CAFile    string   `json:"caFile"`
+			} `json:"external"`
+		} `json:"etcd"`
+	}
+	if err := yaml.Unmarshal([]byte(configMaps.configMap.Data["ClusterConfiguration"]), &config); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(config.Etcd.External.Endpoints, endpoints) {
+		t.Errorf("endpoints = %v, want %v", config.Etcd.External.Endpoints, endpoints)
+	}
+	if config.ControlPlaneEndpoint != "10.0.0.1:6443" || config.Etcd.External.CAFile == "" {
+		t.Errorf("the rest of the cluster configuration was not kept: %+v", config)
+	}
+
+	// the control plane is not initialized yet
+	if err := setKubeadmEtcdEndpoints(&stubConfigMaps{}, endpoints); err != nil {
+		t.Errorf("setKubeadmEtcdEndpoints() without kubeadm config error = %v", err)
+	}
+}
@@ -0,0 +1,251 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// AddGarbageCollector adds a garbage collector for MAAS machines to the
+// Manager. Every interval the machines allocated by cma-ssh, which are those
+// with the agent name of its MAAS client, are compared to the CnctMachine
+// objects:
+//
+//   - a MAAS machine whose ProviderID is not used by any CnctMachine is
+//     released. This includes machines whose owner data, and so ProviderID,
+//     was never set because cma-ssh failed right after allocating them.
+//   - a CnctMachine whose MAAS machine no longer exists is moved to the
+//     error phase. MAAS machines are matched by system id against the whole
+//     inventory since machines allocated before owner data was recorded, or
+//     whose owner data could not be set, are not listed as allocated by
+//     cma-ssh.
+//
+// Both only happen once the mismatch has been observed for longer than
+// gracePeriod so that machines being created or deleted are left alone. The
+// machines of every MAAS region are collected.
+func AddGarbageCollector(mgr manager.Manager, regions maas.Regions, interval, gracePeriod time.Duration) error {
+	return mgr.Add(newGarbageCollector(
+		mgr.GetClient(),
+		mgr.GetRecorder("MachineGarbageCollector"),
+		regions,
+		interval,
+		gracePeriod,
+	))
+}
+
+type garbageCollector struct {
+	client.Client
+	record.EventRecorder
+	regions     maas.Regions
+	interval    time.Duration
+	gracePeriod time.Duration
+	now         func() time.Time
+
+	// orphans and missing hold the time a MAAS machine without a CnctMachine
+	// and a CnctMachine without a MAAS machine were first observed. Orphans
+	// are keyed by region and system id.
+	orphans map[string]time.Time
+	missing map[types.NamespacedName]time.Time
+}
+
+func newGarbageCollector(
+	k8sClient client.Client,
+	recorder record.EventRecorder,
+	regions maas.Regions,
+	interval, gracePeriod time.Duration,
+) *garbageCollector {
+	return &garbageCollector{
+		Client:        k8sClient,
+		EventRecorder: recorder,
+		regions:       regions,
+		interval:      interval,
+		gracePeriod:   gracePeriod,
+		now:           time.Now,
+		orphans:       map[string]time.Time{},
+		missing:       map[types.NamespacedName]time.Time{},
+	}
+}
+
+// Start implements manager.Runnable.
+func (gc *garbageCollector) Start(stop <-chan struct{}) error {
+	wait.Until(func() {
+		if err := gc.collect(); err != nil {
+			log.Error(err, "maas garbage collection failed")
+		}
+	}, gc.interval, stop)
+	return nil
+}
+
+func (gc *garbageCollector) collect() error {
+	log.Info("collecting maas machines")
+	regions, err := gc.regions.Names(context.Background())
+	if err != nil {
+		return err
+	}
+	var machines clusterv1alpha1.CnctMachineList
+	if err := gc.List(context.Background(), &client.ListOptions{}, &machines); err != nil {
+		return err
+	}
+
+	orphans := map[string]time.Time{}
+	missing := map[types.NamespacedName]time.Time{}
+	for _, region := range regions {
+		if err := gc.collectRegion(region, machines.Items, orphans, missing); err != nil {
+			log.Error(err, "maas garbage collection failed", "region", region)
+			// Keep what was observed so far for the region so that its
+			// grace periods continue once it can be listed again.
+			for key, firstSeen := range gc.orphans {
+				if strings.HasPrefix(key, region+"/") {
+					orphans[key] = firstSeen
+				}
+			}
+			for i := range machines.Items {
+				key := types.NamespacedName{Namespace: machines.Items[i].Namespace, Name: machines.Items[i].Name}
+				if firstSeen, ok := gc.missing[key]; ok && machines.Items[i].Status.MaasRegion == region {
+					missing[key] = firstSeen
+				}
+			}
+		}
+	}
+	gc.orphans = orphans
+	gc.missing = missing
+
+	return nil
+}
+
+// collectRegion compares the MAAS machines of a region with the CnctMachine
+// objects and records the mismatches still in their grace period in orphans
+// and missing.
+func (gc *garbageCollector) collectRegion(
+	region string,
+	machines []clusterv1alpha1.CnctMachine,
+	orphans map[string]time.Time,
+	missing map[types.NamespacedName]time.Time,
+) error {
+	maasClient, err := gc.regions.Region(context.Background(), region)
+	if err != nil {
+		return err
+	}
+	maasMachines, err := maasClient.List(context.Background())
+	if err != nil {
+		return err
+	}
+	hosts, err := maasClient.Hosts(context.Background())
+	if err != nil {
+		return err
+	}
+	now := gc.now()
+
+	providerIDs := map[string]bool{}
+	for _, m := range machines {
+		if m.Spec.ProviderID != nil {
+			providerIDs[*m.Spec.ProviderID] = true
+		}
+	}
+	for _, m := range maasMachines {
+		if providerIDs[m.ProviderID] {
+			continue
+		}
+		key := region + "/" + m.SystemID
+		firstSeen, ok := gc.orphans[key]
+		if !ok {
+			firstSeen = now
+		}
+		if now.Sub(firstSeen) < gc.gracePeriod {
+			orphans[key] = firstSeen
+			continue
+		}
+		if err := gc.release(maasClient, m); err != nil {
+			log.Error(err, "could not release orphaned maas machine", "region", region, "systemID", m.SystemID)
+			orphans[key] = firstSeen
+		}
+	}
+
+	systemIDs := map[string]bool{}
+	for _, h := range hosts {
+		systemIDs[h.SystemID] = true
+	}
+	for i := range machines {
+		machine := &machines[i]
+		if machine.Status.MaasRegion != region || !hasMaasMachine(machine) || systemIDs[machine.Status.SystemId] {
+			continue
+		}
+		key := types.NamespacedName{Namespace: machine.Namespace, Name: machine.Name}
+		firstSeen, ok := gc.missing[key]
+		if !ok {
+			firstSeen = now
+		}
+		if now.Sub(firstSeen) < gc.gracePeriod {
+			missing[key] = firstSeen
+			continue
+		}
+		if err := gc.setMissing(machine); err != nil {
+			log.Error(err, "could not set machine phase to error", "machine", key)
+			missing[key] = firstSeen
+		}
+	}
+	return nil
+}
+
+// hasMaasMachine returns true if the machine should be backed by a MAAS
+// machine.
+func hasMaasMachine(machine *clusterv1alpha1.CnctMachine) bool {
+	if machine.Status.SystemId == "" || !machine.DeletionTimestamp.IsZero() {
+		return false
+	}
+	switch machine.Status.Phase {
+	case common.DeployingMachinePhase, common.ProvisioningMachinePhase, common.ReadyMachinePhase, common.UpgradingMachinePhase:
+		return true
+	}
+	return false
+}
+
+func (gc *garbageCollector) release(maasClient maas.MachineProvider, m maas.Machine) error {
+	log.Info("releasing orphaned maas machine", "systemID", m.SystemID, "providerID", m.ProviderID)
+	err := maasClient.Delete(
+		context.Background(),
+		&maas.DeleteRequest{ProviderID: m.ProviderID, SystemID: m.SystemID},
+	)
+	if err != nil {
+		return err
+	}
+	// There is no object for the MAAS machine so the event refers to the
+	// machine by its system id.
+	ref := &corev1.ObjectReference{
+		Kind:      "MaasMachine",
+		Name:      m.SystemID,
+		Namespace: metav1.NamespaceDefault,
+	}
+	gc.Eventf(ref, corev1.EventTypeNormal, "ReleasedOrphan",
+		"released maas machine %s (%s) with provider id %s which has no CnctMachine",
+		m.SystemID, m.Hostname, m.ProviderID)
+	return nil
+}
+
+func (gc *garbageCollector) setMissing(machine *clusterv1alpha1.CnctMachine) error {
+	log.Info("maas machine is missing", "machine", machine.Name, "systemID", machine.Status.SystemId)
+	reason := common.MissingMachineError
+	message := fmt.Sprintf("maas machine %s no longer exists", machine.Status.SystemId)
+	machine.Status.Phase = common.ErrorMachinePhase
+	machine.Status.ErrorReason = &reason
+	machine.Status.ErrorMessage = &message
+	machine.Status.LastUpdated = &metav1.Time{Time: gc.now()}
+	if err := gc.Update(context.Background(), machine); err != nil {
+		return err
+	}
+	gc.Event(machine, corev1.EventTypeWarning, string(reason), message)
+	return nil
+}
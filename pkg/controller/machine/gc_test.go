@@ -0,0 +1,167 @@
+package machine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+)
+
+func Test_garbageCollector_collect(t *testing.T) {
+	ownedID, missingID := "owned-provider-id", "missing-provider-id"
+	owned := &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "owned", Namespace: "cluster"},
+		Spec:       clusterv1alpha1.MachineSpec{ProviderID: &ownedID},
+		Status:     clusterv1alpha1.MachineStatus{Phase: common.ReadyMachinePhase, SystemId: "owned"},
+	}
+	missing := &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "cluster"},
+		Spec:       clusterv1alpha1.MachineSpec{ProviderID: &missingID},
+		Status:     clusterv1alpha1.MachineStatus{Phase: common.ReadyMachinePhase, SystemId: "missing"},
+	}
+	k8sClient := newFakeClientEventer(owned, missing)
+	provider := fake.New(
+		fake.Machine{SystemID: "owned", Allocated: true, Deployed: true, ProviderID: ownedID},
+		fake.Machine{SystemID: "orphan", Allocated: true, Deployed: true, ProviderID: "orphan-provider-id"},
+	)
+
+	now := time.Now()
+	gc := newGarbageCollector(k8sClient, k8sClient, maas.SingleRegion{Provider: provider}, time.Minute, 10*time.Minute)
+	gc.now = func() time.Time { return now }
+
+	if err := gc.collect(); err != nil {
+		t.Fatalf("collect() error = %v", err)
+	}
+	if m, _ := provider.Machine("orphan"); !m.Allocated {
+		t.Errorf("orphan was released before the grace period")
+	}
+
+	now = now.Add(11 * time.Minute)
+	if err := gc.collect(); err != nil {
+		t.Fatalf("collect() error = %v", err)
+	}
+	if m, _ := provider.Machine("orphan"); m.Allocated {
+		t.Errorf("orphan was not released after the grace period")
+	}
+	if m, _ := provider.Machine("owned"); !m.Allocated {
+		t.Errorf("owned machine was released")
+	}
+
+	var got clusterv1alpha1.CnctMachine
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "missing"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Phase != common.ErrorMachinePhase {
+		t.Errorf("missing machine phase = %q, want %q", got.Status.Phase, common.ErrorMachinePhase)
+	}
+	if got.Status.ErrorReason == nil || *got.Status.ErrorReason != common.MissingMachineError {
+		t.Errorf("missing machine error reason = %v, want %q", got.Status.ErrorReason, common.MissingMachineError)
+	}
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "owned"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Phase != common.ReadyMachinePhase {
+		t.Errorf("owned machine phase = %q, want %q", got.Status.Phase, common.ReadyMachinePhase)
+	}
+}
+
+func Test_garbageCollector_releaseFailed(t *testing.T) {
+	k8sClient := newFakeClientEventer()
+	provider := fake.New(fake.Machine{SystemID: "orphan", Allocated: true, Deployed: true, ProviderID: "orphan-provider-id"})
+	provider.ReleaseError = errors.New("maas is unavailable")
+
+	now := time.Now()
+	gc := newGarbageCollector(k8sClient, k8sClient, maas.SingleRegion{Provider: provider}, time.Minute, 10*time.Minute)
+	gc.now = func() time.Time { return now }
+	if err := gc.collect(); err != nil {
+		t.Fatalf("collect() error = %v", err)
+	}
+	now = now.Add(11 * time.Minute)
+	if err := gc.collect(); err != nil {
+		t.Fatalf("collect() error = %v", err)
+	}
+	if m, _ := provider.Machine("orphan"); !m.Allocated {
+		t.Fatalf("orphan was released")
+	}
+	select {
+	case event := <-k8sClient.Events:
+		t.Errorf("event %q recorded for a machine which was not released", event)
+	default:
+	}
+
+	// the release is retried on the next collection
+	provider.ReleaseError = nil
+	if err := gc.collect(); err != nil {
+		t.Fatalf("collect() error = %v", err)
+	}
+	if m, _ := provider.Machine("orphan"); m.Allocated {
+		t.Errorf("orphan was not released once maas recovered")
+	}
+}
+
+func Test_garbageCollector_allocatedWithoutOwnerData(t *testing.T) {
+	// cma-ssh failed between allocating the machine and setting its owner
+	// data, the agent name still marks it as allocated by cma-ssh
+	k8sClient := newFakeClientEventer()
+	provider := fake.New(
+		fake.Machine{SystemID: "orphan", Allocated: true, AgentName: maas.DefaultAgentName},
+		fake.Machine{SystemID: "other", Allocated: true, AgentName: "other-cma-ssh", ProviderID: "other-provider-id"},
+	)
+
+	now := time.Now()
+	gc := newGarbageCollector(k8sClient, k8sClient, maas.SingleRegion{Provider: provider}, time.Minute, 10*time.Minute)
+	gc.now = func() time.Time { return now }
+	for i := 0; i < 2; i++ {
+		if err := gc.collect(); err != nil {
+			t.Fatalf("collect() error = %v", err)
+		}
+		now = now.Add(11 * time.Minute)
+	}
+
+	if m, _ := provider.Machine("orphan"); m.Allocated {
+		t.Errorf("orphan without owner data was not released")
+	}
+	if m, _ := provider.Machine("other"); !m.Allocated {
+		t.Errorf("machine of another cma-ssh was released")
+	}
+}
+
+func Test_garbageCollector_noOwnerData(t *testing.T) {
+	// machines allocated before the agent name was recorded have no
+	// provider id and are not listed as allocated by cma-ssh
+	legacy := &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "cluster"},
+		Status:     clusterv1alpha1.MachineStatus{Phase: common.ReadyMachinePhase, SystemId: "legacy"},
+	}
+	k8sClient := newFakeClientEventer(legacy)
+	provider := fake.New(fake.Machine{SystemID: "legacy", Allocated: true, Deployed: true})
+
+	now := time.Now()
+	gc := newGarbageCollector(k8sClient, k8sClient, maas.SingleRegion{Provider: provider}, time.Minute, 10*time.Minute)
+	gc.now = func() time.Time { return now }
+	for i := 0; i < 2; i++ {
+		if err := gc.collect(); err != nil {
+			t.Fatalf("collect() error = %v", err)
+		}
+		now = now.Add(11 * time.Minute)
+	}
+
+	var got clusterv1alpha1.CnctMachine
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cluster", Name: "legacy"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Phase != common.ReadyMachinePhase {
+		t.Errorf("machine phase = %q, want %q", got.Status.Phase, common.ReadyMachinePhase)
+	}
+	if m, _ := provider.Machine("legacy"); !m.Allocated {
+		t.Errorf("maas machine without owner data was released")
+	}
+}
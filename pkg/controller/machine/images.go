@@ -1,7 +1,12 @@
 package machine
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/samsung-cnct/cma-ssh/pkg/maas"
 )
@@ -13,7 +18,9 @@ type image struct {
 	raw          string
 }
 
-func (i *image) set(key, value string) {
+// set sets the field of the image for key and returns whether the key is
+// known.
+func (i *image) set(key, value string) bool {
 	switch key {
 	case "os":
 		i.os = value
@@ -25,13 +32,30 @@ func (i *image) set(key, value string) {
 		i.instanceType = key
 	case "gpu":
 		i.instanceType = key
+	default:
+		return false
 	}
+	return true
+}
+
+// ImageName returns the canonical name of the image of an os series,
+// kubernetes version and instance type.
+func ImageName(osSeries, k8sVersion, instanceType string) string {
+	return fmt.Sprintf("os=%s,k8s=%s,type=%s", osSeries, k8sVersion, instanceType)
 }
 
 // parse decodes a string into an image and returns if it was successful.
 func parse(raw string) (image, bool) {
+	i, err := parseImage(raw)
+	return i, err == nil
+}
+
+// parseImage decodes a string into an image. The error explains why the
+// string is not an image.
+func parseImage(raw string) (image, error) {
 	var i image
 	i.raw = raw
+	var unknown []string
 	fields := strings.Split(raw, ",")
 	for _, field := range fields {
 		keyValue := strings.Split(field, "=")
@@ -43,31 +67,51 @@ func parse(raw string) (image, bool) {
 			key = keyValue[0]
 			value = keyValue[1]
 		default:
+			unknown = append(unknown, field)
 			continue
 		}
-		i.set(key, value)
+		if !i.set(key, value) && field != "" {
+			unknown = append(unknown, field)
+		}
 	}
-	if i.os == "" || i.k8sVersion == "" || i.instanceType == "" {
-		return image{}, false
+
+	var missing []string
+	if i.os == "" {
+		missing = append(missing, "os=<osSeries>")
 	}
-	return i, true
+	if i.k8sVersion == "" {
+		missing = append(missing, "k8s=<version>")
+	}
+	if i.instanceType == "" {
+		missing = append(missing, "standard, gpu or type=<instanceType>")
+	}
+	if len(missing) > 0 {
+		reason := "missing " + strings.Join(missing, ", ")
+		if len(unknown) > 0 {
+			reason += "; unrecognized " + strings.Join(unknown, ", ")
+		}
+		return image{}, errors.New(reason)
+	}
+	return i, nil
 }
 
-func getImages(c *maas.Client) (images []image) {
-	br, err := c.Controller.BootResources()
+// listImages returns the uploaded boot resources which parse as images.
+func listImages(c maas.MachineProvider) ([]image, error) {
+	br, err := c.ListImages(context.Background())
 	if err != nil {
-		return
+		return nil, err
 	}
 
+	var images []image
 	for _, v := range br {
-		if v.Type() != "Uploaded" {
+		if v.Type != maas.BootResourceUploaded {
 			continue
 		}
-		if i, ok := parse(v.Name()); ok {
+		if i, ok := parse(v.Name); ok {
 			images = append(images, i)
 		}
 	}
-	return
+	return images, nil
 }
 
 func (i *image) findIn(images []image) bool {
@@ -84,15 +128,79 @@ func (i *image) findIn(images []image) bool {
 }
 
 // getImage checks if maas contains an image that matches the user
-// specification and returns it.
-func getImage(c *maas.Client, osVersion, k8sVersion, instanceType string) string {
+// specification and returns it, or "" if there is none. An error is returned
+// if the images could not be listed.
+func getImage(c maas.MachineProvider, osVersion, k8sVersion, instanceType string) (string, error) {
 	i := image{
 		os:           osVersion,
 		k8sVersion:   k8sVersion,
 		instanceType: instanceType,
 	}
-	if i.findIn(getImages(c)) {
-		return i.raw
+	images, err := listImages(c)
+	if err != nil {
+		return "", err
+	}
+	if i.findIn(images) {
+		return i.raw, nil
+	}
+	return "", nil
+}
+
+// Image is an uploaded MaaS image machines can be deployed with.
+type Image struct {
+	OSSeries          string
+	KubernetesVersion string
+	InstanceType      string
+	Architecture      string
+	// Name is the name of the boot resource in MaaS
+	Name     string
+	Uploaded time.Time
+}
+
+// InvalidImage is an uploaded MaaS boot resource machines are never deployed
+// with since its name is not an image.
+type InvalidImage struct {
+	Name         string
+	Architecture string
+	Uploaded     time.Time
+	// Reason explains why the name is not an image
+	Reason string
+}
+
+// ImageCatalog returns the uploaded images, and the uploaded boot resources
+// which are not images, sorted by name.
+func ImageCatalog(c maas.MachineProvider) ([]Image, []InvalidImage, error) {
+	br, err := c.ListImages(context.Background())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var images []Image
+	var invalid []InvalidImage
+	for _, v := range br {
+		if v.Type != maas.BootResourceUploaded {
+			continue
+		}
+		i, err := parseImage(v.Name)
+		if err != nil {
+			invalid = append(invalid, InvalidImage{
+				Name:         v.Name,
+				Architecture: v.Architecture,
+				Uploaded:     v.Uploaded,
+				Reason:       err.Error(),
+			})
+			continue
+		}
+		images = append(images, Image{
+			OSSeries:          i.os,
+			KubernetesVersion: i.k8sVersion,
+			InstanceType:      i.instanceType,
+			Architecture:      v.Architecture,
+			Name:              v.Name,
+			Uploaded:          v.Uploaded,
+		})
 	}
-	return ""
+	sort.Slice(images, func(i, j int) bool { return images[i].Name < images[j].Name })
+	sort.Slice(invalid, func(i, j int) bool { return invalid[i].Name < invalid[j].Name })
+	return images, invalid, nil
 }
@@ -3,6 +3,10 @@ package machine
 import (
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
 )
 
 func Test_parse(t *testing.T) {
@@ -76,3 +80,60 @@ func Test_image_in(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseImage(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        string
+		wantReason string
+	}{
+		{name: "image", raw: "os=ubuntu,k8s=1.13.5,standard"},
+		{name: "empty string", raw: "", wantReason: "missing os=<osSeries>, k8s=<version>, standard, gpu or type=<instanceType>"},
+		{name: "unknown instance type", raw: "os=ubuntu,k8s=1.13.5,highmem", wantReason: "missing standard, gpu or type=<instanceType>; unrecognized highmem"},
+		{name: "malformed field", raw: "os=ubuntu,k8s=1.13.5=1.14.1,gpu", wantReason: "missing k8s=<version>; unrecognized k8s=1.13.5=1.14.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseImage(tt.raw)
+			var reason string
+			if err != nil {
+				reason = err.Error()
+			}
+			if reason != tt.wantReason {
+				t.Errorf("parseImage() reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestImageCatalog(t *testing.T) {
+	uploaded := time.Date(2019, 4, 4, 0, 0, 0, 0, time.UTC)
+	provider := fake.New()
+	provider.AddBootResource(maas.BootResource{Name: "os=ubuntu-xenial,k8s=1.13.5,standard", Type: maas.BootResourceUploaded, Architecture: "amd64/generic", Uploaded: uploaded})
+	provider.AddBootResource(maas.BootResource{Name: "ubuntu/xenial-k8s-1.14", Type: maas.BootResourceUploaded, Architecture: "amd64/generic"})
+	provider.AddBootResource(maas.BootResource{Name: "ubuntu/bionic", Type: "Synced", Architecture: "amd64/generic"})
+
+	images, invalid, err := ImageCatalog(provider)
+	if err != nil {
+		t.Fatalf("ImageCatalog() error = %v", err)
+	}
+	wantImages := []Image{{
+		OSSeries:          "ubuntu-xenial",
+		KubernetesVersion: "1.13.5",
+		InstanceType:      "standard",
+		Architecture:      "amd64/generic",
+		Name:              "os=ubuntu-xenial,k8s=1.13.5,standard",
+		Uploaded:          uploaded,
+	}}
+	if !reflect.DeepEqual(images, wantImages) {
+		t.Errorf("ImageCatalog() images = %+v, want %+v", images, wantImages)
+	}
+	wantInvalid := []InvalidImage{{
+		Name:         "ubuntu/xenial-k8s-1.14",
+		Architecture: "amd64/generic",
+		Reason:       "missing os=<osSeries>, k8s=<version>, standard, gpu or type=<instanceType>; unrecognized ubuntu/xenial-k8s-1.14",
+	}}
+	if !reflect.DeepEqual(invalid, wantInvalid) {
+		t.Errorf("ImageCatalog() invalid = %+v, want %+v", invalid, wantInvalid)
+	}
+}
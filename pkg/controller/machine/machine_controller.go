@@ -22,7 +22,9 @@ import (
 
 	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
 	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/etcd"
 	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/ssh"
 	"github.com/samsung-cnct/cma-ssh/pkg/util"
 
 	"github.com/pkg/errors"
@@ -47,17 +49,21 @@ var log = logf.Log.WithName("CnctMachine-controller")
 // AddWithActuator creates a new Machine Controller and adds it to the Manager
 // with default RBAC. The Manager will set fields on the Controller and Start
 // it when the Manager is Started.
-func AddWithActuator(mgr manager.Manager, maasClient maas.Client) error {
-	return add(mgr, newReconciler(mgr, maasClient))
+func AddWithActuator(mgr manager.Manager, regions maas.Regions, deploy DeployOptions, drift DriftOptions) error {
+	return add(mgr, newReconciler(mgr, regions, deploy, drift))
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager, maasClient maas.Client) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager, regions maas.Regions, deploy DeployOptions, drift DriftOptions) reconcile.Reconciler {
 	return &ReconcileMachine{
 		Client:        mgr.GetClient(),
 		scheme:        mgr.GetScheme(),
 		EventRecorder: mgr.GetRecorder("MachineController"),
-		MAASClient:    maasClient,
+		MAAS:          regions,
+		SSH:           ssh.DefaultDialer,
+		Etcd:          etcd.DefaultDialer,
+		deploy:        deploy.withDefaults(),
+		drift:         drift,
 	}
 }
 
@@ -81,7 +87,11 @@ type ReconcileMachine struct {
 	client.Client
 	scheme *runtime.Scheme
 	record.EventRecorder
-	MAASClient maas.Client
+	MAAS   maas.Regions
+	SSH    ssh.Dialer
+	Etcd   etcd.Dialer
+	deploy DeployOptions
+	drift  DriftOptions
 }
 
 // Reconcile reads that state of the cluster for a Machine object and makes changes based on the state read
@@ -89,6 +99,8 @@ type ReconcileMachine struct {
 // +kubebuilder:rbac:groups=cluster.cnct.sds.samsung.com,resources=cnctmachines;cnctclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cluster.cnct.sds.samsung.com,resources=cnctmaasregions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.cnct.sds.samsung.com,resources=cnctsshhosts,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 func (r *ReconcileMachine) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	log.Info("reconciling machine", "request", request)
@@ -116,15 +128,35 @@ func (r *ReconcileMachine) Reconcile(request reconcile.Request) (reconcile.Resul
 	}
 
 	log.Info("handle machine phases")
+	var result reconcile.Result
 	var err error
 	switch machine.Status.Phase {
+	case common.DeployingMachinePhase:
+		result, err = r.handleDeploying(&machine)
 	case common.ProvisioningMachinePhase:
-		err = r.handleWaitingForReady(&machine)
+		err = r.updateMaasMachine(&machine)
+		if err == nil && isEtcdMachine(&machine) {
+			err = r.handleEtcdWaitingForReady(&machine)
+		} else if err == nil {
+			err = r.handleWaitingForReady(&machine)
+		}
+	case common.ReadyMachinePhase:
+		err = r.updateMaasMachine(&machine)
+		if err == nil {
+			result, err = r.checkDrift(&machine)
+		}
+		if err == nil && isEtcdMachine(&machine) {
+			err = r.syncEtcdEndpoints(machine.Namespace)
+		}
 	case common.DeletingMachinePhase:
 		err = r.handleDelete(&machine)
-	case common.ErrorMachinePhase, common.ReadyMachinePhase, common.UpgradingMachinePhase:
+	case common.ErrorMachinePhase, common.UpgradingMachinePhase:
 	default:
-		err = create(r, &r.MAASClient, &machine)
+		if isSshMachine(&machine) {
+			err = createOnSshHost(r, r.SSH, &machine)
+		} else {
+			err = create(r, r.MAAS, &machine)
+		}
 	}
 	if err != nil {
 		switch e := errors.Cause(err).(type) {
@@ -138,16 +170,13 @@ func (r *ReconcileMachine) Reconcile(request reconcile.Request) (reconcile.Resul
 		case notReadyError:
 			log.Error(err, "during reconcile an object was not ready", "machine", machine)
 			return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
-		case releaseError:
-			log.Error(err, "during reconcile we needed to release a machine", "machine", machine)
-			errRelease := r.MAASClient.Delete(context.Background(), &maas.DeleteRequest{SystemID: e.systemID})
-			if errRelease != nil {
-				log.Error(errRelease, "YOU MUST RELEASE THIS MACHINE MANUALLY", "machine", machine.Status.SystemId)
-			}
-			return reconcile.Result{}, err
 		case unrecoverableError:
 			log.Error(err, "machine object has an unrecoverable error", "machine", machine)
+			reason := common.InvalidConfigurationMachineError
+			message := e.Error()
 			machine.Status.Phase = common.ErrorMachinePhase
+			machine.Status.ErrorReason = &reason
+			machine.Status.ErrorMessage = &message
 			updateErr := r.Client.Update(context.Background(), &machine)
 			if updateErr != nil {
 				return reconcile.Result{}, err
@@ -157,7 +186,7 @@ func (r *ReconcileMachine) Reconcile(request reconcile.Request) (reconcile.Resul
 		}
 	}
 	log.Info("machine reconcile completed", "machine", machine)
-	return reconcile.Result{}, nil
+	return result, nil
 }
 
 func (r *ReconcileMachine) handleUpgrade(
@@ -0,0 +1,37 @@
+package machine
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// maasRegion returns the maas region of the machine. A machine stays in the
+// region it was allocated in, other machines use the region of their
+// cluster. Machines without a cluster use the default region.
+func maasRegion(k8sClient client.Client, machine *clusterv1alpha1.CnctMachine) (string, error) {
+	if machine.Status.SystemId != "" {
+		return machine.Status.MaasRegion, nil
+	}
+	var clusters clusterv1alpha1.CnctClusterList
+	if err := k8sClient.List(context.Background(), &client.ListOptions{Namespace: machine.Namespace}, &clusters); err != nil {
+		return "", errors.Wrap(err, "could not list clusters")
+	}
+	if len(clusters.Items) == 0 {
+		return "", nil
+	}
+	return clusters.Items[0].Spec.MaasRegion, nil
+}
+
+// maasClient returns the provider of the maas region of the machine.
+func (r *ReconcileMachine) maasClient(machine *clusterv1alpha1.CnctMachine) (maas.MachineProvider, error) {
+	region, err := maasRegion(r.Client, machine)
+	if err != nil {
+		return nil, err
+	}
+	return r.MAAS.Region(context.Background(), region)
+}
@@ -0,0 +1,468 @@
+package machine
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/cert"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/ssh"
+	"github.com/samsung-cnct/cma-ssh/pkg/util"
+)
+
+// Files of the bootstrap of an ssh host. The bootstrap script runs in the
+// background and writes its exit status to bootstrapStatusPath once it is
+// done.
+const (
+	bootstrapDir        = "/var/lib/cma-ssh"
+	bootstrapScriptPath = bootstrapDir + "/bootstrap.sh"
+	bootstrapStatusPath = bootstrapDir + "/bootstrap.status"
+	bootstrapLogPath    = "/var/log/cma-ssh-bootstrap.log"
+)
+
+// Provider statuses of machines on ssh hosts.
+const (
+	SshStatusBootstrapping = "Bootstrapping"
+	SshStatusBootstrapped  = "Bootstrapped"
+	SshStatusFailed        = "Failed bootstrap"
+)
+
+// startBootstrapCmd stores the bootstrap script read from stdin and starts it
+// in the background, unless a bootstrap was already started.
+var startBootstrapCmd = fmt.Sprintf(
+	"if [ ! -e %[1]s ]; then mkdir -p %[2]s && cat > %[1]s.tmp && mv %[1]s.tmp %[1]s || exit 1; nohup sh %[1]s < /dev/null > /dev/null 2>&1 & fi",
+	bootstrapScriptPath, bootstrapDir,
+)
+
+// bootstrapStatusCmd prints the exit status of the bootstrap script, nothing
+// while it is running.
+var bootstrapStatusCmd = fmt.Sprintf("cat %s 2>/dev/null || true", bootstrapStatusPath)
+
+// bootstrapLogCmd prints the end of the output of the bootstrap script.
+var bootstrapLogCmd = fmt.Sprintf("tail -n 20 %s", bootstrapLogPath)
+
+// resetCmd undoes the bootstrap of an ssh host.
+var resetCmd = fmt.Sprintf(
+	"kubeadm reset -f && rm -rf %s /etc/kubernetes/pki/certs.tar /var/tmp/masterconfig.yaml /var/tmp/workerconfig.yaml /var/tmp/node-ip.sh",
+	bootstrapDir,
+)
+
+// isSshMachine reports whether the machine is bootstrapped on an ssh host
+// instead of a maas machine.
+func isSshMachine(machine *clusterv1alpha1.CnctMachine) bool {
+	return machine.Spec.SshHost != ""
+}
+
+// provisioned reports whether something was deployed for the machine, a maas
+// machine or the bootstrap of an ssh host.
+func provisioned(machine *clusterv1alpha1.CnctMachine) bool {
+	if isSshMachine(machine) {
+		return machine.Status.SshConfig.Host != ""
+	}
+	return machine.Status.SystemId != ""
+}
+
+// sshCommand runs cmd as root, with sudo unless the user is root.
+func sshCommand(username, cmd string) string {
+	if username == "root" {
+		return "sh -c " + ssh.Quote(cmd)
+	}
+	return "sudo -n sh -c " + ssh.Quote(cmd)
+}
+
+// cloudConfig is the part of the cloud-config userdata of a machine which
+// is replayed on ssh hosts.
+type cloudConfig struct {
+	WriteFiles []struct {
+		Encoding    string `json:"encoding"`
+		Content     string `json:"content"`
+		Owner       string `json:"owner"`
+		Path        string `json:"path"`
+		Permissions string `json:"permissions"`
+	} `json:"write_files"`
+	RunCmd [][]string `json:"runcmd"`
+}
+
+// bootstrapScript converts the cloud-config userdata of a machine to a shell
+// script so ssh hosts are bootstrapped exactly like maas machines, which run
+// the userdata with cloud-init.
+func bootstrapScript(machine *clusterv1alpha1.CnctMachine, userdata string) (string, error) {
+	var config cloudConfig
+	if err := yaml.Unmarshal([]byte(userdata), &config); err != nil {
+		return "", errors.Wrap(err, "could not parse userdata")
+	}
+	var sb strings.Builder
+	sb.WriteString("#!/bin/sh\n")
+	fmt.Fprintf(&sb, "# bootstrap of cnctmachine %s/%s\n", machine.Namespace, machine.Name)
+	fmt.Fprintf(&sb, "exec > %s 2>&1\n", bootstrapLogPath)
+	fmt.Fprintf(&sb, "trap 'echo $? > %s' EXIT\n", bootstrapStatusPath)
+	sb.WriteString("set -ex\n")
+	for _, f := range config.WriteFiles {
+		content := f.Content
+		if f.Encoding != "b64" {
+			content = base64.StdEncoding.EncodeToString([]byte(content))
+		}
+		fmt.Fprintf(&sb, "mkdir -p %s\n", ssh.Quote(path.Dir(f.Path)))
+		fmt.Fprintf(&sb, "printf '%%s' %s | base64 -d > %s\n", ssh.Quote(content), ssh.Quote(f.Path))
+		if f.Owner != "" {
+			fmt.Fprintf(&sb, "chown %s %s\n", ssh.Quote(f.Owner), ssh.Quote(f.Path))
+		}
+		if f.Permissions != "" {
+			fmt.Fprintf(&sb, "chmod %s %s\n", ssh.Quote(f.Permissions), ssh.Quote(f.Path))
+		}
+	}
+	for _, cmd := range config.RunCmd {
+		quoted := make([]string, len(cmd))
+		for i, arg := range cmd {
+			quoted[i] = ssh.Quote(arg)
+		}
+		sb.WriteString(strings.Join(quoted, " "))
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
+
+// sshConfig returns the ssh config of a host with the private key of its key
+// secret.
+func sshConfig(k8sClient client.Client, host *clusterv1alpha1.CnctSshHost) (*ssh.Config, error) {
+	var secret corev1.Secret
+	key := client.ObjectKey{Namespace: host.Spec.KeySecret.Namespace, Name: host.Spec.KeySecret.Name}
+	if err := k8sClient.Get(context.Background(), key, &secret); err != nil {
+		return nil, errors.Wrapf(err, "could not get key secret %s of ssh host %s", key, host.Name)
+	}
+	dataKey := host.Spec.KeySecret.Key
+	if dataKey == "" {
+		dataKey = corev1.SSHAuthPrivateKey
+	}
+	privateKey, ok := secret.Data[dataKey]
+	if !ok || len(privateKey) == 0 {
+		return nil, unrecoverableError{reason: fmt.Sprintf("key secret %s of ssh host %s has no %s", key, host.Name, dataKey)}
+	}
+	config := &ssh.Config{
+		Address:    host.Spec.Address,
+		Port:       host.Spec.Port,
+		Username:   host.Spec.Username,
+		PrivateKey: privateKey,
+		HostKey:    host.Spec.HostKey,
+	}
+	if config.Port == 0 {
+		config.Port = clusterv1alpha1.DefaultSshPort
+	}
+	if config.HostKey == "" && host.Spec.TrustOnFirstUse {
+		config.HostKey = host.Status.HostKey
+		config.TrustOnFirstUse = config.HostKey == ""
+	}
+	if config.HostKey == "" && !config.TrustOnFirstUse {
+		return nil, unrecoverableError{reason: fmt.Sprintf("ssh host %s has no host key and does not trust the key of the first connection", host.Name)}
+	}
+	return config, nil
+}
+
+// dialSshHost connects to a host. The host key presented on the first
+// connection to a host trusting it is recorded in its status.
+func dialSshHost(k8sClient client.Client, dialer ssh.Dialer, host *clusterv1alpha1.CnctSshHost) (ssh.Conn, error) {
+	config, err := sshConfig(k8sClient, host)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := dialer.Dial(context.Background(), config)
+	if err != nil {
+		return nil, err
+	}
+	if config.TrustOnFirstUse {
+		log.Info("trusting host key of ssh host", "host", host.Name, "key", conn.HostKey())
+		host.Status.HostKey = conn.HostKey()
+		host.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+		if err := k8sClient.Update(context.Background(), host); err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "could not record host key of ssh host %s", host.Name)
+		}
+	}
+	return conn, nil
+}
+
+// getSshHost gets the ssh host of a machine.
+func getSshHost(k8sClient client.Client, machine *clusterv1alpha1.CnctMachine, host *clusterv1alpha1.CnctSshHost) error {
+	return k8sClient.Get(context.Background(), client.ObjectKey{Name: machine.Spec.SshHost}, host)
+}
+
+// ownsSshHost reports whether the host is claimed by the machine.
+func ownsSshHost(machine *clusterv1alpha1.CnctMachine, host *clusterv1alpha1.CnctSshHost) bool {
+	owner := host.Status.Owner
+	return owner != nil && owner.Namespace == machine.Namespace && owner.Name == machine.Name
+}
+
+// createOnSshHost bootstraps a machine on its ssh host. The bootstrap runs in
+// the background, handleBootstrapping waits for it to finish.
+func createOnSshHost(k8sClient clientEventer, dialer ssh.Dialer, machine *clusterv1alpha1.CnctMachine) error {
+	c := &creator{k8sClient: k8sClient, dialer: dialer, machine: machine}
+	c.isMaster = isMaster(machine)
+	c.isEtcd = isEtcdMachine(machine)
+	c.getCluster()
+	c.electInitMaster()
+	c.electInitEtcd()
+	c.checkEtcdMembers()
+	c.getSecret()
+	c.createClientsetFromSecret()
+	c.checkIfTokenExists()
+	c.createToken()
+	c.checkApiserverAddress()
+	c.claimSshHost()
+	c.startBootstrap()
+	c.markBootstrapping()
+	return c.err
+}
+
+// claimSshHost records the machine as the owner of its ssh host so a host is
+// never bootstrapped for two machines. The claim of a machine which no longer
+// exists is taken over.
+func (c *creator) claimSshHost() {
+	if c.err != nil {
+		return
+	}
+
+	log.Info("claiming ssh host", "host", c.machine.Spec.SshHost)
+	err := getSshHost(c.k8sClient, c.machine, &c.sshHost)
+	if apierrors.IsNotFound(err) {
+		c.err = notReadyError(fmt.Sprintf("ssh host %s does not exist", c.machine.Spec.SshHost))
+		return
+	} else if err != nil {
+		c.err = errors.Wrapf(err, "could not get ssh host %s", c.machine.Spec.SshHost)
+		return
+	}
+	if ownsSshHost(c.machine, &c.sshHost) {
+		return
+	}
+	if owner := c.sshHost.Status.Owner; owner != nil {
+		var other clusterv1alpha1.CnctMachine
+		err := c.k8sClient.Get(context.Background(), client.ObjectKey{Namespace: owner.Namespace, Name: owner.Name}, &other)
+		switch {
+		case err == nil && !other.DeletionTimestamp.IsZero():
+			c.err = notReadyError(fmt.Sprintf("ssh host %s is being released by machine %s/%s", c.sshHost.Name, owner.Namespace, owner.Name))
+			return
+		case err == nil:
+			c.err = unrecoverableError{reason: fmt.Sprintf("ssh host %s is used by machine %s/%s", c.sshHost.Name, owner.Namespace, owner.Name)}
+			return
+		case !apierrors.IsNotFound(err):
+			c.err = errors.Wrapf(err, "could not get machine %s/%s owning ssh host %s", owner.Namespace, owner.Name, c.sshHost.Name)
+			return
+		}
+		log.Info("taking over ssh host of deleted machine", "host", c.sshHost.Name, "machine", types.NamespacedName{Namespace: owner.Namespace, Name: owner.Name})
+	}
+	c.sshHost.Status.Owner = &clusterv1alpha1.HostOwner{
+		Namespace: c.machine.Namespace,
+		Name:      c.machine.Name,
+		Cluster:   c.cluster.Name,
+	}
+	c.sshHost.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+	if err := c.k8sClient.Update(context.Background(), &c.sshHost); err != nil {
+		c.err = errors.Wrapf(err, "could not claim ssh host %s", c.sshHost.Name)
+	}
+}
+
+// startBootstrap pushes the bootstrap script to the ssh host and starts it.
+// A bootstrap which was already started is left alone so the script runs
+// once even if the machine could not be updated afterwards.
+func (c *creator) startBootstrap() {
+	if c.err != nil {
+		return
+	}
+
+	var bundle *cert.CABundle
+	bundle, c.err = cert.CABundleFromMap(c.secret.Data)
+	if c.err != nil {
+		return
+	}
+	var userdata string
+	userdata, c.err = c.userdata(bundle)
+	if c.err != nil {
+		return
+	}
+	script, err := bootstrapScript(c.machine, userdata)
+	if err != nil {
+		c.err = err
+		return
+	}
+
+	log.Info("starting bootstrap on ssh host", "host", c.sshHost.Name)
+	conn, err := dialSshHost(c.k8sClient, c.dialer, &c.sshHost)
+	if err != nil {
+		c.err = err
+		return
+	}
+	defer conn.Close()
+	cmd := sshCommand(c.sshHost.Spec.Username, startBootstrapCmd)
+	if _, err := conn.Run(context.Background(), cmd, strings.NewReader(script)); err != nil {
+		c.err = errors.Wrapf(err, "could not start bootstrap on ssh host %s", c.sshHost.Name)
+	}
+}
+
+// markBootstrapping records the ssh host on the machine object.
+func (c *creator) markBootstrapping() {
+	if c.err != nil {
+		return
+	}
+
+	// Add the finalizer so the ssh host is reset with the object
+	if !util.ContainsString(c.machine.Finalizers, clusterv1alpha1.MachineFinalizer) {
+		log.Info("adding finalizer to machine")
+		c.machine.Finalizers = append(c.machine.Finalizers, clusterv1alpha1.MachineFinalizer)
+	}
+
+	log.Info("update machine status to deploying")
+	port := c.sshHost.Spec.Port
+	if port == 0 {
+		port = clusterv1alpha1.DefaultSshPort
+	}
+	c.machine.Status.Phase = common.DeployingMachinePhase
+	c.machine.Status.KubernetesVersion = c.cluster.Spec.KubernetesVersion
+	c.machine.Status.SshConfig = clusterv1alpha1.MachineSshConfigInfo{
+		Username: c.sshHost.Spec.Username,
+		Host:     c.sshHost.Spec.Address,
+		Port:     port,
+	}
+	c.machine.Status.ProviderStatus = SshStatusBootstrapping
+	c.machine.Status.ProviderStatusMessage = ""
+	c.machine.Status.DeployStarted = &metav1.Time{Time: time.Now()}
+	c.machine.Status.LastUpdated = c.machine.Status.DeployStarted
+
+	// If this update fails the bootstrap is picked up on the next
+	// reconcile since the host is already claimed by the machine.
+	if err := c.k8sClient.Update(context.Background(), c.machine); err != nil {
+		c.err = errors.Wrap(err, "could not update machine")
+		return
+	}
+
+	c.k8sClient.Event(
+		c.machine,
+		corev1.EventTypeNormal,
+		"ResourceStateChange",
+		"set Finalizer and started bootstrap on ssh host",
+	)
+}
+
+// handleBootstrapping checks the bootstrap of a machine on its ssh host. Once
+// the bootstrap script succeeded the machine moves on to provisioning. There
+// is no other host to retry on so a failed bootstrap moves the machine to the
+// error phase.
+func (r *ReconcileMachine) handleBootstrapping(machine *clusterv1alpha1.CnctMachine) (reconcile.Result, error) {
+	log.Info("checking ssh host bootstrap", "machine", machine.Name, "host", machine.Spec.SshHost)
+	var host clusterv1alpha1.CnctSshHost
+	if err := getSshHost(r.Client, machine, &host); apierrors.IsNotFound(err) {
+		return reconcile.Result{}, r.bootstrapFailed(machine, fmt.Sprintf("ssh host %s was deleted during bootstrap", machine.Spec.SshHost))
+	} else if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "could not get ssh host %s", machine.Spec.SshHost)
+	}
+	timedOut := machine.Status.DeployStarted != nil && time.Since(machine.Status.DeployStarted.Time) > r.deploy.Timeout
+
+	status, message, err := bootstrapStatus(r.Client, r.SSH, &host)
+	if err != nil {
+		if timedOut {
+			return reconcile.Result{}, r.bootstrapFailed(machine, err.Error())
+		}
+		return reconcile.Result{}, err
+	}
+	switch status {
+	case "":
+		if timedOut {
+			return reconcile.Result{}, r.bootstrapFailed(machine, fmt.Sprintf("ssh host %s was not bootstrapped within %s", host.Name, r.deploy.Timeout))
+		}
+		return reconcile.Result{RequeueAfter: r.deploy.PollInterval}, nil
+	case "0":
+		machine.Status.ProviderStatus = SshStatusBootstrapped
+		machine.Status.ProviderStatusMessage = ""
+		return reconcile.Result{}, deployed(r, nil, machine, &maas.Machine{}, host.Spec.Address)
+	default:
+		return reconcile.Result{}, r.bootstrapFailed(machine, fmt.Sprintf("bootstrap of ssh host %s exited with status %s: %s", host.Name, status, message))
+	}
+}
+
+// bootstrapStatus returns the exit status of the bootstrap script of a host,
+// empty while it runs, and the end of its output if it failed.
+func bootstrapStatus(k8sClient client.Client, dialer ssh.Dialer, host *clusterv1alpha1.CnctSshHost) (string, string, error) {
+	conn, err := dialSshHost(k8sClient, dialer, host)
+	if err != nil {
+		return "", "", err
+	}
+	defer conn.Close()
+	out, err := conn.Run(context.Background(), sshCommand(host.Spec.Username, bootstrapStatusCmd), nil)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "could not get bootstrap status of ssh host %s", host.Name)
+	}
+	status := strings.TrimSpace(string(out))
+	if status == "" || status == "0" {
+		return status, "", nil
+	}
+	out, err = conn.Run(context.Background(), sshCommand(host.Spec.Username, bootstrapLogCmd), nil)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "could not get bootstrap output of ssh host %s", host.Name)
+	}
+	return status, strings.TrimSpace(string(out)), nil
+}
+
+// bootstrapFailed moves a machine whose ssh host could not be bootstrapped to
+// the error phase. The host stays claimed until the machine is deleted, which
+// resets it.
+func (r *ReconcileMachine) bootstrapFailed(machine *clusterv1alpha1.CnctMachine, message string) error {
+	log.Info("ssh host bootstrap failed", "machine", machine.Name, "message", message)
+	reason := common.DeployMachineError
+	machine.Status.Phase = common.ErrorMachinePhase
+	machine.Status.ProviderStatus = SshStatusFailed
+	machine.Status.ProviderStatusMessage = message
+	machine.Status.ErrorReason = &reason
+	machine.Status.ErrorMessage = &message
+	machine.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+	r.Event(machine, corev1.EventTypeWarning, "DeployFailed", message)
+	return r.Update(context.Background(), machine)
+}
+
+// releaseSshHost resets the ssh host of a deleted machine with kubeadm reset
+// and releases the claim of the machine. A host which was deleted or is
+// claimed by another machine is left alone.
+func (r *ReconcileMachine) releaseSshHost(machine *clusterv1alpha1.CnctMachine) error {
+	var host clusterv1alpha1.CnctSshHost
+	if err := getSshHost(r.Client, machine, &host); apierrors.IsNotFound(err) {
+		log.Info("ssh host of machine does not exist, skipping reset", "machine", machine.Name, "host", machine.Spec.SshHost)
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "could not get ssh host %s", machine.Spec.SshHost)
+	}
+	if !ownsSshHost(machine, &host) {
+		return nil
+	}
+
+	if provisioned(machine) {
+		log.Info("resetting ssh host", "host", host.Name)
+		conn, err := dialSshHost(r.Client, r.SSH, &host)
+		if err != nil {
+			return err
+		}
+		_, err = conn.Run(context.Background(), sshCommand(host.Spec.Username, resetCmd), nil)
+		conn.Close()
+		if err != nil {
+			return errors.Wrapf(err, "could not reset ssh host %s", host.Name)
+		}
+	}
+
+	host.Status.Owner = nil
+	host.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+	if err := r.Update(context.Background(), &host); err != nil {
+		return errors.Wrapf(err, "could not release ssh host %s", host.Name)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package machine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/ssh/fake"
+)
+
+const testHostKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFakeHostKey"
+
+func testSshHost() *clusterv1alpha1.CnctSshHost {
+	return &clusterv1alpha1.CnctSshHost{
+		ObjectMeta: metav1.ObjectMeta{Name: "host-1"},
+		Spec: clusterv1alpha1.SshHostSpec{
+			Address:         "10.0.0.20",
+			Username:        "ubuntu",
+			KeySecret:       clusterv1alpha1.SshKeySecret{Namespace: "hosts", Name: "host-key"},
+			TrustOnFirstUse: true,
+		},
+	}
+}
+
+func testKeySecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "host-key", Namespace: "hosts"},
+		Data:       map[string][]byte{corev1.SSHAuthPrivateKey: []byte("private key")},
+	}
+}
+
+func testSshMaster() *clusterv1alpha1.CnctMachine {
+	machine := testMaster()
+	machine.Spec.SshHost = "host-1"
+	return machine
+}
+
+// testBootstrapping returns a worker machine whose ssh host host-1 is being
+// bootstrapped and a fake host answering the bootstrap status with status.
+// Workers are used since finishing a master bootstrap creates a kubeconfig.
+func testBootstrapping(started time.Time, status string) (*clusterv1alpha1.CnctMachine, *clusterv1alpha1.CnctSshHost, *fake.Host) {
+	machine := &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "cluster"},
+		Spec: clusterv1alpha1.MachineSpec{
+			Roles:        []common.MachineRoles{common.MachineRoleWorker},
+			InstanceType: "standard",
+			SshHost:      "host-1",
+		},
+	}
+	machine.Finalizers = []string{clusterv1alpha1.MachineFinalizer}
+	machine.Status = clusterv1alpha1.MachineStatus{
+		Phase:          common.DeployingMachinePhase,
+		SshConfig:      clusterv1alpha1.MachineSshConfigInfo{Username: "ubuntu", Host: "10.0.0.20", Port: 22},
+		ProviderStatus: SshStatusBootstrapping,
+		DeployStarted:  &metav1.Time{Time: started},
+	}
+	host := testSshHost()
+	host.Status.Owner = &clusterv1alpha1.HostOwner{Namespace: "cluster", Name: "worker", Cluster: "cluster"}
+	host.Status.HostKey = testHostKey
+	fakeHost := &fake.Host{
+		Key: testHostKey,
+		Handler: func(cmd, stdin string) (string, error) {
+			switch {
+			case strings.Contains(cmd, "bootstrap.status"):
+				return status, nil
+			case strings.Contains(cmd, "tail"):
+				return "kubeadm init failed\n", nil
+			}
+			return "", nil
+		},
+	}
+	return machine, host, fakeHost
+}
+
+func getSshHostObject(t *testing.T, k8sClient client.Client) *clusterv1alpha1.CnctSshHost {
+	var host clusterv1alpha1.CnctSshHost
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Name: "host-1"}, &host); err != nil {
+		t.Fatal(err)
+	}
+	return &host
+}
+
+func Test_createOnSshHost(t *testing.T) {
+	machine := testSshMaster()
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine, testSshHost(), testKeySecret())
+	host := &fake.Host{Key: testHostKey}
+	dialer := fake.New(map[string]*fake.Host{"10.0.0.20": host})
+
+	if err := createOnSshHost(k8sClient, dialer, machine); err != nil {
+		t.Fatalf("createOnSshHost() error = %v", err)
+	}
+
+	commands := host.Commands()
+	if len(commands) != 1 {
+		t.Fatalf("commands = %+v, want the bootstrap started", commands)
+	}
+	if !strings.HasPrefix(commands[0].Cmd, "sudo -n sh -c ") || !strings.Contains(commands[0].Cmd, bootstrapScriptPath) {
+		t.Errorf("bootstrap command = %q", commands[0].Cmd)
+	}
+	for _, want := range []string{
+		"base64 -d > '/etc/kubernetes/pki/certs.tar'",
+		"base64 -d > '/var/tmp/masterconfig.yaml'",
+		"'sh' '-c' 'kubeadm init --node-name master  --config /var/tmp/masterconfig.yaml'",
+	} {
+		if !strings.Contains(commands[0].Stdin, want) {
+			t.Errorf("bootstrap script does not contain %q:\n%s", want, commands[0].Stdin)
+		}
+	}
+	if config := host.Config(); config.Username != "ubuntu" || config.Port != 22 || string(config.PrivateKey) != "private key" {
+		t.Errorf("ssh config = %+v", config)
+	}
+
+	got := getMachine(t, k8sClient, "master")
+	if got.Status.Phase != common.DeployingMachinePhase || got.Status.ProviderStatus != SshStatusBootstrapping {
+		t.Errorf("machine phase = %q, provider status = %q", got.Status.Phase, got.Status.ProviderStatus)
+	}
+	if got.Status.SshConfig.Host != "10.0.0.20" || got.Status.SshConfig.Username != "ubuntu" {
+		t.Errorf("machine ssh config = %+v", got.Status.SshConfig)
+	}
+	if len(got.Finalizers) != 1 {
+		t.Errorf("machine finalizers = %v", got.Finalizers)
+	}
+	sshHost := getSshHostObject(t, k8sClient)
+	if sshHost.Status.Owner == nil || sshHost.Status.Owner.Name != "master" || sshHost.Status.Owner.Cluster != "cluster" {
+		t.Errorf("ssh host owner = %+v", sshHost.Status.Owner)
+	}
+	if sshHost.Status.HostKey != testHostKey {
+		t.Errorf("ssh host key = %q, want the key of the first connection", sshHost.Status.HostKey)
+	}
+}
+
+func Test_createOnSshHost_claimed(t *testing.T) {
+	other := testMaster()
+	other.Name = "other"
+	host := testSshHost()
+	host.Status.Owner = &clusterv1alpha1.HostOwner{Namespace: "cluster", Name: "other"}
+	machine := testSshMaster()
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine, other, host, testKeySecret())
+	fakeHost := &fake.Host{Key: testHostKey}
+
+	err := createOnSshHost(k8sClient, fake.New(map[string]*fake.Host{"10.0.0.20": fakeHost}), machine)
+	if _, ok := err.(unrecoverableError); !ok {
+		t.Fatalf("createOnSshHost() error = %v, want an unrecoverable error", err)
+	}
+	if len(fakeHost.Commands()) != 0 {
+		t.Errorf("commands = %+v, want none on a host claimed by another machine", fakeHost.Commands())
+	}
+
+	// The claim of a deleted machine is taken over.
+	if err := k8sClient.Delete(context.Background(), other); err != nil {
+		t.Fatal(err)
+	}
+	if err := createOnSshHost(k8sClient, fake.New(map[string]*fake.Host{"10.0.0.20": fakeHost}), machine); err != nil {
+		t.Fatalf("createOnSshHost() error = %v", err)
+	}
+	if owner := getSshHostObject(t, k8sClient).Status.Owner; owner == nil || owner.Name != "master" {
+		t.Errorf("ssh host owner = %+v, want master", owner)
+	}
+}
+
+func Test_createOnSshHost_noHostKey(t *testing.T) {
+	host := testSshHost()
+	host.Spec.TrustOnFirstUse = false
+	machine := testSshMaster()
+	k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine, host, testKeySecret())
+	fakeHost := &fake.Host{Key: testHostKey}
+
+	err := createOnSshHost(k8sClient, fake.New(map[string]*fake.Host{"10.0.0.20": fakeHost}), machine)
+	if _, ok := err.(unrecoverableError); !ok {
+		t.Fatalf("createOnSshHost() error = %v, want an unrecoverable error", err)
+	}
+	if len(fakeHost.Commands()) != 0 {
+		t.Errorf("commands = %+v, want none on a host which can not be verified", fakeHost.Commands())
+	}
+	if key := getSshHostObject(t, k8sClient).Status.HostKey; key != "" {
+		t.Errorf("ssh host key = %q, want none recorded", key)
+	}
+
+	// A recorded key is only trusted together with trustOnFirstUse.
+	host = getSshHostObject(t, k8sClient)
+	host.Status.HostKey = testHostKey
+	if err := k8sClient.Update(context.Background(), host); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sshConfig(k8sClient, host); err == nil {
+		t.Errorf("sshConfig() of a host with only a recorded key succeeded")
+	}
+	host.Spec.HostKey = testHostKey
+	if config, err := sshConfig(k8sClient, host); err != nil || config.TrustOnFirstUse {
+		t.Errorf("sshConfig() = %+v, %v, want the host key verified", config, err)
+	}
+}
+
+func Test_handleBootstrapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		started     time.Time
+		status      string
+		wantPhase   common.MachineStatusPhase
+		wantRequeue bool
+		wantMessage string
+	}{
+		{name: "running", started: time.Now(), wantPhase: common.DeployingMachinePhase, wantRequeue: true},
+		{name: "done", started: time.Now(), status: "0\n", wantPhase: common.ProvisioningMachinePhase},
+		{name: "failed", started: time.Now(), status: "1\n", wantPhase: common.ErrorMachinePhase, wantMessage: "kubeadm init failed"},
+		{name: "timeout", started: time.Now().Add(-time.Hour), wantPhase: common.ErrorMachinePhase, wantMessage: "was not bootstrapped within"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine, host, fakeHost := testBootstrapping(tt.started, tt.status)
+			k8sClient := newFakeClientEventer(testCluster(), testSecret(t), machine, host, testKeySecret())
+			r := newTestReconciler(k8sClient, testProvider())
+			r.SSH = fake.New(map[string]*fake.Host{"10.0.0.20": fakeHost})
+
+			result, err := r.handleBootstrapping(machine)
+			if err != nil {
+				t.Fatalf("handleBootstrapping() error = %v", err)
+			}
+			if (result.RequeueAfter > 0) != tt.wantRequeue {
+				t.Errorf("handleBootstrapping() result = %+v", result)
+			}
+			got := getMachine(t, k8sClient, "worker")
+			if got.Status.Phase != tt.wantPhase {
+				t.Errorf("machine phase = %q, want %q", got.Status.Phase, tt.wantPhase)
+			}
+			if tt.wantMessage != "" && (got.Status.ErrorMessage == nil || !strings.Contains(*got.Status.ErrorMessage, tt.wantMessage)) {
+				t.Errorf("machine error message = %v, want %q", got.Status.ErrorMessage, tt.wantMessage)
+			}
+			if tt.wantPhase == common.ProvisioningMachinePhase && got.Status.ProviderStatus != SshStatusBootstrapped {
+				t.Errorf("machine provider status = %q, want %q", got.Status.ProviderStatus, SshStatusBootstrapped)
+			}
+		})
+	}
+}
+
+func Test_deleteMachine_sshHost(t *testing.T) {
+	machine, host, fakeHost := testBootstrapping(time.Now(), "0")
+	machine.Status.Phase = common.DeletingMachinePhase
+	k8sClient := newFakeClientEventer(testCluster(), machine, host, testKeySecret())
+	r := newTestReconciler(k8sClient, testProvider())
+	r.SSH = fake.New(map[string]*fake.Host{"10.0.0.20": fakeHost})
+
+	if err := deleteMachine(r, machine); err != nil {
+		t.Fatalf("deleteMachine() error = %v", err)
+	}
+	if !fakeHost.Ran("kubeadm reset -f") {
+		t.Errorf("commands = %+v, want the host reset", fakeHost.Commands())
+	}
+	if owner := getSshHostObject(t, k8sClient).Status.Owner; owner != nil {
+		t.Errorf("ssh host owner = %+v, want the host released", owner)
+	}
+	if got := getMachine(t, k8sClient, "worker"); len(got.Finalizers) != 0 {
+		t.Errorf("machine finalizers = %v, want none", got.Finalizers)
+	}
+}
@@ -0,0 +1,147 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+const (
+	// PowerActionAnnotation requests a power operation on the MAAS machine
+	// of a CnctMachine: cycle, off or on. The annotation is removed once
+	// the operation has been sent to MAAS.
+	PowerActionAnnotation = "maas-power-action"
+
+	// TagsAnnotation is a comma separated list of MAAS tags kept on the
+	// MAAS machine of a CnctMachine.
+	TagsAnnotation = "maas-tags"
+
+	// syncedTagsAnnotation records the tags added from TagsAnnotation so
+	// that tags removed from TagsAnnotation are removed in MAAS as well.
+	syncedTagsAnnotation = "maas-synced-tags"
+
+	// maxHostnameLength is the maximum length of a MAAS hostname.
+	maxHostnameLength = 63
+)
+
+// maasHostname returns the MAAS hostname of the machine. MAAS hostnames are
+// DNS labels so the dots allowed in object names are replaced.
+func maasHostname(machine *clusterv1alpha1.CnctMachine) string {
+	hostname := strings.Replace(machine.Name, ".", "-", -1)
+	if len(hostname) > maxHostnameLength {
+		hostname = strings.TrimRight(hostname[:maxHostnameLength], "-")
+	}
+	return hostname
+}
+
+func splitTags(tags string) []string {
+	var split []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			split = append(split, tag)
+		}
+	}
+	sort.Strings(split)
+	return split
+}
+
+// updateMaasMachine brings the MAAS machine in line with the CnctMachine. The
+// MAAS machine is named after the CnctMachine, its tags are synced with
+// TagsAnnotation and the power action in PowerActionAnnotation is performed.
+// MAAS is only called if something changed.
+func (r *ReconcileMachine) updateMaasMachine(machine *clusterv1alpha1.CnctMachine) error {
+	if machine.Status.SystemId == "" {
+		return nil
+	}
+	annotations := machine.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	request := &maas.UpdateRequest{SystemID: machine.Status.SystemId}
+	if machine.Spec.ProviderID != nil {
+		request.ProviderID = *machine.Spec.ProviderID
+	}
+	changed := false
+
+	hostname := maasHostname(machine)
+	if annotations["maas-hostname"] != hostname {
+		request.Hostname = hostname
+		request.Description = fmt.Sprintf("cnctmachine %s/%s", machine.Namespace, machine.Name)
+		changed = true
+	}
+
+	tags := splitTags(annotations[TagsAnnotation])
+	synced := splitTags(annotations[syncedTagsAnnotation])
+	if strings.Join(tags, ",") != strings.Join(synced, ",") {
+		request.Tags = tags
+		for _, tag := range synced {
+			if !containsTag(tags, tag) {
+				request.RemoveTags = append(request.RemoveTags, tag)
+			}
+		}
+		changed = true
+	}
+
+	if action, ok := annotations[PowerActionAnnotation]; ok {
+		delete(annotations, PowerActionAnnotation)
+		changed = true
+		if maas.PowerAction(action).Valid() {
+			request.Power = maas.PowerAction(action)
+		} else {
+			r.Eventf(machine, corev1.EventTypeWarning, "InvalidPowerAction",
+				"ignoring power action %q, must be one of %s, %s or %s",
+				action, maas.PowerCycle, maas.PowerOff, maas.PowerOn)
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	log.Info("updating maas machine", "machine", machine.Name, "request", request)
+	maasClient, err := r.maasClient(machine)
+	if err != nil {
+		return err
+	}
+	// Provider plugins cannot update machines. The annotations are recorded
+	// as synced anyway so the update is not attempted again.
+	err = maasClient.Update(context.Background(), request)
+	if err == maas.ErrNotSupported {
+		r.Eventf(machine, corev1.EventTypeWarning, "UpdateNotSupported",
+			"the machine provider cannot rename, tag or power machine %s", machine.Status.SystemId)
+		request.Power = ""
+	} else if err != nil {
+		return errors.Wrapf(err, "could not update maas machine %s", machine.Status.SystemId)
+	}
+
+	annotations["maas-hostname"] = hostname
+	if len(tags) > 0 {
+		annotations[syncedTagsAnnotation] = strings.Join(tags, ",")
+	} else {
+		delete(annotations, syncedTagsAnnotation)
+	}
+	machine.SetAnnotations(annotations)
+	if err := r.Update(context.Background(), machine); err != nil {
+		return errors.Wrap(err, "could not update machine")
+	}
+	if request.Power != "" {
+		r.Eventf(machine, corev1.EventTypeNormal, "PowerAction",
+			"sent power %s to maas machine %s", request.Power, machine.Status.SystemId)
+	}
+	return nil
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
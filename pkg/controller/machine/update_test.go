@@ -0,0 +1,121 @@
+package machine
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+)
+
+var errNotCalled = errors.New("maas should not have been called")
+
+func Test_updateMaasMachine(t *testing.T) {
+	machine := testMaster()
+	machine.Name = "master.cluster"
+	machine.Status.Phase = common.ReadyMachinePhase
+	machine.Status.SystemId = "abc123"
+	machine.Annotations = map[string]string{
+		"maas-hostname":       "node-1",
+		TagsAnnotation:        "ssd, rack-1",
+		PowerActionAnnotation: "cycle",
+	}
+	k8sClient := newFakeClientEventer(machine)
+	provider := fake.New(fake.Machine{SystemID: "abc123", Hostname: "node-1", Tags: []string{"standard"}, Allocated: true, Deployed: true})
+	r := newTestReconciler(k8sClient, provider)
+
+	if err := r.updateMaasMachine(machine); err != nil {
+		t.Fatalf("updateMaasMachine() error = %v", err)
+	}
+	m, _ := provider.Machine("abc123")
+	if m.Hostname != "master-cluster" {
+		t.Errorf("maas hostname = %q, want %q", m.Hostname, "master-cluster")
+	}
+	if m.Description != "cnctmachine cluster/master.cluster" {
+		t.Errorf("maas description = %q", m.Description)
+	}
+	if want := []string{"standard", "rack-1", "ssd"}; !reflect.DeepEqual(m.Tags, want) {
+		t.Errorf("maas tags = %v, want %v", m.Tags, want)
+	}
+	if m.PowerCycles != 1 {
+		t.Errorf("maas power cycles = %d, want 1", m.PowerCycles)
+	}
+	got := getMachine(t, k8sClient, "master.cluster")
+	if _, ok := got.Annotations[PowerActionAnnotation]; ok {
+		t.Errorf("power action annotation was not removed")
+	}
+
+	// Nothing changed so nothing is sent to maas.
+	provider.UpdateError = errNotCalled
+	if err := r.updateMaasMachine(got); err != nil {
+		t.Fatalf("updateMaasMachine() error = %v", err)
+	}
+	provider.UpdateError = nil
+
+	// Tags removed from the annotation are removed in maas.
+	got.Annotations[TagsAnnotation] = "ssd"
+	if err := r.updateMaasMachine(got); err != nil {
+		t.Fatalf("updateMaasMachine() error = %v", err)
+	}
+	m, _ = provider.Machine("abc123")
+	if want := []string{"standard", "ssd"}; !reflect.DeepEqual(m.Tags, want) {
+		t.Errorf("maas tags = %v, want %v", m.Tags, want)
+	}
+}
+
+func Test_updateMaasMachine_invalidPowerAction(t *testing.T) {
+	machine := testMaster()
+	machine.Status.Phase = common.ReadyMachinePhase
+	machine.Status.SystemId = "abc123"
+	machine.Annotations = map[string]string{
+		"maas-hostname":       "master",
+		PowerActionAnnotation: "reset",
+	}
+	k8sClient := newFakeClientEventer(machine)
+	provider := fake.New(fake.Machine{SystemID: "abc123", Allocated: true, Deployed: true})
+	r := newTestReconciler(k8sClient, provider)
+
+	if err := r.updateMaasMachine(machine); err != nil {
+		t.Fatalf("updateMaasMachine() error = %v", err)
+	}
+	if m, _ := provider.Machine("abc123"); m.PowerCycles != 0 || m.PoweredOff {
+		t.Errorf("maas machine power changed: %+v", m)
+	}
+	got := getMachine(t, k8sClient, "master")
+	if _, ok := got.Annotations[PowerActionAnnotation]; ok {
+		t.Errorf("invalid power action annotation was not removed")
+	}
+}
+
+func Test_updateMaasMachine_notSupported(t *testing.T) {
+	machine := testMaster()
+	machine.Status.Phase = common.ReadyMachinePhase
+	machine.Status.SystemId = "abc123"
+	machine.Annotations = map[string]string{
+		"maas-hostname":       "node-1",
+		PowerActionAnnotation: "cycle",
+	}
+	k8sClient := newFakeClientEventer(machine)
+	provider := fake.New(fake.Machine{SystemID: "abc123", Allocated: true, Deployed: true})
+	provider.UpdateError = maas.ErrNotSupported
+	r := newTestReconciler(k8sClient, provider)
+
+	if err := r.updateMaasMachine(machine); err != nil {
+		t.Fatalf("updateMaasMachine() error = %v", err)
+	}
+	got := getMachine(t, k8sClient, "master")
+	if got.Annotations["maas-hostname"] != "master" {
+		t.Errorf("maas-hostname annotation = %q, want the update recorded", got.Annotations["maas-hostname"])
+	}
+	if _, ok := got.Annotations[PowerActionAnnotation]; ok {
+		t.Errorf("power action annotation was not removed")
+	}
+
+	// The update is not attempted again.
+	provider.UpdateError = errNotCalled
+	if err := r.updateMaasMachine(got); err != nil {
+		t.Fatalf("updateMaasMachine() error = %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// DefaultOSSeries is the os of the images machines are deployed with when
+// neither the machine nor its cluster set one.
+const DefaultOSSeries = "ubuntu-xenial"
+
+// VersionAllowListKey is the key of the versions in the allow-list ConfigMap.
+// The versions are separated by whitespace or commas.
+const VersionAllowListKey = "versions"
+
+// ImageKind is the os series and instance type of the images a machine can
+// be deployed with, one per kubernetes version.
+type ImageKind struct {
+	OSSeries     string
+	InstanceType string
+}
+
+func (k ImageKind) String() string {
+	return fmt.Sprintf("osSeries=%s, instanceType=%s", k.OSSeries, k.InstanceType)
+}
+
+// MachineImageKind returns the image kind of a machine of the cluster. The
+// os series of the machine takes precedence over the one of the cluster.
+func MachineImageKind(cluster clusterv1alpha1.ClusterSpec, machine clusterv1alpha1.MachineSpec) ImageKind {
+	kind := ImageKind{OSSeries: machine.OSSeries, InstanceType: machine.InstanceType}
+	if kind.OSSeries == "" {
+		kind.OSSeries = cluster.OSSeries
+	}
+	if kind.OSSeries == "" {
+		kind.OSSeries = DefaultOSSeries
+	}
+	return kind
+}
+
+// MissingImages returns the kinds which have no uploaded image of the
+// kubernetes version.
+func MissingImages(c maas.MachineProvider, k8sVersion string, kinds []ImageKind) ([]ImageKind, error) {
+	images, err := listImages(c)
+	if err != nil {
+		return nil, err
+	}
+	var missing []ImageKind
+	for _, kind := range kinds {
+		i := image{os: kind.OSSeries, k8sVersion: k8sVersion, instanceType: kind.InstanceType}
+		if !i.findIn(images) {
+			missing = append(missing, kind)
+		}
+	}
+	return missing, nil
+}
+
+// KubernetesVersions returns the kubernetes versions which have an uploaded
+// image for every kind, sorted from oldest to newest. Versions not in allowed
+// are left out unless allowed is nil.
+func KubernetesVersions(c maas.MachineProvider, kinds []ImageKind, allowed map[string]bool) ([]string, error) {
+	images, err := listImages(c)
+	if err != nil {
+		return nil, err
+	}
+	// kinds with an image, by version
+	found := map[string]map[ImageKind]bool{}
+	for _, i := range images {
+		if allowed != nil && !allowed[i.k8sVersion] {
+			continue
+		}
+		if _, err := version.ParseGeneric(i.k8sVersion); err != nil {
+			continue
+		}
+		if found[i.k8sVersion] == nil {
+			found[i.k8sVersion] = map[ImageKind]bool{}
+		}
+		found[i.k8sVersion][ImageKind{OSSeries: i.os, InstanceType: i.instanceType}] = true
+	}
+
+	var versions []string
+	for v, kindsFound := range found {
+		complete := true
+		for _, kind := range kinds {
+			complete = complete && kindsFound[kind]
+		}
+		if complete {
+			versions = append(versions, v)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return version.MustParseGeneric(versions[i]).LessThan(version.MustParseGeneric(versions[j]))
+	})
+	return versions, nil
+}
+
+// UpgradeVersions returns the versions a cluster at the current version can
+// be upgraded to: newer patch releases of the same minor version and the
+// releases of the next minor version, since kubeadm can not skip a minor
+// version.
+func UpgradeVersions(current string, versions []string) ([]string, error) {
+	from, err := version.ParseGeneric(current)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid kubernetes version %q", current)
+	}
+	var upgrades []string
+	for _, v := range versions {
+		to, err := version.ParseGeneric(v)
+		if err != nil || !from.LessThan(to) || to.Major() != from.Major() || to.Minor() > from.Minor()+1 {
+			continue
+		}
+		upgrades = append(upgrades, v)
+	}
+	return upgrades, nil
+}
+
+// AllowedVersions reads the allow-list ConfigMap. It returns nil, allowing
+// every version, if name is empty.
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+func AllowedVersions(ctx context.Context, k8sClient client.Reader, name types.NamespacedName) (map[string]bool, error) {
+	if name.Name == "" {
+		return nil, nil
+	}
+	var configMap corev1.ConfigMap
+	if err := k8sClient.Get(ctx, name, &configMap); err != nil {
+		return nil, errors.Wrapf(err, "could not get version allow-list %s", name)
+	}
+	list, ok := configMap.Data[VersionAllowListKey]
+	if !ok {
+		return nil, fmt.Errorf("version allow-list %s has no %s", name, VersionAllowListKey)
+	}
+	allowed := map[string]bool{}
+	for _, v := range strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' || r == '\t' }) {
+		allowed[v] = true
+	}
+	return allowed, nil
+}
@@ -0,0 +1,137 @@
+package machine
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+)
+
+func TestKubernetesVersions(t *testing.T) {
+	provider := fake.New()
+	for _, name := range []string{
+		"os=ubuntu-xenial,k8s=1.12.6,standard",
+		"os=ubuntu-xenial,k8s=1.13.4,standard",
+		"os=ubuntu-xenial,k8s=1.13.4,gpu",
+		"os=ubuntu-xenial,k8s=1.13.10,standard",
+		"os=ubuntu-xenial,k8s=1.13.10,gpu",
+		"os=ubuntu-bionic,k8s=1.13.10,standard",
+		"os=ubuntu-bionic,k8s=1.14.1,standard",
+		"os=ubuntu-xenial,k8s=latest,standard",
+	} {
+		provider.AddBootResource(maas.BootResource{Name: name, Type: "Uploaded"})
+	}
+	provider.AddBootResource(maas.BootResource{Name: "os=ubuntu-xenial,k8s=1.14.1,standard", Type: "Synced"})
+
+	standard := ImageKind{OSSeries: DefaultOSSeries, InstanceType: "standard"}
+	gpu := ImageKind{OSSeries: DefaultOSSeries, InstanceType: "gpu"}
+	bionic := ImageKind{OSSeries: "ubuntu-bionic", InstanceType: "standard"}
+	tests := []struct {
+		name    string
+		kinds   []ImageKind
+		allowed map[string]bool
+		want    []string
+	}{
+		{name: "standard", kinds: []ImageKind{standard}, want: []string{"1.12.6", "1.13.4", "1.13.10"}},
+		{name: "every instance type", kinds: []ImageKind{standard, gpu}, want: []string{"1.13.4", "1.13.10"}},
+		{name: "every os series", kinds: []ImageKind{standard, bionic}, want: []string{"1.13.10"}},
+		{name: "allow-list", kinds: []ImageKind{standard}, allowed: map[string]bool{"1.13.4": true, "1.14.1": true}, want: []string{"1.13.4"}},
+		{name: "no images", kinds: []ImageKind{{OSSeries: DefaultOSSeries, InstanceType: "highmem"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := KubernetesVersions(provider, tt.kinds, tt.allowed)
+			if err != nil {
+				t.Fatalf("KubernetesVersions() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KubernetesVersions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	missing, err := MissingImages(provider, "1.13.4", []ImageKind{standard, gpu, bionic})
+	if err != nil {
+		t.Fatalf("MissingImages() error = %v", err)
+	}
+	if want := []ImageKind{bionic}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("MissingImages() = %v, want %v", missing, want)
+	}
+}
+
+func TestMachineImageKind(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster clusterv1alpha1.ClusterSpec
+		machine clusterv1alpha1.MachineSpec
+		want    ImageKind
+	}{
+		{name: "default", machine: clusterv1alpha1.MachineSpec{InstanceType: "gpu"}, want: ImageKind{OSSeries: DefaultOSSeries, InstanceType: "gpu"}},
+		{name: "cluster", cluster: clusterv1alpha1.ClusterSpec{OSSeries: "ubuntu-bionic"}, machine: clusterv1alpha1.MachineSpec{InstanceType: "gpu"}, want: ImageKind{OSSeries: "ubuntu-bionic", InstanceType: "gpu"}},
+		{name: "machine", cluster: clusterv1alpha1.ClusterSpec{OSSeries: "ubuntu-bionic"}, machine: clusterv1alpha1.MachineSpec{InstanceType: "gpu", OSSeries: "ubuntu-xenial"}, want: ImageKind{OSSeries: "ubuntu-xenial", InstanceType: "gpu"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MachineImageKind(tt.cluster, tt.machine); got != tt.want {
+				t.Errorf("MachineImageKind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpgradeVersions(t *testing.T) {
+	versions := []string{"1.12.6", "1.13.4", "1.13.10", "1.14.1", "1.15.0", "2.0.0"}
+	tests := []struct {
+		name    string
+		current string
+		want    []string
+		wantErr bool
+	}{
+		{name: "patch and next minor", current: "1.13.4", want: []string{"1.13.10", "1.14.1"}},
+		{name: "next minor only", current: "1.12.6", want: []string{"1.13.4", "1.13.10"}},
+		{name: "latest", current: "2.0.0"},
+		{name: "invalid current version", current: "latest", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UpgradeVersions(tt.current, versions)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpgradeVersions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpgradeVersions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowedVersions(t *testing.T) {
+	name := types.NamespacedName{Namespace: "cma-ssh", Name: "versions"}
+	k8sClient := fakeclient.NewFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name},
+		Data:       map[string]string{VersionAllowListKey: "1.13.4, 1.13.10\n1.14.1"},
+	})
+
+	if got, err := AllowedVersions(context.Background(), k8sClient, types.NamespacedName{}); got != nil || err != nil {
+		t.Errorf("AllowedVersions() without allow-list = %v, %v, want nil", got, err)
+	}
+	got, err := AllowedVersions(context.Background(), k8sClient, name)
+	if err != nil {
+		t.Fatalf("AllowedVersions() error = %v", err)
+	}
+	want := map[string]bool{"1.13.4": true, "1.13.10": true, "1.14.1": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AllowedVersions() = %v, want %v", got, want)
+	}
+	if _, err := AllowedVersions(context.Background(), k8sClient, types.NamespacedName{Namespace: "cma-ssh", Name: "missing"}); err == nil {
+		t.Errorf("AllowedVersions() with a missing allow-list expected an error")
+	}
+}
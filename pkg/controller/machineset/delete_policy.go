@@ -17,8 +17,6 @@ limitations under the License.
 package machineset
 
 import (
-	"sort"
-
 	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
 )
 
@@ -47,27 +45,34 @@ func randomDeletePolicy(machine *clusterv1alpha1.CnctMachine) deletePriority {
 	return couldDelete
 }
 
-type sortableMachines struct {
-	machines []*clusterv1alpha1.CnctMachine
-	priority deletePriorityFunc
-}
-
-func (m sortableMachines) Len() int      { return len(m.machines) }
-func (m sortableMachines) Swap(i, j int) { m.machines[i], m.machines[j] = m.machines[j], m.machines[i] }
-func (m sortableMachines) Less(i, j int) bool {
-	return m.priority(m.machines[j]) < m.priority(m.machines[i]) // high to low
-}
-
+// getMachinesToDeletePrioritized returns diff machines to delete. Machines
+// with a higher priority are deleted first. Among machines of equal priority,
+// machines in the zone with the most machines are deleted first so that the
+// remaining machines stay spread across zones.
 func getMachinesToDeletePrioritized(filteredMachines []*clusterv1alpha1.CnctMachine, diff int, fun deletePriorityFunc) []*clusterv1alpha1.CnctMachine {
 	if diff >= len(filteredMachines) {
 		return filteredMachines
 	} else if diff <= 0 {
 		return []*clusterv1alpha1.CnctMachine{}
 	}
-	sortable := sortableMachines{
-		machines: filteredMachines,
-		priority: fun,
+
+	remaining := make([]*clusterv1alpha1.CnctMachine, len(filteredMachines))
+	copy(remaining, filteredMachines)
+	counts := zoneCounts(remaining)
+
+	var machinesToDelete []*clusterv1alpha1.CnctMachine
+	for len(machinesToDelete) < diff {
+		next := 0
+		for i := 1; i < len(remaining); i++ {
+			pi, pnext := fun(remaining[i]), fun(remaining[next])
+			if pi > pnext || pi == pnext && counts[machineZone(remaining[i])] > counts[machineZone(remaining[next])] {
+				next = i
+			}
+		}
+		machine := remaining[next]
+		counts[machineZone(machine)]--
+		remaining = append(remaining[:next], remaining[next+1:]...)
+		machinesToDelete = append(machinesToDelete, machine)
 	}
-	sort.Sort(sortable)
-	return sortable.machines[:diff]
+	return machinesToDelete
 }
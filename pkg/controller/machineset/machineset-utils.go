@@ -40,6 +40,10 @@ func ValidateMachineSet(machineSet *clusterv1alpha1.CnctMachineSet) (bool, error
 	if !selector.Matches(labels.Set(machineSet.Spec.MachineTemplate.Labels)) {
 		return false, errors.Errorf("Failed validation on MachineSet %q label selector does not match machine template label", machineSet.Name)
 	}
+
+	if spread := machineSet.Spec.ZoneSpread; spread != nil && spread.Policy == clusterv1alpha1.PinnedZoneSpread && len(spread.Zones) == 0 {
+		return false, errors.Errorf("Failed validation on MachineSet %q pinned zone spread has no zones", machineSet.Name)
+	}
 	return true, nil
 }
 
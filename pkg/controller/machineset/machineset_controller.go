@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
 	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
 	"github.com/samsung-cnct/cma-ssh/pkg/util"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -55,19 +56,22 @@ var (
 	stateConfirmationInterval = 100 * time.Millisecond
 )
 
-// Add creates a new MachineSet Controller and adds it to the Manager with default RBAC.
+// AddWithActuator creates a new MachineSet Controller and adds it to the Manager with default RBAC.
 // The Manager will set fields on the Controller and start it when the Manager is started.
-func Add(mgr manager.Manager) error {
-	r := newReconciler(mgr)
+// The MAAS regions are used to look up the zones machines are spread across.
+func AddWithActuator(mgr manager.Manager, regions maas.Regions) error {
+	r := newReconciler(mgr, regions)
 	return add(mgr, r, r.MachineToMachineSets)
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager) *ReconcileMachineSet {
+func newReconciler(mgr manager.Manager, regions maas.Regions) *ReconcileMachineSet {
 	return &ReconcileMachineSet{
 		Client:        mgr.GetClient(),
 		scheme:        mgr.GetScheme(),
-		EventRecorder: mgr.GetRecorder("MachineSetController")}
+		EventRecorder: mgr.GetRecorder("MachineSetController"),
+		MAAS:          regions,
+	}
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
@@ -111,6 +115,7 @@ type ReconcileMachineSet struct {
 	client.Client
 	scheme *runtime.Scheme
 	record.EventRecorder
+	MAAS maas.Regions
 }
 
 // Reconcile reads the state of the cluster for a CnctMachineSet object and makes changes
@@ -259,11 +264,23 @@ func (r *ReconcileMachineSet) syncReplicas(ms *clusterv1alpha1.CnctMachineSet, m
 		diff *= -1
 		log.Info("Too few replicas for", "machineset", *ms, "creating", diff)
 
+		zones, err := r.spreadZones(ms)
+		if err != nil {
+			return err
+		}
+		counts := zoneCounts(machines)
+
 		var machineList []*clusterv1alpha1.CnctMachine
 		var errstrings []string
 		for i := 0; i < diff; i++ {
 			log.Info("Creating cnctmachine", "machine", i+1)
 			machine := r.createMachine(ms)
+			if zone := nextZone(zones, counts); zone != "" {
+				if machine.Spec.Constraints == nil {
+					machine.Spec.Constraints = &clusterv1alpha1.MachineConstraints{}
+				}
+				machine.Spec.Constraints.Zone = zone
+			}
 			if err := r.Client.Create(context.Background(), machine); err != nil {
 				log.Error(err, "Unable to create cnctmachine", "Machine", machine.Name)
 				r.EventRecorder.Eventf(ms, corev1.EventTypeWarning, common.FailedCreateMachineReason,
@@ -364,8 +381,8 @@ func (r *ReconcileMachineSet) createMachine(machineSet *clusterv1alpha1.CnctMach
 			Kind:       gv.WithKind("CnctMachine").Kind,
 			APIVersion: gv.String(),
 		},
-		ObjectMeta: machineSet.Spec.MachineTemplate.ObjectMeta,
-		Spec:       machineSet.Spec.MachineTemplate.Spec,
+		ObjectMeta: *machineSet.Spec.MachineTemplate.ObjectMeta.DeepCopy(),
+		Spec:       *machineSet.Spec.MachineTemplate.Spec.DeepCopy(),
 	}
 	machine.ObjectMeta.GenerateName = fmt.Sprintf("%s-", machineSet.Name)
 	machine.ObjectMeta.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(machineSet, controllerKind)}
@@ -24,6 +24,8 @@ import (
 
 	"github.com/onsi/gomega"
 	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	maasfake "github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -76,7 +78,7 @@ func TestReconcile(t *testing.T) {
 	g.Expect(err).NotTo(gomega.HaveOccurred())
 	c = mgr.GetClient()
 
-	r := newReconciler(mgr)
+	r := newReconciler(mgr, maas.SingleRegion{Provider: maasfake.New()})
 	recFn, requests := SetupTestReconcile(r)
 
 	g.Expect(add(mgr, recFn, r.MachineToMachineSets)).NotTo(gomega.HaveOccurred())
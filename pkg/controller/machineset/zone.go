@@ -0,0 +1,98 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/util"
+)
+
+// spreadZones returns the zones the machines of the MachineSet are spread
+// across. It returns nil if the MachineSet does not spread its machines.
+func (r *ReconcileMachineSet) spreadZones(ms *clusterv1alpha1.CnctMachineSet) ([]string, error) {
+	if ms.Spec.ZoneSpread == nil {
+		return nil, nil
+	}
+	switch ms.Spec.ZoneSpread.Policy {
+	case clusterv1alpha1.PinnedZoneSpread:
+		return ms.Spec.ZoneSpread.Zones, nil
+	case clusterv1alpha1.BalancedZoneSpread:
+		maasClient, err := r.maasClient(ms)
+		if err != nil {
+			return nil, err
+		}
+		zones, err := maasClient.Zones(context.Background())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list maas zones")
+		}
+		return zones, nil
+	default:
+		return nil, errors.Errorf("unknown zone spread policy %q", ms.Spec.ZoneSpread.Policy)
+	}
+}
+
+// maasClient returns the provider of the maas region of the cluster of the
+// MachineSet. MachineSets without a cluster use the default region.
+func (r *ReconcileMachineSet) maasClient(ms *clusterv1alpha1.CnctMachineSet) (maas.MachineProvider, error) {
+	var region string
+	if cluster, err := util.GetClusterFromNamespace(r.Client, ms.Namespace); err == nil {
+		region = cluster.Spec.MaasRegion
+	}
+	return r.MAAS.Region(context.Background(), region)
+}
+
+// machineZone returns the zone the machine was allocated in or, if it has not
+// been allocated yet, the zone it was assigned.
+func machineZone(machine *clusterv1alpha1.CnctMachine) string {
+	if machine.Status.Zone != "" {
+		return machine.Status.Zone
+	}
+	if machine.Spec.Constraints != nil {
+		return machine.Spec.Constraints.Zone
+	}
+	return ""
+}
+
+// zoneCounts returns the number of machines in each zone.
+func zoneCounts(machines []*clusterv1alpha1.CnctMachine) map[string]int {
+	counts := map[string]int{}
+	for _, m := range machines {
+		counts[machineZone(m)]++
+	}
+	return counts
+}
+
+// nextZone returns the zone with the fewest machines and counts the new
+// machine in it. Ties are broken by the order of zones.
+func nextZone(zones []string, counts map[string]int) string {
+	if len(zones) == 0 {
+		return ""
+	}
+	next := zones[0]
+	for _, z := range zones[1:] {
+		if counts[z] < counts[next] {
+			next = z
+		}
+	}
+	counts[next]++
+	return next
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	maasfake "github.com/samsung-cnct/cma-ssh/pkg/maas/fake"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func zonedMachine(name, zone string) *clusterv1alpha1.CnctMachine {
+	return &clusterv1alpha1.CnctMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+		Status:     clusterv1alpha1.MachineStatus{Zone: zone},
+	}
+}
+
+func machineNames(machines []*clusterv1alpha1.CnctMachine) []string {
+	var names []string
+	for _, m := range machines {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+// generateNameClient names created objects from their GenerateName which
+// the fake client does not do.
+type generateNameClient struct {
+	client.Client
+	generated int
+}
+
+func (c *generateNameClient) Create(ctx context.Context, obj runtime.Object) error {
+	if accessor, err := meta.Accessor(obj); err == nil && accessor.GetName() == "" {
+		c.generated++
+		accessor.SetName(fmt.Sprintf("%s%d", accessor.GetGenerateName(), c.generated))
+	}
+	return c.Client.Create(ctx, obj)
+}
+
+func TestNextZone(t *testing.T) {
+	zones := []string{"a", "b", "c"}
+	counts := map[string]int{"a": 2, "b": 1}
+	var got []string
+	for i := 0; i < 5; i++ {
+		got = append(got, nextZone(zones, counts))
+	}
+	expected := []string{"c", "b", "c", "a", "b"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got: %v, expected: %v", got, expected)
+	}
+	if zone := nextZone(nil, counts); zone != "" {
+		t.Errorf("Got zone %q without zones", zone)
+	}
+}
+
+func TestGetMachinesToDeletePrioritizedZones(t *testing.T) {
+	annotated := zonedMachine("annotated", "b")
+	annotated.Annotations = map[string]string{DeleteNodeAnnotation: "yes"}
+	testCases := []struct {
+		name     string
+		machines []*clusterv1alpha1.CnctMachine
+		diff     int
+		expected []string
+	}{
+		{
+			name: "most populated zone first",
+			machines: []*clusterv1alpha1.CnctMachine{
+				zonedMachine("a1", "a"),
+				zonedMachine("b1", "b"),
+				zonedMachine("b2", "b"),
+				zonedMachine("b3", "b"),
+				zonedMachine("c1", "c"),
+				zonedMachine("c2", "c"),
+			},
+			diff:     3,
+			expected: []string{"b1", "b2", "c1"},
+		},
+		{
+			name: "priority before zone",
+			machines: []*clusterv1alpha1.CnctMachine{
+				zonedMachine("a1", "a"),
+				zonedMachine("a2", "a"),
+				annotated,
+			},
+			diff:     2,
+			expected: []string{"annotated", "a1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		got := machineNames(getMachinesToDeletePrioritized(tc.machines, tc.diff, randomDeletePolicy))
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("Case %s. Got: %v, expected: %v", tc.name, got, tc.expected)
+		}
+	}
+}
+
+func TestSyncReplicasZoneSpread(t *testing.T) {
+	clusterv1alpha1.AddToScheme(scheme.Scheme)
+	testCases := []struct {
+		name       string
+		zoneSpread *clusterv1alpha1.ZoneSpread
+		expected   map[string]int
+	}{
+		{
+			name:       "balanced",
+			zoneSpread: &clusterv1alpha1.ZoneSpread{Policy: clusterv1alpha1.BalancedZoneSpread},
+			expected:   map[string]int{"a": 2, "b": 2, "c": 2},
+		},
+		{
+			name:       "pinned",
+			zoneSpread: &clusterv1alpha1.ZoneSpread{Policy: clusterv1alpha1.PinnedZoneSpread, Zones: []string{"b", "c"}},
+			expected:   map[string]int{"a": 1, "b": 3, "c": 2},
+		},
+		{
+			name:     "none",
+			expected: map[string]int{"a": 1, "b": 1, "": 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		existing := []*clusterv1alpha1.CnctMachine{zonedMachine("a1", "a"), zonedMachine("b1", "b")}
+		ms := &clusterv1alpha1.CnctMachineSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "zoned", Namespace: "test"},
+			Spec: clusterv1alpha1.MachineSetSpec{
+				Replicas:   6,
+				ZoneSpread: tc.zoneSpread,
+			},
+		}
+		k8sClient := &generateNameClient{Client: fake.NewFakeClient()}
+		r := &ReconcileMachineSet{
+			Client:        k8sClient,
+			scheme:        scheme.Scheme,
+			EventRecorder: record.NewFakeRecorder(10),
+			MAAS: maas.SingleRegion{Provider: maasfake.New(
+				maasfake.Machine{SystemID: "x", Zone: "a"},
+				maasfake.Machine{SystemID: "y", Zone: "b"},
+				maasfake.Machine{SystemID: "z", Zone: "c"},
+			)},
+		}
+		if err := r.syncReplicas(ms, existing); err != nil {
+			t.Fatalf("Case %s. syncReplicas() error = %v", tc.name, err)
+		}
+
+		var created clusterv1alpha1.CnctMachineList
+		if err := k8sClient.List(context.Background(), &client.ListOptions{}, &created); err != nil {
+			t.Fatal(err)
+		}
+		got := zoneCounts(existing)
+		for i := range created.Items {
+			got[machineZone(&created.Items[i])]++
+		}
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("Case %s. Got: %v, expected: %v", tc.name, got, tc.expected)
+		}
+	}
+}
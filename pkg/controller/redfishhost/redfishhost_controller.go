@@ -0,0 +1,117 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redfishhost reads the inventory of CnctRedfishHost servers from
+// their BMC.
+package redfishhost
+
+import (
+	"context"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/redfish"
+)
+
+var log = logf.Log.WithName("CnctRedfishHost-controller")
+
+// DefaultInterval is how often the inventory of a host is read by default.
+const DefaultInterval = 10 * time.Minute
+
+// Add creates a new CnctRedfishHost controller and adds it to the Manager.
+// The inventory of every host is read when it changes and every interval.
+func Add(mgr manager.Manager, dial redfish.DialFunc, interval time.Duration) error {
+	return add(mgr, newReconciler(mgr.GetClient(), dial, interval))
+}
+
+func newReconciler(k8sClient client.Client, dial redfish.DialFunc, interval time.Duration) *ReconcileRedfishHost {
+	return &ReconcileRedfishHost{Client: k8sClient, dial: dial, interval: interval, now: time.Now}
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	c, err := controller.New("redfishhost-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Kind{Type: &clusterv1alpha1.CnctRedfishHost{}}, &handler.EnqueueRequestForObject{})
+}
+
+var _ reconcile.Reconciler = &ReconcileRedfishHost{}
+
+// ReconcileRedfishHost reconciles CnctRedfishHost objects.
+type ReconcileRedfishHost struct {
+	client.Client
+	dial     redfish.DialFunc
+	interval time.Duration
+	now      func() time.Time
+}
+
+// Reconcile reads the inventory of a host from its BMC. The status is only
+// updated when the inventory changed, so that the update does not trigger
+// another reconcile.
+// +kubebuilder:rbac:groups=cluster.cnct.sds.samsung.com,resources=cnctredfishhosts,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;delete
+func (r *ReconcileRedfishHost) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	ctx := context.Background()
+	var host clusterv1alpha1.CnctRedfishHost
+	if err := r.Get(ctx, request.NamespacedName, &host); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	inventory, inventoryErr := r.inventory(ctx, &host)
+	status := host.Status.DeepCopy()
+	if inventoryErr != nil {
+		log.Info("could not read the inventory of redfish host", "host", host.Name, "error", inventoryErr.Error())
+		status.InventoryError = inventoryErr.Error()
+	} else {
+		status.Inventory = *inventory
+		status.InventoryError = ""
+	}
+	if !apiequality.Semantic.DeepEqual(status, &host.Status) {
+		status.LastUpdated = &metav1.Time{Time: r.now()}
+		host.Status = *status
+		if err := r.Update(ctx, &host); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{RequeueAfter: r.interval}, nil
+}
+
+func (r *ReconcileRedfishHost) inventory(ctx context.Context, host *clusterv1alpha1.CnctRedfishHost) (*clusterv1alpha1.RedfishInventory, error) {
+	config, err := redfish.BMCConfig(ctx, r.Client, host)
+	if err != nil {
+		return nil, err
+	}
+	bmc, err := r.dial(config)
+	if err != nil {
+		return nil, err
+	}
+	return bmc.Inventory(ctx)
+}
@@ -0,0 +1,63 @@
+package redfishhost
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/redfish/fake"
+	"github.com/samsung-cnct/cma-ssh/pkg/util/fakeclient"
+)
+
+func TestReconcileRedfishHost_Reconcile(t *testing.T) {
+	k8sClient := fakeclient.New(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "bmc", Namespace: "cma-ssh"},
+			Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+		},
+		&clusterv1alpha1.CnctRedfishHost{
+			ObjectMeta: metav1.ObjectMeta{Name: "server-1"},
+			Spec: clusterv1alpha1.RedfishHostSpec{
+				Endpoint:          "https://10.0.0.5",
+				CredentialsSecret: clusterv1alpha1.RedfishCredentialsSecret{Name: "bmc", Namespace: "cma-ssh"},
+				Region:            "lab",
+			},
+		},
+	)
+	bmc := &fake.BMC{Inventory: clusterv1alpha1.RedfishInventory{UUID: "0b2e3f4a", PowerState: "Off", CPUCount: 8, Memory: 16384}}
+	r := newReconciler(k8sClient, fake.New(map[string]*fake.BMC{"https://10.0.0.5": bmc}).Dial, time.Minute)
+	request := reconcile.Request{NamespacedName: client.ObjectKey{Name: "server-1"}}
+
+	result, err := r.Reconcile(request)
+	if err != nil || result.RequeueAfter != time.Minute {
+		t.Fatalf("Reconcile() = %+v, %v", result, err)
+	}
+	var host clusterv1alpha1.CnctRedfishHost
+	if err := k8sClient.Get(context.Background(), request.NamespacedName, &host); err != nil {
+		t.Fatal(err)
+	}
+	if host.Status.Inventory.UUID != "0b2e3f4a" || host.Status.Inventory.CPUCount != 8 || host.Status.LastUpdated == nil {
+		t.Errorf("status = %+v, want the inventory of the bmc", host.Status)
+	}
+	if config := bmc.Config(); config.Username != "admin" || config.Password != "secret" {
+		t.Errorf("bmc config = %+v, want the credentials of the secret", config)
+	}
+
+	bmc.Error = errors.New("connection reset")
+	if _, err := r.Reconcile(request); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := k8sClient.Get(context.Background(), request.NamespacedName, &host); err != nil {
+		t.Fatal(err)
+	}
+	if host.Status.InventoryError != "connection reset" || host.Status.Inventory.UUID != "0b2e3f4a" {
+		t.Errorf("status = %+v, want the error and the last inventory", host.Status)
+	}
+}
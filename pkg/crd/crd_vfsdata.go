@@ -28,35 +28,75 @@ var Crd = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1938,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x4d\x6f\x23\x37\x0c\xbd\xfb\x57\x10\xe9\x21\xbb\x40\x3d\xae\xd1\x4b\x31\xb7\xd4\xed\x21\x2d\x9a\x06\xeb\xc5\x5e\x8a\x1e\x68\x89\x1e\xab\x2b\x51\xaa\x48\x19\x49\x7f\x7d\x21\x8d\x3f\xc6\xde\x6d\x92\x43\x75\x13\x41\x3d\x3e\x3d\x52\x4f\x98\xdc\x27\xca\xe2\x22\xf7\x80\xc9\xd1\x93\x12\xd7\x9d\x74\x9f\x7f\x90\xce\xc5\xc5\x7e\xb9\x21\xc5\xe5\xec\xb3\x63\xdb\xc3\xaa\x88\xc6\xf0\x81\x24\x96\x6c\xe8\x27\xda\x3a\x76\xea\x22\xcf\x02\x29\x5a\x54\xec\x67\x00\x26\x13\xd6\xe0\x47\x17\x48\x14\x43\xea\x81\x8b\xf7\x33\x00\x8f\x1b\xf2\x52\x73\x00\x4c\x64\xcd\xd1\x7b\xca\x73\x8d\xd1\x1f\x0b\xf6\x70\xb3\xec\xbe\xbb\x99\x01\x30\x06\xaa\xa4\xd2\xa6\xb0\xf5\x24\x1d\x5a\x5b\x89\x19\x36\xda\x89\x95\x4e\x30\x48\xe1\xa1\x33\x31\xcc\x24\x91\xa9\xb8\x68\x6d\x23\x84\xfe\x31\x3b\x56\xca\xab\xe8\x4b\xe0\x56\x73\x0e\xbf\xac\x7f\x7f\x78\x44\xdd\xf5\xd0\x89\xa2\x16\xe9\xd2\x0e\x85\x1a\x1f\x4b\x62\xb2\x4b\x7a\x90\xe2\x50\x15\xc6\xbc\x96\x31\x12\x5a\x9f\x03\xfa\x9c\xa8\x07\xd1\xec\x78\xb8\xc6\x3f\x0a\xd2\x7d\xa1\xc6\x04\xeb\x6e\xa0\x09\x90\x45\xad\xdb\x21\xc7\x92\x7a\x78\xe9\xb6\xe3\xf9\x83\x90\x63\x67\xee\x52\xfa\xb1\x31\x6e\xb1\xe4\x4b\x46\x3f\x55\x6f\x06\x20\x26\xd6\x32\x0f\xf5\x68\x42\x43\x76\x06\xb0\x47\xef\x6c\xe3\x37\x82\xc5\x44\x7c\xf7\x78\xff\xe9\xfb\xb5\xd9\x51\xc0\x31\x08\x90\x72\x4c\x94\xd5\x1d\x6b\xd6\x35\x19\x9d\x53\xec\x4a\xc6\xdb\x0a\x35\xe6\x80\xad\xc3\x42\x02\xba\x23\xd8\x8f\x31\xb2\x20\xad\x0c\xc4\x2d\xe8\xce\x09\x64\x4a\x99\x84\x58\x1b\xa5\x09\x2c\xd4\x14\x64\x88\x9b\xbf\xc8\x68\x07\x6b\xca\x15\x04\x64\x17\x8b\xb7\x75\x98\xf6\x94\x15\x32\x99\x38\xb0\xfb\xe7\x84\x2c\xa0\xb1\x95\xf4\xa8\x24\x7a\x81\xd8\xe6\x83\xd1\x57\x11\x0a\x7d\x0b\xc8\x16\x02\x3e\x43\xa6\x5a\x03\x0a\x4f\xd0\x5a\x8a\x74\xf0\x5b\xcc\x04\x8e\xb7\xb1\x87\x9d\x6a\x92\x7e\xb1\x18\x9c\x1e\x1f\x8b\x89\x21\x14\x76\xfa\xbc\x68\xd3\xed\x36\x45\x63\x96\x85\xa5\x3d\xf9\x05\x26\x37\x6f\x3c\x59\xdb\x03\x0b\xf6\x9b\x7c\x78\x48\x72\x3b\x21\x76\x35\x55\xe3\x6a\x4d\xfe\x4f\x99\x7f\x75\x6c\xc1\x09\xe0\xe1\xd8\x48\xf7\xac\x66\x0d\x55\x11\x3e\xfc\xbc\xfe\x08\xc7\xa2\x4d\xf1\x4b\x89\x9b\xb8\xe7\x63\x72\xd6\xb9\xea\xe2\x78\x4b\x79\xec\xd3\x36\xc7\xd0\x10\x89\x6d\x8a\x8e\xb5\x6d\x8c\x77\xc4\x97\x1a\x4b\xd9\x04\xa7\xb5\xb1\x7f\x17\x12\xad\xed\xe8\x60\x85\xcc\x51\x61\x43\x50\x52\x1d\x7a\xdb\xc1\x3d\xc3\x0a\x03\xf9\x15\x0a\xfd\xdf\x2a\x57\x41\x65\x5e\x15\x7c\x5d\xe7\xa9\x8f\x5d\x26\x8e\xe2\x9c\xc2\x47\xc3\x39\xae\xaf\xbd\x90\xd1\xe6\x42\xc0\xcb\xde\x7d\xd9\xbf\xd5\x98\x04\xb9\x30\x38\x06\x17\x70\x20\x78\x47\x4f\x3d\xec\xc8\x07\x70\x2c\x8a\xde\x57\x2f\xda\x78\x5a\xf0\xe0\xf8\x69\xee\x78\xc8\x24\xf2\xfe\xf6\x0a\xf9\xab\xd7\x6a\xe3\x5e\x51\x5f\xe4\x71\xdf\xea\x16\x21\x0b\xdb\x98\x4f\x65\xdf\x39\x36\xbe\xd8\x36\x44\x38\xbc\x7f\x5b\xbd\xda\x70\x97\xe9\xe2\xe2\xf3\x91\xc3\xab\xd2\x36\x87\x7d\x8b\xb8\xcd\xbd\x5f\xbc\xd2\xc9\x16\xa7\x46\xfe\x2a\xf9\x2b\x5a\xfb\xe3\x0f\xb9\x5f\xa2\x4f\x3b\x5c\xce\xce\x14\xd1\x18\x4a\x4a\xf6\xe1\xda\x90\x6f\x6e\x2e\x9c\xb8\x6d\x4d\xe4\xf1\x7b\x92\x1e\xfe\xf8\xb3\x5a\xb2\xc6\x4c\xf6\x60\x91\x63\xf0\xdf\x00\x00\x00\xff\xff\x55\xb9\xe0\x6d\x92\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x4d\x6f\x1c\x37\x0c\xbd\xcf\xaf\x20\xdc\x43\x12\xa0\x3b\x5b\xa3\x97\x62\x6e\xee\xb6\x07\xb7\xa8\x6b\xc4\x41\x2e\x41\x0e\x5c\x89\x3b\xab\x46\xa2\x54\x91\x5a\xd8\xfd\xf5\x85\x34\xfb\x31\xbb\x81\xed\x1c\xe2\xf1\x45\x04\xf5\xf8\xf8\x48\xbd\xc5\xe4\x3e\x52\x16\x17\x79\x00\x4c\x8e\x1e\x95\xb8\x9e\xa4\xff\xf2\x8b\xf4\x2e\x2e\x77\xd7\x6b\x52\xbc\xee\xbe\x38\xb6\x03\xac\x8a\x68\x0c\xef\x49\x62\xc9\x86\x7e\xa3\x8d\x63\xa7\x2e\x72\x17\x48\xd1\xa2\xe2\xd0\x01\x98\x4c\x58\x83\x1f\x5c\x20\x51\x0c\x69\x00\x2e\xde\x77\x00\x1e\xd7\xe4\xa5\xe6\x00\x98\xc8\x9a\xa3\xf7\x94\x17\x1a\xa3\x3f\x14\x1c\xe0\xea\xba\xff\xe9\xaa\x03\x60\x0c\x54\x49\xa5\x75\x61\xeb\x49\x7a\xb4\xb6\x12\x33\x6c\xb4\x17\x2b\xbd\x60\x90\xc2\x63\x6f\x62\xe8\x24\x91\xa9\xb8\x68\x6d\x23\x84\xfe\x3e\x3b\x56\xca\xab\xe8\x4b\xe0\x56\x73\x01\x7f\x3c\xfc\x7d\x77\x8f\xba\x1d\xa0\x17\x45\x2d\xd2\xa7\x2d\x0a\x35\x3e\x96\xc4\x64\x97\x74\x2f\xc5\xbe\x2a\x4c\x79\x2d\x63\x22\xf4\x70\x0a\xe8\x53\xa2\x01\x44\xb3\xe3\xf1\x12\xff\x20\x48\xff\x95\x1a\x33\xac\x9b\x91\x66\x40\x16\xb5\x1e\xc7\x1c\x4b\x1a\xe0\xa5\x6e\x27\x71\xf6\x42\x4e\x93\xb9\x49\xe9\xd7\xc6\xb8\xc5\x92\x2f\x19\xfd\x5c\xbd\x0e\x40\x4c\xac\x65\xee\x30\x90\x24\x34\x64\x3b\x80\x1d\x7a\x67\xdb\xb4\x26\xb0\x98\x88\x6f\xee\x6f\x3f\xfe\xfc\x60\xb6\x14\xda\x38\x6b\x38\xe5\x98\x28\xab\x3b\xd4\xac\xdf\x6c\x75\x8e\xb1\x0b\x19\xdf\x54\xa8\x29\x07\x6c\x5d\x16\x12\xd0\x2d\xc1\x6e\x8a\x91\x05\x69\x65\x20\x6e\x40\xb7\x4e\x20\x53\xca\x24\xc4\xda\x28\xcd\x60\xa1\xa6\x20\x43\x5c\xff\x43\x46\x7b\x78\xa0\x5c\x41\x40\xb6\xb1\x78\x5b\x97\x69\x47\x59\x21\x93\x89\x23\xbb\xff\x8e\xc8\x02\x1a\x5b\x49\x8f\x4a\xa2\x67\x88\x6d\x3f\x18\x7d\x15\xa1\xd0\x8f\x80\x6c\x21\xe0\x13\x64\xaa\x35\xa0\xf0\x0c\xad\xa5\x48\x0f\x7f\xc5\x4c\xe0\x78\x13\x07\xd8\xaa\x26\x19\x96\xcb\xd1\xe9\xe1\xb1\x98\x18\x42\x61\xa7\x4f\xcb\xb6\xdd\x6e\x5d\x34\x66\x59\x5a\xda\x91\x5f\x62\x72\x8b\xc6\x93\x6b\x6f\xd2\x07\xfb\x43\xde\x3f\x24\x79\x33\x23\x76\xb1\x55\x2d\x36\x0d\xf9\x59\x99\xff\x74\x6c\xc1\x09\xe0\xfe\xda\xd4\xd1\x49\xcd\x1a\xaa\x22\xbc\xff\xfd\xe1\x03\x1c\x8a\x36\xc5\x67\x90\xb0\x17\xf7\x74\x4d\x4e\x3a\x57\x5d\x1c\x6f\x28\xb7\x5b\xb0\xc9\x31\x34\x59\x89\x6d\x8a\x8e\xb5\x1d\x8c\x77\xc4\xe7\x1a\x4b\x59\x07\xa7\x75\xb0\xff\x16\x12\xad\xe3\xe8\x61\x85\xcc\x51\x61\x4d\x50\x52\x5d\x7a\xdb\xc3\x2d\xc3\x0a\x03\xf9\x15\x0a\x7d\x6f\x95\xab\xa0\xb2\xa8\x0a\xbe\xae\xf3\xdc\xc7\x0e\x7f\xf5\xfe\xb0\x17\xe7\x18\x3e\x18\x0e\xc0\xf3\x2f\xa4\x7e\x95\x2c\x9e\xcf\xee\xeb\xf9\xad\xa6\x24\xc8\x85\xc1\x31\xb8\x80\x23\xc1\x5b\x7a\x1c\x60\x4b\x3e\x80\x63\x51\xf4\xbe\x7a\xd1\xda\xd3\x92\x47\xc7\x8f\x0b\xc7\x63\x26\x91\x77\xf3\x96\x9e\x6d\xab\xfe\x37\xd4\x17\x79\xdc\xd6\x0c\x28\x42\x16\x36\x31\x1f\xcb\xbe\x75\x6c\x7c\xb1\x6d\x89\x70\x7c\xf7\x6d\xf5\xea\xc0\x5d\xa6\xb3\xc6\x17\x53\x67\xaf\x4a\xdb\x1c\xf6\x5b\xc4\x6d\xee\xfd\x62\x4b\x47\x5b\x9c\x1b\xf9\xab\xe4\x2f\x68\xed\xed\x6a\x80\xdd\x35\xfa\xb4\xc5\xeb\xee\x44\x11\x8d\xa1\xa4\x64\xef\x2e\x0d\xf9\xea\xea\xcc\x89\xdb\xd1\x44\x9e\x7e\x9e\x64\x80\x4f\x9f\xab\x25\x6b\xcc\x64\xf7\x16\x29\x03\x7c\xfa\xdc\xfd\x3f\x00\x55\xb9\xe0\x6d\x92\x07\x00\x00"),
 		},
 		"/cluster_v1alpha1_cnctcluster.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cluster_v1alpha1_cnctcluster.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 2054,
+			uncompressedSize: 5019,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x3d\x73\x23\x37\x0c\xed\xf5\x2b\x30\x4e\x71\x4d\xb4\x8a\x26\x4d\x66\x3b\x8f\x2e\x85\x73\x13\xc7\x73\xba\x5c\x8a\x4c\x0a\x88\x84\xb4\xcc\x91\x20\x43\x80\x4a\x9c\x5f\x9f\x21\x57\x1f\x2b\xd9\xb1\x5d\xdc\x56\x22\x44\x3c\x3c\x3e\x3c\x12\x98\xdc\x67\xca\xe2\x22\xf7\x80\xc9\xd1\x3f\x4a\x5c\x57\xd2\x7d\xf9\x41\x3a\x17\x17\xfb\xe5\x86\x14\x97\xb3\x2f\x8e\x6d\x0f\xab\x22\x1a\xc3\x47\x92\x58\xb2\xa1\xf7\xb4\x75\xec\xd4\x45\x9e\x05\x52\xb4\xa8\xd8\xcf\x00\x4c\x26\xac\xc1\x4f\x2e\x90\x28\x86\xd4\x03\x17\xef\x67\x00\x1e\x37\xe4\xa5\xee\x01\x30\x91\x35\x47\xef\x29\xcf\x35\x46\x7f\x2c\xd8\xc3\xcd\xb2\xfb\xee\x66\x06\xc0\x18\xa8\x07\xc3\x46\x8d\x2f\xa2\x94\xa5\x3b\xfc\xe8\x6a\xb0\x13\x2b\x9d\x60\x90\xc2\xbb\xce\xc4\x30\x93\x44\xa6\x42\xa3\xb5\x8d\x13\xfa\x87\xec\x58\x29\xaf\xa2\x2f\x81\x5b\xd9\x39\xfc\xb4\xfe\xe5\xfe\x01\x75\xe8\xa1\x13\x45\x2d\xd2\xa5\x01\x85\x1a\x25\x4b\x62\xb2\x4b\xda\xd4\x08\x68\x06\xc7\x04\xe3\xae\xf6\xff\xc8\x68\x7d\x0e\xe8\x63\xa2\x1e\x44\xb3\xe3\xdd\x35\xfa\x51\x91\xee\x89\x1c\x13\xac\xdb\x1d\x4d\x80\x2c\x6a\x5d\xee\x72\x2c\xa9\x87\x17\x0f\x3b\x02\x1c\xa4\x3c\xf4\x86\x8d\xae\xc6\x9c\x16\x4d\xbe\x64\xf4\x97\x0a\xce\x00\xc4\xc4\x5a\xeb\xbe\xa6\x27\x34\x64\x67\x00\x7b\xf4\xce\x36\x92\x23\x60\x4c\xc4\xb7\x0f\x77\x9f\xbf\x5f\x9b\x81\x02\x8e\x41\x80\x94\x63\xa2\xac\xee\x58\xb7\x7e\x13\x03\x9d\x62\x57\x4a\xbe\xab\x50\xe3\x1e\xb0\xd5\x32\x24\xa0\x03\xc1\x7e\x8c\x91\x05\x69\x65\x20\x6e\x41\x07\x27\x90\x29\x65\x12\x62\x6d\x94\x26\xb0\x50\xb7\x20\x43\xdc\xfc\x49\x46\x3b\x58\x53\xae\x20\x20\x43\x2c\xde\x56\x4b\xed\x29\x2b\x64\x32\x71\xc7\xee\xdf\x13\xb2\x80\xc6\x56\xd2\xa3\x92\xe8\x05\x62\xb3\x08\xa3\xaf\x22\x14\xfa\x16\x90\x2d\x04\x7c\x84\x4c\xb5\x06\x14\x9e\xa0\xb5\x2d\xd2\xc1\xcf\x31\x13\x38\xde\xc6\x1e\x06\xd5\x24\xfd\x62\xb1\x73\x7a\xbc\x32\x26\x86\x50\xd8\xe9\xe3\xa2\x79\xdc\x6d\x8a\xc6\x2c\x0b\x4b\x7b\xf2\x0b\x4c\x6e\xde\x78\xb2\xb6\x6b\x16\xec\x37\xf9\x70\x9d\xe4\xdd\x84\xd8\x95\xb5\xc6\xaf\x35\xfa\x7f\x65\xfe\xe0\xd8\x82\x13\xc0\x43\xda\x48\xf7\xac\x66\x0d\x55\x11\x3e\xfe\xb8\xfe\x04\xc7\xa2\x4d\xf1\x4b\x89\x9b\xb8\xe7\x34\x39\xeb\x5c\x75\x71\xbc\xa5\x3c\xf6\x69\x9b\x63\x68\x88\xc4\x36\x45\xc7\xda\x16\xc6\x3b\xe2\x4b\x8d\xa5\x6c\x82\xd3\xda\xd8\xbf\x0a\x89\xd6\x76\x74\xb0\x42\xe6\xa8\xb0\x21\x28\xa9\x3a\xdf\x76\x70\xc7\xb0\xc2\x40\x7e\x85\x42\x5f\x5b\xe5\x2a\xa8\xcc\xab\x82\xaf\xeb\x3c\x7d\xcd\x2e\x37\x8e\xe2\x9c\xc2\xc7\x37\xe7\xf8\x3d\x77\x43\x5a\xe3\xca\x86\x32\x93\x92\x3c\x73\x59\x9e\x74\xf2\x3d\x89\xcb\x64\xe1\xc3\x29\xeb\x78\x57\xae\xb2\x9e\x25\x0f\x4d\xe6\x0a\x30\x2d\x32\x7f\xca\xe1\xd5\xc3\xb5\x87\xee\x2d\xc7\xab\x93\xe3\x60\x81\x17\x0f\x76\xfb\x70\x77\xf2\xca\xdb\x8e\x52\x67\x86\xe8\xaf\xa3\x41\x5e\xc4\xfe\x6d\x20\x86\xbf\xeb\x55\xaf\xd6\x1c\xb9\xb7\x64\x88\x1b\xa9\xfe\xb5\x57\xd9\xdb\x98\x03\xea\xf8\xea\xce\xd5\x05\x7a\x2b\xa3\x36\x30\x5e\xe4\x72\x78\x86\xa7\xb3\xe3\x55\xdc\xab\x16\xec\x8f\x53\x79\xbf\x44\x9f\x06\x5c\xce\xce\xed\x40\x63\x28\x29\xd9\xfb\xeb\x11\x70\x73\x73\xf1\xf2\xb7\xa5\x89\x3c\xce\x43\xe9\xe1\xf7\x3f\xea\x00\xd0\x98\xc9\x1e\x3c\x30\x06\xff\x0b\x00\x00\xff\xff\xbd\xe2\xde\x26\x06\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x4b\x73\xe3\xb8\x11\xbe\xeb\x57\x74\x39\x87\xbd\x58\x74\x9c\xd9\xa4\xb6\x78\x9b\xd2\xb8\x2a\xce\xd6\xcc\xba\x2c\xc7\x39\x6c\xed\xa1\x45\xb4\x44\xac\x41\x00\x8b\x06\xe5\x71\x7e\x7d\xaa\xc1\x87\x48\x8a\x7a\x54\x65\x4d\x1e\x4c\xb0\xf1\x35\xfa\xeb\xa7\x88\x5e\xbf\x52\x60\xed\x6c\x0e\xe8\x35\x7d\x8f\x64\xe5\x89\xb3\xb7\x9f\x38\xd3\xee\x6e\x7f\xbf\xa1\x88\xf7\x8b\x37\x6d\x55\x0e\xab\x9a\xa3\xab\x9e\x89\x5d\x1d\x0a\xfa\x42\x5b\x6d\x75\xd4\xce\x2e\x2a\x8a\xa8\x30\x62\xbe\x00\x28\x02\xa1\x2c\xbe\xe8\x8a\x38\x62\xe5\x73\xb0\xb5\x31\x0b\x00\x83\x1b\x32\x2c\x32\x00\x85\xb3\x31\x38\x63\x28\x2c\xa3\x73\xa6\x53\x98\xc3\xcd\x7d\xf6\xd7\x9b\x05\x80\xc5\x8a\x72\x28\x6c\x11\x0b\x53\x73\xa4\xc0\x59\xfb\x4f\x26\x8b\x19\x2b\xce\x18\x2b\xae\xed\x2e\x2b\x5c\xb5\x60\x4f\x85\x40\xa3\x52\xe9\x4c\x68\x9e\x82\xb6\x91\xc2\xca\x99\xba\xb2\x49\xed\x12\xfe\xb5\xfe\xe5\xdb\x13\xc6\x32\x87\x8c\x23\xc6\x9a\x33\x5f\x22\x53\x3a\x92\x22\x2e\x82\xf6\xb2\x39\x87\x0a\x8b\x52\x5b\x82\x46\x2a\xbd\x6f\x4e\xb4\x3e\x2c\xc4\x0f\x4f\x39\x70\x0c\xda\xee\xa6\xe8\x1d\x23\xd9\x11\x1d\x03\xac\xcf\x3b\x1a\x00\x29\x8c\xf2\xb8\x0b\xae\xf6\x39\x9c\x35\xb6\xa1\xa7\xa5\xb2\xf5\x8d\x2d\xe2\xaa\xd9\x93\x56\xbd\xa9\x03\x9a\x31\x83\x0b\x00\x2e\x9c\xe8\xfa\x86\x15\xb1\xc7\x82\xd4\x02\x60\x8f\x46\xab\xe4\xb3\x06\xd0\x79\xb2\x9f\x9f\x1e\x5f\x3f\xad\x8b\x92\xaa\xe4\x54\x59\xf6\xc1\x79\x0a\x51\x77\x7a\xe5\x1a\x04\x50\xbf\x36\x61\xf2\x07\x81\x6a\x64\x40\x49\xc8\x10\x43\x2c\x09\xf6\xcd\x1a\x29\xe0\xa4\x06\xdc\x16\x62\xa9\x19\x02\xf9\x40\x4c\x36\xa6\x23\x0d\x60\x41\x44\xd0\x82\xdb\xfc\x4e\x45\xcc\x60\x4d\x41\x40\x80\x4b\x57\x1b\x25\x21\xb5\xa7\x10\x21\x50\xe1\x76\x56\xff\xb7\x47\x66\x88\x2e\xa9\x34\x18\x89\xe3\x08\x31\x85\x88\x45\x23\x24\xd4\x74\x0b\x68\x15\x54\xf8\x01\x81\x44\x07\xd4\x76\x80\x96\x44\x38\x83\xaf\x2e\x10\x68\xbb\x75\x39\x94\x31\x7a\xce\xef\xee\x76\x3a\x76\x29\x53\xb8\xaa\xaa\xad\x8e\x1f\x77\x29\xc6\xf5\xa6\x8e\x2e\xf0\x9d\xa2\x3d\x99\x3b\xf4\x7a\x99\xce\x69\xc5\x36\xce\x2a\xf5\x97\xd0\xa6\x13\xff\x30\x38\xd8\x24\xb4\xd2\x5a\xe3\xe8\x93\x34\xff\xac\xad\x02\xcd\x80\xed\xb6\xc6\xa2\x03\x9b\xb2\x24\x24\x3c\x3f\xac\x5f\xa0\x53\x9a\x18\x1f\x40\x42\x4b\xee\x61\x1b\x1f\x78\x16\x5e\xb4\xdd\x52\x48\xbb\x60\x1b\x5c\x95\x68\x25\xab\xbc\xd3\x36\xa6\x87\xc2\x68\xb2\x63\x8e\xb9\xde\x54\x3a\x8a\x63\xff\xa8\x89\xa3\xb8\x23\x83\x15\x5a\xeb\x22\x6c\x08\x6a\x2f\x91\xaf\x32\x78\xb4\xb0\xc2\x8a\xcc\x0a\x99\xfe\x6c\x96\x85\x50\x5e\x0a\x83\x97\x79\x1e\x56\xb3\xee\x4f\xf6\xe7\x2d\x39\xfd\x72\x57\x73\x00\x4e\x67\xc8\xa0\xd8\x3d\x19\xb4\xf4\xd0\x92\x35\x96\x98\x38\x73\x35\xb3\x41\x7c\x2b\x04\x73\xc4\x8d\x21\x40\xa5\x02\x71\xb3\x84\x5e\x73\x93\x0b\x13\xd0\x94\x31\x22\x51\xa1\x14\x06\x06\x0c\x04\x81\xb0\x28\x49\x01\xc6\x0c\x5e\xca\x03\xd2\x48\x14\xde\x4b\x5d\x94\x10\x6a\x7b\x8c\xf9\x56\x6f\x08\x55\x05\x52\xfd\xe5\x54\x35\x93\x02\xbd\x85\xe6\x49\xbc\xca\x14\xb3\xc9\xb6\x53\xdc\xc8\x55\x3a\x8e\xc7\xab\x13\x4e\xfe\xe9\xb8\xe7\x40\x7b\x70\x01\x94\xe5\x54\x07\xbb\x93\x77\x71\x28\x59\x0c\xd2\xcb\x82\x45\x33\x03\x0b\x60\x1c\x2a\xd8\xa0\x41\x5b\x50\xe8\xb6\x1f\x58\x84\xda\x1a\x61\xe4\xf5\xf1\x49\x34\xce\x58\x73\x32\x76\xba\xcb\xbb\x70\xd9\xa4\x27\x17\x7a\x93\x64\xc3\xb1\x21\xff\xf8\xf1\xc7\x4f\xb0\x75\x01\x70\x7c\xe6\x19\x64\x48\xb5\xeb\xa7\xc3\x86\xbd\x0e\xb1\x46\x03\xda\x5f\x74\x8e\xdc\x5b\x17\x2a\x8c\x39\x68\x1b\x3f\xfd\x6d\xe6\x7d\x63\xae\xd4\xcb\xdd\x8c\xfe\xbd\xf6\x17\xcd\x15\x3a\x2b\x7c\x23\x1e\x85\x64\x8a\xdc\xc6\xbb\xc8\xe3\x63\xbf\xeb\x58\xce\x80\x02\xbc\x11\x79\x34\x7a\x4f\xea\x36\x61\x95\xe8\x83\xfb\xfe\x31\x09\xe1\xd2\x19\x25\x55\x4f\x47\xa1\xf0\x1d\x83\x4a\x9a\x67\x11\x13\xfd\xd1\x81\xd4\xa9\x3e\x10\xe6\x68\x3a\x17\xc7\x7d\x43\xd9\x62\x41\xf3\xaf\x27\x94\x3c\x76\xd2\x7d\x64\xf7\x0b\xf2\x34\x74\x21\x03\x32\xeb\x9d\x4d\x2d\x7b\xfe\x8a\xae\xa1\xc3\x3a\x35\x44\xea\x59\x69\x46\x1a\x17\x26\x9a\xdc\xf6\x34\x62\x49\xd2\xb4\xb1\x36\x11\x82\xab\x23\x5d\x15\x4a\x17\xb3\x43\xee\xd6\xb6\x67\x41\x0d\x8f\x5f\xae\xa2\xeb\x75\xbc\xa7\x23\x6d\x1f\x82\x6f\x8e\x17\x40\xab\xce\xde\x56\xc1\x09\x5c\x00\xed\x6f\xa5\x64\x55\x35\x37\x6d\xc8\xea\x3f\x6a\x02\x67\x93\xd1\x96\xe2\xbb\x0b\x6f\x19\xfc\xfd\xfe\xa8\xc4\x9d\x44\xbc\x40\xc9\xa5\x0c\xbb\x9c\x65\x27\xfa\x50\x73\x4b\x7b\xd5\x81\x46\x23\x82\xdc\xcb\x54\x5f\x17\x57\xe2\x50\x2c\xd4\x8b\xf3\xce\xb8\xdd\x47\xbe\x38\xe3\x8b\x87\x81\xa0\x50\xf4\x5e\x52\xa0\xc4\x9d\x40\x74\xf3\x6b\xe7\x8c\xb6\x05\x82\x97\x1e\x38\x81\x85\xd4\x66\x6e\x61\x1d\xb1\x78\x23\xd5\xb9\xa0\x2b\x10\x9b\x8f\x2e\x04\xa7\xc4\x92\xad\xab\x63\x6b\x5b\x98\xa3\xf5\x87\xf9\x8e\x70\x32\x50\xa5\xcd\x05\x4b\x91\x78\x66\xc4\x3d\xa2\xe3\x0b\xb1\x90\x0f\x3f\xf7\xbb\xba\x09\xf7\x5a\x7d\x15\x22\x3f\xd3\xee\x92\xa2\xaf\xbd\x58\x17\xfe\xc3\x1e\xb8\xb2\x45\x1c\x48\x0c\xf2\xfe\xe4\x6c\xd0\x79\x4a\x66\x03\x34\xc6\x15\x32\x8d\x81\xb6\xcd\x74\xd0\x52\x0f\xa1\x51\x59\x38\xbb\xd5\xbb\x5a\x2c\xdd\xba\xe3\x00\x15\x7d\x52\x1f\x31\xba\x70\xf5\x68\x70\x92\x11\xc7\x6b\x0a\x33\x95\x76\xc4\xc7\x2f\xeb\x46\xa8\x63\xc3\x0d\x26\x19\x64\xd0\x15\xee\xfa\x8e\xd3\x10\xd1\xbe\x9f\x80\x42\x1f\xb2\x42\x84\x22\x6f\xdc\x07\xa9\xd4\x83\x6e\x81\xb2\x5d\x06\xf5\xa6\xb6\xb1\x5e\x7e\x27\xab\xd1\xc8\x00\xd2\x2e\x6c\xb4\xb3\xba\x98\xbc\x3f\x42\xff\x3f\xd9\x98\xcb\xee\xe5\x71\x8c\x2e\x2e\xa4\x78\xf3\x7b\x36\x5f\x5c\x6e\x68\xf2\x3d\xa0\x9d\x43\xce\xf2\xff\xf9\xe9\xb1\x1f\x58\xae\x33\x45\x9a\xa4\x8e\x52\x3f\xce\x02\x3f\xb6\x42\x73\x61\xae\x48\xe9\x26\x4e\x53\xb1\x69\x3d\xdb\x0c\xad\x13\xcc\x64\x73\x90\x5f\x1d\x25\xf5\x53\xe1\xa8\x46\x35\x4d\xd3\xc5\x92\xc2\x08\x8e\xe1\x77\xa7\x2d\xe8\x71\x81\x94\x0b\x19\x2a\xaa\x36\xc7\x03\xf7\x59\x8b\xbf\xa6\x82\x76\xd1\xe6\x46\x6c\xce\xea\x76\xae\x19\x59\x7b\x79\x44\x1f\x9a\xd7\xd5\xd4\xd6\x30\x3e\x5b\x97\x4f\x1a\x63\x90\xe3\xbf\x9b\x5f\x6d\x67\xad\xf9\x4f\x49\x16\xde\x51\x0c\x91\x31\x3a\x85\x5e\xda\x0c\x6e\x93\xc6\x3e\xb5\x98\xef\x90\x02\xbd\x8c\xba\xba\xfa\x44\xe9\x2b\xce\xd9\xb3\xb4\xdf\x46\xda\x53\x5c\x87\x3b\xc9\xa0\xb6\x9e\xe7\xb0\xbf\x47\xe3\x4b\xbc\x5f\x1c\xb2\x09\x8b\x82\x7c\x24\xf5\x6d\xfa\x5d\xe6\xe6\x66\xf4\x39\x26\x3d\x16\xce\x36\x1f\xa9\x38\x87\x5f\x7f\x93\xaf\x32\xd1\x05\x52\x6d\x0a\x73\x0e\xbf\xfe\xb6\xf8\xdf\x00\x30\x0b\x51\xec\x9b\x13\x00\x00"),
+		},
+		"/cluster_v1alpha1_cncthost.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cluster_v1alpha1_cncthost.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 5668,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\xdd\x6f\xdb\x36\x10\x7f\xd7\x5f\x71\xc8\x1e\xfa\x12\x2b\x09\x36\x0c\x83\xde\x52\x67\x58\xb3\x2d\x69\x90\xb4\x1d\xb0\xa2\x0f\x34\x79\x96\xb8\x50\xa4\x46\x9e\xec\x39\x7f\xfd\x70\xfa\xb0\x25\xdb\x92\xd5\xac\x58\x6c\xa0\xf5\xf1\x3e\x7e\xfc\xdd\x07\x25\x8a\x42\x7f\x42\x1f\xb4\xb3\x09\x88\x42\xe3\x3f\x84\x96\x7f\x85\xf8\xf9\xa7\x10\x6b\x77\xb1\xba\x5a\x20\x89\xab\xe8\x59\x5b\x95\xc0\xbc\x0c\xe4\xf2\x47\x0c\xae\xf4\x12\x6f\x70\xa9\xad\x26\xed\x6c\x94\x23\x09\x25\x48\x24\x11\x80\xf4\x28\x58\xf8\x41\xe7\x18\x48\xe4\x45\x02\xb6\x34\x26\x02\x30\x62\x81\x26\xb0\x0e\x80\x74\x96\xbc\x33\x06\xfd\x8c\x9c\x33\x6d\xc0\x04\xce\xae\xe2\xcb\xb3\x08\xc0\x8a\x1c\x13\x90\x56\x52\xe6\x02\x85\x58\x9a\x32\x10\xfa\x98\x25\x71\x50\x21\x0e\x22\x0f\xa5\x4d\x63\xe9\xf2\x28\x14\x28\xd9\xaf\x50\xaa\x02\x24\xcc\x83\xd7\x96\xd0\xcf\x9d\x29\x73\x5b\xc5\x9c\xc1\xaf\x4f\xef\xef\x1f\x04\x65\x09\xc4\x81\x04\x95\x21\x66\xd7\x1c\xa8\x82\xa4\x30\x48\xaf\x0b\xb6\x4f\x20\x17\x22\x40\x6f\x99\xff\x93\xc0\xbb\xae\x88\x36\x05\x26\x10\xc8\x6b\x9b\x0e\x44\xa8\xff\x19\xf0\xdf\x59\xac\xbd\x3f\xed\x04\x13\x7c\x17\x6e\x8d\x9e\x4d\x8e\xe0\xaf\xd6\x20\x6c\x17\x6b\xff\x0f\x2c\x9d\xea\xfe\xc5\xd9\x21\x62\xb6\x4b\xb5\xdb\x3f\xdb\x9f\x93\x40\x3b\x33\xe0\xd5\x37\x85\x05\x5b\x9d\x16\xb5\x33\x53\xdd\xbb\xb5\xe5\x22\xa9\x8b\xe5\x30\x4e\xb3\x00\x6e\x6d\xb5\x4d\x81\x32\xac\x92\xdc\x09\x36\xef\x98\x8e\xc5\x6b\x4b\x3e\x3e\xa8\xf7\x8e\xb3\xeb\xb4\xcb\x8b\xaa\x93\x91\x7a\x57\x16\x09\x8c\x16\x74\x9d\xb0\xa6\x57\x9a\xe6\xb3\x92\xde\xb5\x58\x0b\x53\x7a\x61\x3a\xfd\x11\x01\x04\xe9\x8a\xde\x06\x56\xc2\x68\x55\xf5\x62\xed\xc7\x15\x68\xaf\x1f\x6e\x3f\x7d\xff\x24\x33\xcc\xab\x66\x65\x71\xe1\x5d\x81\x9e\x74\x1b\x8e\x3f\x9d\xc1\xb0\x95\xed\x51\xf9\x86\x5d\xd5\x3a\xa0\x78\x14\x60\xa8\xf8\x5c\xd5\x32\x54\x10\xaa\x30\xe0\x96\x40\x99\xe6\xf4\x16\x1e\x03\x5a\xaa\x20\x75\xdc\x02\xab\x08\x0b\x6e\xf1\x17\x4a\x8a\xe1\x09\x3d\x3b\x81\x90\xb9\xd2\x28\x1e\x15\x2b\xf4\x04\x1e\xa5\x4b\xad\x7e\xd9\x7a\x0e\x40\xae\x0a\x69\x04\x61\xa0\x9e\xc7\xaa\xfb\xad\x30\xb0\x12\xa6\xc4\x73\x10\x56\x41\x2e\x36\xe0\x91\x63\x40\x69\x3b\xde\x2a\x95\x10\xc3\x9d\xf3\x08\xda\x2e\x5d\x02\x19\x51\x11\x92\x8b\x8b\x54\x53\x3b\x0a\xa5\xcb\xf3\xd2\x6a\xda\x5c\x54\xb3\x4b\x2f\x4a\x72\x3e\x5c\x28\x5c\xa1\xb9\x10\x85\x9e\x55\x38\x2d\xef\x2d\xc4\xb9\xfa\xae\xad\xe6\xf0\xa6\x03\x6c\xaf\xa2\x2a\x59\x9d\xdf\x41\x9a\x7f\xd3\x56\x81\x0e\x20\x1a\xb3\x7a\x47\x3b\x36\xdb\x3a\x7e\xfc\xf9\xe9\xc3\xae\x85\x98\xf1\x8e\x4b\x68\xc8\xdd\x99\x85\x1d\xcf\xcc\x8b\xb6\x4b\xf4\x75\x9e\x96\xde\xe5\x15\xad\x68\x55\xe1\xb4\xa5\xea\x87\x34\x1a\x6d\x9f\xe3\x50\x2e\x72\x4d\x9c\xd8\xbf\x4b\x0c\xc4\xe9\x88\x61\x2e\xac\x75\x04\x0b\x84\xb2\xe0\x82\x57\x31\xdc\x5a\x98\x8b\x1c\xcd\x5c\x04\xfc\xd6\x2c\x33\xa1\x61\xc6\x0c\x9e\xe6\xb9\x7b\x4a\xb5\x7f\x6c\x9f\x34\xe4\x6c\xc5\xf5\xa8\xea\xaa\x1d\xeb\x11\xfe\x08\x2f\x33\x4d\x28\xa9\xf4\xd8\x5f\x19\xc0\xc0\x5f\x59\x94\x73\x57\x5a\xda\x37\xe8\xe5\x7d\xfe\xf0\xb1\x52\xe2\xd4\x33\xff\xb6\xcc\x17\xe8\xb9\x53\x64\x51\x82\x74\x1e\xfb\xf9\x05\x58\x3a\x9f\x0b\x4a\x40\x5b\xfa\xf1\x87\xbd\xb5\x1a\x0b\x37\x45\xda\xcc\xb6\xf6\xa3\x74\x78\x0e\xa3\x48\x6e\x58\x03\x84\xe7\xa2\x42\x28\xb2\x4d\xd0\x52\x98\xda\x70\xcf\x4e\x13\xe6\x07\xce\x86\xd9\x6b\xd2\xe2\x14\x9a\x63\x0b\x23\x14\xd6\x5f\x9e\x91\xc7\x0d\x7b\x1b\xb8\x17\x39\x32\x71\x0c\x9f\x51\x9f\x03\xc6\x69\x0c\x41\x89\xd7\x04\x0d\xfa\x65\x4a\xd0\x27\xfd\xd2\x0b\x0a\xda\xc2\x2f\x6f\x8f\xda\x8d\x25\xee\x54\xfa\x9a\x75\x91\x1e\xa5\x76\x30\x23\x93\x76\xda\x2a\x08\xef\xc5\xe6\x60\x9d\xbb\x5e\x7b\xec\x4d\xae\xfa\x3b\x83\xed\x13\x52\xf7\x6f\x56\x51\x77\x20\x3e\xda\x81\xe3\xe1\xdb\xe7\xb2\x24\x1a\x49\x40\xfb\xa4\xd6\x36\xd0\xe1\x13\xdd\x04\x22\x8c\x08\xf4\xb1\x9e\x63\xa3\xc1\xfe\xc8\xd0\x6e\x9f\x26\x2a\x2b\x90\x99\xb0\x29\xaa\xe8\x78\xae\xd9\xe5\x8c\xf4\x74\x24\x0c\xff\x11\xd3\xbd\x13\xf9\x00\xc8\xdd\x56\xad\xdd\xf7\xdc\x4a\xea\x48\x9b\x92\xcc\x85\xcc\xb4\xc5\x73\xc0\xbc\xa0\xc3\xe4\x2e\x1d\x1f\x06\xc8\x07\xbb\x28\x0d\x9f\xbd\xe9\xfe\x99\x3d\x86\x15\x73\xe7\x37\xe3\x38\x2b\x15\x6e\x8a\x3b\xfd\x76\x80\xa3\xaf\x1b\x64\x56\xcb\x30\x1a\xf2\xfe\x76\xbe\x1b\x63\x16\x69\xed\xfc\x33\x0f\x4b\xf4\x4b\x21\xf1\x9b\x8c\x32\x5d\x5c\x2b\xe5\x31\x04\xfc\xdf\xdb\x91\x2b\x44\x36\xd1\x93\xe8\x15\xee\x5f\x31\x4c\xb7\xe4\x35\x13\x15\x29\xbb\x04\xe7\x61\xe1\xac\xba\x7c\x0d\x86\x95\x11\x76\x02\x86\x4f\xbf\x5f\xdf\x83\x56\x47\x60\x5c\x82\x5e\x42\x69\x49\xa4\x87\xad\x77\xba\xb8\x4e\x95\xd8\xab\xc6\xde\x2b\xe6\x5b\xf5\x12\x93\x44\x23\x0c\xbc\x67\x0d\xee\xf0\x80\x04\xeb\x76\xf8\x34\x4d\xcd\x72\x61\x8c\x93\x3c\xb6\x60\xb1\x01\x99\x8b\x59\x08\x59\x34\xbd\x94\x9b\x97\x93\x24\x3a\x91\x89\xe6\x65\xa3\x9d\x35\xb6\x53\x1c\x3c\x77\xda\xe5\x8e\xe8\xae\xc6\x18\x7d\x65\x6d\x0c\x55\xe7\x49\xa3\x50\x08\x89\x27\xf7\x71\xdf\x6a\x56\x2f\x0a\xdd\x1a\xef\x60\x6e\x86\x25\x97\x18\x65\xc7\x76\x00\xdb\x0c\xac\xc5\xf1\x14\xc0\xa2\x24\xc8\x44\x00\xeb\xfe\x0b\x1b\x85\x77\x2b\xad\xd0\xdf\xde\x9c\xdc\xd9\xc3\x56\xb5\x57\x22\x7d\x80\x6b\x4d\xd9\xd7\x61\x18\x6a\x83\x59\x07\x5b\x34\xb1\x11\xf8\x75\x3f\x89\xc6\xf6\xe0\x9c\x69\x4b\x6c\xe0\xa6\x60\x02\xe8\xdd\x75\xc9\x89\x60\xad\x1a\x87\x74\xf6\x1c\xdc\x72\xc9\x53\xad\xb4\xcf\xd6\xad\x27\x1f\x82\x87\x2f\x11\x07\xa1\xf8\xee\xa6\x0c\xbd\x9d\xd5\x56\xcd\x3c\x7d\x44\xa1\x36\x1c\xfa\x06\x0b\xe3\x36\xa8\x26\xc7\xde\x04\xc2\xfc\xf6\x66\x3c\x7a\xa3\xd4\x8f\x5f\x09\x41\x4f\x0e\x45\x22\x1d\xdf\xe4\x07\x91\xee\x8e\xdd\x2a\x06\x9b\x44\x93\xce\xc5\xc1\xa8\xc3\xb3\x93\xaf\xa6\x46\xf1\xf0\x65\x55\x6f\xcb\xdb\xcb\xac\x93\x71\x8f\x15\xfd\x6c\x4b\x76\x4f\x78\xe4\x41\x73\xd6\xbd\xef\x6b\x45\xed\xbb\x60\x4f\x98\x57\x0f\x47\xd1\x60\xeb\x34\x57\x2c\x09\xac\xae\x84\x29\x32\x71\x15\xed\x8a\x4d\x48\x89\x05\xa1\xba\xdf\xbf\x3f\x3a\x3b\xeb\xdd\x1c\x55\x3f\xa5\xb3\xf5\x85\x69\x48\xe0\xf3\x17\xbe\x43\x22\xe7\x51\x35\xd7\x3a\x21\x81\xcf\x5f\xa2\x7f\x07\x00\x09\x35\x7d\x03\x24\x16\x00\x00"),
+		},
+		"/cluster_v1alpha1_cnctimageupload.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cluster_v1alpha1_cnctimageupload.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 4875,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\xcd\x72\xdb\x36\x10\xbe\xeb\x29\x76\xdc\x43\x2e\x16\x5d\xa7\x89\xa7\xc3\x9b\xab\xb4\x53\x37\xb5\xe3\xb1\x9c\xf4\x90\xc9\x61\x45\xac\x44\x34\x20\xc0\x62\x01\xc7\xf6\xd3\x77\x16\x24\x25\x8a\xa2\x64\xb5\x13\x51\x17\x2e\x16\xfb\xed\x3f\xb0\xc4\x5a\x7f\x22\xcf\xda\xd9\x1c\xb0\xd6\xf4\x18\xc8\xca\x1b\x67\x5f\x7f\xe6\x4c\xbb\xb3\x87\xf3\x05\x05\x3c\x9f\x7c\xd5\x56\xe5\x30\x8b\x1c\x5c\x75\x47\xec\xa2\x2f\xe8\x1d\x2d\xb5\xd5\x41\x3b\x3b\xa9\x28\xa0\xc2\x80\xf9\x04\xa0\xf0\x84\x42\xbc\xd7\x15\x71\xc0\xaa\xce\xc1\x46\x63\x26\x00\x06\x17\x64\x58\x78\x00\x0a\x67\x83\x77\xc6\x90\x9f\x06\xe7\x4c\x07\x98\xc3\xc9\x79\xf6\xe3\xc9\x04\xc0\x62\x45\x39\x14\xb6\x08\xba\xc2\x15\xc5\xda\x38\x54\x9c\x15\x26\x72\x20\x9f\xc9\x42\xc6\x8a\x33\xc6\x8a\xa3\x5d\x65\x85\xab\x26\x5c\x53\x21\xe2\x51\xa9\xa4\x17\x9a\x5b\xaf\x6d\x20\x3f\x73\x26\x56\x36\x41\x4f\xe1\x8f\xf9\x87\x9b\x5b\x0c\x65\x0e\x19\x07\x0c\x91\x33\xc1\x4a\x5a\x29\xe2\xc2\xeb\x5a\xf6\xe6\x50\x21\x32\x2c\x9c\x0b\xe0\x5b\x8b\x13\x8f\x30\xe7\x70\x25\x4a\xa5\xf7\xf0\x54\x53\x0e\x1c\xbc\xb6\xab\x3d\xf2\xeb\x12\x79\x04\xa0\xb1\x09\x1a\xa6\x9e\xec\xf9\x86\x70\x8c\x70\xef\x56\x9e\x98\x77\xe5\x77\x2b\xe0\x96\x10\x4a\x6a\x81\x40\x5b\xa8\xc9\x17\x64\x43\x0f\xf3\xb6\x2f\xa5\x41\x15\xcf\xad\xc8\x0f\x61\xbb\x58\x67\x3b\x81\xee\x89\xbb\xdc\x72\x8e\xc2\x20\xaf\x2b\xef\x62\x9d\xc3\xc1\x10\x36\x81\x6f\x93\xa4\xcd\x3a\x5b\x84\xe4\xee\x8f\xc9\x5f\x69\xa5\x36\xd1\xa3\xd9\xcd\x8f\x09\x00\x17\x4e\xb4\x9f\x35\x28\x13\x80\x07\x34\x5a\xa5\x94\x6c\xa4\xba\x9a\xec\xe5\xed\xd5\xa7\x9f\xe6\x45\x49\x55\xca\x59\x21\xd7\xde\xd5\xe4\x83\xee\xc0\xe5\xe9\xd5\xc7\x9a\x36\x70\xf2\x2b\x11\xd5\xf0\x80\x92\x8a\x20\x4e\xce\x7e\x68\x68\xa4\x80\x13\x4c\x13\x04\xcd\xe0\xa9\xf6\xc4\x64\x43\x52\xa9\x27\x16\x84\x05\x2d\xb8\xc5\xdf\x54\x84\x0c\xe6\xe4\x45\x08\x70\xe9\xa2\x51\x52\x31\x0f\xe4\x25\x19\x0b\xb7\xb2\xfa\x79\x2d\x99\x21\xb8\x04\x69\x30\x10\x87\x2d\x89\x12\x43\x6f\xd1\x88\x13\x22\x9d\x02\x5a\x05\x15\x3e\x81\x27\xc1\x80\x68\x7b\xd2\x12\x0b\x67\x70\xed\x3c\x81\xb6\x4b\x97\x43\x19\x42\xcd\xf9\xd9\xd9\x4a\x87\xae\x23\x14\xae\xaa\xa2\xd5\xe1\xe9\x2c\x95\xb0\x5e\xc4\xe0\x3c\x9f\x29\x7a\x20\x73\x86\xb5\x9e\x26\x3d\xad\xd8\xc6\x59\xa5\x7e\xe8\x6a\x87\x5f\xf5\x14\x1b\xa4\x75\xa2\x35\xd1\xde\xeb\xe6\xf7\xda\x2a\xd0\x0c\xd8\x6e\x6b\x2c\xda\x78\x53\x48\xe2\x84\xbb\x5f\xe7\xf7\xeb\x82\x4d\x1e\xef\x89\x84\xd6\xb9\x9b\x6d\xbc\xf1\xb3\xf8\x45\xdb\x25\xf9\xb4\x0b\x96\xde\x55\xc9\xad\x64\x55\xed\xb4\x0d\xe9\xa5\x30\xba\x2b\x9c\xee\xc7\x71\x51\xe9\x20\x81\xfd\x27\x12\x07\x09\x47\x06\x33\xb4\xd6\x05\x58\x10\xc4\x5a\xd2\x5f\x65\x70\x65\x61\x86\x15\x99\x19\x32\x7d\x6f\x2f\x8b\x43\x79\x2a\x1e\x7c\xd9\xcf\xfd\x66\xdd\xfd\x64\x7f\xde\x3a\x67\x4d\xee\xda\x29\xc0\xfe\x0a\x91\x07\x7d\x51\xea\x40\x45\x88\x9e\xb6\x57\x06\x41\xbc\xec\x31\x76\x4d\x29\x55\xef\x29\x60\xa5\x2e\xde\x9c\xad\xc8\x92\xd7\x05\xe8\x25\x88\xf7\x98\xb6\x3d\xbd\xc7\x20\xf9\x2f\xb5\xa1\x7b\x31\xe2\x10\xfe\x6f\x2d\x93\xe4\x91\x60\x2f\x9d\xaf\x30\x6c\x69\x02\x01\xfd\x02\x8d\x39\x85\xb0\x7a\x6e\xf5\x18\x88\x84\x11\xbd\xc8\xc6\x6a\x08\x3d\x15\x11\xbb\xb4\xc5\x08\xed\x71\x97\xa6\xd4\xd8\x6e\xa5\xc6\xf6\x2b\xb5\x47\x02\xfa\x11\x09\x8b\xe7\xd7\x23\xd4\x51\xb0\x51\xa9\x1e\xbf\x1d\x1b\x15\x6d\x39\xa0\x2d\x5e\x8e\xcc\x55\x8f\xb1\x17\x0c\xcd\xb0\x88\xda\x04\x58\x3a\x7f\x2c\xe6\xd7\xb8\x20\x6f\x29\x10\x8f\xb4\xee\x1d\xe0\xf7\x43\xee\x46\x67\x63\x48\xc9\x41\xb9\x56\xe5\x58\x74\xb9\x34\xdc\xd1\xea\x25\xd8\xeb\x35\x5b\x97\x8b\x72\xec\x75\x99\x38\xb3\x45\xe8\x71\xec\x53\x02\x64\x6f\x73\xf0\x91\x4a\x5d\xe7\xbe\x24\x39\x84\x30\x1a\x39\x27\x3a\xf1\x91\xc5\x98\x25\xe8\x20\x6f\x6d\x61\x65\xc7\x5a\xe4\x78\x4e\x7e\xa7\xe6\x07\xf6\x7c\x98\x37\x4c\x5b\xb5\x74\x0a\x94\xad\x32\x88\x8b\x68\x43\x9c\x3e\x92\xd5\x68\x8e\x45\x6d\x0e\x8d\x83\x98\xf3\xc4\x32\x5a\xbd\x83\x6d\xfb\x1a\x97\x3c\xd1\x9b\x5d\xe2\x00\xe9\xe3\xdd\x9f\x1d\xcc\xba\x3d\xc8\xd1\x08\xce\x37\xcd\x7b\x44\xc0\x5e\xcb\xe4\xff\x20\xf7\x51\x7a\x11\xf7\x53\x62\x93\xa0\xa1\x5c\xd9\x58\x73\x20\x1b\xda\xdd\x50\x18\xd4\x15\x94\xce\xa8\xee\xec\x1b\xb7\xfe\x25\x0f\xc8\x93\x64\xdd\xe0\xb8\x4e\x3b\x7a\xcd\x3a\xee\xb1\xe4\x4d\xa2\xf6\x48\x39\xe8\x94\xee\xf6\xc8\x35\xee\x46\x7e\x54\x8f\x9b\x8e\xfb\xbb\x60\xd7\x72\xbf\x3d\x06\x56\x86\x87\x41\x3e\x74\xbd\xa2\x09\xcd\xff\xd3\x40\xee\x0f\xda\x93\xca\x47\xd6\xa6\x1b\xcf\x8c\xae\xae\xe3\x37\xba\x2a\x96\x8d\x2c\x8c\x1e\xfa\x07\x97\xc6\x54\x9c\xae\x3b\xc4\x16\x71\xa7\x0d\x6f\xad\xf6\x0f\x86\xad\x85\xde\xa0\x75\x40\x93\x66\x92\xc9\x27\x2f\x27\xb8\xcc\x6f\xdd\xc0\x7a\xf5\x2e\x9f\x1c\x08\xeb\x2f\x5b\xac\x5d\x6a\x6b\xd5\x85\x7a\x6b\x14\x94\x80\x5f\x5f\x5e\xce\x07\x02\x9b\x1b\x45\x9a\x9d\x2e\xde\x0c\xd6\x86\x53\xd5\xe6\x67\x90\xc3\xc7\xe6\x9a\x78\x50\xc3\xbf\x4a\xb2\xfd\x49\x4e\xf6\x41\x51\xa2\x5d\x91\xda\xa3\x88\x08\x9d\x06\xbd\x93\x18\x7b\x73\xb1\x22\x66\x5c\xd1\x41\x3d\xae\x1b\x1e\xa0\xc7\xda\xa0\xb6\x0c\xdf\xca\xa7\xa4\x57\x3b\xd1\x2e\x51\x1b\x52\xc7\x22\x5a\xac\x0e\xc3\xdd\xf4\xba\xcb\x31\x41\xd8\x8b\x94\xe6\xf0\xfc\x68\xee\x76\x24\x3e\xa8\x5b\x37\x37\x77\xfa\x15\xd1\x7b\xe9\xd1\x09\x6a\x38\x6a\xef\x86\xe7\xbf\xe5\x09\x97\xf8\xfa\xed\xc5\x41\x7d\xe6\xbf\x5f\xbe\x7e\x7b\x31\xe8\x4e\xc7\x5a\xcc\xfa\xf9\x70\x24\xe6\xfa\x99\x06\xb2\xc5\xc6\xc5\x53\x20\xfe\x0e\x16\x0e\x6a\xbd\x1d\xa1\x73\x78\x38\x47\x53\x97\x78\x3e\xd9\xd4\x3d\x16\x05\xd5\x81\xd4\xcd\xf0\x6b\xc1\xc9\xc9\xd6\x07\x82\xf4\x5a\x38\xdb\x7c\x10\xe2\x1c\x3e\x7f\x91\x6f\x04\xc1\x79\x52\x6d\x63\xe2\x1c\x3e\x7f\x99\xfc\x3b\x00\xde\x68\x4b\x1f\x0b\x13\x00\x00"),
+		},
+		"/cluster_v1alpha1_cnctmaasregion.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cluster_v1alpha1_cnctmaasregion.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 4233,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4d\x6f\xdc\x36\x13\xbe\xef\xaf\x18\xf8\x3d\xe4\xe2\x95\xe2\xe4\x0d\x10\xa8\x75\x01\x63\x5b\xa0\x6e\x3e\x6a\xd8\x49\x2e\x41\x60\x70\xc9\xd9\x15\xbb\x14\xa9\x72\x86\x9b\x6c\x7f\x7d\x31\x94\xe4\xac\xe5\xfd\x70\xd2\x58\x7b\xb0\x46\xc3\x99\x87\xcf\x7c\x91\xaa\xb5\x1f\x30\x92\x0d\xbe\x02\xd5\x5a\xfc\xc2\xe8\xe5\x8d\x8a\xd5\x4b\x2a\x6c\x28\xd7\x67\x73\x64\x75\x36\x59\x59\x6f\x2a\x98\x25\xe2\xd0\x5c\x23\x85\x14\x35\xfe\x8a\x0b\xeb\x2d\xdb\xe0\x27\x0d\xb2\x32\x8a\x55\x35\x01\xd0\x11\x95\x08\xdf\xd9\x06\x89\x55\xd3\x56\xe0\x93\x73\x13\x00\xa7\xe6\xe8\x48\x74\x00\x74\xf0\x1c\x83\x73\x18\xa7\x1c\x82\x1b\x1c\x56\x70\x72\x56\x3c\x3d\x99\x00\x78\xd5\x60\x05\xda\x6b\x6e\x94\xa2\x88\xcb\x0c\x4b\xbb\x44\x8c\xb1\x10\x79\x41\x86\x0a\x52\x0d\x25\xbf\x2c\x74\x68\x26\xd4\xa2\x16\xeb\xca\x98\x0c\x4b\xb9\xab\x68\x3d\x63\x9c\x05\x97\x1a\x9f\x3d\x4f\xe1\x8f\x9b\x3f\xdf\x5e\x29\xae\x2b\x28\x64\x41\xa1\x5a\xfb\xfe\xfa\x75\x06\x65\x90\x74\xb4\xad\xac\xad\x40\xdc\x0a\x29\x90\xa2\x80\x1f\x10\x0d\xba\xbc\x69\xb1\x02\xe2\x68\xfd\x72\xa7\xdd\xd6\xa5\xa5\xf5\x85\x32\x26\x22\xd1\x43\xfb\x6d\x0c\x6b\x6b\x30\x42\xa7\x08\xdb\x8a\x9d\xab\xab\xfc\xe1\xa8\xb7\x81\xfc\xe2\x01\xf3\x5b\xb6\x2e\x96\xb8\x65\xc8\x28\x96\xd7\x65\x0c\xa9\xad\xe0\x20\xa9\x1d\x98\x3e\x6a\x7d\x1a\x78\xcd\x6f\x94\xa2\xeb\x1c\x94\xfc\xa1\x75\x29\x2a\xf7\x20\x5e\x13\x00\xd2\x41\x88\x9a\x75\x3e\x26\x00\x6b\xe5\xac\xc9\x19\xd2\xd9\x0c\x2d\xfa\x8b\xab\xcb\x0f\xcf\x6f\x74\x8d\x4d\x4e\x21\x11\xb7\x31\xb4\x18\xd9\x0e\xae\xe5\xd9\x4a\xd7\x3b\xd9\x88\xd5\x27\x62\xaa\xd3\x01\x23\x09\x8a\x04\x5c\x23\xac\x3b\x19\x1a\xa0\xec\x06\xc2\x02\xb8\xb6\x04\x11\xdb\x88\x84\x9e\x33\xa4\x2d\xb3\x20\x2a\xca\x43\x98\xff\x85\x9a\x0b\xb8\xc1\x28\x46\x80\xea\x90\x9c\x91\x04\x5e\x63\x64\x88\xa8\xc3\xd2\xdb\x7f\xee\x2c\x13\x70\xc8\x2e\x9d\x62\x24\xbe\x67\x31\x67\xa3\x57\x4e\x48\x48\x78\x0a\xca\x1b\x68\xd4\x06\x22\x8a\x0f\x48\x7e\xcb\x5a\x56\xa1\x02\xde\x84\x88\x60\xfd\x22\x54\x50\x33\xb7\x54\x95\xe5\xd2\xf2\x50\xa0\x3a\x34\x4d\xf2\x96\x37\x65\xae\x28\x3b\x4f\x1c\x22\x95\x06\xd7\xe8\x4a\xd5\xda\x69\xc6\xe9\x65\x6f\x54\x34\xe6\x7f\xb1\x2f\x5e\x7a\xb2\x05\x6c\x94\x5d\x59\xd6\xc5\x7a\x2f\xcd\xaf\xac\x37\x60\x09\x54\xbf\xac\xdb\xd1\x57\x36\x45\x24\x24\x5c\xff\x76\xf3\x0e\x06\xa7\x99\xf1\x2d\x93\xd0\x93\xfb\x75\x19\x7d\xe5\x59\x78\xb1\x7e\x81\x31\xaf\x82\x45\x0c\x4d\xa6\x15\xbd\x69\x83\xf5\x9c\x5f\xb4\xb3\xe8\xef\x73\x4c\x69\xde\x58\x96\xc0\xfe\x9d\x90\x58\xc2\x51\xc0\x4c\x79\x1f\x18\xe6\x08\xa9\x95\xe4\x37\x05\x5c\x7a\x98\xa9\x06\xdd\x4c\x11\xfe\x68\x96\x85\x50\x9a\x0a\x83\xc7\x79\xde\xee\x9d\xc3\x9f\xac\xaf\x7a\x72\xee\xc4\x43\x7b\x03\xd8\x5f\x21\x7d\x95\xbc\xbf\x7e\x7d\x5f\x36\x0a\xdf\xc5\xd5\xe5\xfb\xeb\xd7\x12\x3f\x21\x31\x45\x27\xb9\x2e\xff\xbe\xb9\xb8\xb8\x81\xae\x78\xe1\xe2\xea\xf2\x14\xb0\x58\x16\x99\x91\xaa\x2c\xa5\xb0\xab\x17\xcf\xfe\xff\xb4\x14\xb5\x72\xe4\x60\xe7\xe6\xf6\x57\xed\x2e\x4c\x43\xe1\xda\x7b\x35\x7b\x0f\x5b\x06\xf5\xac\x78\x0a\x76\x01\x12\x51\x42\x7e\x2c\x0e\x1d\xd1\xa0\x67\xab\x1c\xdd\xa0\x8e\xc8\x07\xe1\xcc\xc6\xda\x10\x71\x81\x11\xbd\xee\x3b\x0a\x65\x1b\x50\x07\x67\x86\x64\x17\x84\x23\x9b\x20\xfb\x82\x15\x6e\x46\xf2\x7d\xc1\x93\x67\x85\x9b\x87\xc2\x11\xbc\x57\xb8\x19\xf3\x22\x5e\xc0\xfa\x2d\x70\xa7\x32\xbf\x44\xb3\x23\x6b\x87\x4d\xd8\x41\xe0\x41\x12\xef\x26\xca\x31\x80\x6f\x55\x83\x03\xc2\x8e\xaa\xef\x71\x43\xad\xd2\x8f\xf3\x95\x35\xff\x8b\x43\xe9\x17\x36\xe2\xbd\x9e\x27\xbf\x69\x1e\x80\x3b\x85\xd9\xe7\xe8\xcb\xce\xd2\xed\x47\xe4\xd2\x1e\x2e\x81\x6e\xe0\x9f\x4a\x6e\x13\xf2\x29\x90\x8c\x9c\x2e\xdb\xfa\x9a\xfc\x6c\xb9\x06\x35\x3e\x3b\x8c\x6c\x02\x58\x4f\x8c\xca\x08\x1d\x92\x1d\xc5\x50\xf0\x32\x6f\x1e\x26\xb6\x92\xd6\xb7\xf4\x21\xa2\x29\xbe\x21\x4d\xfb\x03\xcb\xd1\xe8\x5c\x74\x7a\x43\x6c\xda\x7e\x93\x75\x20\xae\xda\x10\x19\x42\x84\xe4\xed\x97\xaa\x2c\xcb\x56\x71\x5d\x72\x28\x29\xe8\xd5\x8f\x0b\x60\x8f\xf4\xb1\x91\x8a\x68\x16\x96\xea\x6a\x72\x60\x53\xd7\x9d\xce\xd1\x58\xc9\x8e\x67\x5e\x73\xaf\xff\x7b\x18\x9d\x08\xe4\x47\xfd\xc4\x0b\x8b\xed\xe5\xdf\x14\xc3\x07\x36\xbf\x33\xa6\xb6\x51\x4b\x3c\x1e\xd2\xcb\xac\x96\x9d\xc8\x06\xbb\x55\xd0\x28\x5d\xe7\x03\x97\x56\x1e\xe6\x08\x06\x5b\x17\x36\x68\x32\x13\x63\x14\xdd\xf3\xae\xc6\x0d\x34\x89\x18\xe6\x21\x70\x56\x04\x43\xe7\x3e\x68\x17\x92\x99\x7a\xe4\x9f\xe8\xbc\x1f\x3f\x3f\xeb\x46\x4d\x89\xea\x5f\xca\x3e\x40\x25\x21\x9a\xf2\xf6\xd6\x34\xb6\xa0\x0d\x31\x36\xd3\x94\xac\xb9\xbd\xed\x65\xba\x56\x44\x96\xa6\x8a\x08\x79\xca\x6a\x79\x7b\x3b\x1e\x5a\xfd\x29\xa4\x6b\x9a\x2b\x8c\x1e\x1d\xc8\xb0\x17\x92\x9d\xf5\x28\x67\xb9\x05\xb2\xae\x65\x50\xda\x08\x1d\x2c\xb9\xf6\x80\x38\xcf\x80\x77\x9a\x14\x7b\x1c\x56\xe8\xa1\x47\x2d\x59\x42\x20\x57\x0a\x41\x03\xac\x96\x43\xbc\xa5\x10\x76\xb1\x63\x19\x9b\x9d\xa1\x38\x1c\xc2\xc3\x3d\xfa\x48\xa7\xce\x91\xec\xa7\x7e\xa0\xf3\x34\x4f\x9e\xd3\xf4\x0b\x7a\xab\xdc\xe9\xea\x25\x9d\x9f\x15\x67\xcf\x8b\x17\xa7\xc4\xca\x1b\x15\xcd\x5e\x0f\x07\xea\x74\x78\x52\x74\x8f\x44\x28\x69\x3f\x00\xa4\x20\xdd\x0d\x23\xa3\x11\x36\xd7\x36\x72\x52\x0e\x1a\x34\x56\x15\x70\xc9\x93\x3d\x06\x25\xb9\x69\x28\x09\x98\x6f\x32\xed\x94\xd3\x4e\xc6\xf7\xdd\xf1\xd2\x23\x7f\x0e\x71\x55\x7c\xff\xce\xf6\xf5\xa1\xed\xc9\xb1\xf3\xd3\xde\x86\xb4\xfd\x59\xc5\xa8\x36\x93\xc7\x79\x9c\xf6\x95\x39\x79\x94\x9f\x91\xb8\x3f\x7d\x55\xb0\x3e\x53\xae\xad\xd5\xd9\x84\x58\x71\xca\x19\xa9\xb4\xc6\x96\xd1\xbc\x1d\x5f\x0d\x4f\x4e\xee\x5d\x07\xf3\xab\x0e\xbe\xbb\x8f\x53\x05\x1f\x3f\xc9\x95\x90\x25\x06\xfd\x61\x8f\x2a\xf8\xf8\x69\xf2\xef\x00\xdf\x30\xc8\x85\x89\x10\x00\x00"),
 		},
 		"/cluster_v1alpha1_cnctmachine.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cluster_v1alpha1_cnctmachine.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 3341,
+			uncompressedSize: 14536,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xcd\x72\x1b\x37\x0c\xbe\xeb\x29\x30\xee\x21\x17\x6b\x5d\x37\x97\x8e\x6e\x19\xa7\x07\x37\x13\x37\x13\xb9\xe9\xa1\xd3\x03\x44\x42\x5a\xd6\x5c\x92\x21\x40\xa5\xea\xd3\x77\xc0\xd5\xca\xd2\xae\xa5\xf8\xd0\x3d\x68\x66\xb1\xe0\x07\xf0\xc3\xaf\x30\xb9\x2f\x94\xd9\xc5\xb0\x00\x4c\x8e\xfe\x11\x0a\xfa\xc6\xcd\xd3\xcf\xdc\xb8\x78\xb3\xbd\x5d\x91\xe0\xed\xec\xc9\x05\xbb\x80\xbb\xc2\x12\xbb\xcf\xc4\xb1\x64\x43\xef\x69\xed\x82\x13\x17\xc3\xac\x23\x41\x8b\x82\x8b\x19\x80\xc9\x84\x2a\x7c\x74\x1d\xb1\x60\x97\x16\x10\x8a\xf7\x33\x00\x8f\x2b\xf2\xac\x3a\x00\x26\x06\xc9\xd1\x7b\xca\x73\x89\xd1\x0f\x06\x17\x70\x75\xdb\xfc\x78\x35\x03\x08\xd8\xd1\x02\x4c\x30\xd2\xa1\x69\x5d\x20\x6e\x8c\x2f\x2c\x94\x1b\x15\x36\x6c\xb9\x61\xec\xb8\x84\x4d\x63\x62\x37\xe3\x44\x46\xa1\xd1\xda\xea\x13\xfa\x4f\xd9\x05\xa1\x7c\x17\x7d\xe9\x42\x35\x3b\x87\x5f\x97\xbf\x3d\x7c\x42\x69\x17\xd0\xb0\xa0\x14\x6e\x52\x8b\x4c\xd5\x25\x4b\x6c\xb2\x4b\x52\xd9\xd8\x1b\x85\x5e\xab\x7e\xef\x3d\x5a\x3e\x0b\x64\x97\x68\x01\x2c\xd9\x85\xcd\x18\x7d\x60\xa4\x99\xd0\x71\x84\xf5\x6e\x43\x47\x40\x16\x45\x5f\x37\x39\x96\xb4\x80\x8b\x97\xed\x01\xf6\x54\xee\x63\x13\x8c\x7c\xec\x9d\xae\xd2\xe4\x4b\x46\x7f\xca\xe0\x0c\x80\x4d\x54\x5b\x0f\x7a\x3c\xa1\x21\x3b\x03\xd8\xa2\x77\xb6\x3a\xd9\x03\xc6\x44\xe1\xdd\xa7\xfb\x2f\x6f\x97\xa6\xa5\x0e\x7b\x21\x40\xca\x31\x51\x16\x37\xd8\xd5\xe7\x28\x81\x0e\xb2\x11\x93\x6f\x14\xaa\xd7\x01\xab\x29\x43\x0c\xd2\x12\x6c\x7b\x19\x59\xe0\x6a\x06\xe2\x1a\xa4\x75\x0c\x99\x52\x26\xa6\x20\xd5\xa5\x23\x58\x50\x15\x0c\x10\x57\x7f\x93\x91\x06\x96\x94\x15\x04\xb8\x8d\xc5\x5b\x4d\xa9\x2d\x65\x81\x4c\x26\x6e\x82\xfb\xf7\x80\xcc\x20\xb1\x9a\xf4\x28\xc4\x72\x82\x58\x53\x24\xa0\x57\x12\x0a\x5d\x03\x06\x0b\x1d\xee\x20\x93\xda\x80\x12\x8e\xd0\xaa\x0a\x37\xf0\x31\x66\x02\x17\xd6\x71\x01\xad\x48\xe2\xc5\xcd\xcd\xc6\xc9\x50\x32\x26\x76\x5d\x09\x4e\x76\x37\x35\xc7\xdd\xaa\x48\xcc\x7c\x63\x69\x4b\xfe\x06\x93\x9b\x57\x3f\x83\xd4\x32\xeb\xec\x0f\x79\x5f\x4e\xfc\xe6\xc8\xb1\x51\x6a\xf5\x4f\x0d\xf4\x59\x9a\x3f\xb8\x60\xc1\x31\xe0\xfe\x58\xef\xee\x33\x9b\x2a\x52\x12\x3e\xff\xb2\x7c\x84\xc1\x68\x65\xfc\x94\xe2\x4a\xee\xf3\x31\x7e\xe6\x59\x79\x71\x61\x4d\xb9\x8f\xd3\x3a\xc7\xae\x22\x52\xb0\x29\xba\x20\xf5\xc5\x78\x47\xe1\x94\x63\x2e\xab\xce\x89\x06\xf6\x6b\x21\x16\x0d\x47\x03\x77\x18\x42\x14\x58\x11\x94\xa4\x99\x6f\x1b\xb8\x0f\x70\x87\x1d\xf9\x3b\x64\xfa\xbf\x59\x56\x42\x79\xae\x0c\x7e\x9f\xe7\xe3\x6e\x76\xaa\xd8\x93\x73\x10\x0f\x3d\x67\x78\x5e\xaa\x90\x3e\xc9\x58\x30\x18\x7a\x54\x90\x93\x2f\xa3\x20\xde\x1f\x29\x42\xa6\x35\x65\x0a\x66\x5f\x2f\xea\x81\x16\xc0\xd0\x99\x24\xaa\xbd\xad\xe3\x71\x91\xf4\x16\xa1\x43\x64\x58\x21\x93\x85\x18\xc0\xa4\x72\x0d\x1b\xfd\xe9\xa8\x8b\x79\x07\x82\x1b\x1e\x1d\x7b\x91\x8c\xfd\xbd\xb6\xce\x52\xbe\x7f\x7f\xd1\xfb\xc7\x9a\x15\x8e\xbc\x85\x6f\xce\x7b\x8d\x2d\x93\xc0\x6a\x57\xfd\x47\x23\x05\x35\x48\xb5\xc6\x4c\x0c\x5c\x3a\xb2\xb0\xda\x4d\x9c\x6f\xdd\xa6\xa5\x0c\x5e\x63\x09\x1a\x43\x65\x14\xbc\x7b\x22\xc0\x22\x91\x0d\xfa\x9a\x83\x28\x07\x3b\xb5\x8c\xd7\x68\x34\xc9\xbf\x39\x69\x27\x98\xfb\x76\x3a\xc7\xe4\x00\x19\x36\x14\x28\x3b\x73\xb8\x59\xf3\x5a\x2a\x72\xf4\xe3\xe8\x02\x38\xa1\x6e\x22\xbc\x00\x32\x7c\xc2\x9c\xf1\xf4\xfa\x82\x2e\xc8\x04\x6a\xc4\x32\xc1\xba\x78\x7f\xad\x64\xb4\x31\x3b\xed\x93\x5b\x02\xef\x58\x6a\x0f\xad\x10\x9a\x1e\x98\x92\xdf\xed\x7b\xdf\x94\x90\x98\x33\x71\x8a\xc1\x2a\x67\x0f\xd1\xd2\x98\x83\x8b\xb7\x1a\x55\xc2\xa5\x5b\xbd\x5c\x3a\x75\x8c\xbe\xa6\x78\x9e\xca\x8a\x72\x20\x21\x7e\x61\xd2\x4c\xd8\xf9\x70\xd0\x1e\x06\x4c\x3f\x57\x08\x42\xb4\x74\x3d\xcc\x8a\x15\x01\x7d\x2d\xe8\x95\x9d\x13\x26\xce\x25\xce\x80\xf6\xda\x3c\xf1\xc8\xf2\x7b\xdf\xd8\x2e\xfa\xfb\x47\x4b\x01\xbe\xe9\x88\xd2\xe2\xe9\x59\xa9\x87\x21\xae\x58\xfb\xae\x1d\x9d\x5e\xc7\xdc\xa1\xf4\xdb\xc2\x5c\x5c\x37\x8e\xec\xf9\x22\xd6\x45\xe7\xa2\x2f\x1f\xa7\x3b\xcf\x2b\x70\x99\xdb\xbb\x18\xd6\x6e\x73\x11\x7b\x39\x68\x41\xd1\x8e\x24\xb1\x8e\xe9\x6c\xf5\xb8\x36\x83\xb5\xdb\x94\x5c\x07\xbe\xc6\x2b\xb5\x3b\x76\x06\xfd\x24\x1a\xdd\xd1\x86\x73\x74\xb5\x33\xa9\xa3\x4f\x1b\x59\xa6\xd2\xc9\xb6\x87\x7c\x68\xac\x2e\xbd\xa0\x7e\xa1\x96\x01\x52\xcc\x2f\xda\x18\x62\xe5\x82\xbc\xfd\xe9\x2c\xa8\xf6\xae\x0d\xe5\xc9\xf7\xc2\xba\x99\x74\x93\x98\x7d\xc7\x9d\xb3\xf5\xc9\x3b\x16\xea\xee\x2f\xe7\xe3\x72\xaf\x34\x9e\x3e\x95\xa1\x1e\x01\x9c\x7d\x65\xbb\xd4\x81\xef\xf2\x69\x05\xcc\xa7\x05\x7d\xbe\x57\x6c\x87\x3f\x27\xdb\x5b\xf4\xa9\xc5\xdb\xd9\x73\xdf\x40\x63\x28\x09\xd9\x87\xf1\x26\x7c\x75\x75\xb2\x00\xd7\x57\xa3\xd5\x5d\x97\x81\x05\xfc\xf9\x97\xee\xc1\x12\x33\xd9\xbd\x03\xbd\xf0\xbf\x00\x00\x00\xff\xff\x41\x6a\xb0\xd6\x0d\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x3b\xdb\x72\xe3\x36\xb2\xef\xfa\x8a\xae\x39\x0f\x79\x91\xe9\x99\x24\x95\x4a\xe9\xcd\x63\x27\x27\x3e\x19\x3b\xae\xd1\xcc\x9c\xaa\x4d\xe5\x01\x24\x9a\x22\xd6\x20\xc0\xa0\x41\x79\x3d\x5f\xbf\xd5\x20\x28\x51\xe2\x45\xf4\x25\xd9\x5a\x6b\x6a\x37\x02\x9b\x8d\xbe\xdf\x00\x89\x4a\x7d\x41\x47\xca\x9a\x15\x88\x4a\xe1\xbf\x3c\x1a\xfe\x46\xc9\xfd\x8f\x94\x28\x7b\xbe\x7d\x97\xa2\x17\xef\x16\xf7\xca\xc8\x15\x5c\xd6\xe4\x6d\xf9\x11\xc9\xd6\x2e\xc3\x2b\xcc\x95\x51\x5e\x59\xb3\x28\xd1\x0b\x29\xbc\x58\x2d\x00\x32\x87\x82\x17\x3f\xa9\x12\xc9\x8b\xb2\x5a\x81\xa9\xb5\x5e\x00\x68\x91\xa2\x26\x86\x01\xc8\xac\xf1\xce\x6a\x8d\xee\xcc\x5b\xab\xdb\x0d\x57\xf0\xe6\x5d\xf2\xf6\xcd\x02\xc0\x88\x12\x57\x90\x99\xcc\x97\x22\x2b\x94\x41\x4a\x32\x5d\x93\x47\x97\xf0\x62\x42\x92\x12\x12\x25\xd5\x66\x93\x64\xb6\x5c\x50\x85\x19\xa3\x16\x52\x06\x9a\x84\xbe\x73\xca\x78\x74\x97\x56\xd7\xa5\x09\xdb\x9e\xc1\xff\xad\x7f\xbb\xbd\x13\xbe\x58\x41\x42\x5e\xf8\x9a\x92\xaa\x10\x84\x81\x24\x89\x94\x39\x55\xf1\xcb\x2b\x88\x9b\x42\x03\x15\x9e\x37\x14\xad\xf7\x0b\xfe\xb1\xc2\x15\x90\x77\xca\x6c\x8e\xb1\xb7\x12\x49\x7a\xe2\xe8\xe0\xba\xd8\x60\x07\x91\x14\x9e\xbf\x6e\x9c\xad\xab\x15\x4c\x32\xdb\x88\x27\x8a\x32\xea\xc6\x64\xfe\xa6\x21\x3a\xac\x56\xba\x76\x42\x1f\x4a\x70\x01\x40\x99\xe5\xbd\x6e\x45\x89\x54\x89\x0c\xe5\x02\x60\x2b\xb4\x92\x41\x67\x0d\x42\x5b\xa1\xb9\xb8\xbb\xfe\xf2\xdd\x3a\x2b\xb0\x0c\x4a\xe5\xe5\xca\xd9\x0a\x9d\x57\xed\xbe\xfc\xe9\x18\xd0\x6e\xed\x48\x92\xdf\x30\xaa\x06\x06\x24\x9b\x0c\x12\xf8\x02\x61\xdb\xac\xa1\x04\x0a\xdb\x80\xcd\xc1\x17\x8a\xc0\x61\xe5\x90\xd0\xf8\x40\x52\x07\x2d\x30\x88\x30\x60\xd3\x7f\x62\xe6\x13\x58\xa3\x63\x24\x40\x85\xad\xb5\x64\x93\xda\xa2\xf3\xe0\x30\xb3\x1b\xa3\xbe\xee\x30\x13\x78\x1b\xb6\xd4\xc2\x23\xf9\x03\x8c\xc1\x44\x8c\xd0\x2c\x84\x1a\x97\x20\x8c\x84\x52\x3c\x82\x43\xde\x03\x6a\xd3\xc1\x16\x40\x28\x81\x1b\xeb\x10\x94\xc9\xed\x0a\x0a\xef\x2b\x5a\x9d\x9f\x6f\x94\x6f\x5d\x26\xb3\x65\x59\x1b\xe5\x1f\xcf\x83\x8d\xab\xb4\xf6\xd6\xd1\xb9\xc4\x2d\xea\x73\x51\xa9\xb3\x40\xa7\x61\xde\x28\x29\xe5\xff\xb8\xe8\x4e\xf4\x4d\x87\xb0\x23\xd3\x0a\x6b\x8d\xa2\x47\xc5\xfc\xab\x32\x12\x14\x81\x88\xaf\x35\x1c\xed\xa5\xc9\x4b\x2c\x84\x8f\x3f\xad\x3f\x41\xbb\x69\x90\x78\x07\x25\x44\xe1\xee\x5f\xa3\xbd\x9c\x59\x2e\xca\xe4\xe8\xc2\x5b\x90\x3b\x5b\x06\xb1\xa2\x91\x95\x55\xc6\x87\x2f\x99\x56\x68\x0e\x65\x4c\x75\x5a\x2a\xcf\x8a\xfd\xb3\x46\xf2\xac\x8e\x04\x2e\x85\x31\xd6\x43\x8a\x50\x57\x6c\xf9\x32\x81\x6b\x03\x97\xa2\x44\x7d\x29\x08\x5f\x5b\xca\x2c\x50\x3a\x63\x09\x9e\x96\x73\x37\x9a\xb5\x7f\xfc\xfe\x2a\x0a\x67\xb7\xdc\xc6\x1c\x80\x71\x0f\x89\xc1\x8e\xbc\x13\xca\xf8\xa3\x07\x47\x3a\xbc\xdc\xc3\x41\x5e\x3b\x5f\xa0\x63\x4d\x79\xa7\x32\x0f\x0f\x85\xca\x0a\x28\x85\xa0\x36\x38\x11\x64\xc2\x40\x8a\x47\x28\x01\x84\xd6\x36\x63\x99\x1e\x3d\x19\xa3\x8f\x3f\xc2\x65\x85\xf2\x98\xf9\xda\x61\xff\xe9\x11\xa1\x17\x1d\x60\x76\x4a\x56\x7c\x24\x6a\x09\x98\x6c\x12\x10\xa5\xfc\xe1\xfb\xf3\x0d\x1a\x74\x2a\x1b\x40\x37\x28\xf8\xf6\x13\x9c\x32\x17\x19\xd2\x49\x4a\xae\x77\xa0\x5d\x22\xa0\xac\xc9\x43\x21\xb6\x7d\xd9\x00\x28\x8f\xe5\x20\xe2\x69\x01\x35\x9f\x5c\xa4\x4e\x1d\x28\x7d\x82\xb8\x9f\x03\x30\x3b\x25\xd3\xc6\x21\xbb\x15\x56\x83\x26\x90\xbc\x63\x76\x14\x27\x30\x06\xe1\xbd\xc8\x0a\x94\xe0\xed\x28\xe0\xa4\x50\x9b\x7f\x21\x07\xcf\x24\xff\x03\xc3\x82\x92\x68\xbc\xca\x15\xd2\x21\xb9\xa0\x4c\x58\x88\xc6\x76\x1c\xaa\x0f\xff\x1c\x52\xad\x3d\xbd\x84\xf2\x90\xae\x66\x52\xbe\x66\xd8\x21\xb9\x07\xf7\x09\x98\xfe\x03\xb2\xa7\x3a\x35\xe8\xe7\xb2\x10\x80\x0f\x79\x70\x90\x29\xe9\x5a\x5e\x1a\x74\xcc\xc7\x28\xc6\x8e\x2b\xbd\x22\x1f\x5b\x75\x90\x86\x26\x98\xf8\x72\x7d\xd5\x72\xb0\xd5\xc2\x80\x92\xc7\x36\xb4\x27\x6a\x14\x23\x4c\x91\x9b\x5b\x57\x0a\xbf\x62\x94\x3f\x7c\x3f\x0a\xd5\x30\xc5\xb2\xd8\xa0\x1b\x84\xe2\xdc\xa4\x1c\x8e\x30\x76\xd6\xd4\xae\x83\xcf\x06\x33\xc3\xfe\xd3\x3c\x16\xce\x89\xc7\xde\xd3\x52\x99\xcb\xbb\xcf\x97\xb6\x36\x83\x56\x71\x20\xca\x9b\x3d\x6c\x2b\xd2\x52\x19\x55\xd6\x25\x98\xba\x4c\x31\x98\x45\x56\xd5\x90\x59\x87\xb4\x78\xba\xa4\xa6\x65\x54\x2a\x73\x83\xa5\x75\x8f\x73\x08\x6d\x20\x8f\xc9\x14\x65\x20\xde\xe6\x50\xc6\xe7\x06\x6e\xd4\xfb\x57\x27\xd5\x58\xff\x49\x6c\xe8\x24\xa1\xb7\x0d\x5c\x3f\x6f\x18\xfb\x9c\xdc\x71\xc2\x71\xa6\xec\xa0\xb2\x56\x9f\x24\xf7\xce\x5a\x3d\x1a\xd2\x76\xf5\x1c\xa3\xe2\x72\xb7\x2d\x01\x06\xb0\x42\xa8\xdc\x16\x4f\xe4\x80\xbc\x75\x62\x73\xba\x34\x58\x37\x70\x7d\xa9\xb2\x44\x13\xf8\x54\x20\xe4\xca\x91\x07\x34\xbe\xb1\x91\x9a\x46\x9c\x3f\xb7\x5c\x69\x22\x38\x6b\x3d\x48\x45\xf7\xc9\xd3\x34\x72\x3a\x9b\xbf\x38\x1b\x32\x55\xfd\x44\xf8\x2a\xe9\x4e\x7d\x9d\x9d\xed\xd4\x57\x3c\x76\x36\x52\x5f\x77\x16\xd2\x12\xf9\xbf\x43\xbe\x36\xcf\xe3\xe6\xf8\x5d\x84\x19\x71\xbd\x01\xba\x77\xde\x17\x08\xdc\x19\x49\xac\x20\x89\xc6\x53\xc2\x84\xd2\x67\x0b\x78\xda\x25\xe7\xe4\x04\x96\xf1\x6b\xa7\x04\x3f\x27\x72\x0d\x87\x2d\x76\x30\x56\x73\x3b\x01\x69\xbb\xde\x6b\x43\x5e\x98\x0c\x3f\x3d\x56\x23\xe4\x8a\xcd\xe2\x49\x32\x3e\x21\xdd\x29\xfe\xbe\x5a\x73\x3a\x86\xfc\xc3\x9a\xf1\xea\x4d\x6c\x85\xd2\x22\x55\x5a\xf9\xc7\x80\xee\x2f\x08\x77\xa3\x0a\x54\x1d\x59\xae\x16\x13\x2c\x74\x85\x0e\x0e\x73\x74\x68\xda\x26\x85\xb1\x73\xf4\x6e\xb5\xe7\x2d\x37\x1e\x5b\x45\x43\x35\xb4\x32\x0d\xdb\xa9\x20\x94\x60\x0d\x64\x55\xbd\x84\x0d\xff\x4f\x4c\xa3\x6c\x32\x8b\x99\xac\x19\xf4\x0f\xd6\xdd\x4f\x92\x7e\xdb\xc0\xf0\x3c\x25\x57\x9b\xda\x21\x1d\x16\x6d\x74\xa0\x8e\x96\x89\x14\x73\xeb\xfa\xf2\x57\x9e\x15\x29\xb1\xd2\xf6\xb1\x17\xe6\xa7\x02\x74\x6a\x8d\x1c\x34\xbf\x03\x5a\xdf\x33\x14\x1b\x40\x18\xb3\x61\x50\x35\x54\xc5\x23\xa9\x4c\xe8\x0e\xc9\x4f\xb3\xef\xd3\x89\xa3\xb4\x72\x6e\x74\xbe\xb1\x72\x67\xcc\xcc\x14\xcf\x61\xf8\xf5\xb6\x51\xce\xbc\xda\xe2\x59\x2a\xb2\xfb\xba\x1a\xc5\x08\x5c\xfc\xff\xf8\xf6\xdb\xe4\x3b\x21\x13\xb8\xc2\x5c\x70\x7e\x69\x3d\x3c\x28\x42\x36\x8b\x4b\x48\x85\x66\xcb\x3b\x73\x6e\x28\x5f\x9e\x30\x90\xfd\x87\x6b\x8c\x99\x2c\xde\x76\x7c\x94\x59\x8c\xac\xf1\x7f\xbe\x7d\x09\x09\x95\x70\x38\x30\x33\x19\xa1\xe2\xae\x81\x06\xe1\x70\x17\x39\x76\xb6\x3a\xcf\x26\xda\x3f\x6f\x03\xf1\xa3\x10\x13\xb6\x33\x9b\xb9\xe9\x38\x39\x27\xff\xb0\x86\x46\x1e\x45\xc9\xbd\x76\x76\xda\x0b\x6f\xb5\x38\xa1\x8d\xee\x60\xc6\x82\x56\xe6\x9e\xff\xbf\xe9\x59\x69\xf1\x24\x91\x9e\x76\x47\x55\x5d\x48\xe9\x90\xe6\x9a\xca\xf5\x5d\x84\x6f\x1d\x53\xc4\xaf\xd1\x5a\x76\x7c\x82\x9a\x9a\x6a\xf0\x59\x80\xca\x82\x37\x2f\x5e\x60\x09\xcf\x08\x26\x85\x7d\x38\x22\x74\x83\x9e\x80\xa7\xac\x91\x95\x25\x5c\xd4\x13\x7d\x33\x40\xfa\xd8\x86\x8c\x51\x20\x34\x75\x39\x4e\xd8\xd9\xf4\x0e\x67\x51\x3c\x13\x00\x57\xbf\x5c\xde\x4d\x3c\xfe\xa0\xcc\xfd\xe7\xea\x25\x92\x7d\x66\x0c\xeb\x07\x0b\x0e\xbf\x93\x11\x61\x0e\x31\x56\xe2\xf5\xdd\x5c\x72\x02\x30\x94\xe2\x1e\x07\x2c\x54\x51\x87\xb4\xe9\x01\xd0\x7d\x9d\xa2\x33\xe8\x91\x02\x01\xa0\xaa\x65\xc0\x47\x54\x40\x61\xc9\xf3\x89\xc7\x92\x3b\x6e\x28\x05\x9f\xae\x51\xf3\x58\x54\x6a\x02\x69\x3b\xf1\x4f\xe0\xc2\x43\xc9\x58\xb8\x0e\xdb\x93\xd4\xcc\xa5\x03\xa2\xb8\xe9\xa9\x54\x94\x5a\xab\x51\x98\xbf\x77\x72\xd6\xc4\xa5\x97\xe8\x94\xa7\x5a\x33\xc9\xfa\xf2\xe1\xe2\x76\x09\x2a\x07\x42\xbf\x0c\x1b\x77\x07\x63\x3b\xd1\x3d\x28\x5f\xf4\x4f\x65\x0e\xff\xda\x59\x5a\xa8\xf1\x2b\x36\x89\x90\x81\xb9\x38\x45\x21\xf7\xdf\x3d\xa1\xce\x9b\x9e\x9b\x5f\x99\xc0\xb8\xdf\x5f\x51\xac\xa5\x24\xd3\x6a\x10\x25\xca\xe4\x2f\xef\x1b\x5f\x90\xea\x1a\xdb\x78\xdd\x4c\x37\xfa\xa2\xa5\x35\xba\x81\x44\x74\xa0\xe9\xdf\xd6\x0d\x10\xd8\x2d\x3a\xa7\x64\xf4\x60\x4b\x40\x71\x3d\x8f\x47\x65\xe1\x88\x37\x38\xdf\xa0\xc6\x63\x6d\xbd\x98\x69\x96\xa1\x89\x90\xe8\xae\xaf\x26\xc9\xfb\x14\x0e\xef\x14\x6a\x09\x0f\x4a\x6b\x3e\x82\x23\xf4\x9c\x0f\x98\x28\x91\xf9\x5a\x78\xeb\x88\x03\x03\xf7\x00\x54\x97\x28\x21\xed\x17\x03\x85\xda\xf0\x01\x95\xe6\x23\x37\x9e\xe6\x28\xce\xd0\xa0\xd5\x3d\x82\xa8\xbd\xa5\x4c\xe8\x70\x54\x28\xfc\x6e\x9f\xd6\xcc\xb8\x06\x66\x43\xef\xe1\x8c\x22\x39\x13\x95\x02\x41\x10\xcf\x8f\x76\x9c\x25\x73\x45\xe1\xac\xee\x2b\x69\xa4\xc4\x18\x45\x32\x6e\x21\x44\xc5\x2f\x96\xfc\xa4\x98\xd7\x0d\xcc\x61\x1c\xca\x41\xc0\xa5\xc9\x7c\xfb\xb0\xdb\xc4\x2b\x82\xd4\x5a\xcf\x27\x80\x55\x35\x30\x12\xb3\x26\x18\x54\x88\xdc\x1d\x57\x6f\xc7\x4e\x66\x03\xe2\xa0\x25\x4b\xba\xe7\x89\xcb\xb6\xad\xeb\xa1\x65\x35\xb7\x43\x3b\x69\xc3\xf0\x53\x54\x95\x7e\x0c\xe5\x5a\x4c\x12\x34\x5b\xf0\x23\x63\xc2\x43\xc9\xc4\xdd\x8e\x1a\x4c\x9e\x02\xfd\x3d\xbd\xa5\x44\xa9\x78\x5a\x20\xaf\x78\xcb\xfe\xf3\x23\x7a\xaf\x0e\xc0\x43\x7f\xd1\xd0\xda\x44\x3f\x8f\x32\xf8\x4a\x18\x6f\x37\x3d\xba\x2f\x50\x0d\x07\x3a\xfb\x60\x62\x77\xe4\x2d\xdc\x23\x56\x80\x3e\x63\x3d\x76\x46\x75\x7c\xa2\x2d\x94\x41\x07\xaa\x14\x1b\x6c\x76\x7c\x70\xca\x7b\x1c\x8e\xe1\xde\xbe\x76\x41\x1d\x78\xb9\xe3\x14\x3f\x06\x71\x24\xa3\x9b\xdd\x0b\x6c\xf0\xe7\x5b\xe1\xce\xb5\x4a\xcf\x77\xac\x48\xae\xa0\x76\xcb\xcc\xf3\xb3\xab\xce\x01\xe4\x73\xa0\x27\xf7\x9c\x88\x01\x2f\xac\x25\x59\xa3\x51\xe3\x24\xd3\x04\xae\xf3\xe0\x62\x1c\x71\x59\xe1\x54\x0a\xad\x8f\x2f\xa5\x1c\xfe\xe5\x0e\xa3\x61\x70\xc8\xe4\xc3\xfd\x80\x38\x0c\x01\xe3\xf8\x3c\x59\x0c\xbe\x39\x8f\xb1\xb1\x91\xe3\xd4\xe0\xf1\xbf\x74\x62\xbb\x37\xeb\xd7\xad\x17\x82\x4b\xe5\x4a\x0f\x1a\xc8\x81\x04\xef\x1a\xb8\x36\x2b\x68\xf1\x68\x6b\xdf\xda\x4a\x38\xe8\xe0\xc7\xf4\x48\x1e\xcb\x25\xfc\xac\x85\x5f\x3c\xb9\x79\x1b\x73\xa0\xb3\x31\x7c\x67\xf0\xe1\xcb\xcd\xe0\xfa\xfb\x8c\x2f\x1d\x0c\x3e\xfa\x78\x71\x7d\xf5\x6e\xf1\x24\x45\x8e\xca\xd7\x9f\xbe\x1b\xc3\x45\x6c\x5e\x6b\xbd\xe4\xe2\xa2\xb0\x4e\x71\x73\xb9\x45\xd0\x8a\xbb\x8f\x3c\xa2\xe0\xa4\xb5\xcb\x5e\x43\x8d\x51\x66\x9d\x43\xaa\xe2\x1c\xee\xd6\x4a\x4c\x16\xb3\x2c\x75\xc2\x36\x86\xed\x62\xf0\x85\xe6\x3a\xe1\x6a\x71\x3a\x16\xa7\xda\x66\xf7\x57\xb8\x55\x19\x4e\x0b\xe6\x7d\x07\x70\x37\xfb\x0a\x6f\x83\x8c\xab\x03\x39\xf5\x08\x23\x70\xb5\xa5\x3c\x3c\x88\xd1\x84\x3a\x22\x97\xe9\x5c\xc2\xa3\x8d\x91\x03\xb6\x03\x26\x78\x48\xaa\x59\x8d\x62\x3f\xbd\xe5\x18\xb3\x78\x46\xa8\xd8\x3b\x39\xcd\xda\x79\x07\xbd\x0b\xd9\x41\x6c\x21\xa7\xf3\x40\xa5\x12\xce\x87\x2b\xa5\xc3\x2d\xd9\x88\x5c\x66\x91\x3a\x66\x3b\xa7\x73\xce\x78\xbe\x09\xc4\xef\x32\x8e\xe0\xbc\x5b\xca\xb7\xcf\x11\x24\xa9\xaf\x73\x76\x5f\x77\x8f\x1a\xc3\xee\x13\x87\x8d\xa7\x1b\xc6\x53\xcd\xe2\x78\xba\xfa\x2b\x35\xc1\x87\xd4\x3f\x5b\x37\x43\x1c\x9f\x1b\xc8\xb8\x98\x22\xc1\x43\x21\x7c\xe3\x7c\x35\xb5\xb5\x6e\x23\xa7\xdc\xba\xc5\x93\x89\x1d\x4f\x72\x23\x7d\xf2\xc8\x49\xe5\x93\x23\x1a\xc4\xd0\xf0\x11\xfd\xc9\x1e\xf8\xaa\x0b\x09\x19\xbb\x24\xf5\x82\x10\x5f\x09\xd5\x18\x0e\xb6\x44\xce\x9d\xb0\x38\x42\xc9\xf7\xed\x94\x46\x19\x37\x2e\x8f\x6f\x96\x4e\xdb\xd3\xb8\x25\x35\xe8\xd6\x5e\x38\x8f\x72\x06\x1f\x11\x92\xb3\xf6\x43\x81\xf1\x44\x8e\xe2\x62\x83\xac\xbd\x5e\x3b\x1c\x5f\x5b\x32\xf9\xa6\xeb\x99\x57\x3d\x25\x8d\x2a\x1c\x9d\xb3\xee\x06\x89\x06\x9a\xaa\xe9\x97\x3e\xa2\x20\x6b\x26\x99\xbb\x0e\x9d\x0a\x20\x5f\x94\x6d\x1a\x75\xbe\x72\x1a\x3a\x51\x01\x1e\x5d\xa9\xf8\x66\x74\xe5\x6c\xaa\xb1\x0c\xf7\xaa\x4d\xa6\xf4\xf1\x6e\xfc\xe9\x70\xbe\x84\xd4\xfa\x02\x7e\xda\xd3\x10\x22\xe9\x4f\x1d\x46\xba\x73\x87\xa4\x0b\xd9\xc3\xdb\x02\x56\xb6\xaa\xf9\xf6\x36\x8f\x2c\xb8\x06\x06\xaa\xb3\x4c\x99\xcc\xc7\x5b\xce\x54\x2b\x2f\x52\x8d\x71\x90\x19\x1b\x6a\x9e\x36\x54\x0e\xb9\x52\xb0\x66\xd9\x47\x5e\x70\x25\xd6\x27\x2c\xf6\x15\xdc\x4e\xf3\x4d\xef\x2d\xba\xd4\x12\x46\x41\x1f\x6c\xd5\x43\xa9\xed\x66\xc3\x40\xcc\x71\x51\x97\xc2\xc4\x09\x4a\x10\x78\x32\x57\x7f\xfb\x99\xed\xc0\xe5\xfa\x9e\x16\x7f\xdd\x4f\x78\xe3\x9d\xfa\x36\x1a\xf3\xec\x75\xd9\x5e\x8f\x4f\x11\xf0\xcf\x5a\x68\x2e\x91\x0e\xaa\xa0\xb1\x21\x4c\x8b\x6d\x2e\xd5\x5a\x90\xff\xdc\xdc\xe5\x9e\xa4\xf7\xff\xd9\x83\xb8\xd0\xe0\x99\x57\xfc\x81\x45\x78\x19\x6c\x4a\x7c\xa5\x5f\xbe\x96\xf3\xb0\x9b\x7e\xc4\xcd\x29\x01\xde\xec\xc0\xda\xb2\x9c\x47\x34\x9d\xd5\x8e\x75\x87\x0a\xa9\xbd\x6d\x70\x4c\x28\x1f\x90\x2d\x01\xcb\xca\x3f\xc6\xa1\x1e\xb6\x65\x3a\xb8\xb0\xc1\x5c\xca\xc3\xaf\x52\x4e\x10\xdd\xfb\x81\xca\x1c\xbc\x71\x90\xb6\xee\xd5\xa1\xbd\x0d\xee\x0e\x40\xa1\x54\xec\x27\x9d\x18\x1e\x15\x67\xf3\xae\x78\xfa\x4e\x16\xea\x90\xab\x36\x44\x3e\x8f\xce\xe8\x9d\x4f\x20\xb7\xf5\xe7\x31\xaa\xcb\xf8\xdc\xe6\x53\xa1\x6c\x2e\xb5\x44\xc5\x65\x98\x65\x4d\x52\xb8\x6e\xa1\x42\xcb\xce\x6e\xc8\x11\xd5\xc9\x30\xd0\x6b\x67\x61\x21\x54\xb1\xff\xb6\x65\xf0\xcc\xa1\xf0\x54\x19\x5e\x0c\x8c\x2a\x7b\xd4\x1d\xcc\x2a\x9b\x33\x9b\xa1\xbe\x01\xac\xeb\x1e\x47\x0d\x60\x85\xf6\xb5\xf6\x84\x69\x00\x66\x54\x92\xfc\xaf\xb2\x6e\x90\xdc\x36\x0c\x28\xe3\xbf\xfb\x76\xf1\xd4\xc2\xb1\x26\x74\x63\xe5\xf4\x04\x39\xa3\x45\x52\xd3\xa2\x5f\x4f\x87\xba\x75\x04\x3a\xbe\x01\x14\x24\xda\x60\x00\xd5\x3b\x5e\x19\x25\x67\xe8\xf2\xd4\xe8\xc5\xa9\xb0\x07\xbf\xb1\x57\xe3\x13\x8c\x7a\xa8\xc8\x3c\xeb\x27\xa6\xc5\xa8\xa4\x62\xf2\x58\xc1\xf6\x9d\xd0\x55\x21\xde\x2d\xf6\xbd\xaf\xc8\x32\xac\x3c\xca\xdb\xe3\x1f\xb1\xbd\x79\x73\xf0\xdb\xb5\xf0\x35\xe3\x5e\x9d\xc5\x49\x2b\xf8\xfd\x0f\xfe\x09\x9b\xb7\x0e\x65\x24\x80\x56\xf0\xfb\x1f\x8b\x7f\x0f\x00\xc3\xab\xb7\x43\xc8\x38\x00\x00"),
 		},
 		"/cluster_v1alpha1_cnctmachineset.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cluster_v1alpha1_cnctmachineset.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 5980,
+			uncompressedSize: 16927,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5c\x5f\x73\xdb\x36\x12\x7f\xd7\xa7\xd8\x49\x1f\x72\x37\x63\x51\x4d\xdb\xe9\x74\xf4\xe6\xd8\x69\xeb\xbb\xd8\xf1\xc4\x6e\x6e\xa6\x9d\x3e\x80\xc0\x52\xc4\x19\x04\x58\x00\x94\xa3\x7c\xfa\x9b\x05\x41\x4a\x94\xc4\x3f\x8a\xdd\xb4\x73\x91\xa7\xae\x48\x60\xb1\x7f\x7e\xd8\x5d\x2c\x00\xb3\x52\x7e\x40\xeb\xa4\xd1\x4b\x60\xa5\xc4\x8f\x1e\x35\x7d\x73\xc9\xc3\x0f\x2e\x91\x66\xb1\x7e\x95\xa2\x67\xaf\x66\x0f\x52\x8b\x25\x5c\x54\xce\x9b\xe2\x3d\x3a\x53\x59\x8e\x97\x98\x49\x2d\xbd\x34\x7a\x56\xa0\x67\x82\x79\xb6\x9c\x01\x70\x8b\x8c\x1e\xde\xcb\x02\x9d\x67\x45\xb9\x04\x5d\x29\x35\x03\x50\x2c\x45\xe5\xa8\x0d\x00\x37\xda\x5b\xa3\x14\xda\xb9\x37\x46\x35\x03\x2e\xe1\xc5\xab\xe4\xeb\x17\x33\x00\xcd\x0a\x5c\x02\xd7\xdc\x17\x8c\xe7\x52\xa3\x43\xef\x12\xae\x2a\xe7\xd1\x26\xf4\x3c\x71\xc2\x25\x8e\x15\xae\xd2\xab\x84\x9b\x62\xe6\x4a\xe4\x44\x9d\x09\x11\xd8\x62\xea\xd6\x4a\xed\xd1\x5e\x18\x55\x15\x3a\x8c\x3c\x87\x7f\xdd\xbd\xbb\xb9\x65\x3e\x5f\x42\xe2\x3c\xf3\x95\x4b\xca\x9c\x39\x0c\x5c\x09\x74\xdc\xca\x92\x3a\x2f\x21\x8e\x0b\x0e\x3d\xd4\x2d\x43\x9b\x9a\xb1\xbb\xed\x03\xbf\x29\x71\x09\xce\x5b\xa9\x57\xfb\x23\x34\x8a\x49\x0e\xb4\xb2\x43\xeb\x7c\x85\x3b\x84\x04\xf3\xf4\x75\x65\x4d\x55\x2e\x61\x50\xe0\x5a\x4b\x51\xa3\xd1\x44\x9a\xfb\xeb\x9a\xf1\x3b\xf4\xe1\x45\xa9\x2a\xcb\xd4\x81\x2e\x67\x00\x8e\x1b\x1a\xf1\x86\x15\xe8\x4a\xc6\x51\xcc\x00\xd6\x4c\x49\x11\x0c\x58\x93\x35\x25\xea\xf3\xdb\xab\x0f\xdf\xde\xf1\x1c\x8b\x60\x61\x7a\x5c\x5a\x53\xa2\xf5\xb2\x19\x9d\x3e\x3b\x68\x6a\x9f\xed\xe9\xf4\x25\x91\xaa\xdb\x80\x20\xfc\xa0\x03\x9f\x23\xac\xeb\x67\x28\xc0\x85\x61\xc0\x64\xe0\x73\xe9\xc0\x62\x69\xd1\xa1\xf6\x81\xa5\x1d\xb2\x40\x4d\x98\x06\x93\xfe\x17\xb9\x4f\xe0\x0e\x2d\x11\x01\x97\x9b\x4a\x09\xc2\xd7\x1a\xad\x07\x8b\xdc\xac\xb4\xfc\xd4\x52\x76\xe0\x4d\x18\x52\x31\x8f\xce\x77\x28\x06\xb0\x68\xa6\x48\x09\x15\x9e\x01\xd3\x02\x0a\xb6\x01\x8b\x34\x06\x54\x7a\x87\x5a\x68\xe2\x12\xb8\x36\x16\x41\xea\xcc\x2c\x21\xf7\xbe\x74\xcb\xc5\x62\x25\x7d\x33\x7f\xb8\x29\x8a\x4a\x4b\xbf\x59\x04\xc0\xcb\xb4\xf2\xc6\xba\x85\xc0\x35\xaa\x05\x2b\xe5\x3c\xf0\xa9\x49\x36\x97\x14\xe2\x2b\x1b\xe7\x96\x7b\xb9\xc3\xd8\x1e\xc0\xc2\xb3\xda\xdc\xbd\x6a\xfe\xb7\xd4\x02\xa4\x03\x16\xbb\xd5\x12\x6d\xb5\x49\x8f\x48\x09\xef\xdf\xdc\xdd\x43\x33\x68\xd0\xf8\x0e\x49\x88\xca\xdd\x76\x73\x5b\x3d\x93\x5e\xa4\xce\xd0\x86\x5e\x90\x59\x53\x04\xb5\xa2\x16\xa5\x91\xda\x87\x2f\x5c\x49\xd4\x5d\x1d\xbb\x2a\x2d\xa4\x27\xc3\xfe\x51\xa1\xf3\x64\x8e\x04\x2e\x98\xd6\xc6\x43\x8a\x50\x95\x84\x7f\x91\xc0\x95\x86\x0b\x56\xa0\xba\x60\x0e\x9f\x5b\xcb\xa4\x50\x37\x27\x0d\x8e\xeb\x79\xd7\xb5\x35\xff\xa8\xff\x32\x2a\xa7\x7d\xdc\x78\x1f\x80\xfe\x19\x42\x9f\x38\x05\xef\xb1\x28\x09\x82\xdd\x97\x7b\x76\xbc\xee\xb6\xed\x4c\x19\x81\x4e\x5a\x82\xb5\xa7\x37\x26\x03\x64\x3c\x07\xa9\x9d\x67\x9a\xe3\x1e\xd5\x30\x5b\x22\xb5\xbd\x57\x7d\x7c\xf6\x09\x3f\xa8\x84\x3e\x65\x4c\x19\x2c\x86\x05\xe7\x2d\x93\xda\xf7\x34\xd8\x53\xd0\xc5\xb6\x3d\x64\x95\xf5\x39\x5a\x82\xb3\xb7\x92\x7b\x78\xcc\x25\xcf\xa1\x60\xcc\x35\x4a\x77\x3d\x34\x01\x38\xd3\x04\x3f\xa6\x94\xe1\x04\xc0\x9e\x86\x63\xfc\xd3\x87\x59\x9e\x4b\x8f\xdc\x57\xf6\xc0\xba\xbd\x82\x9c\xef\x74\x22\x5b\xd1\xec\x89\x4c\x9f\x01\x26\xab\x04\x58\x21\xbe\xff\x6e\xb1\x42\x8d\x56\xf2\x01\xb2\x47\x51\xbc\xff\x09\x9e\x2e\x63\x1c\xdd\x64\x0e\xaf\xda\x2e\xbb\xcc\x41\x51\x39\x0f\x39\x5b\xe3\xac\x87\x08\x00\x48\x8f\xc5\xe0\x40\xd3\x14\x5b\x7f\x32\x96\x5a\x79\x14\x5c\x03\xcc\xff\x18\x3a\x91\x47\x24\xde\x29\x6a\x36\x4a\xae\xc9\x91\x48\xa3\x14\x77\xd4\x46\x94\x98\xf7\x8c\xe7\x28\xc0\x9b\xd1\xae\x93\x8c\x52\xff\x84\x2c\xe9\x44\xf1\xde\x52\x1f\x90\x02\xb5\x97\x99\x8c\x16\xda\x61\x56\x4f\x94\x2f\xc2\x5f\x1a\x4d\xb3\xa8\x52\xde\xcd\x46\x7a\x9c\x22\x59\xc8\x31\x4e\x94\xec\xae\x8c\xda\xde\xb7\x5b\x98\xd6\x81\xe2\xdf\xc8\x76\xae\x4a\x35\xfa\x53\x45\x0c\x9d\xba\x32\x5a\xe0\x52\xd8\x46\xd6\x9a\xec\x28\x55\xd8\x37\xfb\x9f\x26\xe7\x5a\x76\x72\x8f\x09\x42\x7e\xb8\xba\x6c\x24\x5c\x2b\xa6\x41\x8a\x7e\x66\x47\x29\xc3\x14\x71\x32\x63\x0b\xe6\x97\x34\xc4\xf7\xdf\x8d\xb6\xae\x85\xa7\x29\xb3\x42\x3b\xd8\x9a\x12\x17\x0a\xbc\xc3\x0a\x98\xd7\xab\x9d\xc1\x36\x83\x11\x74\xfb\xa9\x9b\x31\x6b\xd9\xa6\xb7\x55\x21\xf5\xc5\xed\x2f\x17\xa6\xd2\x83\xe8\xeb\x98\xe4\x7a\xdb\xa7\x31\x4d\x21\xb5\x2c\xaa\x02\x74\x55\xa4\x18\xe0\xc7\xcb\x0a\xb8\xb1\xe8\x66\x4f\xd7\xf4\x34\x1d\x17\x52\x5f\x63\x61\xec\xe6\x14\x41\xea\x1e\xfb\x62\xb0\x22\x08\x67\x32\x28\xe2\x7b\x3d\x40\x13\xe0\x5a\xbe\xfe\x62\x62\x6a\xe3\xef\xd9\xca\x4d\x16\xf2\xa6\x6e\x7f\x18\x7b\xb5\x79\x8e\xf8\x3b\x71\xf2\x4f\xc1\x62\x69\x8c\x9a\x2c\xd6\xad\x31\xaa\xd7\xbd\xb7\x0b\x13\x22\x39\x40\x91\xfc\x41\x9b\xba\x85\xa5\xc8\xec\x89\x92\x3a\x6f\x2c\x5b\x4d\x4f\xdf\xee\xea\xf6\x87\xd6\x21\xcb\x24\x70\x9f\x23\x64\xd2\x3a\x0f\xa8\xbd\xed\x57\x1d\x7d\xa4\x83\xca\xa1\x20\xb8\x05\x72\xd6\x18\x0f\x42\xba\x87\xe4\x69\x16\x9e\x9e\x61\x3d\x5b\x06\x42\x5c\xc7\xe4\xa3\x31\xcf\xfe\x22\xfe\xf8\xbf\x3f\x23\xf9\x90\x9f\x4e\xce\x3d\xe4\x27\xdc\x77\x29\x4e\x7e\x6a\x31\x4a\xe2\x8d\x52\xa4\xa4\x11\x7e\x1a\xf2\x2b\xa7\x79\x97\x53\x7c\x4c\x6c\x3b\xe2\x66\x8e\x48\xde\x7a\x1a\x12\x71\x0b\xe4\xb8\x12\x71\x6e\x3c\x44\x4f\x00\xe4\xc9\x26\x9c\xe6\x7e\x4e\x89\xd1\x64\xcd\x2f\x15\xa2\xfd\x29\xde\xfe\xb8\xab\x27\x1b\x10\x9c\x9a\x02\x67\x2c\x65\x0d\x10\x05\xb8\x8a\x05\x81\xfb\x4d\x89\xe0\xd9\x6a\xf6\x24\x9b\x4d\xb4\xd6\x14\x7d\x7c\x32\x7a\xba\x7f\xfd\xd5\xe8\xfe\x55\x00\x5b\x33\xa9\x58\x2a\x95\xf4\x9b\x40\xf6\x0b\x86\x8a\x51\x80\x34\x05\x19\xd2\xff\x72\x36\x41\xd4\x8e\xc1\x2c\x66\x68\x51\x37\x8b\x6e\x1a\x8d\x22\x64\x83\x8a\x81\x34\xb8\xb4\x66\x2d\xa9\xa6\x4a\x80\x09\xd1\x34\x65\x14\x56\x8c\x06\x5e\x56\x67\xb0\xa2\xff\xc4\xb4\x88\xa0\x39\xfb\x4c\x15\x68\xf4\x8f\xc6\x3e\x4c\x12\xed\xa6\x6e\x4b\xc5\xd9\x4c\xae\x2a\x8b\xae\xbb\x08\x70\x1d\xb3\x46\x21\x7b\x08\x03\xa4\x98\x85\xda\xab\x27\x60\x08\x2c\x95\xd9\x3c\xa9\x6e\x93\x1a\x2d\x06\xe1\xdf\x91\xe5\x35\xb5\x26\x30\x85\xca\x7e\x0d\x25\x28\xf3\x8d\x93\x9c\xa9\x1d\x91\x9e\x36\xdf\xa6\x07\xed\xc2\x88\xc1\xe9\x74\x44\x84\x6b\x23\xda\x49\x45\xc2\x53\x51\x98\xc8\x34\x05\x27\xee\xe5\x1a\xe7\x29\xe3\x0f\x55\x39\x4a\x19\x68\xd1\xfa\xc3\xd7\xdf\x24\xdf\x32\x91\xc0\x25\x66\x8c\xca\x07\xd1\x43\xd5\xf3\x54\xd4\x0f\xcf\x20\x65\x8a\x10\x3e\xb7\x76\x28\xa7\x99\x08\xc0\xed\x87\xf2\xc7\x13\x55\x70\xb3\xe3\x4b\x48\x05\x51\x74\xfa\xdf\xaf\x9f\x93\xb5\x92\x59\x1c\xa8\x69\xf6\x70\x77\x5b\xf7\x02\x66\xb1\xf5\x7c\xed\x1c\x69\xb0\x76\x4a\xe9\x83\xaa\xed\x41\xb8\xd1\x4e\x13\xb0\x79\xb2\x12\xa6\xc5\x85\x53\xe2\x37\x59\x7c\xa4\x49\xd4\xfc\x97\x8a\xf2\x5b\x55\x2f\x67\x13\xad\xbc\x5b\x58\x35\xa0\xa4\x7e\xa0\xdf\x75\xcd\xc7\xcd\x9e\x64\xa2\xe9\xee\x43\x96\xe7\x42\x58\x74\xa7\x42\xf4\xea\x36\xf6\x6b\x1c\x09\x8b\x5f\x23\x4a\x5b\x7d\x8c\x92\x25\xdd\x85\x6d\x55\xc9\x83\x17\x9a\x3d\x23\xf2\x9e\xe0\x1c\x73\xf3\xd8\x15\x04\x56\xe8\x1d\xd0\x16\x56\x14\xf5\x6c\x94\x30\xc0\x79\x45\x13\x6f\xd3\xb8\xc0\xd1\x1e\xa8\xab\x62\x9c\xe1\x79\xa0\x3b\xa1\x59\xad\xd6\x09\x0d\x2f\x7f\xbe\xb8\x9d\xd0\xec\xad\xd4\x0f\xbf\x94\xcf\x69\xa1\x27\xfa\xee\xc3\xc0\x4b\xe1\x68\x92\xa7\x3b\x85\x49\x23\xf0\xea\xf6\x54\x36\x43\x27\x28\xd8\x03\x1e\x99\x21\xd2\x6d\x59\x1e\xa5\x5b\x17\x78\x1f\xaa\x14\xad\x46\x8f\x2e\x30\x04\xb2\x3c\x0b\xcf\x9d\xcb\x21\x37\xce\xd3\xb6\xf5\x59\x28\x1f\x14\x8c\x0e\x4b\x4c\x02\x28\x11\x60\xa5\x6c\xb7\x6f\x13\x38\xf7\x50\x10\xb5\x90\x79\xb7\x5a\x8d\xfb\x64\xd3\x4a\xee\x91\xbd\xa9\x41\x3e\x35\x46\x21\xd3\x7f\xf7\x1a\x7b\xed\xa3\x9f\x13\x57\x54\x11\x3f\x51\xa0\x0f\x6f\xcf\x6f\xce\x40\x66\x74\x3a\xe5\x2c\x30\xb4\x5b\x5c\x3f\x01\x51\x8f\xd2\xe7\xf5\x16\x7e\x53\x97\x0f\xeb\xca\x92\xe0\x19\xb2\x23\x5a\xc0\x20\x13\xcd\xf7\x09\x24\xa5\x77\xa8\xb2\xba\xea\xd5\xe5\x87\x82\x44\x9d\x2f\x0b\xe2\x5d\x23\x0a\x14\xc9\x5f\x56\x1b\x79\xc6\x34\x63\x02\x7c\x9e\x2d\xcb\x18\x25\x64\xdc\x1d\xda\x81\xa0\xdf\x41\xd2\xbb\xbb\xba\x31\x98\x35\x5a\x2b\x45\xf4\x52\xc6\x81\x8b\xcf\xb3\x78\x96\x23\x9c\x44\xea\x21\x09\xb1\x5e\x29\xc7\xd6\x6d\xa3\xd3\x22\x2c\x5c\x05\xda\xab\xcb\x49\xec\xdf\x13\x74\x33\x89\x4a\xc0\xa3\x54\x8a\x36\xf1\xe9\xc0\x56\xba\x09\x4c\x33\xee\x2b\xe6\x8d\x75\xe4\x14\x69\xdd\xe9\xaa\x62\x60\x83\x29\xdd\x40\x2e\x57\x74\x88\x40\xd1\xd9\x11\xaa\xd6\x4a\xca\x9e\x40\xc9\x07\x04\x56\x79\xe3\x38\x53\xe1\xcc\x0b\xf3\xed\x78\x0d\xbc\x87\x26\x7a\x98\x66\x51\x85\x73\x72\xb4\xcc\x41\xdc\xcb\x6f\x25\x4e\x3e\x57\x65\xd6\xa8\x7e\x63\x8f\xa4\x89\xa3\xc4\xc7\x11\xe9\x5c\xfe\xb3\x71\x7e\x92\xb9\xee\xea\xb6\x5d\x4f\x9c\x01\x83\x0b\xcd\x7d\xf3\x72\xa7\xf0\xd5\x43\x33\x94\xc9\x53\x63\x3c\x9d\xfe\x28\xcb\xba\xae\x41\x00\x0e\x51\x70\xc7\x65\x35\x65\x67\xbd\x02\xd6\x29\x2b\xf4\x7b\x9d\x9d\x33\x25\x67\x6d\xc9\x82\xe0\xd3\x14\xf9\x85\x09\x9b\x2e\xac\x2c\xd5\x26\xa4\xea\x31\xf0\xba\xcf\x36\xe0\xc8\x76\x43\x57\x83\x91\x8b\xbd\x22\x0a\x55\x6a\xff\xda\xfa\x89\x40\x21\xa9\xaa\x26\x2e\x89\x95\xfe\x76\x7b\xf2\x5c\x76\xba\x85\xb5\x6e\x2d\x4b\xed\xf5\x29\x54\x90\xf2\xc3\x56\xde\xc0\xd4\xa5\x1f\x8a\x5c\x39\x4a\x0b\xe6\x51\xc7\x95\xbc\x37\xf0\x80\x58\x02\x7a\x4e\x78\xd8\x16\xee\xc9\x19\x78\x26\xf5\x48\x88\x90\x05\x5b\x61\xcd\xd5\xa3\x95\xde\xa3\x1e\xde\x7b\x7e\xd6\x45\x59\x90\xf9\x96\xd2\xb1\xb1\x96\x7b\x3a\xbd\x6e\x3b\xd2\x3c\x59\xac\x99\x5d\x28\x99\x2e\x5a\x91\x05\x98\x61\xb1\xeb\x4f\xdb\x91\xb4\xf7\x6c\x6b\x96\x23\xec\x9c\xd2\x6b\x12\x2f\x13\xbc\xda\x33\xad\x3c\x08\x4b\x11\x6b\x4e\xa4\x09\x5c\x65\xc1\x39\xb8\x69\xb9\x24\x25\x9e\x05\x53\x0a\x9d\x87\xcc\x62\x84\x66\x08\x16\x4c\xa9\xf0\x3e\x6c\x05\xc4\x4d\xc1\x64\x36\x42\x70\xdc\xd5\x4c\xdf\x90\x18\xda\x96\xf8\x3f\xdf\x17\xda\x4e\xbc\x2f\x93\xd1\x05\x07\x9b\x49\x35\x08\xc4\x8e\x25\x6e\xeb\xf6\x4d\x1c\x55\x6c\x63\x2a\xdf\x60\x32\x6c\x19\xd3\x6b\xb7\x71\x1e\x8b\xe1\xf5\xdf\x8f\x8a\xf9\x69\xa5\x89\xb1\x09\x3e\x0f\xb4\x06\x1b\xbc\xfd\x70\x3d\xf8\xfe\x35\xa7\x23\x75\x83\x4d\xde\x9f\x5f\x5d\xbe\x9a\x3d\x09\x38\xa3\x76\xf3\xd3\x4f\xa0\xd2\x22\x27\xab\x94\x3a\xa3\xf4\x30\x37\x56\x52\xa9\x65\x8d\xa0\x24\xad\x9d\xb3\x48\x8a\xd2\x84\x90\x2f\xf4\x90\x84\xa6\x50\xce\x8d\xb5\xe8\xca\x58\x8d\xbf\x31\x02\x93\xd9\x67\xcd\xa4\x09\xd8\x1c\xc6\xe5\x00\x81\xde\x57\x16\x4b\x25\x39\x3b\x60\xab\xa3\xb1\xf7\xb1\x51\xe7\x34\xf3\xf6\xc4\x11\x11\xef\x39\xaa\x3c\xb4\x0e\xec\x5f\xf5\x39\x54\xc8\xbd\xb1\x83\x4c\xbd\xbc\x8b\xad\x68\x46\xb1\xfa\xf0\x16\xfc\x51\xa1\xdd\x84\x75\x51\x93\x4c\xd1\x6c\x63\xbe\xb9\x64\x50\x30\xcf\xf3\x3d\xaa\x75\x29\x25\x2a\x02\x38\x39\x92\x24\x9e\x8e\x78\xc0\x4d\xbd\x0e\x09\x87\xf1\x23\xa9\xe0\x44\x03\x21\xaa\x7f\x1a\x2b\x8e\x24\x24\x54\x3b\xc4\xed\x95\x19\x41\xd3\x35\x2c\xb5\xa2\x9a\xee\xd0\x27\x70\xd5\xa1\xb5\xbb\x77\xec\xe3\xf1\xf1\x97\x2f\x0f\xcb\xc9\x41\xd0\xe3\xd7\x18\xb6\x75\x26\x3a\x63\x2f\x0c\x77\x14\xb1\x39\x96\xde\x2d\x48\x27\x6b\x89\x8f\x8b\x47\x63\x1f\xa4\x5e\xcd\x29\x64\xcd\x6b\x44\xb8\x45\x4d\x74\xf1\x55\xf8\x3d\x6f\xf4\xef\x5e\x1e\x35\xd9\x11\x18\xd1\x1e\xee\x5d\x69\x91\x89\x41\x9b\xfd\xda\x36\x6b\xa1\x44\xd5\xda\xd6\x54\x94\xb4\xb9\x40\x06\x18\xb7\xc6\x51\x4a\xcc\x0e\x55\x40\xa3\xb9\x10\xb8\xeb\x54\x38\x86\xef\xd0\x18\x78\x6e\x8c\x8b\x18\xa5\x86\x84\x50\x5c\x13\x2c\xe2\x30\xc9\x6c\x7a\x76\x57\x1a\x25\xf9\xd1\x73\x6d\x1d\xb9\x6e\x43\x33\x62\x05\x65\x38\xd1\xfe\xba\xde\x2f\xa3\xac\x0d\x6e\xa5\xd6\x47\xd3\xe0\x3e\xef\x3c\x6f\xbb\x1f\x7d\xd9\x4b\x6f\xd0\x8f\x92\x2a\xdc\xa8\x20\x64\xa0\xce\x31\x86\x3a\x91\x2e\xc3\x90\xe1\xe2\xc7\x3b\xad\x36\x21\xb3\x69\xea\x50\xc7\x7d\x7f\xcd\x64\xd4\x5f\x32\x3b\xc9\x19\x8e\xc4\x83\x7e\x27\xd8\x97\x2c\xcc\x23\x1f\xd3\xd0\x7c\x8c\xca\xbc\xf5\x49\xb3\x91\xfe\xf5\xbd\xb3\xe5\x6c\x1c\x5f\x68\xad\xb1\xd7\xe8\xdc\x91\x75\x64\xaf\x0a\x42\xa7\xf7\xc8\x5c\xf7\xca\xd6\x81\x21\xaf\xc2\xc2\x0a\x90\x2e\xd1\xd4\x6e\x8b\x70\x19\xf6\x47\x18\x78\xb4\x85\xd4\x4c\x51\x0e\x93\x2a\x2c\xc2\x9d\x2b\xcd\xa5\x3a\xa6\xf0\x1d\xe7\xe8\xce\x20\x35\x3e\x87\x37\x5b\x26\x82\x77\x7c\xb3\x23\xc9\x6e\x4d\x27\xd9\x6d\x79\x40\xb8\x69\x58\x9a\xb2\xa2\x7b\x35\x54\x0e\xa2\x2c\x1a\x5c\xc5\xb9\xd4\xdc\xc7\x2b\x50\xae\x92\x9e\xa5\x0a\x63\x81\x3c\xc0\xb2\x2e\x50\x96\x16\x29\x76\x1b\x7d\x98\x2f\x3d\xe6\x94\x6b\x1d\x32\x16\x57\x30\x54\x5a\x20\xff\xb9\x46\x9b\x1a\x87\x51\xd3\x9d\xa1\x0e\x48\x2a\xb3\x5a\x51\x23\x92\x38\xaf\x0a\xa6\x63\x75\x2a\x68\x3c\x01\x2a\x9e\x3a\x3a\x34\x88\x4a\xb4\x97\xda\xe2\x1d\x29\x72\x4e\xc7\x48\x7a\xcb\xb4\x93\x21\xfb\x08\x86\x8d\x11\x86\xed\x5c\xb6\x84\xb8\xcf\x48\x26\xa4\xa9\x88\x1f\x4b\xe4\xa4\xac\x10\x62\x0e\x28\x66\xf2\x23\x2d\xbf\x2b\x6f\x0a\xda\x40\x62\x4a\xc5\x70\xe8\x65\x81\xf0\x8f\x50\x15\x73\x14\x09\x68\xbf\xa5\xf2\xb4\x54\xfe\xe7\x19\xa4\x95\x6f\x4a\x30\x07\x14\xa5\xae\x57\xfb\x31\x8e\x9a\x02\x7d\x4e\x6a\xa0\x22\x5e\xa5\x05\x2b\xe8\xaa\x1f\x0d\xf3\x68\x8d\x5e\xb5\x5e\x61\xff\x26\xd4\x91\x48\x46\x57\x8e\x20\x9e\x99\x6c\x6a\x23\xc1\x9c\x4d\x56\xdc\x18\x7b\xab\x8d\xfa\x86\x5f\xe0\xa4\x60\xba\x3a\xb2\x97\x1e\x80\x11\xaf\x8e\x11\xda\x9b\xd9\x9c\xc0\x9b\x8f\xac\x28\x55\x2c\x8f\x36\x33\x20\xaa\xfd\x31\x58\x2b\x54\x06\xc3\x75\xca\x03\xb2\xdc\x14\xa9\xd4\x81\xbb\x40\xc0\xa1\xa7\x12\x95\x6b\x0e\x4b\x92\x2c\x67\x9d\x34\x81\x8c\x55\x69\x57\x95\xa5\xb1\xc7\xae\x27\xa5\x9b\x5e\x19\x9b\x73\xa4\x21\xad\x74\x32\x55\xc7\x9a\xd1\x86\x26\xaa\xec\x90\x2e\x92\x75\xb8\x95\x8d\xf9\x0b\xe9\xda\xca\x93\x48\x00\xce\xf5\x26\x02\x8f\x7c\x43\x54\x40\x60\xd9\x70\x5e\x59\x10\xd5\x51\xc7\x4b\x06\x69\xfd\x44\x6b\xa6\x68\x65\xd7\x5e\xc7\x12\x82\xf0\xe7\x6a\xcf\xd3\x9e\x26\xd9\xbb\xe0\x7a\xe4\xc6\x22\xd3\x62\x61\x6c\x98\x64\x28\x1a\xad\x6e\xa5\x7d\xe9\x08\xae\x65\xe5\x93\xa9\x9e\x92\x52\xfc\x4d\x48\xe3\x50\x34\x09\xec\xa0\xcb\xbc\xef\x24\xb5\x8d\xcb\xab\x61\x4f\x6b\xe6\x98\x79\xd5\xe9\x5a\x73\x1d\xb3\x7e\xb6\x47\x16\xf6\x01\xdc\xe4\x73\xcd\xf3\xad\x3a\x92\xfe\x84\xf9\xdb\x6f\x26\x27\xcc\x8a\x39\xff\x4b\x7d\x0f\x73\x50\xc4\xff\xe4\xa8\xe1\x31\x08\x25\x5d\x0c\x55\xa1\x33\x98\x94\xbc\x02\x8a\x1e\x76\x88\xf4\x9c\x5c\xc8\x54\xed\x37\xf4\x7e\xa2\x8a\xf9\xce\xe5\xe4\x1e\xc6\xde\x1d\x34\x07\x8b\x19\x65\xa1\xc4\x2b\xd6\x85\xf7\xae\x6f\x30\x7b\x57\x81\xe9\xc7\x22\x47\xed\xd5\xa6\x1d\x7e\x9a\xa6\x4f\x58\x9a\x84\x9b\xe7\x83\xa2\x6c\x47\x8c\x0a\x9e\xaa\x32\x4a\x7b\x37\x9f\x85\x54\x26\x36\x5b\xbc\xb6\xbb\x3a\x3b\xa2\xc3\x79\x03\xc5\x3d\xb2\xa1\x2a\x4f\x61\x4c\x0a\xa4\xab\xa9\x81\x07\x78\x24\x98\x90\xda\xc3\x76\x70\x4e\x27\x11\x11\x75\xbb\x0f\x48\x2e\x58\x3a\x78\xf1\x9e\x1a\xbf\x78\x1e\x04\xdb\x29\x72\x37\xca\x69\x6a\x28\x05\x6d\x3d\x1c\xda\xfc\x70\x12\x3f\x07\x8f\xc7\xd3\xc2\x66\x84\xfe\xb4\x30\x5e\x94\x5f\xc2\xfa\x15\x53\x65\xce\x5e\xcd\xb6\x29\x22\xe3\xb4\x38\x43\x71\xb3\xff\x47\x01\x5e\xbc\xe8\xfc\x21\x80\xf0\x95\x53\x89\x81\xa6\x80\x5b\xc2\x6f\xbf\xd3\x1f\x03\xf0\xc6\xa2\x88\x97\xf3\xdd\x12\x7e\xfb\x7d\xf6\xbf\x01\x00\x0e\xc7\x75\xe6\x1f\x42\x00\x00"),
+		},
+		"/cluster_v1alpha1_cnctredfishhost.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cluster_v1alpha1_cnctredfishhost.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 7454,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xcd\x92\xdb\xb8\x11\xbe\xeb\x29\xba\x9c\xc3\x5e\x66\xa8\x9d\xca\x26\x95\xe2\xcd\x96\x37\xc9\x24\x35\xb6\xca\x1a\x3b\x55\xd9\xda\x03\x04\x34\x45\x64\x40\x80\x41\x83\x9a\xd5\x3c\x7d\xaa\x41\x82\x94\x48\x89\x23\xaf\x1d\x51\x17\x02\x8d\xfe\xf9\xfa\x07\x0d\x50\xd4\xfa\x0b\x7a\xd2\xce\xe6\x20\x6a\x8d\xbf\x05\xb4\xfc\x46\xd9\xd3\x5f\x28\xd3\x6e\xb9\xbf\xdb\x62\x10\x77\x8b\x27\x6d\x55\x0e\xab\x86\x82\xab\x3e\x21\xb9\xc6\x4b\x7c\x8f\x85\xb6\x3a\x68\x67\x17\x15\x06\xa1\x44\x10\xf9\x02\x40\x7a\x14\x3c\xf8\xa8\x2b\xa4\x20\xaa\x3a\x07\xdb\x18\xb3\x00\x30\x62\x8b\x86\x98\x06\x40\x3a\x1b\xbc\x33\x06\xfd\x6d\x70\xce\x24\x81\x39\xbc\xb9\xcb\x7e\x7c\xb3\x00\xb0\xa2\xc2\x1c\xa4\x95\xc1\xa3\x2a\x34\x95\xa5\xa3\x40\x99\x34\x0d\x05\xf4\x19\x4f\x64\xa4\x28\x23\x51\x51\x63\x77\x99\x74\xd5\x82\x6a\x94\xcc\x5e\x28\x15\xf5\x12\x66\xed\xb5\x0d\xe8\x57\xce\x34\x95\x8d\xa2\x6f\xe1\x1f\x9b\x8f\x1f\xd6\x22\x94\x39\x64\xbc\x20\x43\xab\x6a\xa7\x6d\x88\x7a\x29\x24\xe9\x75\xcd\xab\x73\xd8\x56\x12\x1a\xcf\xaa\x27\x7d\x7e\x3e\xa6\x0d\x87\x1a\x73\xa0\xe0\xb5\xdd\x9d\xe5\xec\x71\xc7\xe8\x4c\xf8\xb6\xe3\xe0\x0a\x08\x25\x02\x1b\x76\x24\xe2\xd3\xb0\x68\x56\x40\x10\xa1\xa1\x8c\x82\x08\x38\x95\x20\x8c\x71\x32\xba\x01\x06\x8a\xd6\x84\x4d\xff\x7e\x05\x7b\x6d\xf7\x68\x83\xf3\x87\xac\x76\xcf\xe8\x37\xe7\xa5\xc5\xb9\x89\xa0\x35\x8f\xbe\x2a\x28\x85\x4e\x36\x89\x9b\x23\x56\x6f\x77\xc7\x1a\xab\x56\x89\x9d\x77\x4d\x9d\xc3\x6c\x44\xb4\x46\x77\x31\xd7\x05\xb1\x95\xe1\x53\x1b\x52\x7f\x4f\xc8\xd7\xa6\xf1\xc2\x4c\xc3\x6d\x01\x40\xd2\xb1\xcc\x55\x2b\x65\x01\xb0\x17\x46\xab\x08\x6d\xcb\xd5\xd5\x68\xdf\xae\xef\xbf\xfc\x71\x23\x4b\xac\x62\x0a\xf0\x70\xed\x5d\x8d\x3e\xe8\x24\x9c\x9f\xa3\x74\xeb\xc7\x46\x50\xfe\xc0\xac\x5a\x1a\x50\x9c\x60\x48\x31\x48\xf6\xed\x18\x2a\xa0\x28\xa6\x0d\x1e\x4d\xe0\xb1\xf6\x48\x68\x43\x54\xe9\x88\x2d\x30\x89\xb0\xe0\xb6\xff\x41\x19\x32\xd8\xa0\x67\x26\x40\xa5\x6b\x8c\xe2\x04\xdc\xa3\x0f\xe0\x51\xba\x9d\xd5\x2f\x3d\x67\x82\xe0\xa2\x48\x23\x02\x52\x38\xe1\x18\x93\xc9\x0a\xc3\x20\x34\x78\x03\xc2\x2a\xa8\xc4\x01\x3c\xb2\x0c\x68\xec\x11\xb7\x48\x42\x19\x3c\x38\x8f\xa0\x6d\xe1\x72\x28\x43\xa8\x29\x5f\x2e\x77\x3a\xa4\x02\x23\x5d\x55\x35\x56\x87\xc3\x32\x56\x04\xbd\x6d\x82\xf3\xb4\x54\xb8\x47\xb3\x14\xb5\xbe\x8d\x7a\x5a\xb6\x8d\xb2\x4a\xfd\xc1\x77\xc5\x87\x7e\x38\x52\x6c\x14\x5f\x71\xac\xf5\xf6\x45\x98\xff\xa9\xad\x02\x4d\x20\xba\x65\xad\x45\x03\x9a\x3c\xc4\x20\x7c\xfa\x79\xf3\x08\x49\x68\x44\xfc\x88\x25\x74\xe0\x0e\xcb\x68\xc0\x99\x71\xd1\xb6\x40\x1f\x57\x41\xe1\x5d\x15\x61\x4d\xe5\x26\xbe\x48\xa3\xd1\x86\x13\x96\xd4\x6c\x2b\x1d\xd8\xb1\xff\x6d\x90\x02\xbb\x23\x83\x95\xb0\xd6\x05\xd8\x22\x34\x35\x87\xbf\xca\xe0\xde\xc2\x4a\x54\x68\x56\x82\xf0\x7b\xa3\xcc\x80\xd2\x2d\x23\xf8\x3a\xce\xc7\xb5\x3f\xfd\x78\x7d\xde\x81\xd3\x0f\xa7\xea\x0c\x70\x39\x43\xf8\xd9\x3a\x17\x1e\x30\x94\xee\xc4\x7d\x13\x17\xbe\xeb\xc9\xd8\x8f\x5f\xb4\x0f\x8d\x30\x0f\xa8\xb4\xb8\x89\xd0\x2a\x2c\x44\x63\xc2\x0d\x38\x0f\xeb\xdf\x70\xc4\xea\xac\x29\xfc\x97\x1e\x15\xda\xa0\x85\xa1\x0d\x4a\x8f\x61\x56\x89\xd5\x98\x1a\x3c\x16\xe8\xd1\xca\x2e\x6f\x29\xf2\x80\xd2\x19\x95\x42\xaa\x21\xf4\x5c\x95\x46\x7c\x21\x66\x53\x2d\x88\x9e\x9d\x57\x69\x73\x78\xf7\xb0\x1a\xd1\x5d\x82\xad\xaf\x96\x93\xd1\x91\xce\x1f\x44\x85\x89\x7d\xab\xde\x99\x15\x17\xf1\x49\x62\xa8\x16\xf2\x3a\x59\x91\xf2\x5b\x04\x72\x26\x68\x8f\x93\x70\xb8\x85\x33\x30\xde\x0e\xda\x8d\x66\xce\x06\x25\xff\x53\x46\xe6\x8b\x19\x4b\xd2\xce\xcf\xc1\xc6\x86\x34\xde\x24\x9b\xde\x3d\xac\x6e\x00\xb3\x5d\xd6\xa7\xde\xdd\x8f\x19\x3f\x7f\x5a\x5c\x69\xa3\xb6\x84\xb2\xf1\xb8\x79\xd2\xf5\x17\xf4\xba\x38\xcc\xea\x72\x3f\x21\x07\xa5\x49\x6c\xcd\xb0\x59\xe8\x42\x77\xfb\xff\xa0\xe4\x88\x25\x80\xe4\x38\x8a\x84\x63\x14\x5b\xac\xb6\xce\x19\x14\xf6\x64\x4e\xd7\x6f\x95\xf2\x48\x34\xaf\xe1\xba\xa3\x4a\x70\x89\xee\x35\x35\x3c\x50\x0a\x02\x67\x25\x82\xc2\xda\xb8\x43\xac\x68\xc5\x88\x25\x80\x0e\xcc\x80\x4b\x1f\x61\x38\xcf\xa8\xc0\x20\x4b\x24\xe0\x9a\x29\x8d\x6b\xd4\x2d\x77\xa5\x40\x88\x2a\x16\xdd\x29\x4f\x82\x86\x50\xdd\x40\xed\xdd\x5e\x2b\x54\x9d\x14\x67\xfb\xb4\xe8\x84\x20\xa5\x81\xbe\x0f\x82\x0f\xf7\xab\xd3\x2d\x80\x1f\xdd\x63\xcc\x5b\x81\xf3\x81\x40\xd8\x43\x76\xad\xfb\x6b\xe7\xcc\x2c\x9c\x6b\xe7\x4c\x42\xb2\xdf\x8c\x78\xd5\xa4\x87\xbc\x42\x5a\xdb\x7d\xce\xca\x6b\x7b\xd0\x24\xd1\x1e\x15\x8c\x95\x95\xe1\x41\x08\x6a\x29\x6e\xe0\x59\x87\x12\xba\x66\x37\xf6\x4d\x23\xb6\x00\x84\xe1\x66\xf0\x16\x6f\xb9\x6d\x6f\xca\xb8\xdb\x6b\x75\xa6\x03\x05\xac\x62\xcb\x38\xa7\xf7\xa6\x27\x4b\xba\x77\x9d\x1e\xd4\x3c\xd6\xd9\x40\xb1\x13\xea\x52\x76\xd9\xe9\xbd\xdc\xdf\x2d\xdb\xe5\xb4\xbc\x1b\x7b\x0e\xe0\xb1\x44\x70\xd6\x1c\x3a\x4d\x12\x2b\xf6\x78\x17\x4f\xa0\x8b\x14\xa8\x57\x3b\x3e\x88\xdd\x7c\x1e\x3d\x8a\x1d\x81\xf0\xd8\x05\x21\x05\xc1\x29\xc3\xfc\x8e\x32\x40\x0a\xcb\x9d\xc1\x80\xab\x18\x47\xa8\x0e\x58\x4d\x04\xcd\xe8\x95\xa6\x84\xf7\xe2\x70\x32\xf3\xe2\x2c\xce\x6a\xfc\x6f\x4e\xa3\x0e\x7c\xb1\x17\xda\x88\xad\x36\x3a\x1c\xe0\xe5\x28\xbf\xae\x0f\xd7\x73\xb5\xff\xb6\xaf\xd8\x27\x83\x93\x9d\xfb\x64\xf6\xe8\x2c\x36\xb3\x1d\xf0\x19\xa6\xa1\x6b\xba\x94\xb6\x6c\x6d\x82\xf0\x01\xd5\x2c\x22\xef\x8f\x29\x39\x2e\x9f\x4b\xb4\x83\xf7\x9e\x05\xc5\x96\x07\x55\x9b\x4c\x3c\xa1\x2b\xd1\x9d\x78\x86\xa7\x70\xbe\x12\xa1\x3d\xfd\xdc\x06\x5d\xe1\x75\x00\x02\x6f\x0d\xc1\xbb\x79\x15\x23\x49\x72\x5b\x94\x3e\x28\xa8\xa9\xaf\xd1\x51\xc3\x6b\xe5\xf6\x35\x73\x56\xf4\x7d\x5f\x59\x3b\xe9\xa5\xf0\xea\x99\x43\xde\xa3\x50\x43\xdb\xfc\x75\x8d\x90\xac\x9b\x95\x6b\xa6\x5b\xfa\x44\xfc\x6a\xfd\x39\x12\x26\xdb\x6d\x53\x6d\xd1\x73\x9c\xca\xba\x01\xe9\x3c\x4e\xab\xfd\xe0\x0b\x6d\xc3\x9f\x7f\x3a\x33\xdf\xc6\x16\x9f\x96\x76\xdd\x11\xf8\xf8\x51\x9a\x9e\xe8\x55\xcd\xde\x33\x55\x9f\xfb\xca\xeb\xfd\xb0\x1d\xb5\x45\xe8\x0c\x87\x0b\x89\xfe\x1a\x5c\xf3\xdd\xe3\x2b\x7e\x1e\x1e\xd2\x2f\x33\x0c\x4e\xac\xdb\xe8\x97\xbe\x1a\x44\xdb\x40\x5b\xf8\xdb\xbb\x8b\x8b\x5f\x43\xfc\x1a\xdc\xe7\x7a\xc9\x99\x8e\x32\x4d\xb1\x75\x67\xa7\x2e\x36\x96\xf3\xa5\x94\x9f\x4a\xd8\xa6\x10\x32\x34\x1e\x7d\xbe\xf8\x4a\xd0\x2b\xac\xce\x64\xd7\x04\xea\x87\x48\x96\x02\x5c\x54\x31\xdc\x5d\x01\x55\x37\x6e\xe1\x41\xbf\x5b\x7c\x3d\xe4\xf3\x60\x57\x4e\xa1\xc9\x2f\x2e\xbb\x60\x92\xd5\x92\x5e\x35\x88\x5b\xb0\x3e\x31\x30\x94\xe8\x2d\x86\xa8\x88\x2f\x84\xfc\x7f\x67\x49\xdf\x00\x5f\x26\x19\xe9\xdb\x37\xc3\x38\xa8\x7d\xbf\xde\xff\x94\x7a\x59\xa4\x71\xf7\x78\x91\x6f\xf4\x4a\xd7\x0c\x74\xe6\xf2\xd9\x9f\x02\xaf\xe6\x86\xda\x1c\xe0\xc9\xba\x67\x26\xa9\xe0\xb9\xd4\x66\x28\xe4\x33\x4c\xf9\x1e\xa9\xb1\x56\xdb\x5d\xb6\xb8\x40\x32\x07\xda\x15\x8e\xbd\x26\x19\xba\xc0\x11\xb2\x83\x2b\x5f\x7c\x83\xa8\x6f\x2c\x66\xbf\xbb\x4e\x7c\x43\x31\x18\xae\x58\xf3\xc5\x2b\x21\xb5\xee\x49\x39\xaf\x3f\x5a\xbe\xe4\xf8\x58\x14\x17\x05\x5e\xb0\xb4\x69\xb4\x7a\x55\xd6\xe7\xcf\xf7\xef\x4f\x53\xea\x26\x9e\x98\xe2\xc5\x47\xa1\x8f\x3b\xd1\xd8\xd9\xe8\xf3\x91\x36\x73\x4e\xfb\x3a\xb5\x2f\x22\xdc\x37\x1c\x3f\x7b\xef\x26\xe5\xf4\x7c\xd7\x11\x49\x19\xc3\xe7\xf2\xd0\xe5\x55\x37\x03\x32\xde\x93\x76\xd7\x6e\xdc\x8c\x2c\xae\x54\xf1\x7b\x1d\x91\x5b\xc4\xd4\x08\xb1\x11\x4f\xb8\x78\xd2\xbd\xa8\x9f\x11\x14\x3e\xb7\xd7\x88\xb3\x1a\xfe\x2b\xf5\xa9\x6d\x57\x1c\xd7\x81\x2c\x85\xdd\x4d\x5c\xf6\xbb\x9b\xd3\xee\x0c\xee\xef\xdf\xcf\xaa\xb2\xee\xc9\x12\x5a\x69\x21\xe8\xfe\xae\xac\x12\xb2\xd4\x76\x28\x78\x30\xba\xac\xe5\xff\x70\x48\x2a\x9c\xbf\x56\x49\x46\xf8\xaf\xad\x33\x66\xb5\xdc\x0c\x74\xd3\x3e\xff\xbc\x37\xa3\xf7\xa6\x45\xf7\xf1\x5c\xf3\x9d\x7a\x61\x0b\xce\x66\xdf\xcb\x01\x74\xae\xde\x9c\x5a\x95\xca\xcc\xdb\x84\xdd\x4d\x77\xa6\xe1\x1b\x4d\xe7\xbb\x17\x1e\xc6\xaa\x0e\x87\x76\xdb\x19\x71\x84\x63\xaf\x40\xe1\xf1\x4a\x05\x47\xc9\xde\x7d\x06\xc9\x61\x7f\x27\x4c\x5d\x8a\xbb\xc5\x70\x62\x13\x52\x62\x1d\x50\x7d\x18\x7f\xf1\x79\xf3\xe6\xe4\x23\x4f\x7c\x95\xce\xb6\xdf\x08\x29\x87\x5f\x7e\xe5\xef\x3c\xc1\x79\x54\xdd\xa7\x17\xca\xe1\x97\x5f\x17\xff\x1b\x00\x83\xe9\x9e\xb5\x1e\x1d\x00\x00"),
+		},
+		"/cluster_v1alpha1_cnctsshhost.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cluster_v1alpha1_cnctsshhost.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 4739,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x4d\x6f\x24\xb9\x0d\xbd\xfb\x57\x10\xde\x83\x13\xc0\x2e\x67\xb2\x41\x10\xf4\x6d\x30\x3b\x08\x9c\x64\x66\x07\xb6\xb3\x39\x04\x39\xb0\x25\x76\x97\x62\x95\x54\x2b\x52\xed\xe9\xfc\xfa\x80\x52\x55\x7f\x55\xb5\xb7\x77\x31\x75\xb0\xd1\x2a\x15\x45\x3d\x3e\x92\x4f\xc2\xde\xfd\x44\x89\x5d\x0c\x0b\xc0\xde\xd1\x57\xa1\xa0\xbf\xb8\x79\xf9\x0b\x37\x2e\xde\x6f\xde\x2d\x49\xf0\xdd\xd5\x8b\x0b\x76\x01\x1f\x32\x4b\xec\x1e\x89\x63\x4e\x86\x7e\xa0\x95\x0b\x4e\x5c\x0c\x57\x1d\x09\x5a\x14\x5c\x5c\x01\x98\x44\xa8\x83\xcf\xae\x23\x16\xec\xfa\x05\x84\xec\xfd\x15\x80\xc7\x25\x79\xd6\x39\x00\x26\x06\x49\xd1\x7b\x4a\x77\x12\xa3\x1f\x17\x5c\xc0\xf5\xbb\xe6\x0f\xd7\x57\x00\x01\x3b\x5a\x80\x09\x46\x3a\x34\xad\x0b\xc4\x24\xdc\x18\x9f\x59\x28\x35\x3a\xde\xb0\xe5\x86\xb1\xe3\x1c\xd6\x8d\x89\xdd\x15\xf7\x64\xd4\x3a\x5a\x5b\xdc\x42\xff\x25\xb9\x20\x94\x3e\x44\x9f\xbb\x50\x56\xbe\x83\xbf\x3d\xfd\xf8\xf9\x0b\x4a\xbb\x80\x86\x05\x25\x73\xd3\xb7\xc8\x54\xbc\xb2\xc4\x26\xb9\x5e\x0a\x20\xc3\xba\xc0\x24\x50\x67\x96\x39\xd5\xb1\xa7\xfd\x80\x6c\x7b\x5a\x00\x4b\x72\x61\x7d\xba\xc2\x08\x4c\x33\x41\xe5\xc0\xd6\xfb\x35\x1d\x18\xb2\x28\xfa\x73\x9d\x62\xee\x17\xf0\xe6\x86\xab\x81\x01\xd1\x21\x44\xc1\xc8\xa7\xea\xf8\x13\x49\x79\xd1\xfb\x9c\xd0\x4f\xb0\xbc\x02\x60\x13\x75\xc5\xcf\x6a\xa4\x47\x43\xf6\x0a\x60\x83\xde\xd9\xe2\x6a\x35\x1b\x7b\x0a\xef\xbf\x3c\xfc\xf4\xfd\x93\x69\xa9\xc3\x3a\x08\xd0\xa7\xd8\x53\x12\x37\xae\xae\xcf\x01\x9b\x76\x63\x27\x98\xde\xa8\xa9\x3a\x07\xac\xf2\x87\x18\xa4\x25\xd8\xd4\x31\xb2\xc0\x65\x19\x88\x2b\x90\xd6\x31\x24\xea\x13\x31\x05\x29\x2e\x1d\x98\x05\x9d\x82\x01\xe2\xf2\xbf\x64\xa4\x81\x27\x4a\x6a\x04\xb8\x8d\xd9\x5b\xe5\xd7\x86\x92\x40\x22\x13\xd7\xc1\xfd\x6f\x67\x99\x41\x62\x59\xd2\xa3\x10\xcb\x91\xc5\x42\x96\x80\x5e\x41\xc8\x74\x0b\x18\x2c\x74\xb8\x85\x44\xba\x06\xe4\x70\x60\xad\x4c\xe1\x06\x3e\xc5\x44\xe0\xc2\x2a\x2e\xa0\x15\xe9\x79\x71\x7f\xbf\x76\x32\xe6\x8f\x89\x5d\x97\x83\x93\xed\x7d\x21\xbc\x5b\x66\x89\x89\xef\x2d\x6d\xc8\xdf\x63\xef\xee\x8a\x9f\x41\x4a\xce\x75\xf6\xbb\x34\xe4\x16\xdf\x1c\x38\x76\x42\xb0\xfa\x94\x70\x9f\x85\xf9\xef\x2e\x58\x70\x0c\x38\x7c\x56\xdd\xdd\xa3\xa9\x43\x0a\xc2\xe3\xc7\xa7\x67\x18\x17\x2d\x88\x1f\x43\x5c\xc0\xdd\x7f\xc6\x7b\x9c\x15\x17\x17\x56\x94\x6a\x9c\x56\x29\x76\xc5\x22\x05\xdb\x47\x17\xa4\xfc\x30\xde\x51\x38\xc6\x98\xf3\xb2\x73\xa2\x81\xfd\x39\x13\x8b\x86\xa3\x81\x0f\x18\x42\x14\x58\x12\xe4\x5e\xf9\x6f\x1b\x78\x08\xf0\x01\x3b\xf2\x1f\x90\xe9\x5b\xa3\xac\x80\xf2\x9d\x22\xf8\xcb\x38\x1f\x96\xb6\xe3\x89\x15\x9c\xdd\xf0\x58\x7d\xc6\x67\x2e\x43\x8a\xc1\x9a\x82\xcf\xd4\xf5\x4a\xc1\xe3\x97\x27\x71\xfc\x74\x3c\xf7\x28\x65\x2c\xb1\x4b\x4a\x6b\xd1\x37\x71\x05\x84\xa6\x05\x17\x58\x30\x18\x3a\xb1\x5a\xb2\x65\xb0\x76\xf2\xea\x9c\x9f\xe7\x36\xff\x26\x08\xe7\xc0\xb8\x64\x31\x28\x19\x58\xdd\x7f\x56\xe3\xb3\x33\x4e\x10\x7a\x38\xf8\x00\x12\xad\x28\x51\x30\x03\x42\xea\xa1\xee\x7b\x2c\xe4\x12\xcf\x58\x2c\x7e\x6d\x5c\xa9\x4a\x2e\x40\x87\xc8\xb0\x44\x26\x0b\x31\x80\xe9\xf3\x2d\xac\xf5\x4f\x47\x5d\x4c\x5b\x10\x5c\xf3\x19\x43\xb3\x0c\x9a\xac\x63\x29\x3d\xfc\x70\xd1\xee\x9e\x4b\x6a\x39\xf2\x16\x5e\x9d\xf7\x9a\x20\xda\x8d\x96\xdb\xb2\x3f\x34\x92\x51\x99\x5e\x0a\x95\x89\x81\x73\x57\x6a\xf8\xfc\xb3\xdc\x42\xeb\xd6\x2d\x25\xf0\x9a\x18\xa0\x09\xa1\x91\x00\xef\x5e\x08\x30\x4b\x64\x83\xbe\x24\x34\xca\x6e\xbd\x52\x13\x57\x68\xce\xed\x48\x9f\x57\x27\xed\xd8\xa9\xee\xb0\x77\x80\x0c\x6b\x0a\x94\x9c\xd9\xed\xb8\xf9\xad\x90\xa5\xe8\xcf\xb1\x05\xc0\x09\x75\x67\x5f\x5e\x60\x7c\x9c\x82\x29\xe1\x76\x76\x86\xa0\x0b\x72\x76\x89\x93\x68\x11\xac\xb2\xf7\xb7\x0a\x66\x1b\x93\xd3\xa6\xb5\x21\xf0\x8e\xa5\x34\xb4\x62\x4a\xfb\x0f\xf6\xbd\x9f\x5f\xae\x2c\x59\x1b\x94\x89\x29\x11\xf7\x31\x58\xad\xd6\x9f\xa3\xa5\x73\x18\x5e\x84\xc2\x99\x4c\xbd\x04\x85\x37\x0c\x9c\x7d\x95\xa8\xf7\xce\xe0\xc4\xad\x23\xc4\x1e\x87\x49\x47\x85\x2d\xe4\x6e\x49\xa9\x00\xa6\x09\x3c\x5f\xb5\x56\x31\x75\x28\x0b\xa5\xe7\x9f\xff\x34\xeb\x93\x12\x77\x4d\xe9\xb8\xf9\x90\x27\x23\x31\xbd\xe9\xd4\xcd\xd3\x30\xab\x36\xd0\xa2\x5a\xe1\xe7\x4c\x69\x0b\x71\x43\x69\x2c\x27\x5c\xf3\x64\xd0\x1b\x1d\x8a\x69\x27\xd0\xe9\x76\x06\x20\xc0\xc4\x1c\xa4\x81\x7f\x14\x73\x2f\xb4\xad\x59\x5b\x65\x44\x35\xd5\x65\x96\x6a\x48\xab\x50\x4c\xf6\xc4\x7b\xa8\xdc\x58\xd2\x5e\x3d\xdb\x5a\x0b\x1c\xc3\x5e\xf4\x35\xf0\x70\x64\x4b\x9d\xd8\x95\xc0\xa1\x93\xdc\xdc\x4c\x4b\x58\x95\xe7\xb3\xbd\xf6\x25\x2f\x29\x05\x12\x2a\xed\xd6\x46\xc3\xda\x69\x0d\xf5\xc2\xf7\x8a\xc9\xc6\xd1\xeb\xfd\x6b\x4c\x2f\x2e\xac\xef\xb4\x1a\xdc\x55\x46\xf0\x7d\x35\x7a\xff\x5d\xf9\x7f\x37\xe2\x7f\xd4\x76\xdf\xa0\x91\x8a\x04\x6d\x72\x87\xf1\xba\xdb\x45\xf1\x17\x3b\x72\xd1\xe8\x97\xf4\x64\x4a\x29\xa6\x4f\xc4\x8c\xeb\x49\xdb\x39\x5b\x43\xca\x47\x8f\x84\x7c\xac\x77\x27\x7c\x7a\x08\x55\x18\xa9\x02\xa9\x81\x96\x96\x14\x62\x65\x97\x50\xea\x9c\x4a\xce\x3e\xc5\xa5\xa7\xae\x08\xd6\x60\x9c\x9f\xab\x58\x07\x74\xe2\x5b\x58\x46\x69\xe1\xe3\xde\x89\xc2\xa7\x8f\x07\x3b\x39\xec\x19\xcd\xe1\xcc\x89\xe1\x71\x62\x1f\xfb\xac\xf4\xb0\xb5\xa4\x23\x70\x36\xc6\x05\x23\x83\x7e\xe4\xec\x04\x97\x9e\x34\xfd\x76\x9c\x2a\x1d\xa2\x4f\x54\x25\xfa\xed\xd4\x78\xeb\x3c\xcd\x38\xa6\x24\x46\x17\x00\xa1\x53\xc6\x6d\x28\x2d\x23\xd3\x28\x58\x0f\x97\x9a\x52\x35\xae\xd7\x3a\x49\x77\xdc\xe6\x0e\xc3\xd0\xfd\x0a\xe2\x0d\x68\x25\x66\xaa\x8d\x73\x77\x22\x18\x04\xa6\xf6\xcf\x39\x93\x92\x30\xb0\x2b\xf5\xba\x04\x76\xc8\x49\x3c\x38\xa9\xc2\x0a\xcd\x98\xab\x98\x08\xe8\x6b\x4f\x46\xc1\x2a\x49\x39\xb1\xb8\x72\x5f\xc9\x96\xbe\xda\xa1\x38\x83\xde\x0f\x05\x44\x5c\x47\xf0\xbb\xd2\x75\x59\x73\xc7\x10\xc4\x2c\xb8\x26\xfe\xfd\x2d\x2c\xb3\x14\x15\x44\x38\xed\xe4\x2e\x58\x67\x54\xed\xd5\xca\x13\x3b\x92\x56\x61\x50\x91\x90\x83\xc5\x4e\xcf\x49\xba\xcc\x6b\x8a\x61\x5d\x63\xa8\x9c\x39\x91\x91\x33\xb9\xaf\x7a\x0d\x62\x1a\xfa\x4e\x58\xb9\x75\x4e\x25\x9c\xf5\x20\xb6\x2f\x20\x7b\x34\xea\xf1\xa8\x16\x2e\x0c\x19\xfd\x8c\xbb\xa2\x87\x85\xa2\xbb\xc1\xf1\x2e\x9b\x1b\xf8\xf8\x15\xbb\xde\x13\x17\xeb\x63\x06\x0c\xb0\xbf\x96\x68\x15\xe5\x51\xce\xa2\x13\xb3\x26\x76\x4b\x17\x8a\x77\xc5\x00\x93\xe8\x79\x86\xb5\x68\xaa\xab\xba\x97\xdb\xa3\xc2\xaa\xc1\xca\x81\x73\xdf\xc7\x24\x33\x0a\x69\xd0\x53\x73\x7b\x1c\x30\xa9\x8d\x98\x9d\x12\x72\x3a\x0d\x9c\x30\xf9\xd5\xd4\x2e\x69\x74\x4c\x72\x63\xf8\x3b\xc7\x23\xba\x0a\x03\xbc\x0f\xdb\x81\x78\x5a\x1b\x0e\x79\x17\x8d\xc9\x09\x6c\x9e\x55\x2e\xd5\xa3\xa1\x4e\xec\xc2\xf4\x69\xec\x4b\x06\x83\xe2\x87\xd6\x2a\xff\xb8\x56\x9e\xdd\xb9\xf7\xe4\x76\xe0\xf0\x19\x8e\x7b\x18\xec\x7d\x4c\x25\xc9\xc8\x8e\xa8\xee\x77\x7b\xc3\x4a\xd7\x3e\xcb\xa9\x1c\x39\x5b\x29\x55\x14\x6d\x4b\xe3\x23\xfb\x78\x89\x2e\x78\x3e\x92\x01\x63\xc9\xab\xc8\xb4\xa8\x92\xaa\xb4\x95\xda\xe0\xc6\xb3\x6c\x1d\x9b\x3b\xf8\xcc\x75\xc0\x71\xfc\xa0\x6f\x9e\x97\x18\xdf\xff\xf1\x62\x89\xe1\x91\xe5\x9f\xf5\x10\xfb\xe6\x16\xff\xd5\x52\x80\xd7\xb2\x29\xc7\x43\xab\x2a\x1f\x43\x5c\x6a\x55\x98\xd0\x74\x74\x47\x4d\xdf\x69\x09\xb9\x14\xfd\xd1\xde\x5f\x55\x91\x1f\xdc\xec\x9c\x71\xec\xc7\xc9\x74\x3d\x57\x69\xc7\xad\xf2\x6c\xbd\x1f\x1f\xa1\x8d\x3c\xe5\x52\x22\x43\x41\xb4\xe4\x0d\xf6\x2e\x43\xfa\x57\x88\xb9\x72\x6d\x77\xc9\xf1\xf9\xe9\xf8\x02\xef\x02\xc8\x12\xa1\xdd\xfe\x26\xa6\xa2\xdd\xee\xf9\xba\x8a\x69\x2a\xce\xde\x8f\x54\x9c\xd6\x4b\x2e\x6d\x4c\x4f\x4b\x64\x07\x5b\xaf\x4a\x93\xa2\x8a\xa3\x25\x68\xf5\x60\x4a\x14\xea\x25\xab\x66\x77\xbd\xe6\xb9\x7e\xd4\xc9\xd7\xdf\x86\xc1\xbf\x4e\xb9\x3b\xde\x71\x60\x26\xe6\xd3\x24\xfe\x16\x3e\xce\xcb\xc2\x71\x85\xf3\xb2\x70\x33\xde\x75\x6f\xde\xa1\xef\x5b\x7c\x77\xb5\x97\x88\x68\x54\xce\x92\xfd\x7c\x7a\xa3\x7a\x7d\x7d\x74\x8b\x5a\x7e\x1a\x3d\x94\x95\x06\xb4\x80\x7f\xff\xe7\x4a\xa5\x66\x4c\x64\x87\x9b\xcd\x3a\xf8\xff\x00\x00\x00\xff\xff\xeb\x13\x02\x35\x5c\x17\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x4f\x73\xdb\xb8\x0e\xbf\xfb\x53\x60\xf2\x0e\xbd\xd8\xca\x4b\x7b\x79\xa3\x5b\x27\xed\x9b\xcd\x66\xda\x66\x9a\xa4\x7b\xe8\x74\x76\x68\x12\xb6\xb8\xa1\x48\x2e\x01\x39\xeb\xfd\xf4\x3b\xa0\x24\x5b\x96\x1d\xdb\x6d\xd7\xd2\x45\xfc\x03\xfc\x00\x02\x3f\x10\x56\xd1\x7e\xc1\x44\x36\xf8\x12\x54\xb4\xf8\x17\xa3\x97\x2f\x2a\x9e\xfe\x47\x85\x0d\x97\xab\xab\x39\xb2\xba\x9a\x3c\x59\x6f\x4a\xb8\x6e\x88\x43\xfd\x19\x29\x34\x49\xe3\x3b\x5c\x58\x6f\xd9\x06\x3f\xa9\x91\x95\x51\xac\xca\x09\x80\x4e\xa8\x64\xf0\xc1\xd6\x48\xac\xea\x58\x82\x6f\x9c\x9b\x00\x38\x35\x47\x47\xb2\x06\x40\x07\xcf\x29\x38\x87\x69\xc6\x21\xb8\x5e\x61\x09\x17\x57\xc5\x7f\x2f\x26\x00\x5e\xd5\x58\x82\xf6\x9a\x89\xaa\x2a\x10\x53\xa1\x5d\x43\x8c\xa9\x90\xc1\x82\x0c\x15\xa4\x6a\x6a\xfc\xb2\xd0\xa1\x9e\x50\x44\x2d\xa2\x95\x31\x19\x93\x72\x77\xc9\x7a\xc6\x74\x1d\x5c\x53\xfb\xac\x76\x06\xbf\xde\x7f\xfa\x78\xa7\xb8\x2a\xa1\x90\x0d\x85\x32\x26\x21\x51\x86\x64\x90\x74\xb2\x51\x36\x97\x40\x54\xc1\x70\xb2\x85\xf3\x76\x30\xc2\xeb\x88\x25\x10\x27\xeb\x97\x07\x65\x37\x84\x49\xb6\x1d\x16\x2e\xb3\x03\xc9\x8f\xfd\xe7\x51\xb1\xac\xb8\xa1\x22\x3c\x7b\xf1\x42\xeb\x8d\x7d\xe9\xdd\x04\x84\x67\x6f\xfd\x12\xb8\x42\x10\xff\x0d\x94\x5d\x0f\xb6\x9e\xad\xef\xb0\x29\xb5\xd2\x95\xf5\x78\x44\xd9\x87\x76\xc5\x49\x65\x7d\x0c\x15\x7b\x01\x34\x10\xf6\x76\x39\x14\x64\x14\xcb\xe7\x32\x85\x26\x96\x70\x34\x3c\xda\x13\xec\x82\xaf\x8b\x66\xaf\xf9\x9e\xaa\x5f\x7a\xb8\xd1\x35\x49\xb9\xdd\x98\x9b\x00\x90\x0e\x71\xc7\x67\x2b\xe5\xac\xc9\x21\xde\x4a\x0b\x11\xfd\xdb\xbb\x9b\x2f\x6f\xee\x75\x85\x75\xce\x01\x19\x8e\x29\x44\x4c\x6c\x7b\xa5\xf2\x0c\xf2\x6d\x33\x36\x72\xe8\x2b\x11\xd5\xae\x01\x23\x19\x86\x94\x8f\x70\xd5\x8e\xa1\x01\xca\x6a\x20\x2c\x80\x2b\x4b\x90\x30\x26\x24\xf4\x9c\x21\x0d\xc4\x82\x2c\x51\x1e\xc2\xfc\x0f\xd4\x5c\xc0\x3d\x26\x11\x02\x54\x85\xc6\x19\xc9\xc0\x15\x26\x86\x84\x3a\x2c\xbd\xfd\x7b\x23\x99\x80\x43\x56\xe9\x14\x23\xf1\x8e\xc4\x9c\x51\x5e\x39\x58\x29\xd7\xe0\x14\x94\x37\x50\xab\x35\x24\x14\x1d\xd0\xf8\x81\xb4\xbc\x84\x0a\xf8\x10\x12\x82\xf5\x8b\x50\x42\xc5\x1c\xa9\xbc\xbc\x5c\x5a\xee\x19\x46\x87\xba\x6e\xbc\xe5\xf5\x65\xa6\x04\x3b\x6f\x38\x24\xba\x34\xb8\x42\x77\xa9\xa2\x9d\x65\x9c\x5e\x6c\xa3\xa2\x36\xff\x49\x1d\xfb\xd0\xab\x01\xb0\x51\x5c\xe5\xb1\xf6\x94\x5f\x74\xf3\xad\xf5\x06\x2c\x81\xea\xb6\xb5\x16\x6d\xbd\xd9\x47\xf3\xe7\xf7\xf7\x0f\xd0\x2b\xcd\x1e\x1f\x88\x84\xce\xb9\xdb\x6d\xb4\xf5\xb3\xf8\xc5\xfa\x05\xa6\xbc\x0b\x16\x29\xd4\xd9\xad\xe8\x4d\x0c\xd6\x73\xfe\xd0\xce\xa2\xdf\xf5\x31\x35\xf3\xda\xb2\x1c\xec\x9f\x0d\x12\xcb\x71\x14\x70\xad\xbc\x0f\x0c\x73\x84\x26\x4a\xd8\x9b\x02\x6e\x3c\x5c\xab\x1a\xdd\xb5\x22\xfc\xb7\xbd\x2c\x0e\xa5\x99\x78\xf0\xb4\x9f\x87\xe4\xdf\xff\x64\x7f\xd9\x39\x67\x33\xdc\x53\x34\xc0\xcb\x19\x22\x4f\xc7\xbb\xbb\x83\xa3\xf3\xeb\x98\x58\x4e\x50\xdc\x68\x63\xbf\x0b\x42\xca\x74\x27\xe9\x2e\x09\xd0\x33\x52\x01\x37\x0c\xa3\xc3\x93\xb7\x21\x34\xa0\x5a\x29\x3e\x98\x56\x94\x37\x53\x58\x84\x04\xb5\x12\x9e\xa4\x69\x9e\x55\xd1\x6e\xce\xae\x18\xc9\x39\xe8\x18\x79\x45\xf5\x2d\xae\x8f\xda\x22\x0c\x74\x8b\xeb\xde\x96\xd8\xcc\x9d\xd5\xf0\x84\xeb\x21\x7e\xb0\x1e\x54\xc3\x55\x48\x92\x5c\xbf\x3f\xe1\x7a\xdf\x96\x45\x48\xb5\xe2\x02\xde\x5b\xae\x30\x81\x65\x71\xc6\x43\x6a\x88\x3f\xf9\xff\xdb\x44\xfc\x48\x08\x75\x43\x39\x8e\x08\xcf\xb7\xe2\x09\xd7\xf7\xa8\x13\xf2\x51\x3b\x6e\xfb\x55\x90\x70\x81\x09\xbd\xee\xb8\x8b\xf2\x5e\xa8\x82\x33\x7d\x5a\xc5\x64\x57\x8a\x51\xac\x1c\x89\x04\xa1\x1f\x17\x96\x62\xf0\xb3\xe5\x6a\x34\xfd\x52\xd0\x74\x30\xf7\x07\xf7\x41\xf6\x6e\x1d\x60\x10\x65\x5b\xa4\x53\x29\xff\xb3\x6e\xfa\x10\x42\x79\xec\x02\x24\x23\x09\x77\xb3\xf7\x84\x27\x37\xa5\xec\x14\xce\x8f\x83\xf8\x6d\x51\xfd\x88\x1a\x8a\x4a\x9f\xa7\x2b\xaf\xfc\x19\x85\x42\x57\x36\xe1\x0e\xe5\xca\x3b\x83\xcd\xcd\x61\xfb\x9b\x6d\xd1\x8d\x66\x0e\x32\x87\xbc\x31\xa4\xe3\xe1\x77\x17\x12\x6f\x0c\xa0\x0a\x28\xd7\xbb\x29\xbc\x7e\xfd\xf2\x59\xb5\x19\x53\x82\xf5\xfc\xe6\xf5\x68\xae\x45\x22\x15\x6f\xd9\xdd\x95\xfa\x87\x77\x53\xea\x28\xaa\x71\xfa\xe5\xbd\x6d\x5a\x48\xdc\x75\x65\x03\x0d\x84\x36\x02\x17\xb2\x50\x8a\xb3\x47\xbd\x57\xd0\xe5\x95\x92\x2b\x55\x36\x19\x92\x0c\xef\x03\x37\x5f\x0d\xc5\xd0\x01\x9b\x74\x36\x17\xf0\x70\x8e\x60\xad\x7c\x8e\x68\x46\xe7\xb6\xb4\x93\xcb\x96\xf2\x60\xeb\x18\x88\x43\x9a\x02\xc9\xed\xc0\x92\xd0\x55\xf0\x6e\x0d\xa4\x16\x28\xe8\xd5\x9e\xc4\x6c\x2b\x1a\xf0\xc8\xcf\x21\x3d\x1d\xa6\x9b\x79\x08\x0e\xd5\x2e\x9c\xfe\xea\x7c\xd4\xb3\x8f\xdd\xa2\x01\x5f\x28\xca\x34\xdf\x53\x5c\x0a\x21\x53\xe0\x1c\x41\x39\x17\x9e\xd1\x00\x87\x91\x48\x80\xd4\x78\xa0\xc6\x84\xcc\x36\xa1\x61\x50\x10\x15\xd1\x73\x48\xe6\x4c\x82\x3c\x14\xfa\xb3\xbe\x20\xed\x8c\xed\xf4\x04\xfd\xe0\x86\x5d\x27\x27\xd2\xa0\xbd\x8f\x97\x93\xd3\x6c\xf8\x73\x65\x67\x73\xf8\x87\xa3\x73\x24\x14\x06\x41\x35\x3d\x50\xa6\xba\x24\x3b\xcf\x95\xd2\x25\x12\x3f\xb6\x77\x9c\xa3\xf0\x7f\xab\x70\x27\xf4\x65\x1f\xe8\x4a\xf9\x25\x9a\x17\xb2\x5c\x84\xce\xd8\xd6\x78\x2e\x96\xdc\x69\x1d\x45\xf1\x49\x56\xf4\x2e\xbc\xf6\x9a\xbb\x76\x67\xeb\x43\x4b\xa0\x9d\xb2\x35\x1a\x98\xaf\xbf\xa3\x98\x75\xed\xcc\xfe\xc4\x08\x41\xd7\x98\xf4\x18\x86\xf7\x1e\xc1\xd3\x4f\x87\xc5\x18\xe2\x01\xc1\x27\xeb\x49\xf9\x23\x9b\xbe\xb7\x08\x09\xc3\x0d\xcb\xdf\x00\xf3\x14\xb0\x8e\xbc\x16\x9e\xe3\xea\x90\x05\xb0\xe9\x48\x9f\x15\xe5\xac\xd7\x12\x49\x30\x5f\x83\xae\xd5\x4c\x3a\xef\x79\xc3\x50\x29\x02\x1f\x7e\xc6\x1b\x31\x85\x95\x35\x98\x6e\xde\x9d\xb4\xec\x6e\xb3\x34\x1f\xc1\x61\x80\x07\xae\x3a\x3f\x5c\x70\xb7\xd8\xce\x2b\xae\xa3\xe1\xae\xd5\x2c\x61\x75\xa5\x5c\xac\xd4\xd5\x64\x4b\x3c\x4a\x6b\x8c\x8c\xe6\xe3\xb8\x9b\xbe\xb8\xd8\x69\xa2\xf3\xa7\x0e\xbe\xfd\x33\x86\x4a\xf8\xfa\x4d\x7a\x69\x0e\x09\x4d\xd7\xde\x52\x09\x5f\xbf\x4d\xfe\x19\x00\x15\x2d\x6d\x9e\x83\x12\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons_v1alpha1_appbundle.yaml"].(os.FileInfo),
 		fs["/cluster_v1alpha1_cnctcluster.yaml"].(os.FileInfo),
+		fs["/cluster_v1alpha1_cncthost.yaml"].(os.FileInfo),
+		fs["/cluster_v1alpha1_cnctimageupload.yaml"].(os.FileInfo),
+		fs["/cluster_v1alpha1_cnctmaasregion.yaml"].(os.FileInfo),
 		fs["/cluster_v1alpha1_cnctmachine.yaml"].(os.FileInfo),
 		fs["/cluster_v1alpha1_cnctmachineset.yaml"].(os.FileInfo),
+		fs["/cluster_v1alpha1_cnctredfishhost.yaml"].(os.FileInfo),
+		fs["/cluster_v1alpha1_cnctsshhost.yaml"].(os.FileInfo),
 	}
 
 	return fs
@@ -0,0 +1,237 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package etcd manages the members of the external etcd clusters through the
+// json gateway of etcd, so the etcd client and its grpc dependencies are not
+// needed.
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ClientPort is the port etcd serves its clients at, PeerPort the port of
+// the other members.
+const (
+	ClientPort = 2379
+	PeerPort   = 2380
+)
+
+// RequestTimeout is the deadline of a request to an etcd member.
+const RequestTimeout = 10 * time.Second
+
+// gatewayPrefixes are the paths of the json gateway, from the newest to the
+// oldest etcd release serving it.
+var gatewayPrefixes = []string{"/v3", "/v3beta", "/v3alpha"}
+
+// Config describes how to connect to an etcd cluster.
+type Config struct {
+	// Endpoints are the client urls of the members, e.g.
+	// https://10.0.0.5:2379. They are tried in order.
+	Endpoints []string
+	// CA is the PEM encoded etcd CA certificate.
+	CA []byte
+	// Cert and Key are the PEM encoded client certificate and key signed by
+	// the etcd CA.
+	Cert []byte
+	Key  []byte
+}
+
+// MemberID is the id of an etcd member. The gateway encodes it as a string.
+type MemberID uint64
+
+// MarshalJSON encodes the id as a string.
+func (id MemberID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(id), 10))
+}
+
+// UnmarshalJSON decodes the id from a string or a number.
+func (id *MemberID) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid member id %s", data)
+	}
+	*id = MemberID(n)
+	return nil
+}
+
+func (id MemberID) String() string {
+	return strconv.FormatUint(uint64(id), 16)
+}
+
+// Member is a member of an etcd cluster. A member which was added but has not
+// started yet has no name.
+type Member struct {
+	ID         MemberID `json:"ID"`
+	Name       string   `json:"name"`
+	PeerURLs   []string `json:"peerURLs"`
+	ClientURLs []string `json:"clientURLs"`
+}
+
+// Client manages an etcd cluster.
+type Client interface {
+	// MemberList returns the members of the cluster.
+	MemberList(ctx context.Context) ([]Member, error)
+	// MemberRemove removes a member from the cluster.
+	MemberRemove(ctx context.Context, id MemberID) error
+	// Status checks that the member serving the endpoint is up, it returns
+	// its etcd version.
+	Status(ctx context.Context, endpoint string) (string, error)
+}
+
+// Dialer connects to etcd clusters. The machine controller dials a new Client
+// whenever it checks or removes a member, since the endpoints of the ready
+// etcd machines change over the life of a cluster.
+type Dialer interface {
+	Dial(config *Config) (Client, error)
+}
+
+// DialerFunc is a function implementing Dialer.
+type DialerFunc func(config *Config) (Client, error)
+
+// Dial calls f.
+func (f DialerFunc) Dial(config *Config) (Client, error) {
+	return f(config)
+}
+
+// DefaultDialer connects to etcd clusters over the network.
+var DefaultDialer Dialer = DialerFunc(Dial)
+
+// Dial returns a Client of the etcd cluster. No request is made until the
+// client is used.
+func Dial(config *Config) (Client, error) {
+	if len(config.Endpoints) == 0 {
+		return nil, fmt.Errorf("no etcd endpoints")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(config.CA) {
+		return nil, fmt.Errorf("could not parse etcd ca certificate")
+	}
+	cert, err := tls.X509KeyPair(config.Cert, config.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse etcd client certificate")
+	}
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			RootCAs:      pool,
+			Certificates: []tls.Certificate{cert},
+		},
+		TLSHandshakeTimeout: 5 * time.Second,
+	}
+	return &client{
+		endpoints: config.Endpoints,
+		http:      &http.Client{Transport: transport, Timeout: RequestTimeout},
+	}, nil
+}
+
+type client struct {
+	endpoints []string
+	http      *http.Client
+}
+
+var _ Client = &client{}
+
+// post sends a request to the gateway of the endpoint, trying the paths of
+// older etcd releases if the endpoint does not serve the newest one.
+func (c *client) post(ctx context.Context, endpoint, path string, body, out interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	for _, prefix := range gatewayPrefixes {
+		url := strings.TrimSuffix(endpoint, "/") + prefix + path
+		request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		request = request.WithContext(ctx)
+		request.Header.Set("Content-Type", "application/json")
+		response, err := c.http.Do(request)
+		if err != nil {
+			return errors.Wrapf(err, "etcd %s failed", url)
+		}
+		result, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return errors.Wrapf(err, "could not read etcd %s response", url)
+		}
+		if response.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			return fmt.Errorf("etcd %s failed with %s: %s", url, response.Status, strings.TrimSpace(string(result)))
+		}
+		if out != nil {
+			if err := json.Unmarshal(result, out); err != nil {
+				return errors.Wrapf(err, "could not decode etcd %s response", url)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("etcd %s does not serve the json gateway", endpoint)
+}
+
+// postAny sends the request to the first endpoint answering it.
+func (c *client) postAny(ctx context.Context, path string, body, out interface{}) error {
+	var errs []string
+	for _, endpoint := range c.endpoints {
+		err := c.post(ctx, endpoint, path, body, out)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return fmt.Errorf("no etcd endpoint answered: %s", strings.Join(errs, "; "))
+}
+
+func (c *client) MemberList(ctx context.Context) ([]Member, error) {
+	var response struct {
+		Members []Member `json:"members"`
+	}
+	if err := c.postAny(ctx, "/cluster/member/list", struct{}{}, &response); err != nil {
+		return nil, err
+	}
+	return response.Members, nil
+}
+
+func (c *client) MemberRemove(ctx context.Context, id MemberID) error {
+	return c.postAny(ctx, "/cluster/member/remove", struct {
+		ID MemberID `json:"ID"`
+	}{ID: id}, nil)
+}
+
+func (c *client) Status(ctx context.Context, endpoint string) (string, error) {
+	var response struct {
+		Version string `json:"version"`
+	}
+	if err := c.post(ctx, endpoint, "/maintenance/status", struct{}{}, &response); err != nil {
+		return "", err
+	}
+	return response.Version, nil
+}
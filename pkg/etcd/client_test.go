@@ -0,0 +1,127 @@
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/cert"
+)
+
+// testGateway emulates the json gateway of an etcd 3.3 member, which serves
+// /v3beta only.
+type testGateway struct {
+	mu      sync.Mutex
+	members []map[string]interface{}
+	removed []string
+}
+
+func (g *testGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var body map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&body)
+	switch r.URL.Path {
+	case "/v3beta/cluster/member/list":
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"header":  map[string]string{"cluster_id": "1"},
+			"members": g.members,
+		})
+	case "/v3beta/cluster/member/remove":
+		g.removed = append(g.removed, body["ID"].(string))
+		json.NewEncoder(w).Encode(map[string]interface{}{})
+	case "/v3beta/maintenance/status":
+		json.NewEncoder(w).Encode(map[string]interface{}{"version": "3.3.10"})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestGateway(t *testing.T, g *testGateway) (*httptest.Server, *Config) {
+	bundle, err := cert.NewCABundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem, keyPem, err := bundle.EtcdClientCert("cma-ssh", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(bundle.Etcd)
+
+	server := httptest.NewUnstartedServer(g)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, &Config{Endpoints: []string{server.URL}, CA: serverCA, Cert: certPem, Key: keyPem}
+}
+
+func TestClient(t *testing.T) {
+	g := &testGateway{members: []map[string]interface{}{
+		{"ID": "10276657743932975437", "name": "etcd-a", "peerURLs": []string{"https://10.0.0.5:2380"}, "clientURLs": []string{"https://10.0.0.5:2379"}},
+		// ids encoded as numbers are accepted too
+		{"ID": uint64(9372538179322589801), "peerURLs": []string{"https://10.0.0.6:2380"}},
+	}}
+	server, config := newTestGateway(t, g)
+	defer server.Close()
+	// the first endpoint is down
+	config.Endpoints = append([]string{"https://127.0.0.1:1"}, config.Endpoints...)
+
+	c, err := Dial(config)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	ctx := context.Background()
+	members, err := c.MemberList(ctx)
+	if err != nil {
+		t.Fatalf("MemberList() error = %v", err)
+	}
+	want := []Member{
+		{ID: 10276657743932975437, Name: "etcd-a", PeerURLs: []string{"https://10.0.0.5:2380"}, ClientURLs: []string{"https://10.0.0.5:2379"}},
+		{ID: 9372538179322589801, PeerURLs: []string{"https://10.0.0.6:2380"}},
+	}
+	if !reflect.DeepEqual(members, want) {
+		t.Errorf("MemberList() = %+v, want %+v", members, want)
+	}
+
+	if err := c.MemberRemove(ctx, members[1].ID); err != nil {
+		t.Fatalf("MemberRemove() error = %v", err)
+	}
+	if want := []string{"9372538179322589801"}; !reflect.DeepEqual(g.removed, want) {
+		t.Errorf("removed %v, want %v", g.removed, want)
+	}
+
+	version, err := c.Status(ctx, server.URL)
+	if err != nil || version != "3.3.10" {
+		t.Errorf("Status() = %q, %v, want 3.3.10", version, err)
+	}
+	if _, err := c.Status(ctx, "https://127.0.0.1:1"); err == nil {
+		t.Errorf("Status() of a down endpoint succeeded")
+	}
+}
+
+func TestClient_untrustedCert(t *testing.T) {
+	server, config := newTestGateway(t, &testGateway{})
+	defer server.Close()
+	other, err := cert.NewCABundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Cert, config.Key, err = other.EtcdClientCert("cma-ssh", nil); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Dial(config)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	if _, err := c.MemberList(context.Background()); err == nil {
+		t.Errorf("MemberList() with a certificate of another ca succeeded")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory etcd.Dialer for tests.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/etcd"
+)
+
+// Cluster is a fake etcd cluster. Every endpoint connects to it.
+type Cluster struct {
+	// Down are the endpoints whose member is not serving.
+	Down map[string]bool
+	// Error is returned by every call if it is set.
+	Error error
+
+	mu      sync.Mutex
+	members []etcd.Member
+	removed []etcd.MemberID
+	config  etcd.Config
+}
+
+// New returns a cluster of the members.
+func New(members ...etcd.Member) *Cluster {
+	return &Cluster{members: members}
+}
+
+// Members returns the members of the cluster.
+func (c *Cluster) Members() []etcd.Member {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]etcd.Member(nil), c.members...)
+}
+
+// Removed returns the ids of the removed members.
+func (c *Cluster) Removed() []etcd.MemberID {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]etcd.MemberID(nil), c.removed...)
+}
+
+// Config returns the config of the last connection to the cluster.
+func (c *Cluster) Config() etcd.Config {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.config
+}
+
+// Dial connects to the cluster.
+func (c *Cluster) Dial(config *etcd.Config) (etcd.Client, error) {
+	if len(config.Endpoints) == 0 {
+		return nil, fmt.Errorf("no etcd endpoints")
+	}
+	c.mu.Lock()
+	c.config = *config
+	c.mu.Unlock()
+	return &client{cluster: c}, nil
+}
+
+type client struct {
+	cluster *Cluster
+}
+
+func (c *client) MemberList(ctx context.Context) ([]etcd.Member, error) {
+	if c.cluster.Error != nil {
+		return nil, c.cluster.Error
+	}
+	return c.cluster.Members(), nil
+}
+
+func (c *client) MemberRemove(ctx context.Context, id etcd.MemberID) error {
+	if c.cluster.Error != nil {
+		return c.cluster.Error
+	}
+	c.cluster.mu.Lock()
+	defer c.cluster.mu.Unlock()
+	for i, m := range c.cluster.members {
+		if m.ID == id {
+			c.cluster.members = append(c.cluster.members[:i], c.cluster.members[i+1:]...)
+			c.cluster.removed = append(c.cluster.removed, id)
+			return nil
+		}
+	}
+	return fmt.Errorf("etcdserver: member not found")
+}
+
+func (c *client) Status(ctx context.Context, endpoint string) (string, error) {
+	if c.cluster.Error != nil {
+		return "", c.cluster.Error
+	}
+	if c.cluster.Down[endpoint] {
+		return "", fmt.Errorf("etcd %s failed: connection refused", endpoint)
+	}
+	return "3.3.10", nil
+}
+
+var _ etcd.Dialer = &Cluster{}
@@ -82,10 +82,20 @@ type CreateClusterMsg struct {
 	// Machines which comprise the cluster control plane
 	ControlPlaneNodes *ControlPlaneMachineSpec `protobuf:"bytes,3,opt,name=control_plane_nodes,json=controlPlaneNodes,proto3" json:"control_plane_nodes,omitempty"`
 	// Machines which comprise the cluster
-	WorkerNodePools      []*MachineSpec `protobuf:"bytes,4,rep,name=worker_node_pools,json=workerNodePools,proto3" json:"worker_node_pools,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	WorkerNodePools []*MachineSpec `protobuf:"bytes,4,rep,name=worker_node_pools,json=workerNodePools,proto3" json:"worker_node_pools,omitempty"`
+	// Reject the request if MaaS does not have enough machines available
+	Preflight bool `protobuf:"varint,5,opt,name=preflight,proto3" json:"preflight,omitempty"`
+	// The CnctMaasRegion machines are allocated in, the default region if empty
+	MaasRegion string `protobuf:"bytes,6,opt,name=maas_region,json=maasRegion,proto3" json:"maas_region,omitempty"`
+	// The os of the MaaS images the machines are deployed with, e.g. ubuntu-bionic, ubuntu-xenial if empty
+	OsSeries string `protobuf:"bytes,7,opt,name=os_series,json=osSeries,proto3" json:"os_series,omitempty"`
+	// The stable address of the apiservers, the address of the first control plane machine if unset
+	ControlPlaneEndpoint *ControlPlaneEndpoint `protobuf:"bytes,8,opt,name=control_plane_endpoint,json=controlPlaneEndpoint,proto3" json:"control_plane_endpoint,omitempty"`
+	// Dedicated machines running an external etcd cluster, etcd runs on the control plane machines if unset
+	EtcdNodes            *ControlPlaneMachineSpec `protobuf:"bytes,9,opt,name=etcd_nodes,json=etcdNodes,proto3" json:"etcd_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *CreateClusterMsg) Reset()         { *m = CreateClusterMsg{} }
@@ -141,6 +151,118 @@ func (m *CreateClusterMsg) GetWorkerNodePools() []*MachineSpec {
 	return nil
 }
 
+func (m *CreateClusterMsg) GetPreflight() bool {
+	if m != nil {
+		return m.Preflight
+	}
+	return false
+}
+
+func (m *CreateClusterMsg) GetMaasRegion() string {
+	if m != nil {
+		return m.MaasRegion
+	}
+	return ""
+}
+
+func (m *CreateClusterMsg) GetOsSeries() string {
+	if m != nil {
+		return m.OsSeries
+	}
+	return ""
+}
+
+func (m *CreateClusterMsg) GetControlPlaneEndpoint() *ControlPlaneEndpoint {
+	if m != nil {
+		return m.ControlPlaneEndpoint
+	}
+	return nil
+}
+
+func (m *CreateClusterMsg) GetEtcdNodes() *ControlPlaneMachineSpec {
+	if m != nil {
+		return m.EtcdNodes
+	}
+	return nil
+}
+
+// The address of a load balancer or a virtual ip in front of the apiservers
+type ControlPlaneEndpoint struct {
+	// The ip or dns name of the endpoint, an external load balancer unless vip is set
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// The port of the endpoint, 6443 for a load balancer and 8443 for a virtual ip if unset
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Serve the host as a virtual ip of the control plane machines with keepalived and haproxy
+	Vip bool `protobuf:"varint,3,opt,name=vip,proto3" json:"vip,omitempty"`
+	// The interface the virtual ip is assigned to, the node interface or the interface of the default route if empty
+	VipInterface string `protobuf:"bytes,4,opt,name=vip_interface,json=vipInterface,proto3" json:"vip_interface,omitempty"`
+	// The vrrp router id of the virtual ip, unique on its network, 51 if unset
+	VirtualRouterId      int32    `protobuf:"varint,5,opt,name=virtual_router_id,json=virtualRouterId,proto3" json:"virtual_router_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ControlPlaneEndpoint) Reset()         { *m = ControlPlaneEndpoint{} }
+func (m *ControlPlaneEndpoint) String() string { return proto.CompactTextString(m) }
+func (*ControlPlaneEndpoint) ProtoMessage()    {}
+func (*ControlPlaneEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+func (m *ControlPlaneEndpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ControlPlaneEndpoint.Unmarshal(m, b)
+}
+func (m *ControlPlaneEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ControlPlaneEndpoint.Marshal(b, m, deterministic)
+}
+func (m *ControlPlaneEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlPlaneEndpoint.Merge(m, src)
+}
+func (m *ControlPlaneEndpoint) XXX_Size() int {
+	return xxx_messageInfo_ControlPlaneEndpoint.Size(m)
+}
+func (m *ControlPlaneEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlPlaneEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlPlaneEndpoint proto.InternalMessageInfo
+
+func (m *ControlPlaneEndpoint) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *ControlPlaneEndpoint) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *ControlPlaneEndpoint) GetVip() bool {
+	if m != nil {
+		return m.Vip
+	}
+	return false
+}
+
+func (m *ControlPlaneEndpoint) GetVipInterface() string {
+	if m != nil {
+		return m.VipInterface
+	}
+	return ""
+}
+
+func (m *ControlPlaneEndpoint) GetVirtualRouterId() int32 {
+	if m != nil {
+		return m.VirtualRouterId
+	}
+	return 0
+}
+
 type CreateClusterReply struct {
 	// Whether or not the cluster was provisioned by this request
 	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
@@ -155,7 +277,7 @@ func (m *CreateClusterReply) Reset()         { *m = CreateClusterReply{} }
 func (m *CreateClusterReply) String() string { return proto.CompactTextString(m) }
 func (*CreateClusterReply) ProtoMessage()    {}
 func (*CreateClusterReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
 }
 
 func (m *CreateClusterReply) XXX_Unmarshal(b []byte) error {
@@ -202,7 +324,7 @@ func (m *GetClusterMsg) Reset()         { *m = GetClusterMsg{} }
 func (m *GetClusterMsg) String() string { return proto.CompactTextString(m) }
 func (*GetClusterMsg) ProtoMessage()    {}
 func (*GetClusterMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 
 func (m *GetClusterMsg) XXX_Unmarshal(b []byte) error {
@@ -243,7 +365,7 @@ func (m *GetClusterReply) Reset()         { *m = GetClusterReply{} }
 func (m *GetClusterReply) String() string { return proto.CompactTextString(m) }
 func (*GetClusterReply) ProtoMessage()    {}
 func (*GetClusterReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 
 func (m *GetClusterReply) XXX_Unmarshal(b []byte) error {
@@ -290,7 +412,7 @@ func (m *DeleteClusterMsg) Reset()         { *m = DeleteClusterMsg{} }
 func (m *DeleteClusterMsg) String() string { return proto.CompactTextString(m) }
 func (*DeleteClusterMsg) ProtoMessage()    {}
 func (*DeleteClusterMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 func (m *DeleteClusterMsg) XXX_Unmarshal(b []byte) error {
@@ -332,7 +454,7 @@ func (m *DeleteClusterReply) Reset()         { *m = DeleteClusterReply{} }
 func (m *DeleteClusterReply) String() string { return proto.CompactTextString(m) }
 func (*DeleteClusterReply) ProtoMessage()    {}
 func (*DeleteClusterReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
 func (m *DeleteClusterReply) XXX_Unmarshal(b []byte) error {
@@ -377,7 +499,7 @@ func (m *GetClusterListMsg) Reset()         { *m = GetClusterListMsg{} }
 func (m *GetClusterListMsg) String() string { return proto.CompactTextString(m) }
 func (*GetClusterListMsg) ProtoMessage()    {}
 func (*GetClusterListMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
 func (m *GetClusterListMsg) XXX_Unmarshal(b []byte) error {
@@ -412,7 +534,7 @@ func (m *GetClusterListReply) Reset()         { *m = GetClusterListReply{} }
 func (m *GetClusterListReply) String() string { return proto.CompactTextString(m) }
 func (*GetClusterListReply) ProtoMessage()    {}
 func (*GetClusterListReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
 func (m *GetClusterListReply) XXX_Unmarshal(b []byte) error {
@@ -463,7 +585,7 @@ func (m *ClusterItem) Reset()         { *m = ClusterItem{} }
 func (m *ClusterItem) String() string { return proto.CompactTextString(m) }
 func (*ClusterItem) ProtoMessage()    {}
 func (*ClusterItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
 func (m *ClusterItem) XXX_Unmarshal(b []byte) error {
@@ -523,7 +645,7 @@ func (m *ClusterDetailItem) Reset()         { *m = ClusterDetailItem{} }
 func (m *ClusterDetailItem) String() string { return proto.CompactTextString(m) }
 func (*ClusterDetailItem) ProtoMessage()    {}
 func (*ClusterDetailItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
 func (m *ClusterDetailItem) XXX_Unmarshal(b []byte) error {
@@ -586,7 +708,7 @@ func (m *KubernetesLabel) Reset()         { *m = KubernetesLabel{} }
 func (m *KubernetesLabel) String() string { return proto.CompactTextString(m) }
 func (*KubernetesLabel) ProtoMessage()    {}
 func (*KubernetesLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 
 func (m *KubernetesLabel) XXX_Unmarshal(b []byte) error {
@@ -627,8 +749,15 @@ type ControlPlaneMachineSpec struct {
 	Labels []*KubernetesLabel `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
 	// Type of machines to provision (standard or gpu)
 	InstanceType string `protobuf:"bytes,2,opt,name=instanceType,proto3" json:"instanceType,omitempty"`
-	// The number of machines
-	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// The number of machines, 1 if unset. It must be odd so that the etcd
+	// members running on the machines keep quorum
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// MaaS allocation constraints for the machines
+	Constraints *MachineConstraints `protobuf:"bytes,4,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// MaaS zones the machines are spread across in order
+	Zones []string `protobuf:"bytes,5,rep,name=zones,proto3" json:"zones,omitempty"`
+	// Overrides the os series of the cluster for the control plane machines
+	OsSeries             string   `protobuf:"bytes,6,opt,name=os_series,json=osSeries,proto3" json:"os_series,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -638,7 +767,7 @@ func (m *ControlPlaneMachineSpec) Reset()         { *m = ControlPlaneMachineSpec
 func (m *ControlPlaneMachineSpec) String() string { return proto.CompactTextString(m) }
 func (*ControlPlaneMachineSpec) ProtoMessage()    {}
 func (*ControlPlaneMachineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
 
 func (m *ControlPlaneMachineSpec) XXX_Unmarshal(b []byte) error {
@@ -680,6 +809,27 @@ func (m *ControlPlaneMachineSpec) GetCount() int32 {
 	return 0
 }
 
+func (m *ControlPlaneMachineSpec) GetConstraints() *MachineConstraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+func (m *ControlPlaneMachineSpec) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *ControlPlaneMachineSpec) GetOsSeries() string {
+	if m != nil {
+		return m.OsSeries
+	}
+	return ""
+}
+
 // The specification for a set of machines
 type MachineSpec struct {
 	// The name of the machine set
@@ -689,7 +839,13 @@ type MachineSpec struct {
 	// Type of machines to provision (standard or gpu)
 	InstanceType string `protobuf:"bytes,3,opt,name=instanceType,proto3" json:"instanceType,omitempty"`
 	// The number of machines
-	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// MaaS allocation constraints for the machines
+	Constraints *MachineConstraints `protobuf:"bytes,5,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// How the machines are spread across MaaS zones
+	ZoneSpread *ZoneSpread `protobuf:"bytes,6,opt,name=zone_spread,json=zoneSpread,proto3" json:"zone_spread,omitempty"`
+	// Overrides the os series of the cluster for the machines of the set
+	OsSeries             string   `protobuf:"bytes,7,opt,name=os_series,json=osSeries,proto3" json:"os_series,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -699,7 +855,7 @@ func (m *MachineSpec) Reset()         { *m = MachineSpec{} }
 func (m *MachineSpec) String() string { return proto.CompactTextString(m) }
 func (*MachineSpec) ProtoMessage()    {}
 func (*MachineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 
 func (m *MachineSpec) XXX_Unmarshal(b []byte) error {
@@ -748,321 +904,1154 @@ func (m *MachineSpec) GetCount() int32 {
 	return 0
 }
 
-// Get version of API Server
-type GetVersionMsg struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MachineSpec) GetConstraints() *MachineConstraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
 }
 
-func (m *GetVersionMsg) Reset()         { *m = GetVersionMsg{} }
-func (m *GetVersionMsg) String() string { return proto.CompactTextString(m) }
-func (*GetVersionMsg) ProtoMessage()    {}
-func (*GetVersionMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+func (m *MachineSpec) GetZoneSpread() *ZoneSpread {
+	if m != nil {
+		return m.ZoneSpread
+	}
+	return nil
 }
 
-func (m *GetVersionMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetVersionMsg.Unmarshal(m, b)
-}
-func (m *GetVersionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetVersionMsg.Marshal(b, m, deterministic)
-}
-func (m *GetVersionMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetVersionMsg.Merge(m, src)
-}
-func (m *GetVersionMsg) XXX_Size() int {
-	return xxx_messageInfo_GetVersionMsg.Size(m)
-}
-func (m *GetVersionMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetVersionMsg.DiscardUnknown(m)
+func (m *MachineSpec) GetOsSeries() string {
+	if m != nil {
+		return m.OsSeries
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetVersionMsg proto.InternalMessageInfo
-
-// Reply for version request
-type GetVersionReply struct {
-	// If operation was OK
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	// Version Information
-	VersionInformation   *GetVersionReply_VersionInformation `protobuf:"bytes,2,opt,name=version_information,json=versionInformation,proto3" json:"version_information,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_unrecognized     []byte                              `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+// The spread of a set of machines across MaaS zones
+type ZoneSpread struct {
+	// Balanced spreads machines across all zones, Pinned across the given zones
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The zones to spread machines across with the Pinned policy
+	Zones                []string `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetVersionReply) Reset()         { *m = GetVersionReply{} }
-func (m *GetVersionReply) String() string { return proto.CompactTextString(m) }
-func (*GetVersionReply) ProtoMessage()    {}
-func (*GetVersionReply) Descriptor() ([]byte, []int) {
+func (m *ZoneSpread) Reset()         { *m = ZoneSpread{} }
+func (m *ZoneSpread) String() string { return proto.CompactTextString(m) }
+func (*ZoneSpread) ProtoMessage()    {}
+func (*ZoneSpread) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
-func (m *GetVersionReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetVersionReply.Unmarshal(m, b)
+func (m *ZoneSpread) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZoneSpread.Unmarshal(m, b)
 }
-func (m *GetVersionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetVersionReply.Marshal(b, m, deterministic)
+func (m *ZoneSpread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZoneSpread.Marshal(b, m, deterministic)
 }
-func (m *GetVersionReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetVersionReply.Merge(m, src)
+func (m *ZoneSpread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneSpread.Merge(m, src)
 }
-func (m *GetVersionReply) XXX_Size() int {
-	return xxx_messageInfo_GetVersionReply.Size(m)
+func (m *ZoneSpread) XXX_Size() int {
+	return xxx_messageInfo_ZoneSpread.Size(m)
 }
-func (m *GetVersionReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetVersionReply.DiscardUnknown(m)
+func (m *ZoneSpread) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneSpread.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetVersionReply proto.InternalMessageInfo
+var xxx_messageInfo_ZoneSpread proto.InternalMessageInfo
 
-func (m *GetVersionReply) GetOk() bool {
+func (m *ZoneSpread) GetPolicy() string {
 	if m != nil {
-		return m.Ok
+		return m.Policy
 	}
-	return false
+	return ""
 }
 
-func (m *GetVersionReply) GetVersionInformation() *GetVersionReply_VersionInformation {
+func (m *ZoneSpread) GetZones() []string {
 	if m != nil {
-		return m.VersionInformation
+		return m.Zones
 	}
 	return nil
 }
 
-type GetVersionReply_VersionInformation struct {
-	// The tag on the git repository
-	GitVersion string `protobuf:"bytes,1,opt,name=git_version,json=gitVersion,proto3" json:"git_version,omitempty"`
-	// The hash of the git commit
-	GitCommit string `protobuf:"bytes,2,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
-	// Whether or not the tree was clean when built
-	GitTreeState string `protobuf:"bytes,3,opt,name=git_tree_state,json=gitTreeState,proto3" json:"git_tree_state,omitempty"`
-	// Date of build
-	BuildDate string `protobuf:"bytes,4,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
-	// Version of go used to compile
-	GoVersion string `protobuf:"bytes,5,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
-	// Compiler used
-	Compiler string `protobuf:"bytes,6,opt,name=compiler,proto3" json:"compiler,omitempty"`
-	// Platform it was compiled for / running on
-	Platform             string   `protobuf:"bytes,7,opt,name=platform,proto3" json:"platform,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The MaaS allocation constraints for a set of machines
+type MachineConstraints struct {
+	// Minimum number of cpu cores
+	MinCpuCount int32 `protobuf:"varint,1,opt,name=min_cpu_count,json=minCpuCount,proto3" json:"min_cpu_count,omitempty"`
+	// Minimum amount of memory in MiB
+	MinMemory int32 `protobuf:"varint,2,opt,name=min_memory,json=minMemory,proto3" json:"min_memory,omitempty"`
+	// Architecture of the machines, e.g. amd64/generic
+	Architecture string `protobuf:"bytes,3,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// MaaS availability zone to allocate from
+	Zone string `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
+	// MaaS resource pool to allocate from
+	Pool string `protobuf:"bytes,5,opt,name=pool,proto3" json:"pool,omitempty"`
+	// MaaS tags the machines must have in addition to the instanceType tag
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// MaaS tags the machines must not have
+	NotTags []string `protobuf:"bytes,7,rep,name=not_tags,json=notTags,proto3" json:"not_tags,omitempty"`
+	// Disks the machines must have, the first is used for the root disk
+	Storage []*StorageConstraint `protobuf:"bytes,8,rep,name=storage,proto3" json:"storage,omitempty"`
+	// Network interfaces the machines must have
+	Interfaces           []*InterfaceConstraint `protobuf:"bytes,9,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *MachineConstraints) Reset()         { *m = MachineConstraints{} }
+func (m *MachineConstraints) String() string { return proto.CompactTextString(m) }
+func (*MachineConstraints) ProtoMessage()    {}
+func (*MachineConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 
-func (m *GetVersionReply_VersionInformation) Reset()         { *m = GetVersionReply_VersionInformation{} }
-func (m *GetVersionReply_VersionInformation) String() string { return proto.CompactTextString(m) }
-func (*GetVersionReply_VersionInformation) ProtoMessage()    {}
-func (*GetVersionReply_VersionInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14, 0}
+func (m *MachineConstraints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MachineConstraints.Unmarshal(m, b)
 }
-
-func (m *GetVersionReply_VersionInformation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetVersionReply_VersionInformation.Unmarshal(m, b)
+func (m *MachineConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MachineConstraints.Marshal(b, m, deterministic)
 }
-func (m *GetVersionReply_VersionInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetVersionReply_VersionInformation.Marshal(b, m, deterministic)
+func (m *MachineConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MachineConstraints.Merge(m, src)
 }
-func (m *GetVersionReply_VersionInformation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetVersionReply_VersionInformation.Merge(m, src)
+func (m *MachineConstraints) XXX_Size() int {
+	return xxx_messageInfo_MachineConstraints.Size(m)
 }
-func (m *GetVersionReply_VersionInformation) XXX_Size() int {
-	return xxx_messageInfo_GetVersionReply_VersionInformation.Size(m)
+func (m *MachineConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_MachineConstraints.DiscardUnknown(m)
 }
-func (m *GetVersionReply_VersionInformation) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetVersionReply_VersionInformation.DiscardUnknown(m)
+
+var xxx_messageInfo_MachineConstraints proto.InternalMessageInfo
+
+func (m *MachineConstraints) GetMinCpuCount() int32 {
+	if m != nil {
+		return m.MinCpuCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetVersionReply_VersionInformation proto.InternalMessageInfo
+func (m *MachineConstraints) GetMinMemory() int32 {
+	if m != nil {
+		return m.MinMemory
+	}
+	return 0
+}
 
-func (m *GetVersionReply_VersionInformation) GetGitVersion() string {
+func (m *MachineConstraints) GetArchitecture() string {
 	if m != nil {
-		return m.GitVersion
+		return m.Architecture
 	}
 	return ""
 }
 
-func (m *GetVersionReply_VersionInformation) GetGitCommit() string {
+func (m *MachineConstraints) GetZone() string {
 	if m != nil {
-		return m.GitCommit
+		return m.Zone
 	}
 	return ""
 }
 
-func (m *GetVersionReply_VersionInformation) GetGitTreeState() string {
+func (m *MachineConstraints) GetPool() string {
 	if m != nil {
-		return m.GitTreeState
+		return m.Pool
 	}
 	return ""
 }
 
-func (m *GetVersionReply_VersionInformation) GetBuildDate() string {
+func (m *MachineConstraints) GetTags() []string {
 	if m != nil {
-		return m.BuildDate
+		return m.Tags
 	}
-	return ""
+	return nil
 }
 
-func (m *GetVersionReply_VersionInformation) GetGoVersion() string {
+func (m *MachineConstraints) GetNotTags() []string {
 	if m != nil {
-		return m.GoVersion
+		return m.NotTags
 	}
-	return ""
+	return nil
 }
 
-func (m *GetVersionReply_VersionInformation) GetCompiler() string {
+func (m *MachineConstraints) GetStorage() []*StorageConstraint {
 	if m != nil {
-		return m.Compiler
+		return m.Storage
 	}
-	return ""
+	return nil
 }
 
-func (m *GetVersionReply_VersionInformation) GetPlatform() string {
+func (m *MachineConstraints) GetInterfaces() []*InterfaceConstraint {
 	if m != nil {
-		return m.Platform
+		return m.Interfaces
 	}
-	return ""
+	return nil
 }
 
-type GetUpgradeClusterInformationMsg struct {
-	// What is the cluster that we are considering for upgrade
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+// A disk of a minimum size
+type StorageConstraint struct {
+	// Optional label of the disk
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Minimum size of the disk in GB
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// MaaS tags the disk must have
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUpgradeClusterInformationMsg) Reset()         { *m = GetUpgradeClusterInformationMsg{} }
-func (m *GetUpgradeClusterInformationMsg) String() string { return proto.CompactTextString(m) }
-func (*GetUpgradeClusterInformationMsg) ProtoMessage()    {}
-func (*GetUpgradeClusterInformationMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+func (m *StorageConstraint) Reset()         { *m = StorageConstraint{} }
+func (m *StorageConstraint) String() string { return proto.CompactTextString(m) }
+func (*StorageConstraint) ProtoMessage()    {}
+func (*StorageConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 
-func (m *GetUpgradeClusterInformationMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUpgradeClusterInformationMsg.Unmarshal(m, b)
+func (m *StorageConstraint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageConstraint.Unmarshal(m, b)
 }
-func (m *GetUpgradeClusterInformationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUpgradeClusterInformationMsg.Marshal(b, m, deterministic)
+func (m *StorageConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageConstraint.Marshal(b, m, deterministic)
 }
-func (m *GetUpgradeClusterInformationMsg) XXX_Merge(src proto.Message) {
+func (m *StorageConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageConstraint.Merge(m, src)
+}
+func (m *StorageConstraint) XXX_Size() int {
+	return xxx_messageInfo_StorageConstraint.Size(m)
+}
+func (m *StorageConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageConstraint proto.InternalMessageInfo
+
+func (m *StorageConstraint) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *StorageConstraint) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *StorageConstraint) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// A network interface attached to a network
+type InterfaceConstraint struct {
+	// Label of the interface
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// MaaS space the interface is attached to
+	Space string `protobuf:"bytes,2,opt,name=space,proto3" json:"space,omitempty"`
+	// Name or cidr of the subnet the interface is attached to
+	Subnet string `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// MaaS fabric the interface is attached to
+	Fabric string `protobuf:"bytes,4,opt,name=fabric,proto3" json:"fabric,omitempty"`
+	// VLAN id the interface is attached to, 0 means any
+	Vid                  int32    `protobuf:"varint,5,opt,name=vid,proto3" json:"vid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InterfaceConstraint) Reset()         { *m = InterfaceConstraint{} }
+func (m *InterfaceConstraint) String() string { return proto.CompactTextString(m) }
+func (*InterfaceConstraint) ProtoMessage()    {}
+func (*InterfaceConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+}
+
+func (m *InterfaceConstraint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InterfaceConstraint.Unmarshal(m, b)
+}
+func (m *InterfaceConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InterfaceConstraint.Marshal(b, m, deterministic)
+}
+func (m *InterfaceConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterfaceConstraint.Merge(m, src)
+}
+func (m *InterfaceConstraint) XXX_Size() int {
+	return xxx_messageInfo_InterfaceConstraint.Size(m)
+}
+func (m *InterfaceConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterfaceConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterfaceConstraint proto.InternalMessageInfo
+
+func (m *InterfaceConstraint) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *InterfaceConstraint) GetSpace() string {
+	if m != nil {
+		return m.Space
+	}
+	return ""
+}
+
+func (m *InterfaceConstraint) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *InterfaceConstraint) GetFabric() string {
+	if m != nil {
+		return m.Fabric
+	}
+	return ""
+}
+
+func (m *InterfaceConstraint) GetVid() int32 {
+	if m != nil {
+		return m.Vid
+	}
+	return 0
+}
+
+// Get version of API Server
+type GetVersionMsg struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionMsg) Reset()         { *m = GetVersionMsg{} }
+func (m *GetVersionMsg) String() string { return proto.CompactTextString(m) }
+func (*GetVersionMsg) ProtoMessage()    {}
+func (*GetVersionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+}
+
+func (m *GetVersionMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionMsg.Unmarshal(m, b)
+}
+func (m *GetVersionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionMsg.Marshal(b, m, deterministic)
+}
+func (m *GetVersionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionMsg.Merge(m, src)
+}
+func (m *GetVersionMsg) XXX_Size() int {
+	return xxx_messageInfo_GetVersionMsg.Size(m)
+}
+func (m *GetVersionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionMsg proto.InternalMessageInfo
+
+// Reply for version request
+type GetVersionReply struct {
+	// If operation was OK
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	// Version Information
+	VersionInformation   *GetVersionReply_VersionInformation `protobuf:"bytes,2,opt,name=version_information,json=versionInformation,proto3" json:"version_information,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *GetVersionReply) Reset()         { *m = GetVersionReply{} }
+func (m *GetVersionReply) String() string { return proto.CompactTextString(m) }
+func (*GetVersionReply) ProtoMessage()    {}
+func (*GetVersionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+}
+
+func (m *GetVersionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionReply.Unmarshal(m, b)
+}
+func (m *GetVersionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionReply.Marshal(b, m, deterministic)
+}
+func (m *GetVersionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionReply.Merge(m, src)
+}
+func (m *GetVersionReply) XXX_Size() int {
+	return xxx_messageInfo_GetVersionReply.Size(m)
+}
+func (m *GetVersionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionReply proto.InternalMessageInfo
+
+func (m *GetVersionReply) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *GetVersionReply) GetVersionInformation() *GetVersionReply_VersionInformation {
+	if m != nil {
+		return m.VersionInformation
+	}
+	return nil
+}
+
+type GetVersionReply_VersionInformation struct {
+	// The tag on the git repository
+	GitVersion string `protobuf:"bytes,1,opt,name=git_version,json=gitVersion,proto3" json:"git_version,omitempty"`
+	// The hash of the git commit
+	GitCommit string `protobuf:"bytes,2,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	// Whether or not the tree was clean when built
+	GitTreeState string `protobuf:"bytes,3,opt,name=git_tree_state,json=gitTreeState,proto3" json:"git_tree_state,omitempty"`
+	// Date of build
+	BuildDate string `protobuf:"bytes,4,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	// Version of go used to compile
+	GoVersion string `protobuf:"bytes,5,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// Compiler used
+	Compiler string `protobuf:"bytes,6,opt,name=compiler,proto3" json:"compiler,omitempty"`
+	// Platform it was compiled for / running on
+	Platform             string   `protobuf:"bytes,7,opt,name=platform,proto3" json:"platform,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionReply_VersionInformation) Reset()         { *m = GetVersionReply_VersionInformation{} }
+func (m *GetVersionReply_VersionInformation) String() string { return proto.CompactTextString(m) }
+func (*GetVersionReply_VersionInformation) ProtoMessage()    {}
+func (*GetVersionReply_VersionInformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19, 0}
+}
+
+func (m *GetVersionReply_VersionInformation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionReply_VersionInformation.Unmarshal(m, b)
+}
+func (m *GetVersionReply_VersionInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionReply_VersionInformation.Marshal(b, m, deterministic)
+}
+func (m *GetVersionReply_VersionInformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionReply_VersionInformation.Merge(m, src)
+}
+func (m *GetVersionReply_VersionInformation) XXX_Size() int {
+	return xxx_messageInfo_GetVersionReply_VersionInformation.Size(m)
+}
+func (m *GetVersionReply_VersionInformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionReply_VersionInformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionReply_VersionInformation proto.InternalMessageInfo
+
+func (m *GetVersionReply_VersionInformation) GetGitVersion() string {
+	if m != nil {
+		return m.GitVersion
+	}
+	return ""
+}
+
+func (m *GetVersionReply_VersionInformation) GetGitCommit() string {
+	if m != nil {
+		return m.GitCommit
+	}
+	return ""
+}
+
+func (m *GetVersionReply_VersionInformation) GetGitTreeState() string {
+	if m != nil {
+		return m.GitTreeState
+	}
+	return ""
+}
+
+func (m *GetVersionReply_VersionInformation) GetBuildDate() string {
+	if m != nil {
+		return m.BuildDate
+	}
+	return ""
+}
+
+func (m *GetVersionReply_VersionInformation) GetGoVersion() string {
+	if m != nil {
+		return m.GoVersion
+	}
+	return ""
+}
+
+func (m *GetVersionReply_VersionInformation) GetCompiler() string {
+	if m != nil {
+		return m.Compiler
+	}
+	return ""
+}
+
+func (m *GetVersionReply_VersionInformation) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+type GetUpgradeClusterInformationMsg struct {
+	// What is the cluster that we are considering for upgrade
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUpgradeClusterInformationMsg) Reset()         { *m = GetUpgradeClusterInformationMsg{} }
+func (m *GetUpgradeClusterInformationMsg) String() string { return proto.CompactTextString(m) }
+func (*GetUpgradeClusterInformationMsg) ProtoMessage()    {}
+func (*GetUpgradeClusterInformationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+}
+
+func (m *GetUpgradeClusterInformationMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUpgradeClusterInformationMsg.Unmarshal(m, b)
+}
+func (m *GetUpgradeClusterInformationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUpgradeClusterInformationMsg.Marshal(b, m, deterministic)
+}
+func (m *GetUpgradeClusterInformationMsg) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_GetUpgradeClusterInformationMsg.Merge(m, src)
 }
-func (m *GetUpgradeClusterInformationMsg) XXX_Size() int {
-	return xxx_messageInfo_GetUpgradeClusterInformationMsg.Size(m)
+func (m *GetUpgradeClusterInformationMsg) XXX_Size() int {
+	return xxx_messageInfo_GetUpgradeClusterInformationMsg.Size(m)
+}
+func (m *GetUpgradeClusterInformationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUpgradeClusterInformationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUpgradeClusterInformationMsg proto.InternalMessageInfo
+
+func (m *GetUpgradeClusterInformationMsg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetUpgradeClusterInformationReply struct {
+	// Can the cluster be upgraded
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	// What versions are possible right now
+	Versions             []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUpgradeClusterInformationReply) Reset()         { *m = GetUpgradeClusterInformationReply{} }
+func (m *GetUpgradeClusterInformationReply) String() string { return proto.CompactTextString(m) }
+func (*GetUpgradeClusterInformationReply) ProtoMessage()    {}
+func (*GetUpgradeClusterInformationReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+}
+
+func (m *GetUpgradeClusterInformationReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUpgradeClusterInformationReply.Unmarshal(m, b)
+}
+func (m *GetUpgradeClusterInformationReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUpgradeClusterInformationReply.Marshal(b, m, deterministic)
+}
+func (m *GetUpgradeClusterInformationReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUpgradeClusterInformationReply.Merge(m, src)
+}
+func (m *GetUpgradeClusterInformationReply) XXX_Size() int {
+	return xxx_messageInfo_GetUpgradeClusterInformationReply.Size(m)
+}
+func (m *GetUpgradeClusterInformationReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUpgradeClusterInformationReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUpgradeClusterInformationReply proto.InternalMessageInfo
+
+func (m *GetUpgradeClusterInformationReply) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *GetUpgradeClusterInformationReply) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type UpgradeClusterMsg struct {
+	// What is the cluster that we are considering for upgrade
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// What version are we upgrading to?
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeClusterMsg) Reset()         { *m = UpgradeClusterMsg{} }
+func (m *UpgradeClusterMsg) String() string { return proto.CompactTextString(m) }
+func (*UpgradeClusterMsg) ProtoMessage()    {}
+func (*UpgradeClusterMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+}
+
+func (m *UpgradeClusterMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeClusterMsg.Unmarshal(m, b)
+}
+func (m *UpgradeClusterMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeClusterMsg.Marshal(b, m, deterministic)
+}
+func (m *UpgradeClusterMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeClusterMsg.Merge(m, src)
+}
+func (m *UpgradeClusterMsg) XXX_Size() int {
+	return xxx_messageInfo_UpgradeClusterMsg.Size(m)
+}
+func (m *UpgradeClusterMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeClusterMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeClusterMsg proto.InternalMessageInfo
+
+func (m *UpgradeClusterMsg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpgradeClusterMsg) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type UpgradeClusterReply struct {
+	// Was this a successful request
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeClusterReply) Reset()         { *m = UpgradeClusterReply{} }
+func (m *UpgradeClusterReply) String() string { return proto.CompactTextString(m) }
+func (*UpgradeClusterReply) ProtoMessage()    {}
+func (*UpgradeClusterReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+}
+
+func (m *UpgradeClusterReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeClusterReply.Unmarshal(m, b)
+}
+func (m *UpgradeClusterReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeClusterReply.Marshal(b, m, deterministic)
+}
+func (m *UpgradeClusterReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeClusterReply.Merge(m, src)
+}
+func (m *UpgradeClusterReply) XXX_Size() int {
+	return xxx_messageInfo_UpgradeClusterReply.Size(m)
+}
+func (m *UpgradeClusterReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeClusterReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeClusterReply proto.InternalMessageInfo
+
+func (m *UpgradeClusterReply) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type AddNodePoolMsg struct {
+	// What is the cluster to add node pools to
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	// What Machines to add to the cluster
+	WorkerNodePools      []*MachineSpec `protobuf:"bytes,2,rep,name=worker_node_pools,json=workerNodePools,proto3" json:"worker_node_pools,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AddNodePoolMsg) Reset()         { *m = AddNodePoolMsg{} }
+func (m *AddNodePoolMsg) String() string { return proto.CompactTextString(m) }
+func (*AddNodePoolMsg) ProtoMessage()    {}
+func (*AddNodePoolMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+}
+
+func (m *AddNodePoolMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddNodePoolMsg.Unmarshal(m, b)
+}
+func (m *AddNodePoolMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddNodePoolMsg.Marshal(b, m, deterministic)
+}
+func (m *AddNodePoolMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddNodePoolMsg.Merge(m, src)
+}
+func (m *AddNodePoolMsg) XXX_Size() int {
+	return xxx_messageInfo_AddNodePoolMsg.Size(m)
+}
+func (m *AddNodePoolMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddNodePoolMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddNodePoolMsg proto.InternalMessageInfo
+
+func (m *AddNodePoolMsg) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *AddNodePoolMsg) GetWorkerNodePools() []*MachineSpec {
+	if m != nil {
+		return m.WorkerNodePools
+	}
+	return nil
+}
+
+type AddNodePoolReply struct {
+	// Whether or not the node pool was provisioned by this request
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddNodePoolReply) Reset()         { *m = AddNodePoolReply{} }
+func (m *AddNodePoolReply) String() string { return proto.CompactTextString(m) }
+func (*AddNodePoolReply) ProtoMessage()    {}
+func (*AddNodePoolReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+}
+
+func (m *AddNodePoolReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddNodePoolReply.Unmarshal(m, b)
+}
+func (m *AddNodePoolReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddNodePoolReply.Marshal(b, m, deterministic)
+}
+func (m *AddNodePoolReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddNodePoolReply.Merge(m, src)
+}
+func (m *AddNodePoolReply) XXX_Size() int {
+	return xxx_messageInfo_AddNodePoolReply.Size(m)
+}
+func (m *AddNodePoolReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddNodePoolReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddNodePoolReply proto.InternalMessageInfo
+
+func (m *AddNodePoolReply) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type DeleteNodePoolMsg struct {
+	// What is the cluster to delete node pools
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	// What is the node pool names to delete
+	NodePoolNames        []string `protobuf:"bytes,2,rep,name=node_pool_names,json=nodePoolNames,proto3" json:"node_pool_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNodePoolMsg) Reset()         { *m = DeleteNodePoolMsg{} }
+func (m *DeleteNodePoolMsg) String() string { return proto.CompactTextString(m) }
+func (*DeleteNodePoolMsg) ProtoMessage()    {}
+func (*DeleteNodePoolMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+}
+
+func (m *DeleteNodePoolMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNodePoolMsg.Unmarshal(m, b)
+}
+func (m *DeleteNodePoolMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNodePoolMsg.Marshal(b, m, deterministic)
+}
+func (m *DeleteNodePoolMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNodePoolMsg.Merge(m, src)
+}
+func (m *DeleteNodePoolMsg) XXX_Size() int {
+	return xxx_messageInfo_DeleteNodePoolMsg.Size(m)
+}
+func (m *DeleteNodePoolMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNodePoolMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNodePoolMsg proto.InternalMessageInfo
+
+func (m *DeleteNodePoolMsg) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *DeleteNodePoolMsg) GetNodePoolNames() []string {
+	if m != nil {
+		return m.NodePoolNames
+	}
+	return nil
+}
+
+type GetClusterNodesStatusMsg struct {
+	// What is the name of the Cluster to query
+	ClusterName          string   `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClusterNodesStatusMsg) Reset()         { *m = GetClusterNodesStatusMsg{} }
+func (m *GetClusterNodesStatusMsg) String() string { return proto.CompactTextString(m) }
+func (*GetClusterNodesStatusMsg) ProtoMessage()    {}
+func (*GetClusterNodesStatusMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
-func (m *GetUpgradeClusterInformationMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUpgradeClusterInformationMsg.DiscardUnknown(m)
+
+func (m *GetClusterNodesStatusMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClusterNodesStatusMsg.Unmarshal(m, b)
+}
+func (m *GetClusterNodesStatusMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClusterNodesStatusMsg.Marshal(b, m, deterministic)
+}
+func (m *GetClusterNodesStatusMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterNodesStatusMsg.Merge(m, src)
+}
+func (m *GetClusterNodesStatusMsg) XXX_Size() int {
+	return xxx_messageInfo_GetClusterNodesStatusMsg.Size(m)
+}
+func (m *GetClusterNodesStatusMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterNodesStatusMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUpgradeClusterInformationMsg proto.InternalMessageInfo
+var xxx_messageInfo_GetClusterNodesStatusMsg proto.InternalMessageInfo
 
-func (m *GetUpgradeClusterInformationMsg) GetName() string {
+func (m *GetClusterNodesStatusMsg) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+// GetClusterNodesStatusReply is the response to GetNodePool
+// or cluster machines status
+type GetClusterNodesStatusReply struct {
+	// The name of the cluster
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Count of machines in cluster
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Gets list of nodes in a cluster
+	Machines             []*GetClusterNodesStatusReply_MachineStatus `protobuf:"bytes,3,rep,name=machines,proto3" json:"machines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *GetClusterNodesStatusReply) Reset()         { *m = GetClusterNodesStatusReply{} }
+func (m *GetClusterNodesStatusReply) String() string { return proto.CompactTextString(m) }
+func (*GetClusterNodesStatusReply) ProtoMessage()    {}
+func (*GetClusterNodesStatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+}
+
+func (m *GetClusterNodesStatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClusterNodesStatusReply.Unmarshal(m, b)
+}
+func (m *GetClusterNodesStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClusterNodesStatusReply.Marshal(b, m, deterministic)
+}
+func (m *GetClusterNodesStatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterNodesStatusReply.Merge(m, src)
+}
+func (m *GetClusterNodesStatusReply) XXX_Size() int {
+	return xxx_messageInfo_GetClusterNodesStatusReply.Size(m)
+}
+func (m *GetClusterNodesStatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterNodesStatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterNodesStatusReply proto.InternalMessageInfo
+
+func (m *GetClusterNodesStatusReply) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-type GetUpgradeClusterInformationReply struct {
-	// Can the cluster be upgraded
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	// What versions are possible right now
-	Versions             []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+func (m *GetClusterNodesStatusReply) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GetClusterNodesStatusReply) GetMachines() []*GetClusterNodesStatusReply_MachineStatus {
+	if m != nil {
+		return m.Machines
+	}
+	return nil
+}
+
+// The status of a machine
+type GetClusterNodesStatusReply_MachineStatus struct {
+	// Node k8s status
+	K8SNodeStatus string `protobuf:"bytes,1,opt,name=k8sNodeStatus,proto3" json:"k8sNodeStatus,omitempty"`
+	// Kubernetes Version
+	K8SVersion string `protobuf:"bytes,2,opt,name=k8sVersion,proto3" json:"k8sVersion,omitempty"`
+	// MaaS Node system_id
+	MaasSystemId string `protobuf:"bytes,4,opt,name=maasSystemId,proto3" json:"maasSystemId,omitempty"`
+	// MaaS Node hostname
+	MaasHostname string `protobuf:"bytes,5,opt,name=maasHostname,proto3" json:"maasHostname,omitempty"`
+	// MaaS node status
+	MaasNodeStatus string `protobuf:"bytes,6,opt,name=maasNodeStatus,proto3" json:"maasNodeStatus,omitempty"`
+	// MaaS IP Address
+	MaasIPAddr           string   `protobuf:"bytes,7,opt,name=maasIPAddr,proto3" json:"maasIPAddr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUpgradeClusterInformationReply) Reset()         { *m = GetUpgradeClusterInformationReply{} }
-func (m *GetUpgradeClusterInformationReply) String() string { return proto.CompactTextString(m) }
-func (*GetUpgradeClusterInformationReply) ProtoMessage()    {}
-func (*GetUpgradeClusterInformationReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+func (m *GetClusterNodesStatusReply_MachineStatus) Reset() {
+	*m = GetClusterNodesStatusReply_MachineStatus{}
+}
+func (m *GetClusterNodesStatusReply_MachineStatus) String() string { return proto.CompactTextString(m) }
+func (*GetClusterNodesStatusReply_MachineStatus) ProtoMessage()    {}
+func (*GetClusterNodesStatusReply_MachineStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28, 0}
 }
 
-func (m *GetUpgradeClusterInformationReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUpgradeClusterInformationReply.Unmarshal(m, b)
+func (m *GetClusterNodesStatusReply_MachineStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.Unmarshal(m, b)
 }
-func (m *GetUpgradeClusterInformationReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUpgradeClusterInformationReply.Marshal(b, m, deterministic)
+func (m *GetClusterNodesStatusReply_MachineStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.Marshal(b, m, deterministic)
 }
-func (m *GetUpgradeClusterInformationReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUpgradeClusterInformationReply.Merge(m, src)
+func (m *GetClusterNodesStatusReply_MachineStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.Merge(m, src)
 }
-func (m *GetUpgradeClusterInformationReply) XXX_Size() int {
-	return xxx_messageInfo_GetUpgradeClusterInformationReply.Size(m)
+func (m *GetClusterNodesStatusReply_MachineStatus) XXX_Size() int {
+	return xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.Size(m)
 }
-func (m *GetUpgradeClusterInformationReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUpgradeClusterInformationReply.DiscardUnknown(m)
+func (m *GetClusterNodesStatusReply_MachineStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUpgradeClusterInformationReply proto.InternalMessageInfo
+var xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus proto.InternalMessageInfo
+
+func (m *GetClusterNodesStatusReply_MachineStatus) GetK8SNodeStatus() string {
+	if m != nil {
+		return m.K8SNodeStatus
+	}
+	return ""
+}
+
+func (m *GetClusterNodesStatusReply_MachineStatus) GetK8SVersion() string {
+	if m != nil {
+		return m.K8SVersion
+	}
+	return ""
+}
+
+func (m *GetClusterNodesStatusReply_MachineStatus) GetMaasSystemId() string {
+	if m != nil {
+		return m.MaasSystemId
+	}
+	return ""
+}
+
+func (m *GetClusterNodesStatusReply_MachineStatus) GetMaasHostname() string {
+	if m != nil {
+		return m.MaasHostname
+	}
+	return ""
+}
+
+func (m *GetClusterNodesStatusReply_MachineStatus) GetMaasNodeStatus() string {
+	if m != nil {
+		return m.MaasNodeStatus
+	}
+	return ""
+}
+
+func (m *GetClusterNodesStatusReply_MachineStatus) GetMaasIPAddr() string {
+	if m != nil {
+		return m.MaasIPAddr
+	}
+	return ""
+}
+
+type DeleteNodePoolReply struct {
+	// Was this a successful request
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNodePoolReply) Reset()         { *m = DeleteNodePoolReply{} }
+func (m *DeleteNodePoolReply) String() string { return proto.CompactTextString(m) }
+func (*DeleteNodePoolReply) ProtoMessage()    {}
+func (*DeleteNodePoolReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+}
+
+func (m *DeleteNodePoolReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNodePoolReply.Unmarshal(m, b)
+}
+func (m *DeleteNodePoolReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNodePoolReply.Marshal(b, m, deterministic)
+}
+func (m *DeleteNodePoolReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNodePoolReply.Merge(m, src)
+}
+func (m *DeleteNodePoolReply) XXX_Size() int {
+	return xxx_messageInfo_DeleteNodePoolReply.Size(m)
+}
+func (m *DeleteNodePoolReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNodePoolReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNodePoolReply proto.InternalMessageInfo
+
+func (m *DeleteNodePoolReply) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type ScaleNodePoolMsg struct {
+	// What is the name of the cluster to scale a node pool
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	// What node pools to scale
+	NodePools []*ScaleNodePoolSpec `protobuf:"bytes,2,rep,name=node_pools,json=nodePools,proto3" json:"node_pools,omitempty"`
+	// Reject the request if MaaS does not have enough machines available
+	Preflight            bool     `protobuf:"varint,3,opt,name=preflight,proto3" json:"preflight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScaleNodePoolMsg) Reset()         { *m = ScaleNodePoolMsg{} }
+func (m *ScaleNodePoolMsg) String() string { return proto.CompactTextString(m) }
+func (*ScaleNodePoolMsg) ProtoMessage()    {}
+func (*ScaleNodePoolMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+}
+
+func (m *ScaleNodePoolMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScaleNodePoolMsg.Unmarshal(m, b)
+}
+func (m *ScaleNodePoolMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScaleNodePoolMsg.Marshal(b, m, deterministic)
+}
+func (m *ScaleNodePoolMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScaleNodePoolMsg.Merge(m, src)
+}
+func (m *ScaleNodePoolMsg) XXX_Size() int {
+	return xxx_messageInfo_ScaleNodePoolMsg.Size(m)
+}
+func (m *ScaleNodePoolMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScaleNodePoolMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScaleNodePoolMsg proto.InternalMessageInfo
 
-func (m *GetUpgradeClusterInformationReply) GetOk() bool {
+func (m *ScaleNodePoolMsg) GetClusterName() string {
 	if m != nil {
-		return m.Ok
+		return m.ClusterName
 	}
-	return false
+	return ""
 }
 
-func (m *GetUpgradeClusterInformationReply) GetVersions() []string {
+func (m *ScaleNodePoolMsg) GetNodePools() []*ScaleNodePoolSpec {
 	if m != nil {
-		return m.Versions
+		return m.NodePools
 	}
 	return nil
 }
 
-type UpgradeClusterMsg struct {
-	// What is the cluster that we are considering for upgrade
+func (m *ScaleNodePoolMsg) GetPreflight() bool {
+	if m != nil {
+		return m.Preflight
+	}
+	return false
+}
+
+type ScaleNodePoolSpec struct {
+	// What is the node pool name to scale
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// What version are we upgrading to?
-	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Number of machines to scale
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpgradeClusterMsg) Reset()         { *m = UpgradeClusterMsg{} }
-func (m *UpgradeClusterMsg) String() string { return proto.CompactTextString(m) }
-func (*UpgradeClusterMsg) ProtoMessage()    {}
-func (*UpgradeClusterMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+func (m *ScaleNodePoolSpec) Reset()         { *m = ScaleNodePoolSpec{} }
+func (m *ScaleNodePoolSpec) String() string { return proto.CompactTextString(m) }
+func (*ScaleNodePoolSpec) ProtoMessage()    {}
+func (*ScaleNodePoolSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 
-func (m *UpgradeClusterMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpgradeClusterMsg.Unmarshal(m, b)
+func (m *ScaleNodePoolSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScaleNodePoolSpec.Unmarshal(m, b)
 }
-func (m *UpgradeClusterMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpgradeClusterMsg.Marshal(b, m, deterministic)
+func (m *ScaleNodePoolSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScaleNodePoolSpec.Marshal(b, m, deterministic)
 }
-func (m *UpgradeClusterMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeClusterMsg.Merge(m, src)
+func (m *ScaleNodePoolSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScaleNodePoolSpec.Merge(m, src)
 }
-func (m *UpgradeClusterMsg) XXX_Size() int {
-	return xxx_messageInfo_UpgradeClusterMsg.Size(m)
+func (m *ScaleNodePoolSpec) XXX_Size() int {
+	return xxx_messageInfo_ScaleNodePoolSpec.Size(m)
 }
-func (m *UpgradeClusterMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeClusterMsg.DiscardUnknown(m)
+func (m *ScaleNodePoolSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScaleNodePoolSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeClusterMsg proto.InternalMessageInfo
+var xxx_messageInfo_ScaleNodePoolSpec proto.InternalMessageInfo
 
-func (m *UpgradeClusterMsg) GetName() string {
+func (m *ScaleNodePoolSpec) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *UpgradeClusterMsg) GetVersion() string {
+func (m *ScaleNodePoolSpec) GetCount() int32 {
 	if m != nil {
-		return m.Version
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-type UpgradeClusterReply struct {
+type ScaleNodePoolReply struct {
 	// Was this a successful request
 	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1070,545 +2059,649 @@ type UpgradeClusterReply struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpgradeClusterReply) Reset()         { *m = UpgradeClusterReply{} }
-func (m *UpgradeClusterReply) String() string { return proto.CompactTextString(m) }
-func (*UpgradeClusterReply) ProtoMessage()    {}
-func (*UpgradeClusterReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+func (m *ScaleNodePoolReply) Reset()         { *m = ScaleNodePoolReply{} }
+func (m *ScaleNodePoolReply) String() string { return proto.CompactTextString(m) }
+func (*ScaleNodePoolReply) ProtoMessage()    {}
+func (*ScaleNodePoolReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 
-func (m *UpgradeClusterReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpgradeClusterReply.Unmarshal(m, b)
+func (m *ScaleNodePoolReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScaleNodePoolReply.Unmarshal(m, b)
 }
-func (m *UpgradeClusterReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpgradeClusterReply.Marshal(b, m, deterministic)
+func (m *ScaleNodePoolReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScaleNodePoolReply.Marshal(b, m, deterministic)
 }
-func (m *UpgradeClusterReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeClusterReply.Merge(m, src)
+func (m *ScaleNodePoolReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScaleNodePoolReply.Merge(m, src)
 }
-func (m *UpgradeClusterReply) XXX_Size() int {
-	return xxx_messageInfo_UpgradeClusterReply.Size(m)
+func (m *ScaleNodePoolReply) XXX_Size() int {
+	return xxx_messageInfo_ScaleNodePoolReply.Size(m)
 }
-func (m *UpgradeClusterReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeClusterReply.DiscardUnknown(m)
+func (m *ScaleNodePoolReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScaleNodePoolReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeClusterReply proto.InternalMessageInfo
+var xxx_messageInfo_ScaleNodePoolReply proto.InternalMessageInfo
 
-func (m *UpgradeClusterReply) GetOk() bool {
+func (m *ScaleNodePoolReply) GetOk() bool {
 	if m != nil {
 		return m.Ok
 	}
 	return false
 }
 
-type AddNodePoolMsg struct {
-	// What is the cluster to add node pools to
+type RebootMachineMsg struct {
+	// What is the name of the cluster of the machine
 	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	// What Machines to add to the cluster
-	WorkerNodePools      []*MachineSpec `protobuf:"bytes,2,rep,name=worker_node_pools,json=workerNodePools,proto3" json:"worker_node_pools,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// What is the name of the machine to reboot
+	MachineName          string   `protobuf:"bytes,2,opt,name=machineName,proto3" json:"machineName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddNodePoolMsg) Reset()         { *m = AddNodePoolMsg{} }
-func (m *AddNodePoolMsg) String() string { return proto.CompactTextString(m) }
-func (*AddNodePoolMsg) ProtoMessage()    {}
-func (*AddNodePoolMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+func (m *RebootMachineMsg) Reset()         { *m = RebootMachineMsg{} }
+func (m *RebootMachineMsg) String() string { return proto.CompactTextString(m) }
+func (*RebootMachineMsg) ProtoMessage()    {}
+func (*RebootMachineMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 
-func (m *AddNodePoolMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddNodePoolMsg.Unmarshal(m, b)
+func (m *RebootMachineMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebootMachineMsg.Unmarshal(m, b)
 }
-func (m *AddNodePoolMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddNodePoolMsg.Marshal(b, m, deterministic)
+func (m *RebootMachineMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebootMachineMsg.Marshal(b, m, deterministic)
 }
-func (m *AddNodePoolMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddNodePoolMsg.Merge(m, src)
+func (m *RebootMachineMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebootMachineMsg.Merge(m, src)
 }
-func (m *AddNodePoolMsg) XXX_Size() int {
-	return xxx_messageInfo_AddNodePoolMsg.Size(m)
+func (m *RebootMachineMsg) XXX_Size() int {
+	return xxx_messageInfo_RebootMachineMsg.Size(m)
 }
-func (m *AddNodePoolMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddNodePoolMsg.DiscardUnknown(m)
+func (m *RebootMachineMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebootMachineMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddNodePoolMsg proto.InternalMessageInfo
+var xxx_messageInfo_RebootMachineMsg proto.InternalMessageInfo
 
-func (m *AddNodePoolMsg) GetClusterName() string {
+func (m *RebootMachineMsg) GetClusterName() string {
 	if m != nil {
 		return m.ClusterName
 	}
 	return ""
 }
 
-func (m *AddNodePoolMsg) GetWorkerNodePools() []*MachineSpec {
+func (m *RebootMachineMsg) GetMachineName() string {
 	if m != nil {
-		return m.WorkerNodePools
+		return m.MachineName
 	}
-	return nil
+	return ""
 }
 
-type AddNodePoolReply struct {
-	// Whether or not the node pool was provisioned by this request
+type RebootMachineReply struct {
+	// Was this a successful request
 	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddNodePoolReply) Reset()         { *m = AddNodePoolReply{} }
-func (m *AddNodePoolReply) String() string { return proto.CompactTextString(m) }
-func (*AddNodePoolReply) ProtoMessage()    {}
-func (*AddNodePoolReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+func (m *RebootMachineReply) Reset()         { *m = RebootMachineReply{} }
+func (m *RebootMachineReply) String() string { return proto.CompactTextString(m) }
+func (*RebootMachineReply) ProtoMessage()    {}
+func (*RebootMachineReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *AddNodePoolReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddNodePoolReply.Unmarshal(m, b)
+func (m *RebootMachineReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebootMachineReply.Unmarshal(m, b)
 }
-func (m *AddNodePoolReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddNodePoolReply.Marshal(b, m, deterministic)
+func (m *RebootMachineReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebootMachineReply.Marshal(b, m, deterministic)
 }
-func (m *AddNodePoolReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddNodePoolReply.Merge(m, src)
+func (m *RebootMachineReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebootMachineReply.Merge(m, src)
 }
-func (m *AddNodePoolReply) XXX_Size() int {
-	return xxx_messageInfo_AddNodePoolReply.Size(m)
+func (m *RebootMachineReply) XXX_Size() int {
+	return xxx_messageInfo_RebootMachineReply.Size(m)
 }
-func (m *AddNodePoolReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddNodePoolReply.DiscardUnknown(m)
+func (m *RebootMachineReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebootMachineReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddNodePoolReply proto.InternalMessageInfo
+var xxx_messageInfo_RebootMachineReply proto.InternalMessageInfo
 
-func (m *AddNodePoolReply) GetOk() bool {
+func (m *RebootMachineReply) GetOk() bool {
 	if m != nil {
 		return m.Ok
 	}
 	return false
 }
 
-type DeleteNodePoolMsg struct {
-	// What is the cluster to delete node pools
-	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	// What is the node pool names to delete
-	NodePoolNames        []string `protobuf:"bytes,2,rep,name=node_pool_names,json=nodePoolNames,proto3" json:"node_pool_names,omitempty"`
+type GetCapacityMsg struct {
+	// Instance types to report, all machine tags if empty
+	InstanceTypes []string `protobuf:"bytes,1,rep,name=instance_types,json=instanceTypes,proto3" json:"instance_types,omitempty"`
+	// Only report machines in this MaaS zone
+	Zone string `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	// Only report machines in this MaaS resource pool
+	Pool string `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	// The MaaS region to report, the default region if empty
+	MaasRegion           string   `protobuf:"bytes,4,opt,name=maas_region,json=maasRegion,proto3" json:"maas_region,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteNodePoolMsg) Reset()         { *m = DeleteNodePoolMsg{} }
-func (m *DeleteNodePoolMsg) String() string { return proto.CompactTextString(m) }
-func (*DeleteNodePoolMsg) ProtoMessage()    {}
-func (*DeleteNodePoolMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+func (m *GetCapacityMsg) Reset()         { *m = GetCapacityMsg{} }
+func (m *GetCapacityMsg) String() string { return proto.CompactTextString(m) }
+func (*GetCapacityMsg) ProtoMessage()    {}
+func (*GetCapacityMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 
-func (m *DeleteNodePoolMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteNodePoolMsg.Unmarshal(m, b)
+func (m *GetCapacityMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapacityMsg.Unmarshal(m, b)
 }
-func (m *DeleteNodePoolMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteNodePoolMsg.Marshal(b, m, deterministic)
+func (m *GetCapacityMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapacityMsg.Marshal(b, m, deterministic)
 }
-func (m *DeleteNodePoolMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteNodePoolMsg.Merge(m, src)
+func (m *GetCapacityMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapacityMsg.Merge(m, src)
 }
-func (m *DeleteNodePoolMsg) XXX_Size() int {
-	return xxx_messageInfo_DeleteNodePoolMsg.Size(m)
+func (m *GetCapacityMsg) XXX_Size() int {
+	return xxx_messageInfo_GetCapacityMsg.Size(m)
 }
-func (m *DeleteNodePoolMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteNodePoolMsg.DiscardUnknown(m)
+func (m *GetCapacityMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapacityMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteNodePoolMsg proto.InternalMessageInfo
+var xxx_messageInfo_GetCapacityMsg proto.InternalMessageInfo
 
-func (m *DeleteNodePoolMsg) GetClusterName() string {
+func (m *GetCapacityMsg) GetInstanceTypes() []string {
 	if m != nil {
-		return m.ClusterName
+		return m.InstanceTypes
+	}
+	return nil
+}
+
+func (m *GetCapacityMsg) GetZone() string {
+	if m != nil {
+		return m.Zone
 	}
 	return ""
 }
 
-func (m *DeleteNodePoolMsg) GetNodePoolNames() []string {
+func (m *GetCapacityMsg) GetPool() string {
 	if m != nil {
-		return m.NodePoolNames
+		return m.Pool
 	}
-	return nil
+	return ""
 }
 
-type GetClusterNodesStatusMsg struct {
-	// What is the name of the Cluster to query
-	ClusterName          string   `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetCapacityMsg) GetMaasRegion() string {
+	if m != nil {
+		return m.MaasRegion
+	}
+	return ""
 }
 
-func (m *GetClusterNodesStatusMsg) Reset()         { *m = GetClusterNodesStatusMsg{} }
-func (m *GetClusterNodesStatusMsg) String() string { return proto.CompactTextString(m) }
-func (*GetClusterNodesStatusMsg) ProtoMessage()    {}
-func (*GetClusterNodesStatusMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+type GetCapacityReply struct {
+	// The available machines grouped by instance type, zone and pool
+	Capacity             []*CapacityItem `protobuf:"bytes,1,rep,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetClusterNodesStatusMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClusterNodesStatusMsg.Unmarshal(m, b)
+func (m *GetCapacityReply) Reset()         { *m = GetCapacityReply{} }
+func (m *GetCapacityReply) String() string { return proto.CompactTextString(m) }
+func (*GetCapacityReply) ProtoMessage()    {}
+func (*GetCapacityReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
-func (m *GetClusterNodesStatusMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClusterNodesStatusMsg.Marshal(b, m, deterministic)
+
+func (m *GetCapacityReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapacityReply.Unmarshal(m, b)
 }
-func (m *GetClusterNodesStatusMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterNodesStatusMsg.Merge(m, src)
+func (m *GetCapacityReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapacityReply.Marshal(b, m, deterministic)
 }
-func (m *GetClusterNodesStatusMsg) XXX_Size() int {
-	return xxx_messageInfo_GetClusterNodesStatusMsg.Size(m)
+func (m *GetCapacityReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapacityReply.Merge(m, src)
 }
-func (m *GetClusterNodesStatusMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterNodesStatusMsg.DiscardUnknown(m)
+func (m *GetCapacityReply) XXX_Size() int {
+	return xxx_messageInfo_GetCapacityReply.Size(m)
+}
+func (m *GetCapacityReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapacityReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClusterNodesStatusMsg proto.InternalMessageInfo
+var xxx_messageInfo_GetCapacityReply proto.InternalMessageInfo
 
-func (m *GetClusterNodesStatusMsg) GetClusterName() string {
+func (m *GetCapacityReply) GetCapacity() []*CapacityItem {
 	if m != nil {
-		return m.ClusterName
+		return m.Capacity
 	}
-	return ""
+	return nil
 }
 
-// GetClusterNodesStatusReply is the response to GetNodePool
-// or cluster machines status
-type GetClusterNodesStatusReply struct {
-	// The name of the cluster
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	// Count of machines in cluster
-	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	// Gets list of nodes in a cluster
-	Machines             []*GetClusterNodesStatusReply_MachineStatus `protobuf:"bytes,3,rep,name=machines,proto3" json:"machines,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
-	XXX_unrecognized     []byte                                      `json:"-"`
-	XXX_sizecache        int32                                       `json:"-"`
+type CapacityItem struct {
+	// The instance type, i.e. the MaaS tag, of the machines
+	InstanceType string `protobuf:"bytes,1,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	// The MaaS zone of the machines
+	Zone string `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	// The MaaS resource pool of the machines
+	Pool string `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	// The number of machines ready to be allocated
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// The machines ready to be allocated
+	Machines             []*MachineHardware `protobuf:"bytes,5,rep,name=machines,proto3" json:"machines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *GetClusterNodesStatusReply) Reset()         { *m = GetClusterNodesStatusReply{} }
-func (m *GetClusterNodesStatusReply) String() string { return proto.CompactTextString(m) }
-func (*GetClusterNodesStatusReply) ProtoMessage()    {}
-func (*GetClusterNodesStatusReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+func (m *CapacityItem) Reset()         { *m = CapacityItem{} }
+func (m *CapacityItem) String() string { return proto.CompactTextString(m) }
+func (*CapacityItem) ProtoMessage()    {}
+func (*CapacityItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
 
-func (m *GetClusterNodesStatusReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClusterNodesStatusReply.Unmarshal(m, b)
+func (m *CapacityItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityItem.Unmarshal(m, b)
 }
-func (m *GetClusterNodesStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClusterNodesStatusReply.Marshal(b, m, deterministic)
+func (m *CapacityItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityItem.Marshal(b, m, deterministic)
 }
-func (m *GetClusterNodesStatusReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterNodesStatusReply.Merge(m, src)
+func (m *CapacityItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityItem.Merge(m, src)
 }
-func (m *GetClusterNodesStatusReply) XXX_Size() int {
-	return xxx_messageInfo_GetClusterNodesStatusReply.Size(m)
+func (m *CapacityItem) XXX_Size() int {
+	return xxx_messageInfo_CapacityItem.Size(m)
 }
-func (m *GetClusterNodesStatusReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterNodesStatusReply.DiscardUnknown(m)
+func (m *CapacityItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClusterNodesStatusReply proto.InternalMessageInfo
+var xxx_messageInfo_CapacityItem proto.InternalMessageInfo
 
-func (m *GetClusterNodesStatusReply) GetName() string {
+func (m *CapacityItem) GetInstanceType() string {
 	if m != nil {
-		return m.Name
+		return m.InstanceType
 	}
 	return ""
 }
 
-func (m *GetClusterNodesStatusReply) GetCount() int32 {
+func (m *CapacityItem) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *CapacityItem) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *CapacityItem) GetCount() int32 {
 	if m != nil {
 		return m.Count
 	}
 	return 0
 }
 
-func (m *GetClusterNodesStatusReply) GetMachines() []*GetClusterNodesStatusReply_MachineStatus {
+func (m *CapacityItem) GetMachines() []*MachineHardware {
 	if m != nil {
 		return m.Machines
 	}
 	return nil
 }
 
-// The status of a machine
-type GetClusterNodesStatusReply_MachineStatus struct {
-	// Node k8s status
-	K8SNodeStatus string `protobuf:"bytes,1,opt,name=k8sNodeStatus,proto3" json:"k8sNodeStatus,omitempty"`
-	// Kubernetes Version
-	K8SVersion string `protobuf:"bytes,2,opt,name=k8sVersion,proto3" json:"k8sVersion,omitempty"`
-	// MaaS Node system_id
-	MaasSystemId string `protobuf:"bytes,4,opt,name=maasSystemId,proto3" json:"maasSystemId,omitempty"`
-	// MaaS Node hostname
-	MaasHostname string `protobuf:"bytes,5,opt,name=maasHostname,proto3" json:"maasHostname,omitempty"`
-	// MaaS node status
-	MaasNodeStatus string `protobuf:"bytes,6,opt,name=maasNodeStatus,proto3" json:"maasNodeStatus,omitempty"`
-	// MaaS IP Address
-	MaasIPAddr           string   `protobuf:"bytes,7,opt,name=maasIPAddr,proto3" json:"maasIPAddr,omitempty"`
+type MachineHardware struct {
+	// The MaaS system id of the machine
+	SystemId string `protobuf:"bytes,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// The MaaS hostname of the machine
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Architecture of the machine, e.g. amd64/generic
+	Architecture string `protobuf:"bytes,3,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// Number of cpu cores
+	CpuCount int32 `protobuf:"varint,4,opt,name=cpu_count,json=cpuCount,proto3" json:"cpu_count,omitempty"`
+	// Amount of memory in MiB
+	Memory int32 `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	// Total size of the disks in GB
+	Storage int32 `protobuf:"varint,6,opt,name=storage,proto3" json:"storage,omitempty"`
+	// MaaS tags of the machine
+	Tags                 []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetClusterNodesStatusReply_MachineStatus) Reset() {
-	*m = GetClusterNodesStatusReply_MachineStatus{}
-}
-func (m *GetClusterNodesStatusReply_MachineStatus) String() string { return proto.CompactTextString(m) }
-func (*GetClusterNodesStatusReply_MachineStatus) ProtoMessage()    {}
-func (*GetClusterNodesStatusReply_MachineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23, 0}
+func (m *MachineHardware) Reset()         { *m = MachineHardware{} }
+func (m *MachineHardware) String() string { return proto.CompactTextString(m) }
+func (*MachineHardware) ProtoMessage()    {}
+func (*MachineHardware) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 
-func (m *GetClusterNodesStatusReply_MachineStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.Unmarshal(m, b)
+func (m *MachineHardware) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MachineHardware.Unmarshal(m, b)
 }
-func (m *GetClusterNodesStatusReply_MachineStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.Marshal(b, m, deterministic)
+func (m *MachineHardware) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MachineHardware.Marshal(b, m, deterministic)
 }
-func (m *GetClusterNodesStatusReply_MachineStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.Merge(m, src)
+func (m *MachineHardware) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MachineHardware.Merge(m, src)
 }
-func (m *GetClusterNodesStatusReply_MachineStatus) XXX_Size() int {
-	return xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.Size(m)
+func (m *MachineHardware) XXX_Size() int {
+	return xxx_messageInfo_MachineHardware.Size(m)
 }
-func (m *GetClusterNodesStatusReply_MachineStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus.DiscardUnknown(m)
+func (m *MachineHardware) XXX_DiscardUnknown() {
+	xxx_messageInfo_MachineHardware.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClusterNodesStatusReply_MachineStatus proto.InternalMessageInfo
+var xxx_messageInfo_MachineHardware proto.InternalMessageInfo
 
-func (m *GetClusterNodesStatusReply_MachineStatus) GetK8SNodeStatus() string {
+func (m *MachineHardware) GetSystemId() string {
 	if m != nil {
-		return m.K8SNodeStatus
+		return m.SystemId
 	}
 	return ""
 }
 
-func (m *GetClusterNodesStatusReply_MachineStatus) GetK8SVersion() string {
+func (m *MachineHardware) GetHostname() string {
 	if m != nil {
-		return m.K8SVersion
+		return m.Hostname
 	}
 	return ""
 }
 
-func (m *GetClusterNodesStatusReply_MachineStatus) GetMaasSystemId() string {
+func (m *MachineHardware) GetArchitecture() string {
 	if m != nil {
-		return m.MaasSystemId
+		return m.Architecture
 	}
 	return ""
 }
 
-func (m *GetClusterNodesStatusReply_MachineStatus) GetMaasHostname() string {
+func (m *MachineHardware) GetCpuCount() int32 {
 	if m != nil {
-		return m.MaasHostname
+		return m.CpuCount
 	}
-	return ""
+	return 0
 }
 
-func (m *GetClusterNodesStatusReply_MachineStatus) GetMaasNodeStatus() string {
+func (m *MachineHardware) GetMemory() int32 {
 	if m != nil {
-		return m.MaasNodeStatus
+		return m.Memory
 	}
-	return ""
+	return 0
 }
 
-func (m *GetClusterNodesStatusReply_MachineStatus) GetMaasIPAddr() string {
+func (m *MachineHardware) GetStorage() int32 {
 	if m != nil {
-		return m.MaasIPAddr
+		return m.Storage
 	}
-	return ""
+	return 0
 }
 
-type DeleteNodePoolReply struct {
-	// Was this a successful request
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+func (m *MachineHardware) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ListImagesMsg struct {
+	// The MaaS region to report, the default region if empty
+	MaasRegion           string   `protobuf:"bytes,1,opt,name=maas_region,json=maasRegion,proto3" json:"maas_region,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteNodePoolReply) Reset()         { *m = DeleteNodePoolReply{} }
-func (m *DeleteNodePoolReply) String() string { return proto.CompactTextString(m) }
-func (*DeleteNodePoolReply) ProtoMessage()    {}
-func (*DeleteNodePoolReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+func (m *ListImagesMsg) Reset()         { *m = ListImagesMsg{} }
+func (m *ListImagesMsg) String() string { return proto.CompactTextString(m) }
+func (*ListImagesMsg) ProtoMessage()    {}
+func (*ListImagesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 
-func (m *DeleteNodePoolReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteNodePoolReply.Unmarshal(m, b)
+func (m *ListImagesMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListImagesMsg.Unmarshal(m, b)
 }
-func (m *DeleteNodePoolReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteNodePoolReply.Marshal(b, m, deterministic)
+func (m *ListImagesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListImagesMsg.Marshal(b, m, deterministic)
 }
-func (m *DeleteNodePoolReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteNodePoolReply.Merge(m, src)
+func (m *ListImagesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListImagesMsg.Merge(m, src)
 }
-func (m *DeleteNodePoolReply) XXX_Size() int {
-	return xxx_messageInfo_DeleteNodePoolReply.Size(m)
+func (m *ListImagesMsg) XXX_Size() int {
+	return xxx_messageInfo_ListImagesMsg.Size(m)
 }
-func (m *DeleteNodePoolReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteNodePoolReply.DiscardUnknown(m)
+func (m *ListImagesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListImagesMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteNodePoolReply proto.InternalMessageInfo
+var xxx_messageInfo_ListImagesMsg proto.InternalMessageInfo
 
-func (m *DeleteNodePoolReply) GetOk() bool {
+func (m *ListImagesMsg) GetMaasRegion() string {
 	if m != nil {
-		return m.Ok
+		return m.MaasRegion
 	}
-	return false
+	return ""
 }
 
-type ScaleNodePoolMsg struct {
-	// What is the name of the cluster to scale a node pool
-	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	// What node pools to scale
-	NodePools            []*ScaleNodePoolSpec `protobuf:"bytes,2,rep,name=node_pools,json=nodePools,proto3" json:"node_pools,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type ListImagesReply struct {
+	// The images machines can be deployed with
+	Images []*ImageItem `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	// The uploaded boot resources whose name is not an image, machines are never deployed with them
+	InvalidImages        []*InvalidImageItem `protobuf:"bytes,2,rep,name=invalid_images,json=invalidImages,proto3" json:"invalid_images,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *ScaleNodePoolMsg) Reset()         { *m = ScaleNodePoolMsg{} }
-func (m *ScaleNodePoolMsg) String() string { return proto.CompactTextString(m) }
-func (*ScaleNodePoolMsg) ProtoMessage()    {}
-func (*ScaleNodePoolMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+func (m *ListImagesReply) Reset()         { *m = ListImagesReply{} }
+func (m *ListImagesReply) String() string { return proto.CompactTextString(m) }
+func (*ListImagesReply) ProtoMessage()    {}
+func (*ListImagesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 
-func (m *ScaleNodePoolMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScaleNodePoolMsg.Unmarshal(m, b)
+func (m *ListImagesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListImagesReply.Unmarshal(m, b)
 }
-func (m *ScaleNodePoolMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScaleNodePoolMsg.Marshal(b, m, deterministic)
+func (m *ListImagesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListImagesReply.Marshal(b, m, deterministic)
 }
-func (m *ScaleNodePoolMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScaleNodePoolMsg.Merge(m, src)
+func (m *ListImagesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListImagesReply.Merge(m, src)
 }
-func (m *ScaleNodePoolMsg) XXX_Size() int {
-	return xxx_messageInfo_ScaleNodePoolMsg.Size(m)
+func (m *ListImagesReply) XXX_Size() int {
+	return xxx_messageInfo_ListImagesReply.Size(m)
 }
-func (m *ScaleNodePoolMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScaleNodePoolMsg.DiscardUnknown(m)
+func (m *ListImagesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListImagesReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScaleNodePoolMsg proto.InternalMessageInfo
+var xxx_messageInfo_ListImagesReply proto.InternalMessageInfo
 
-func (m *ScaleNodePoolMsg) GetClusterName() string {
+func (m *ListImagesReply) GetImages() []*ImageItem {
 	if m != nil {
-		return m.ClusterName
+		return m.Images
 	}
-	return ""
+	return nil
 }
 
-func (m *ScaleNodePoolMsg) GetNodePools() []*ScaleNodePoolSpec {
+func (m *ListImagesReply) GetInvalidImages() []*InvalidImageItem {
 	if m != nil {
-		return m.NodePools
+		return m.InvalidImages
 	}
 	return nil
 }
 
-type ScaleNodePoolSpec struct {
-	// What is the node pool name to scale
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Number of machines to scale
-	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+type ImageItem struct {
+	// The os series of the image, e.g. ubuntu-xenial
+	OsSeries string `protobuf:"bytes,1,opt,name=os_series,json=osSeries,proto3" json:"os_series,omitempty"`
+	// The Kubernetes version installed in the image
+	K8SVersion string `protobuf:"bytes,2,opt,name=k8s_version,json=k8sVersion,proto3" json:"k8s_version,omitempty"`
+	// The instance type the image is built for
+	InstanceType string `protobuf:"bytes,3,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	// The architecture of the image, e.g. amd64/generic
+	Architecture string `protobuf:"bytes,4,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// The name of the MaaS boot resource
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// The day the image was uploaded, as an RFC 3339 date
+	Uploaded             string   `protobuf:"bytes,6,opt,name=uploaded,proto3" json:"uploaded,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScaleNodePoolSpec) Reset()         { *m = ScaleNodePoolSpec{} }
-func (m *ScaleNodePoolSpec) String() string { return proto.CompactTextString(m) }
-func (*ScaleNodePoolSpec) ProtoMessage()    {}
-func (*ScaleNodePoolSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+func (m *ImageItem) Reset()         { *m = ImageItem{} }
+func (m *ImageItem) String() string { return proto.CompactTextString(m) }
+func (*ImageItem) ProtoMessage()    {}
+func (*ImageItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
 }
 
-func (m *ScaleNodePoolSpec) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScaleNodePoolSpec.Unmarshal(m, b)
+func (m *ImageItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImageItem.Unmarshal(m, b)
 }
-func (m *ScaleNodePoolSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScaleNodePoolSpec.Marshal(b, m, deterministic)
+func (m *ImageItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImageItem.Marshal(b, m, deterministic)
 }
-func (m *ScaleNodePoolSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScaleNodePoolSpec.Merge(m, src)
+func (m *ImageItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageItem.Merge(m, src)
 }
-func (m *ScaleNodePoolSpec) XXX_Size() int {
-	return xxx_messageInfo_ScaleNodePoolSpec.Size(m)
+func (m *ImageItem) XXX_Size() int {
+	return xxx_messageInfo_ImageItem.Size(m)
 }
-func (m *ScaleNodePoolSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScaleNodePoolSpec.DiscardUnknown(m)
+func (m *ImageItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScaleNodePoolSpec proto.InternalMessageInfo
+var xxx_messageInfo_ImageItem proto.InternalMessageInfo
 
-func (m *ScaleNodePoolSpec) GetName() string {
+func (m *ImageItem) GetOsSeries() string {
+	if m != nil {
+		return m.OsSeries
+	}
+	return ""
+}
+
+func (m *ImageItem) GetK8SVersion() string {
+	if m != nil {
+		return m.K8SVersion
+	}
+	return ""
+}
+
+func (m *ImageItem) GetInstanceType() string {
+	if m != nil {
+		return m.InstanceType
+	}
+	return ""
+}
+
+func (m *ImageItem) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *ImageItem) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ScaleNodePoolSpec) GetCount() int32 {
+func (m *ImageItem) GetUploaded() string {
 	if m != nil {
-		return m.Count
+		return m.Uploaded
 	}
-	return 0
+	return ""
 }
 
-type ScaleNodePoolReply struct {
-	// Was this a successful request
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+type InvalidImageItem struct {
+	// The name of the MaaS boot resource
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The architecture of the boot resource, e.g. amd64/generic
+	Architecture string `protobuf:"bytes,2,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// The day the boot resource was uploaded, as an RFC 3339 date
+	Uploaded string `protobuf:"bytes,3,opt,name=uploaded,proto3" json:"uploaded,omitempty"`
+	// Why the name is not an image
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScaleNodePoolReply) Reset()         { *m = ScaleNodePoolReply{} }
-func (m *ScaleNodePoolReply) String() string { return proto.CompactTextString(m) }
-func (*ScaleNodePoolReply) ProtoMessage()    {}
-func (*ScaleNodePoolReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+func (m *InvalidImageItem) Reset()         { *m = InvalidImageItem{} }
+func (m *InvalidImageItem) String() string { return proto.CompactTextString(m) }
+func (*InvalidImageItem) ProtoMessage()    {}
+func (*InvalidImageItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
 }
 
-func (m *ScaleNodePoolReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScaleNodePoolReply.Unmarshal(m, b)
+func (m *InvalidImageItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidImageItem.Unmarshal(m, b)
 }
-func (m *ScaleNodePoolReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScaleNodePoolReply.Marshal(b, m, deterministic)
+func (m *InvalidImageItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidImageItem.Marshal(b, m, deterministic)
 }
-func (m *ScaleNodePoolReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScaleNodePoolReply.Merge(m, src)
+func (m *InvalidImageItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidImageItem.Merge(m, src)
 }
-func (m *ScaleNodePoolReply) XXX_Size() int {
-	return xxx_messageInfo_ScaleNodePoolReply.Size(m)
+func (m *InvalidImageItem) XXX_Size() int {
+	return xxx_messageInfo_InvalidImageItem.Size(m)
 }
-func (m *ScaleNodePoolReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScaleNodePoolReply.DiscardUnknown(m)
+func (m *InvalidImageItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidImageItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScaleNodePoolReply proto.InternalMessageInfo
+var xxx_messageInfo_InvalidImageItem proto.InternalMessageInfo
 
-func (m *ScaleNodePoolReply) GetOk() bool {
+func (m *InvalidImageItem) GetName() string {
 	if m != nil {
-		return m.Ok
+		return m.Name
 	}
-	return false
+	return ""
+}
+
+func (m *InvalidImageItem) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *InvalidImageItem) GetUploaded() string {
+	if m != nil {
+		return m.Uploaded
+	}
+	return ""
+}
+
+func (m *InvalidImageItem) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
 func init() {
 	proto.RegisterEnum("cnct.kaas.api.ClusterStatus", ClusterStatus_name, ClusterStatus_value)
 	proto.RegisterType((*CreateClusterMsg)(nil), "cnct.kaas.api.CreateClusterMsg")
+	proto.RegisterType((*ControlPlaneEndpoint)(nil), "cnct.kaas.api.ControlPlaneEndpoint")
 	proto.RegisterType((*CreateClusterReply)(nil), "cnct.kaas.api.CreateClusterReply")
 	proto.RegisterType((*GetClusterMsg)(nil), "cnct.kaas.api.GetClusterMsg")
 	proto.RegisterType((*GetClusterReply)(nil), "cnct.kaas.api.GetClusterReply")
@@ -1621,6 +2714,10 @@ func init() {
 	proto.RegisterType((*KubernetesLabel)(nil), "cnct.kaas.api.KubernetesLabel")
 	proto.RegisterType((*ControlPlaneMachineSpec)(nil), "cnct.kaas.api.ControlPlaneMachineSpec")
 	proto.RegisterType((*MachineSpec)(nil), "cnct.kaas.api.MachineSpec")
+	proto.RegisterType((*ZoneSpread)(nil), "cnct.kaas.api.ZoneSpread")
+	proto.RegisterType((*MachineConstraints)(nil), "cnct.kaas.api.MachineConstraints")
+	proto.RegisterType((*StorageConstraint)(nil), "cnct.kaas.api.StorageConstraint")
+	proto.RegisterType((*InterfaceConstraint)(nil), "cnct.kaas.api.InterfaceConstraint")
 	proto.RegisterType((*GetVersionMsg)(nil), "cnct.kaas.api.GetVersionMsg")
 	proto.RegisterType((*GetVersionReply)(nil), "cnct.kaas.api.GetVersionReply")
 	proto.RegisterType((*GetVersionReply_VersionInformation)(nil), "cnct.kaas.api.GetVersionReply.VersionInformation")
@@ -1638,111 +2735,178 @@ func init() {
 	proto.RegisterType((*ScaleNodePoolMsg)(nil), "cnct.kaas.api.ScaleNodePoolMsg")
 	proto.RegisterType((*ScaleNodePoolSpec)(nil), "cnct.kaas.api.ScaleNodePoolSpec")
 	proto.RegisterType((*ScaleNodePoolReply)(nil), "cnct.kaas.api.ScaleNodePoolReply")
+	proto.RegisterType((*RebootMachineMsg)(nil), "cnct.kaas.api.RebootMachineMsg")
+	proto.RegisterType((*RebootMachineReply)(nil), "cnct.kaas.api.RebootMachineReply")
+	proto.RegisterType((*GetCapacityMsg)(nil), "cnct.kaas.api.GetCapacityMsg")
+	proto.RegisterType((*GetCapacityReply)(nil), "cnct.kaas.api.GetCapacityReply")
+	proto.RegisterType((*CapacityItem)(nil), "cnct.kaas.api.CapacityItem")
+	proto.RegisterType((*MachineHardware)(nil), "cnct.kaas.api.MachineHardware")
+	proto.RegisterType((*ListImagesMsg)(nil), "cnct.kaas.api.ListImagesMsg")
+	proto.RegisterType((*ListImagesReply)(nil), "cnct.kaas.api.ListImagesReply")
+	proto.RegisterType((*ImageItem)(nil), "cnct.kaas.api.ImageItem")
+	proto.RegisterType((*InvalidImageItem)(nil), "cnct.kaas.api.InvalidImageItem")
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6e, 0x1b, 0x47,
-	0x12, 0xde, 0xa1, 0xfe, 0x8b, 0x22, 0x45, 0xb6, 0xbc, 0x36, 0x77, 0x56, 0xb2, 0xe8, 0xb1, 0x2d,
-	0x7b, 0xb5, 0x2b, 0xd2, 0xd6, 0x7a, 0xbd, 0x86, 0xe2, 0x20, 0x51, 0x28, 0x59, 0x26, 0x62, 0x51,
-	0xc4, 0x50, 0xd2, 0xc1, 0x80, 0x41, 0x34, 0x87, 0xed, 0xd1, 0x84, 0xc3, 0xe9, 0xc1, 0x74, 0x53,
-	0x86, 0x7d, 0xf0, 0xc1, 0x41, 0x4e, 0xb9, 0x04, 0xf1, 0x35, 0x97, 0x00, 0x79, 0x92, 0xbc, 0x42,
-	0x5e, 0x21, 0x41, 0xae, 0x39, 0xe6, 0x18, 0x74, 0xcf, 0x90, 0x9c, 0x3f, 0xca, 0x12, 0x7c, 0x12,
-	0xbb, 0xba, 0xba, 0xbe, 0xaf, 0xba, 0xaa, 0xab, 0x6a, 0x04, 0x0b, 0xd8, 0xb5, 0x2a, 0xae, 0x47,
-	0x39, 0x45, 0x39, 0xc3, 0x31, 0x78, 0xa5, 0x87, 0x31, 0xab, 0x60, 0xd7, 0x52, 0x57, 0x4c, 0x4a,
-	0x4d, 0x9b, 0x54, 0xb1, 0x6b, 0x55, 0xb1, 0xe3, 0x50, 0x8e, 0xb9, 0x45, 0x1d, 0xe6, 0x2b, 0xab,
-	0xff, 0x91, 0x7f, 0x8c, 0x4d, 0x93, 0x38, 0x9b, 0xec, 0x15, 0x36, 0x4d, 0xe2, 0x55, 0xa9, 0x2b,
-	0x35, 0x92, 0xda, 0xda, 0xef, 0x0a, 0x14, 0x6a, 0x1e, 0xc1, 0x9c, 0xd4, 0xec, 0x01, 0xe3, 0xc4,
-	0x3b, 0x60, 0x26, 0x42, 0x30, 0xed, 0xe0, 0x3e, 0x29, 0x29, 0x65, 0xe5, 0xee, 0x82, 0x2e, 0x7f,
-	0xa3, 0x35, 0xc8, 0xf6, 0x1e, 0xb1, 0xf6, 0x19, 0xf1, 0x98, 0x45, 0x9d, 0x52, 0x46, 0x6e, 0x41,
-	0xef, 0x11, 0x3b, 0xf1, 0x25, 0xe8, 0x04, 0x96, 0x0d, 0xea, 0x70, 0x8f, 0xda, 0x6d, 0xd7, 0xc6,
-	0x0e, 0x69, 0x3b, 0xb4, 0x4b, 0x58, 0x69, 0xaa, 0xac, 0xdc, 0xcd, 0x6e, 0xad, 0x57, 0x22, 0x2e,
-	0x54, 0x6a, 0xbe, 0x66, 0x53, 0x28, 0x1e, 0x60, 0xe3, 0xd4, 0x72, 0x48, 0xcb, 0x25, 0x86, 0x5e,
-	0x34, 0x42, 0x1b, 0x0d, 0x61, 0x00, 0x3d, 0x81, 0xe2, 0x2b, 0xea, 0xf5, 0x88, 0x27, 0x0d, 0xb6,
-	0x5d, 0x4a, 0x6d, 0x56, 0x9a, 0x2e, 0x4f, 0xdd, 0xcd, 0x6e, 0xa9, 0x31, 0xab, 0x61, 0x4b, 0x4b,
-	0xfe, 0x21, 0x61, 0xa3, 0x29, 0x8e, 0x68, 0xcf, 0x01, 0x45, 0x1c, 0xd5, 0x89, 0x6b, 0xbf, 0x46,
-	0x79, 0xc8, 0xd0, 0x9e, 0x74, 0x74, 0x5e, 0xcf, 0xd0, 0x1e, 0x7a, 0x00, 0x73, 0x86, 0xbf, 0x2f,
-	0x5d, 0x4c, 0x62, 0x04, 0xa7, 0xeb, 0x9c, 0xf4, 0xf5, 0xa1, 0xaa, 0x76, 0x13, 0x72, 0xfb, 0x84,
-	0x9f, 0x7f, 0x83, 0xda, 0x0b, 0x58, 0x1a, 0x2b, 0xa5, 0xa3, 0x6f, 0xc7, 0xd1, 0xcb, 0xe9, 0xe8,
-	0xbb, 0x84, 0x63, 0xcb, 0x8e, 0x72, 0x58, 0x87, 0xc2, 0x2e, 0xb1, 0xc9, 0x87, 0x02, 0xa9, 0x3d,
-	0x06, 0x14, 0xd1, 0x4b, 0x67, 0x72, 0x15, 0x66, 0x19, 0xc7, 0x7c, 0xc0, 0x82, 0x48, 0x07, 0x2b,
-	0x6d, 0x19, 0x8a, 0x63, 0x27, 0x9e, 0x59, 0x8c, 0x1f, 0x30, 0x53, 0x7b, 0x01, 0xcb, 0x51, 0x61,
-	0xba, 0xcd, 0x87, 0x30, 0x1f, 0x90, 0x15, 0x56, 0xa7, 0x3e, 0x70, 0xb9, 0x23, 0x5d, 0xed, 0x2d,
-	0x64, 0x43, 0x1b, 0xa9, 0xd9, 0x79, 0x1b, 0xf2, 0x3e, 0xc1, 0x76, 0x9f, 0x30, 0x86, 0x4d, 0x12,
-	0xd0, 0xce, 0xf9, 0xd2, 0x03, 0x5f, 0x88, 0x1e, 0x8c, 0xbc, 0x12, 0x69, 0x99, 0xdf, 0x5a, 0x49,
-	0xc7, 0x6f, 0x49, 0x9d, 0x91, 0xcf, 0x3f, 0x29, 0x50, 0x4c, 0x5c, 0xfc, 0xc7, 0xd0, 0xb8, 0x0e,
-	0xd0, 0x1b, 0x74, 0x88, 0x41, 0x9d, 0x97, 0x96, 0x29, 0xa9, 0x88, 0xa7, 0x34, 0x92, 0x84, 0x68,
-	0x4e, 0x5f, 0x82, 0xe6, 0x27, 0xb0, 0xf4, 0xe5, 0xa0, 0x43, 0x3c, 0x87, 0x70, 0xc2, 0x9e, 0xe1,
-	0x0e, 0xb1, 0x53, 0x39, 0x5e, 0x81, 0x99, 0x33, 0x6c, 0x0f, 0x86, 0xd4, 0xfc, 0x85, 0xf6, 0xad,
-	0x02, 0xd7, 0x26, 0x3c, 0x4a, 0xf4, 0x10, 0x66, 0x6d, 0x61, 0x8e, 0x95, 0x14, 0x19, 0xb5, 0xeb,
-	0x31, 0x3a, 0x31, 0x54, 0x3d, 0xd0, 0x46, 0x1a, 0x2c, 0x5a, 0x0e, 0xe3, 0xd8, 0x31, 0xc8, 0xd1,
-	0x6b, 0x77, 0x08, 0x18, 0x91, 0x09, 0x36, 0x06, 0x1d, 0x38, 0x5c, 0xde, 0xc2, 0x8c, 0xee, 0x2f,
-	0xb4, 0xf7, 0x0a, 0x64, 0xc3, 0x0c, 0xd2, 0xfc, 0x18, 0xb3, 0xca, 0x7c, 0x14, 0xab, 0xa9, 0xf3,
-	0x58, 0x4d, 0x87, 0x59, 0x2d, 0xc9, 0x57, 0x1e, 0xd4, 0x3b, 0x91, 0xf7, 0x7f, 0x66, 0xe4, 0x93,
-	0x0e, 0x24, 0xe9, 0x49, 0xdf, 0x81, 0xe5, 0xa0, 0x66, 0xb6, 0x2d, 0xe7, 0x25, 0xf5, 0xfa, 0xb2,
-	0xfc, 0x06, 0xcf, 0xfb, 0x7e, 0x8c, 0x73, 0xcc, 0x58, 0x25, 0x58, 0xd4, 0xc7, 0x07, 0x75, 0x74,
-	0x96, 0x90, 0xa9, 0x7f, 0x28, 0x80, 0x92, 0xaa, 0xa2, 0x64, 0x9b, 0x16, 0x1f, 0x95, 0x6c, 0xff,
-	0xf2, 0xc0, 0xb4, 0x86, 0x18, 0x68, 0x15, 0xc4, 0xaa, 0x6d, 0xd0, 0x7e, 0xdf, 0xe2, 0x41, 0x78,
-	0x16, 0x4c, 0x8b, 0xd7, 0xa4, 0x00, 0xdd, 0x82, 0xbc, 0xd8, 0xe6, 0x1e, 0x21, 0x6d, 0x91, 0x63,
-	0xa3, 0xbb, 0x32, 0x2d, 0x7e, 0xe4, 0x11, 0x22, 0xf2, 0x8f, 0x08, 0x23, 0x9d, 0x81, 0x65, 0x77,
-	0xdb, 0x5d, 0xa1, 0x31, 0xed, 0x1b, 0x91, 0x92, 0xdd, 0x60, 0xdb, 0xa4, 0x23, 0x0e, 0x33, 0x01,
-	0x06, 0x1d, 0x52, 0x50, 0x61, 0xde, 0xa0, 0x7d, 0xd7, 0xb2, 0x89, 0x57, 0x9a, 0x95, 0x9b, 0xa3,
-	0xb5, 0xd8, 0x73, 0x6d, 0xcc, 0x85, 0x43, 0xa5, 0x39, 0x7f, 0x6f, 0xb8, 0xd6, 0xfe, 0x07, 0x6b,
-	0xfb, 0x84, 0x1f, 0xbb, 0xa6, 0x87, 0xbb, 0xc3, 0x4a, 0x16, 0xf2, 0x7d, 0x52, 0xf1, 0x3b, 0x84,
-	0x1b, 0xe7, 0x1d, 0x4b, 0x0f, 0xa1, 0x0a, 0xf3, 0x01, 0x7f, 0x3f, 0xd7, 0x16, 0xf4, 0xd1, 0x5a,
-	0xdb, 0x81, 0x62, 0xd4, 0xda, 0xa4, 0xfe, 0x59, 0x82, 0xb9, 0x68, 0xef, 0x1c, 0x2e, 0xb5, 0xdb,
-	0xb0, 0x1c, 0x35, 0x91, 0xca, 0x42, 0x7b, 0x03, 0xf9, 0x9d, 0x6e, 0x77, 0xd8, 0xcf, 0x04, 0x4c,
-	0x19, 0xb2, 0x41, 0x8d, 0x6c, 0x8c, 0xd1, 0xc2, 0xa2, 0xf4, 0xde, 0x99, 0xb9, 0x7c, 0xef, 0xd4,
-	0xa0, 0x10, 0xc2, 0x4e, 0xe7, 0xf7, 0x02, 0x8a, 0x7e, 0x5f, 0xb9, 0x1c, 0xc5, 0x75, 0x58, 0x1a,
-	0x71, 0x6b, 0x8b, 0x9b, 0x1a, 0xde, 0x71, 0xce, 0x09, 0xec, 0x08, 0x35, 0xa6, 0x3d, 0x86, 0xd2,
-	0xb8, 0xc7, 0xc8, 0xc9, 0xc0, 0x2f, 0x7f, 0x17, 0x42, 0xd1, 0xbe, 0x9e, 0x02, 0x35, 0xf5, 0xb8,
-	0xef, 0x0b, 0x82, 0xe9, 0xd0, 0x49, 0xf9, 0x7b, 0x5c, 0x03, 0x32, 0xa1, 0x1a, 0x80, 0x5a, 0x30,
-	0xdf, 0xf7, 0x6f, 0x4a, 0xf4, 0x10, 0x71, 0x91, 0xff, 0x4f, 0xbe, 0xe1, 0x09, 0x30, 0xa3, 0x3b,
-	0xf6, 0x45, 0x23, 0x43, 0xea, 0x6f, 0x0a, 0xe4, 0x22, 0x7b, 0xe8, 0x16, 0xe4, 0x7a, 0x8f, 0x98,
-	0x30, 0xe0, 0x0b, 0x02, 0x66, 0x51, 0xa1, 0xec, 0x23, 0xa3, 0x01, 0x2c, 0x65, 0x24, 0xd3, 0x60,
-	0xb1, 0x8f, 0x31, 0x6b, 0xbd, 0x66, 0x9c, 0xf4, 0xeb, 0xdd, 0xe0, 0x71, 0x46, 0x64, 0x43, 0x9d,
-	0xa7, 0x94, 0x71, 0x99, 0xb3, 0x33, 0x63, 0x9d, 0xa1, 0x0c, 0xad, 0x43, 0x5e, 0xac, 0x43, 0x74,
-	0xfc, 0xa7, 0x1a, 0x93, 0x0a, 0x3e, 0x42, 0x52, 0x6f, 0xee, 0x74, 0xbb, 0x5e, 0xf0, 0x64, 0x43,
-	0x12, 0x91, 0xe9, 0xd1, 0x14, 0x49, 0xcf, 0xa4, 0x01, 0x14, 0x5a, 0x06, 0xb6, 0x2f, 0x99, 0x48,
-	0x9f, 0x01, 0x24, 0x92, 0x3c, 0x3e, 0x3e, 0x45, 0xcc, 0xca, 0x54, 0x5f, 0x70, 0x46, 0x49, 0xfe,
-	0x29, 0x14, 0x13, 0xfb, 0x93, 0x3a, 0x68, 0x32, 0x33, 0xb4, 0x5b, 0x80, 0x22, 0xc7, 0x53, 0x7d,
-	0xdb, 0x78, 0x0b, 0xb9, 0x48, 0xf7, 0x46, 0x57, 0x01, 0xb5, 0x8e, 0x76, 0x8e, 0x8e, 0x5b, 0xed,
-	0xe3, 0x46, 0xab, 0xb9, 0x57, 0xab, 0x3f, 0xa9, 0xef, 0xed, 0x16, 0xfe, 0x86, 0x0a, 0xb0, 0xd8,
-	0xd4, 0x0f, 0x4f, 0xea, 0xad, 0xfa, 0x61, 0xa3, 0xde, 0xd8, 0x2f, 0x28, 0x28, 0x0b, 0x73, 0xfa,
-	0x71, 0x43, 0x2e, 0x32, 0x68, 0x09, 0xb2, 0xfa, 0x5e, 0xed, 0xb0, 0x51, 0xab, 0x3f, 0x13, 0x82,
-	0x29, 0xb4, 0x08, 0xf3, 0xad, 0xa3, 0xc3, 0x66, 0x53, 0xac, 0xa6, 0xd1, 0x02, 0xcc, 0xec, 0xe9,
-	0xfa, 0xa1, 0x5e, 0x98, 0x11, 0x1b, 0xbb, 0x7b, 0xfb, 0xfa, 0xce, 0xee, 0xde, 0x6e, 0x61, 0x76,
-	0xeb, 0x67, 0x80, 0xb9, 0x80, 0x00, 0xa2, 0x90, 0x8b, 0x4c, 0xc4, 0x68, 0x2d, 0x3e, 0x67, 0xc4,
-	0x3e, 0x0c, 0xd4, 0x1b, 0xe7, 0x29, 0x48, 0x87, 0x35, 0xf5, 0xdd, 0x2f, 0xbf, 0xbe, 0xcf, 0x5c,
-	0xd1, 0x96, 0xe4, 0xe7, 0xc9, 0xd9, 0xfd, 0x6a, 0x10, 0xa3, 0x6d, 0x65, 0x03, 0x19, 0x00, 0xe3,
-	0xd7, 0x81, 0x56, 0x26, 0x3e, 0x1c, 0x01, 0x75, 0x7d, 0xe2, 0xae, 0x8f, 0x73, 0x4d, 0xe2, 0x14,
-	0x51, 0x1c, 0x07, 0xd9, 0x90, 0x8b, 0xcc, 0xb7, 0x09, 0xaf, 0xe2, 0x53, 0x72, 0xc2, 0xab, 0xe4,
-	0x78, 0x3c, 0x44, 0xdb, 0x48, 0xa0, 0x71, 0xc8, 0x47, 0x47, 0x5f, 0x54, 0x9e, 0x48, 0x3c, 0x18,
-	0x97, 0x55, 0xed, 0x5c, 0x0d, 0x1f, 0x70, 0x45, 0x02, 0x5e, 0x45, 0x57, 0x62, 0x80, 0x55, 0x5b,
-	0x60, 0x7c, 0xa7, 0xc0, 0xdf, 0x53, 0xeb, 0x0c, 0xba, 0x73, 0x91, 0x6a, 0x24, 0x48, 0xfc, 0xeb,
-	0xc2, 0x65, 0x4b, 0xbb, 0x29, 0xb9, 0xac, 0xa2, 0x7f, 0xc6, 0xb9, 0xc8, 0x2f, 0x3c, 0x7f, 0xfa,
-	0x44, 0x8e, 0x64, 0x94, 0x32, 0x85, 0xac, 0x4c, 0x9c, 0x71, 0x26, 0x84, 0x39, 0x3c, 0x01, 0x25,
-	0xc3, 0x1c, 0x74, 0x4d, 0xe4, 0x40, 0x36, 0xd4, 0x92, 0xd0, 0x6a, 0xcc, 0x4e, 0xb4, 0x55, 0xaa,
-	0x6b, 0x93, 0xb7, 0x7d, 0x9c, 0x35, 0x89, 0xf3, 0x0f, 0x2d, 0x71, 0xdf, 0xa2, 0x9c, 0x88, 0xdc,
-	0xe5, 0x90, 0x8f, 0xd6, 0xae, 0x44, 0xa0, 0x13, 0xdd, 0x2f, 0x11, 0xe8, 0x94, 0xe2, 0x37, 0x0c,
-	0xf4, 0x46, 0x2a, 0x30, 0xe2, 0x90, 0x8b, 0x14, 0x95, 0x44, 0x32, 0xc7, 0x0b, 0x65, 0x22, 0x99,
-	0x93, 0x35, 0x69, 0xe8, 0xab, 0x3a, 0xd1, 0xd7, 0x1f, 0x15, 0x58, 0x39, 0x6f, 0x4c, 0x42, 0x95,
-	0x64, 0xd4, 0xce, 0x1b, 0xc5, 0xd4, 0x7b, 0x97, 0xd0, 0x8f, 0x70, 0x44, 0xd7, 0xe2, 0x1c, 0x07,
-	0xfe, 0x39, 0xf4, 0x06, 0xf2, 0x51, 0x13, 0x89, 0x78, 0x24, 0xe6, 0xb2, 0x44, 0x3c, 0x52, 0xc6,
-	0x2e, 0x4d, 0x93, 0xc0, 0x2b, 0xea, 0x24, 0xe0, 0x6d, 0x65, 0xe3, 0x8b, 0x1f, 0x32, 0xdf, 0xef,
-	0x7c, 0x93, 0x41, 0xef, 0x14, 0x28, 0x07, 0x67, 0xcb, 0x07, 0xd8, 0xc1, 0x26, 0xf1, 0xca, 0x3b,
-	0xcd, 0x7a, 0xb9, 0xd5, 0x7a, 0x5a, 0x76, 0x3d, 0x7a, 0x66, 0x75, 0x89, 0xa7, 0x9d, 0xc0, 0x62,
-	0x0b, 0xf7, 0xd9, 0xc0, 0x31, 0xcb, 0xb5, 0x46, 0xed, 0x08, 0xdd, 0x39, 0xe5, 0xdc, 0x65, 0xdb,
-	0xd5, 0xaa, 0x69, 0xf1, 0xd3, 0x41, 0xa7, 0x62, 0xd0, 0x7e, 0x95, 0xf9, 0x0a, 0x9b, 0x82, 0x5d,
-	0xd5, 0xe8, 0xe3, 0x4d, 0xc6, 0x4e, 0xd5, 0xd5, 0x40, 0x5a, 0x31, 0x6c, 0x3a, 0xe8, 0x3a, 0x98,
-	0x5b, 0x67, 0xe4, 0x73, 0xb3, 0x8f, 0x2d, 0x5b, 0x9c, 0xd9, 0x9a, 0x3d, 0xbb, 0x57, 0xb9, 0x5f,
-	0xb9, 0xb7, 0x91, 0xc9, 0x28, 0x5b, 0x05, 0xec, 0xba, 0xb6, 0x65, 0xc8, 0xeb, 0xab, 0x7e, 0xc5,
-	0xa8, 0xb3, 0x9d, 0x90, 0x78, 0x27, 0xf0, 0xef, 0x03, 0xea, 0x91, 0x32, 0xee, 0xd0, 0x01, 0xff,
-	0x20, 0xed, 0x0b, 0xd3, 0x7c, 0x5e, 0x74, 0x7b, 0x66, 0xd5, 0x24, 0x0e, 0xf1, 0x30, 0x27, 0x5d,
-	0x71, 0x69, 0x9d, 0x59, 0xf9, 0xaf, 0xa5, 0xff, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x13, 0x03,
-	0x84, 0x06, 0xc2, 0x12, 0x00, 0x00,
+	// 2495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xef, 0x92, 0xa2, 0x44, 0x3e, 0x8a, 0x12, 0x35, 0x76, 0x1d, 0x66, 0x2d, 0xdb, 0xf4, 0x3a,
+	0x71, 0x1c, 0xb7, 0x16, 0x1d, 0x35, 0x4d, 0x02, 0x35, 0x45, 0xab, 0x50, 0x8a, 0x43, 0x24, 0x92,
+	0xd5, 0xa5, 0x6c, 0xa0, 0x01, 0x02, 0x62, 0xb8, 0x1c, 0xaf, 0xb6, 0x5a, 0xee, 0x2c, 0x76, 0x86,
+	0x0c, 0xe4, 0x83, 0x51, 0x24, 0xe8, 0xa9, 0x87, 0x16, 0xcd, 0xb5, 0x97, 0x00, 0xbd, 0xf6, 0xd0,
+	0xaf, 0xd0, 0x6f, 0xd0, 0xf6, 0x2b, 0x34, 0xf7, 0x1e, 0x7b, 0x2c, 0xe6, 0xcf, 0x92, 0xfb, 0x8f,
+	0xb4, 0xdc, 0x9c, 0xb8, 0xf3, 0xe6, 0xcd, 0xfb, 0xbd, 0xf7, 0xe6, 0xcd, 0xcc, 0x6f, 0x86, 0x50,
+	0xc3, 0xa1, 0xb7, 0x13, 0x46, 0x94, 0x53, 0xd4, 0x70, 0x02, 0x87, 0xef, 0x9c, 0x63, 0xcc, 0x76,
+	0x70, 0xe8, 0x99, 0xdb, 0x2e, 0xa5, 0xae, 0x4f, 0x3a, 0x38, 0xf4, 0x3a, 0x38, 0x08, 0x28, 0xc7,
+	0xdc, 0xa3, 0x01, 0x53, 0xca, 0xe6, 0x8f, 0xe5, 0x8f, 0xf3, 0xc0, 0x25, 0xc1, 0x03, 0xf6, 0x25,
+	0x76, 0x5d, 0x12, 0x75, 0x68, 0x28, 0x35, 0xf2, 0xda, 0xd6, 0x77, 0x65, 0x68, 0x76, 0x23, 0x82,
+	0x39, 0xe9, 0xfa, 0x13, 0xc6, 0x49, 0x74, 0xc4, 0x5c, 0x84, 0x60, 0x25, 0xc0, 0x63, 0xd2, 0x32,
+	0xda, 0xc6, 0xbd, 0x9a, 0x2d, 0xbf, 0xd1, 0x2d, 0xa8, 0x9f, 0x7f, 0xc0, 0x06, 0x53, 0x12, 0x31,
+	0x8f, 0x06, 0xad, 0x92, 0xec, 0x82, 0xf3, 0x0f, 0xd8, 0x53, 0x25, 0x41, 0x4f, 0xe1, 0x8a, 0x43,
+	0x03, 0x1e, 0x51, 0x7f, 0x10, 0xfa, 0x38, 0x20, 0x83, 0x80, 0x8e, 0x08, 0x6b, 0x95, 0xdb, 0xc6,
+	0xbd, 0xfa, 0xee, 0xdd, 0x9d, 0x54, 0x08, 0x3b, 0x5d, 0xa5, 0x79, 0x22, 0x14, 0x8f, 0xb0, 0x73,
+	0xe6, 0x05, 0xa4, 0x1f, 0x12, 0xc7, 0xde, 0x72, 0x12, 0x1d, 0xc7, 0xc2, 0x00, 0xfa, 0x18, 0xb6,
+	0xbe, 0xa4, 0xd1, 0x39, 0x89, 0xa4, 0xc1, 0x41, 0x48, 0xa9, 0xcf, 0x5a, 0x2b, 0xed, 0xf2, 0xbd,
+	0xfa, 0xae, 0x99, 0xb1, 0x9a, 0xb4, 0xb4, 0xa9, 0x06, 0x09, 0x1b, 0x27, 0x62, 0x08, 0xda, 0x86,
+	0x5a, 0x18, 0x91, 0x67, 0xbe, 0xe7, 0x9e, 0xf1, 0x56, 0xa5, 0x6d, 0xdc, 0xab, 0xda, 0x73, 0x81,
+	0x08, 0x6f, 0x8c, 0x31, 0x1b, 0x44, 0xc4, 0x15, 0xe1, 0xad, 0xaa, 0xf0, 0x84, 0xc8, 0x96, 0x12,
+	0x74, 0x1d, 0x6a, 0x94, 0x0d, 0x18, 0x89, 0x3c, 0xc2, 0x5a, 0x6b, 0xb2, 0xbb, 0x4a, 0x59, 0x5f,
+	0xb6, 0xd1, 0xaf, 0xe1, 0x5a, 0x3a, 0x76, 0x12, 0x8c, 0x42, 0xea, 0x05, 0xbc, 0x55, 0x95, 0xe1,
+	0xdf, 0x59, 0x12, 0xfe, 0xa1, 0x56, 0xb5, 0xaf, 0x3a, 0x05, 0x52, 0x74, 0x08, 0x40, 0xb8, 0x33,
+	0xd2, 0xd9, 0xac, 0xbd, 0x52, 0x36, 0x6b, 0x62, 0xa4, 0xcc, 0xa2, 0xf5, 0xad, 0x01, 0x57, 0x8b,
+	0x50, 0xc5, 0x5c, 0x9f, 0x51, 0xc6, 0xe3, 0xb9, 0x16, 0xdf, 0x42, 0x16, 0xd2, 0x88, 0xcb, 0x49,
+	0xae, 0xd8, 0xf2, 0x1b, 0x35, 0xa1, 0x3c, 0xf5, 0x42, 0x39, 0x9d, 0x55, 0x5b, 0x7c, 0xa2, 0x3b,
+	0xd0, 0x98, 0x7a, 0xe1, 0xc0, 0x0b, 0x38, 0x89, 0x9e, 0x61, 0x87, 0xb4, 0x56, 0xa4, 0x89, 0xf5,
+	0xa9, 0x17, 0xf6, 0x62, 0x19, 0xba, 0x0f, 0x5b, 0x53, 0x2f, 0xe2, 0x13, 0xec, 0x0f, 0x22, 0x3a,
+	0xe1, 0x24, 0x1a, 0x78, 0x23, 0x99, 0xfd, 0x8a, 0xbd, 0xa9, 0x3b, 0x6c, 0x29, 0xef, 0x8d, 0xac,
+	0xcf, 0x01, 0xa5, 0x4a, 0xd1, 0x26, 0xa1, 0x7f, 0x81, 0x36, 0xa0, 0x44, 0xcf, 0xa5, 0x7b, 0x55,
+	0xbb, 0x44, 0xcf, 0xd1, 0xbb, 0xb0, 0xe6, 0xa8, 0x7e, 0xe9, 0x5f, 0xbe, 0x0a, 0xf4, 0xe8, 0x1e,
+	0x27, 0x63, 0x3b, 0x56, 0xb5, 0xee, 0x40, 0xe3, 0x11, 0xe1, 0xcb, 0x6b, 0xdc, 0xfa, 0x02, 0x36,
+	0xe7, 0x4a, 0xc5, 0xe8, 0x7b, 0x59, 0xf4, 0x76, 0x31, 0xfa, 0x01, 0xe1, 0xd8, 0xf3, 0xd3, 0x3e,
+	0xdc, 0x85, 0xe6, 0x01, 0xf1, 0xc9, 0xcb, 0x96, 0x9a, 0xf5, 0x21, 0xa0, 0x94, 0x5e, 0xb1, 0x27,
+	0xd7, 0x60, 0x95, 0x71, 0xcc, 0x27, 0x4c, 0xaf, 0x45, 0xdd, 0xb2, 0xae, 0xc0, 0xd6, 0x3c, 0x88,
+	0xcf, 0x3c, 0xc6, 0x8f, 0x98, 0x6b, 0x7d, 0x01, 0x57, 0xd2, 0xc2, 0x62, 0x9b, 0xef, 0x41, 0x55,
+	0x3b, 0x2b, 0xac, 0x96, 0x5f, 0x92, 0xdc, 0x99, 0xae, 0xf5, 0x02, 0xea, 0x89, 0x8e, 0xc2, 0xfd,
+	0xe3, 0x4d, 0xd8, 0x50, 0x0e, 0x0e, 0xc6, 0x84, 0x31, 0xec, 0x12, 0xed, 0x76, 0x43, 0x49, 0x8f,
+	0x94, 0x10, 0xbd, 0x3b, 0x8b, 0x4a, 0x54, 0xda, 0xc6, 0xee, 0x76, 0x31, 0x7e, 0x5f, 0xea, 0xcc,
+	0x62, 0xfe, 0x8b, 0x01, 0x5b, 0xb9, 0xc4, 0x7f, 0x1f, 0x37, 0x6e, 0x02, 0x9c, 0x4f, 0x86, 0xc4,
+	0xa1, 0xc1, 0x33, 0xcf, 0x6d, 0x95, 0xf5, 0x66, 0x37, 0x93, 0x24, 0xdc, 0x5c, 0x79, 0x05, 0x37,
+	0x7f, 0x06, 0x9b, 0x9f, 0x4e, 0x86, 0x24, 0x0a, 0x08, 0x27, 0xec, 0x33, 0x3c, 0x24, 0x7e, 0xa1,
+	0x8f, 0x57, 0xa1, 0x32, 0xc5, 0xfe, 0x24, 0x76, 0x4d, 0x35, 0xac, 0xaf, 0x4b, 0xf0, 0xda, 0x82,
+	0x85, 0x8e, 0xde, 0x83, 0x55, 0x5f, 0x98, 0x63, 0x2d, 0x43, 0xce, 0xda, 0xcd, 0x8c, 0x3b, 0x19,
+	0x54, 0x5b, 0x6b, 0x23, 0x0b, 0xd6, 0xbd, 0x80, 0x71, 0x1c, 0x38, 0xe4, 0xf4, 0x22, 0x8c, 0x01,
+	0x53, 0x32, 0xe1, 0x8d, 0x43, 0x27, 0x01, 0x97, 0x59, 0xa8, 0xd8, 0xaa, 0x81, 0xba, 0x50, 0x77,
+	0x68, 0xc0, 0x78, 0x84, 0xbd, 0x80, 0xab, 0x2c, 0xd4, 0x77, 0x6f, 0x17, 0xef, 0xc7, 0xdd, 0xb9,
+	0xa2, 0x9d, 0x1c, 0x25, 0x4c, 0x3f, 0xa7, 0x01, 0x61, 0xad, 0x4a, 0xbb, 0x2c, 0x02, 0x95, 0x8d,
+	0xf4, 0x4e, 0xbb, 0x9a, 0xde, 0x69, 0xad, 0xbf, 0x95, 0xa0, 0x9e, 0x8c, 0xbc, 0x28, 0x7f, 0xf3,
+	0x6c, 0x94, 0xbe, 0x57, 0x36, 0xca, 0xcb, 0xb2, 0xb1, 0xb2, 0x24, 0x1b, 0x95, 0xff, 0x2b, 0x1b,
+	0x7b, 0x50, 0x17, 0x09, 0x18, 0xb0, 0x30, 0x22, 0x78, 0x24, 0x23, 0xaf, 0xef, 0xbe, 0x9e, 0x31,
+	0xf2, 0x39, 0x15, 0x81, 0x0b, 0x05, 0x1b, 0x9e, 0xcf, 0xbe, 0x97, 0x9e, 0x4e, 0xd6, 0x1e, 0xc0,
+	0x7c, 0x98, 0xd8, 0x37, 0x42, 0xea, 0x7b, 0xce, 0x85, 0xce, 0x99, 0x6e, 0xcd, 0x27, 0xa3, 0x94,
+	0x98, 0x0c, 0xeb, 0x9f, 0x25, 0x40, 0x79, 0xc7, 0x91, 0x05, 0x8d, 0xb1, 0x17, 0x0c, 0x9c, 0x70,
+	0x32, 0x50, 0xe9, 0x30, 0x64, 0x3a, 0xea, 0x63, 0x2f, 0xe8, 0x86, 0x93, 0xae, 0x4c, 0xca, 0x0d,
+	0x00, 0xa1, 0x33, 0x26, 0x63, 0x1a, 0x5d, 0xe8, 0xb3, 0xa4, 0x36, 0xf6, 0x82, 0x23, 0x29, 0x10,
+	0xd9, 0xc6, 0x91, 0x73, 0xe6, 0x71, 0xe2, 0xf0, 0x49, 0x34, 0xcb, 0x76, 0x52, 0x26, 0x66, 0x57,
+	0xb8, 0xa1, 0x4f, 0x16, 0xf9, 0xad, 0x0e, 0x27, 0xea, 0xcb, 0x24, 0xd7, 0x6c, 0xf9, 0x2d, 0x64,
+	0x1c, 0xbb, 0xa2, 0x5a, 0x84, 0xeb, 0xf2, 0x1b, 0xbd, 0x0e, 0xd5, 0x80, 0xf2, 0x81, 0x94, 0xaf,
+	0x49, 0xf9, 0x5a, 0x40, 0xf9, 0xa9, 0xe8, 0xda, 0x83, 0x35, 0xc6, 0x69, 0x24, 0x56, 0x7f, 0xb5,
+	0x5d, 0x2e, 0xd8, 0xc4, 0xfb, 0xaa, 0x77, 0x1e, 0xb1, 0x1d, 0x0f, 0x40, 0x1f, 0x01, 0xcc, 0x4e,
+	0x3c, 0x71, 0x1e, 0x8b, 0xe1, 0x56, 0x66, 0xf8, 0xec, 0xf8, 0x4b, 0x18, 0x48, 0x8c, 0xb2, 0x7e,
+	0x05, 0x5b, 0x39, 0x04, 0x91, 0x7f, 0x59, 0x87, 0x7a, 0x5a, 0x54, 0x43, 0x44, 0xc6, 0xbc, 0xe7,
+	0x24, 0x3e, 0x8a, 0xc5, 0xf7, 0x2c, 0xda, 0xf2, 0x3c, 0x5a, 0xeb, 0x6b, 0x03, 0xae, 0x14, 0xc0,
+	0x2e, 0xb0, 0x7a, 0x15, 0x2a, 0x2c, 0xc4, 0x8e, 0x32, 0x5b, 0xb3, 0x55, 0x43, 0x9e, 0x28, 0x93,
+	0x61, 0x40, 0xb8, 0x9e, 0x0b, 0xdd, 0x12, 0xf2, 0x67, 0x78, 0x18, 0x79, 0x8e, 0x9e, 0x07, 0xdd,
+	0x52, 0x94, 0x20, 0x3e, 0xcd, 0xc5, 0xa7, 0xb5, 0x29, 0x4f, 0x59, 0xcd, 0x08, 0xc5, 0xb9, 0xf3,
+	0xdf, 0x12, 0x6c, 0xce, 0x25, 0xc5, 0x87, 0xce, 0x10, 0xae, 0x68, 0x56, 0x39, 0xf0, 0x82, 0x67,
+	0x34, 0x1a, 0x4b, 0x82, 0xaa, 0x8f, 0xd7, 0x77, 0x32, 0xa9, 0xcd, 0x18, 0xdb, 0xd1, 0x8d, 0xde,
+	0x7c, 0xa0, 0x8d, 0xa6, 0x39, 0x99, 0xf9, 0x1f, 0x03, 0x50, 0x5e, 0x55, 0xb0, 0x3e, 0xd7, 0xe3,
+	0x33, 0x52, 0xab, 0x72, 0x04, 0xae, 0x17, 0x63, 0x88, 0x1a, 0x16, 0x0a, 0x0e, 0x1d, 0x8f, 0x3d,
+	0xae, 0xb3, 0x55, 0x73, 0x3d, 0xde, 0x95, 0x02, 0xf4, 0x06, 0x6c, 0x88, 0x6e, 0x1e, 0x11, 0x32,
+	0x60, 0x1c, 0xf3, 0x59, 0x15, 0xbb, 0x1e, 0x3f, 0x8d, 0x08, 0x11, 0xfb, 0x3f, 0x11, 0x46, 0x86,
+	0x13, 0xcf, 0x1f, 0x0d, 0x46, 0x42, 0x43, 0xe5, 0xb0, 0x26, 0x25, 0x07, 0xba, 0xdb, 0xa5, 0x33,
+	0x1f, 0x2a, 0x1a, 0x83, 0xc6, 0x2e, 0x98, 0x50, 0x75, 0xe8, 0x38, 0xf4, 0x7c, 0x12, 0xc5, 0xbb,
+	0x61, 0xdc, 0x16, 0x7d, 0xa1, 0x8f, 0xb9, 0x08, 0x28, 0x5e, 0xf5, 0x71, 0xdb, 0xfa, 0x29, 0xdc,
+	0x7a, 0x44, 0xf8, 0x93, 0xd0, 0x8d, 0xf0, 0x28, 0x66, 0x12, 0x89, 0xd8, 0x17, 0x91, 0x8f, 0xc7,
+	0x70, 0x7b, 0xd9, 0xb0, 0xe2, 0x29, 0x34, 0xa1, 0xaa, 0xfd, 0x8f, 0xb7, 0x8f, 0x59, 0xdb, 0xda,
+	0x87, 0xad, 0xb4, 0xb5, 0x05, 0xc8, 0xa8, 0x05, 0x6b, 0xe9, 0xdb, 0x45, 0xdc, 0xb4, 0xde, 0x84,
+	0x2b, 0x69, 0x13, 0x85, 0x5e, 0x58, 0xcf, 0x61, 0x63, 0x7f, 0x34, 0x8a, 0x19, 0xbf, 0x80, 0x69,
+	0x43, 0x5d, 0x73, 0x94, 0xe3, 0x39, 0x5a, 0x52, 0x54, 0x7c, 0xbb, 0x28, 0xbd, 0xf2, 0xed, 0xc2,
+	0xb2, 0xa0, 0x99, 0xc0, 0x2e, 0xf6, 0xef, 0x0b, 0xd8, 0x52, 0xbc, 0xee, 0xd5, 0x5c, 0xbc, 0x0b,
+	0x9b, 0x33, 0xdf, 0x06, 0x22, 0x53, 0x71, 0x8e, 0x1b, 0x81, 0xb6, 0x23, 0xd4, 0x98, 0xf5, 0x21,
+	0xb4, 0xe6, 0x1c, 0x4f, 0x40, 0x30, 0x45, 0x3f, 0x2e, 0x85, 0x62, 0x7d, 0x5d, 0x06, 0xb3, 0x70,
+	0xb8, 0x8a, 0x05, 0xc1, 0x4a, 0x62, 0xa4, 0xfc, 0x9e, 0x9f, 0x85, 0xa5, 0xe4, 0x59, 0xd8, 0x87,
+	0xea, 0x58, 0x65, 0x4a, 0xed, 0x50, 0xf5, 0xdd, 0xf7, 0xf3, 0x6b, 0x78, 0x01, 0xcc, 0x2c, 0xc7,
+	0x4a, 0x34, 0x33, 0x64, 0x7e, 0x67, 0x40, 0x23, 0xd5, 0x87, 0xde, 0x80, 0xc6, 0xf9, 0x07, 0x4c,
+	0x18, 0x50, 0x02, 0xed, 0x59, 0x5a, 0x28, 0x79, 0xdc, 0xec, 0x8a, 0x5a, 0x70, 0x69, 0xb5, 0x60,
+	0x5d, 0xdc, 0xf1, 0xfa, 0x17, 0x8c, 0x93, 0x71, 0x6f, 0x14, 0x5f, 0x61, 0x92, 0xb2, 0x58, 0xe7,
+	0x13, 0xca, 0xb8, 0xac, 0xd9, 0xca, 0x5c, 0x27, 0x96, 0xa1, 0xbb, 0xb0, 0x21, 0xda, 0x09, 0x77,
+	0xd4, 0x52, 0xcd, 0x48, 0x85, 0x3f, 0x42, 0xd2, 0x3b, 0xd9, 0x1f, 0x8d, 0x22, 0xbd, 0x64, 0x13,
+	0x12, 0x51, 0xe9, 0xe9, 0x12, 0x29, 0xae, 0xa4, 0x6f, 0x0c, 0x68, 0xf6, 0x1d, 0xec, 0xbf, 0x62,
+	0x25, 0xfd, 0x02, 0x20, 0x57, 0xe5, 0xb9, 0xa3, 0x2f, 0x69, 0x56, 0xdd, 0x22, 0x83, 0xe2, 0x3b,
+	0x74, 0x39, 0x73, 0x87, 0xb6, 0x7e, 0x0e, 0x5b, 0xb9, 0xd1, 0x8b, 0x08, 0x6e, 0xbe, 0x70, 0xac,
+	0x37, 0x00, 0xa5, 0x86, 0x17, 0x87, 0xfe, 0x14, 0x9a, 0x36, 0x19, 0x52, 0xca, 0x75, 0x39, 0x5c,
+	0x2e, 0xf2, 0xb6, 0xb8, 0xde, 0x4b, 0x7d, 0xa9, 0xa1, 0x0a, 0x21, 0x29, 0x12, 0xe8, 0x29, 0xbb,
+	0xc5, 0xe8, 0xbf, 0x35, 0x60, 0x43, 0x94, 0x2f, 0x0e, 0xb1, 0xe3, 0xf1, 0x0b, 0x01, 0xfe, 0x26,
+	0x6c, 0xc4, 0x0c, 0x71, 0xc0, 0x2f, 0x42, 0xa2, 0x38, 0x78, 0xcd, 0x6e, 0x24, 0x79, 0x23, 0x9b,
+	0x51, 0x99, 0x52, 0x01, 0x95, 0x29, 0x27, 0xa8, 0x4c, 0xe6, 0x21, 0x62, 0x25, 0xfb, 0x10, 0x61,
+	0x7d, 0x0a, 0xcd, 0x84, 0x07, 0xca, 0xcd, 0xf7, 0xa1, 0xea, 0x68, 0x81, 0xbe, 0x01, 0x5c, 0xcf,
+	0x5e, 0x48, 0x74, 0xb7, 0xbe, 0xb8, 0xe9, 0x96, 0xf5, 0x57, 0x03, 0xd6, 0x93, 0x5d, 0xe2, 0x52,
+	0x9f, 0x8a, 0xa6, 0x65, 0x14, 0x90, 0xe0, 0xcb, 0xc6, 0x52, 0x4c, 0x96, 0xf7, 0x12, 0x1b, 0x44,
+	0xa5, 0x90, 0xa0, 0xeb, 0x29, 0xf8, 0x04, 0x47, 0xa3, 0x2f, 0x71, 0x44, 0xe6, 0xfb, 0x80, 0xf5,
+	0x0f, 0x03, 0x36, 0x33, 0xbd, 0x82, 0xfb, 0x32, 0xb9, 0x56, 0xc5, 0xd3, 0x82, 0x72, 0xb7, 0xca,
+	0xe2, 0xc5, 0x6b, 0x42, 0xf5, 0x2c, 0x5e, 0xb8, 0xca, 0xdd, 0x59, 0xfb, 0x52, 0x0c, 0xf4, 0x3a,
+	0xd4, 0xe6, 0x24, 0x57, 0x85, 0x51, 0x75, 0x62, 0x86, 0x7b, 0x0d, 0x56, 0x35, 0xbb, 0x55, 0x1c,
+	0x48, 0xb7, 0xc4, 0x49, 0x16, 0xf3, 0xcb, 0x55, 0xd9, 0x11, 0x37, 0x67, 0xd4, 0x6d, 0x2d, 0x41,
+	0xdd, 0x1e, 0x42, 0x43, 0xdc, 0xc8, 0x7b, 0x63, 0xec, 0x12, 0xb9, 0x59, 0x67, 0x4a, 0xc0, 0xc8,
+	0x95, 0xc0, 0xef, 0x0d, 0xd8, 0x9c, 0x0f, 0x51, 0x25, 0xf0, 0x10, 0x56, 0x3d, 0xd9, 0xd4, 0x05,
+	0xd0, 0xca, 0x72, 0x52, 0xd1, 0x29, 0x67, 0x5f, 0xeb, 0xa1, 0x8f, 0x45, 0xe1, 0x4e, 0xb1, 0xef,
+	0x8d, 0x06, 0x7a, 0xa4, 0xda, 0x11, 0x6e, 0x65, 0x47, 0x2a, 0xa5, 0xb9, 0x81, 0x86, 0x97, 0x90,
+	0x30, 0xeb, 0xef, 0x06, 0xd4, 0x66, 0x9d, 0xe9, 0x9b, 0x88, 0x91, 0x79, 0x27, 0x7b, 0xe9, 0x23,
+	0x62, 0xae, 0xfc, 0x8a, 0xee, 0x60, 0xd9, 0x79, 0x5b, 0x29, 0xbe, 0x39, 0x24, 0x36, 0x6b, 0xf9,
+	0x2d, 0x6a, 0x61, 0x12, 0xfa, 0x14, 0x8f, 0xc8, 0x28, 0x66, 0x52, 0x71, 0xdb, 0x7a, 0x01, 0xcd,
+	0x6c, 0x9c, 0x85, 0x5b, 0x57, 0x16, 0xbb, 0x54, 0x80, 0x9d, 0xc4, 0x29, 0xa7, 0x71, 0x44, 0xc9,
+	0x44, 0x04, 0xb3, 0xd9, 0xca, 0xd6, 0xad, 0xfb, 0x2f, 0xa0, 0x91, 0x7a, 0x33, 0x40, 0xd7, 0x00,
+	0xf5, 0x4f, 0xf7, 0x4f, 0x9f, 0xf4, 0x07, 0x4f, 0x8e, 0xfb, 0x27, 0x87, 0xdd, 0xde, 0xc7, 0xbd,
+	0xc3, 0x83, 0xe6, 0x0f, 0x50, 0x13, 0xd6, 0x4f, 0xec, 0xc7, 0x4f, 0x7b, 0xfd, 0xde, 0xe3, 0xe3,
+	0xde, 0xf1, 0xa3, 0xa6, 0x81, 0xea, 0xb0, 0x66, 0x3f, 0x39, 0x96, 0x8d, 0x12, 0xda, 0x84, 0xba,
+	0x7d, 0xd8, 0x7d, 0x7c, 0xdc, 0xed, 0x7d, 0x26, 0x04, 0x65, 0xb4, 0x0e, 0xd5, 0xfe, 0xe9, 0xe3,
+	0x93, 0x13, 0xd1, 0x5a, 0x41, 0x35, 0xa8, 0x1c, 0xda, 0xf6, 0x63, 0xbb, 0x59, 0x11, 0x1d, 0x07,
+	0x87, 0x8f, 0xec, 0xfd, 0x83, 0xc3, 0x83, 0xe6, 0xea, 0xee, 0x1f, 0x1a, 0xb0, 0xa6, 0x1d, 0x40,
+	0x14, 0x1a, 0xa9, 0x77, 0x38, 0x94, 0xad, 0x88, 0xec, 0x83, 0xb1, 0x79, 0x7b, 0x99, 0x82, 0xac,
+	0x4f, 0xcb, 0xfc, 0xea, 0x5f, 0xff, 0xfe, 0xa6, 0x74, 0xd5, 0xda, 0x94, 0xcf, 0xd6, 0xd3, 0x77,
+	0x3a, 0x7a, 0x7b, 0xde, 0x33, 0xee, 0x23, 0x07, 0x60, 0xce, 0x09, 0xd0, 0xf6, 0x42, 0xba, 0x20,
+	0xa0, 0x6e, 0x2e, 0xec, 0x55, 0x38, 0xaf, 0x49, 0x9c, 0x2d, 0x94, 0xc5, 0x41, 0x3e, 0x34, 0x52,
+	0xaf, 0x6a, 0xb9, 0xa8, 0xb2, 0x6f, 0x73, 0xe6, 0xed, 0x65, 0x0a, 0x29, 0xb4, 0xfb, 0x39, 0x34,
+	0xae, 0xce, 0x89, 0xf9, 0x83, 0x1b, 0x6a, 0x2f, 0x74, 0x5c, 0x3f, 0xd2, 0x99, 0xd6, 0x52, 0x0d,
+	0x05, 0xb8, 0x2d, 0x01, 0xaf, 0xa1, 0xab, 0x19, 0xc0, 0x8e, 0x2f, 0x30, 0xfe, 0x68, 0xc0, 0x0f,
+	0x0b, 0xd9, 0x15, 0x7a, 0xeb, 0x32, 0x1c, 0x4c, 0x38, 0xf1, 0xf6, 0xa5, 0xc9, 0x9a, 0x75, 0x47,
+	0xfa, 0x72, 0x03, 0x5d, 0xcf, 0xfa, 0x22, 0xdf, 0xaa, 0xd5, 0x9b, 0x17, 0x0a, 0xa4, 0x47, 0x05,
+	0x77, 0xaf, 0xed, 0x85, 0x37, 0xbb, 0x05, 0xd3, 0x9c, 0xbc, 0xf7, 0xe5, 0xa7, 0x59, 0xef, 0x29,
+	0x28, 0x80, 0x7a, 0x82, 0x88, 0xa3, 0x1b, 0x19, 0x3b, 0xe9, 0x0b, 0x82, 0x79, 0x6b, 0x71, 0xb7,
+	0xc2, 0xb9, 0x25, 0x71, 0x5e, 0xb7, 0x72, 0xf9, 0x16, 0x07, 0x9c, 0xa8, 0x5d, 0x0e, 0x1b, 0x69,
+	0xc6, 0x96, 0x9b, 0xe8, 0x1c, 0xe7, 0x37, 0xad, 0xa5, 0x1a, 0xa9, 0x89, 0xbe, 0x5f, 0x08, 0x8c,
+	0x38, 0x34, 0x52, 0x5c, 0x29, 0x57, 0xcc, 0x59, 0x76, 0x68, 0xde, 0x5e, 0xa6, 0x90, 0x8a, 0xd5,
+	0x5c, 0x18, 0xeb, 0xb7, 0x06, 0x6c, 0x2f, 0xbb, 0x1c, 0xa2, 0x9d, 0xfc, 0xac, 0x2d, 0xbb, 0x80,
+	0x9a, 0x0f, 0x5f, 0x41, 0x3f, 0xe5, 0x23, 0x7a, 0x2d, 0xeb, 0xe3, 0x44, 0x8d, 0x43, 0xcf, 0x61,
+	0x23, 0x6d, 0x22, 0x37, 0x1f, 0xb9, 0xdb, 0xa8, 0x69, 0x2d, 0xd5, 0x50, 0xc0, 0x96, 0x04, 0xde,
+	0x36, 0x17, 0x01, 0x8b, 0xfc, 0xbc, 0x80, 0x46, 0x8a, 0x43, 0xe6, 0x66, 0x25, 0xcb, 0x5c, 0xcd,
+	0xdb, 0xcb, 0x14, 0x14, 0xf0, 0xdb, 0x12, 0xf8, 0x8e, 0x75, 0x33, 0x0b, 0xac, 0x49, 0x51, 0x27,
+	0x92, 0x63, 0x04, 0xbe, 0x0b, 0xf5, 0x04, 0x35, 0xcc, 0xd5, 0x7e, 0x9a, 0xb8, 0x9a, 0xb7, 0x16,
+	0x77, 0x2b, 0xe4, 0x96, 0x44, 0x46, 0xa8, 0x39, 0x43, 0x8e, 0x2d, 0x0f, 0x01, 0xe6, 0xfc, 0x23,
+	0xb7, 0x92, 0x53, 0x6c, 0xc6, 0xbc, 0xb9, 0xb0, 0x57, 0xa1, 0x5c, 0x93, 0x28, 0x4d, 0xb4, 0x11,
+	0xa3, 0x28, 0x32, 0xf2, 0xd1, 0x9f, 0x4b, 0x7f, 0xda, 0xff, 0x5d, 0x09, 0x7d, 0x65, 0x40, 0x5b,
+	0x4f, 0x44, 0xfb, 0x08, 0x07, 0xd8, 0x25, 0x51, 0x7b, 0xff, 0xa4, 0xd7, 0xee, 0xf7, 0x3f, 0x69,
+	0x87, 0x11, 0x9d, 0x7a, 0x23, 0x12, 0x59, 0x4f, 0x61, 0xbd, 0x8f, 0xc7, 0x6c, 0x12, 0xb8, 0xed,
+	0xee, 0x71, 0xf7, 0x14, 0xbd, 0x75, 0xc6, 0x79, 0xc8, 0xf6, 0x3a, 0x1d, 0xd7, 0xe3, 0x67, 0x93,
+	0xe1, 0x8e, 0x43, 0xc7, 0x1d, 0xa6, 0x14, 0x1e, 0x08, 0x6f, 0x3a, 0xce, 0x18, 0x3f, 0x60, 0xec,
+	0xcc, 0xbc, 0xa1, 0xa5, 0x3b, 0x8e, 0x4f, 0x27, 0xa3, 0x00, 0x73, 0x6f, 0x4a, 0x7e, 0xe9, 0x8e,
+	0xb1, 0xe7, 0x8b, 0x31, 0xbb, 0xab, 0xd3, 0x87, 0x3b, 0xef, 0xec, 0x3c, 0xbc, 0x5f, 0x2a, 0x19,
+	0xbb, 0x4d, 0x1c, 0x86, 0xbe, 0xe7, 0xc8, 0x5a, 0xec, 0xfc, 0x86, 0xd1, 0x60, 0x2f, 0x27, 0x89,
+	0x9e, 0xc2, 0x8f, 0x8e, 0x68, 0x44, 0xda, 0x78, 0x48, 0x27, 0xfc, 0xa5, 0x6e, 0x5f, 0xda, 0xcd,
+	0xcf, 0xb7, 0xc2, 0x73, 0xb7, 0xe3, 0x92, 0x80, 0x44, 0x98, 0x93, 0x91, 0x48, 0xd4, 0x70, 0x55,
+	0xfe, 0x7f, 0xfb, 0x93, 0xff, 0x0d, 0x00, 0xbc, 0x65, 0x1e, 0xdb, 0x27, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1779,6 +2943,12 @@ type ClusterClient interface {
 	GetUpgradeClusterInformation(ctx context.Context, in *GetUpgradeClusterInformationMsg, opts ...grpc.CallOption) (*GetUpgradeClusterInformationReply, error)
 	// Will attempt to upgrade a cluster
 	UpgradeCluster(ctx context.Context, in *UpgradeClusterMsg, opts ...grpc.CallOption) (*UpgradeClusterReply, error)
+	// Will power cycle a machine of a provisioned cluster
+	RebootMachine(ctx context.Context, in *RebootMachineMsg, opts ...grpc.CallOption) (*RebootMachineReply, error)
+	// Will return the MaaS machines available for each instance type, zone and pool
+	GetCapacity(ctx context.Context, in *GetCapacityMsg, opts ...grpc.CallOption) (*GetCapacityReply, error)
+	// Will return the images uploaded to MaaS, and the uploaded boot resources which are not usable images
+	ListImages(ctx context.Context, in *ListImagesMsg, opts ...grpc.CallOption) (*ListImagesReply, error)
 }
 
 type clusterClient struct {
@@ -1888,6 +3058,33 @@ func (c *clusterClient) UpgradeCluster(ctx context.Context, in *UpgradeClusterMs
 	return out, nil
 }
 
+func (c *clusterClient) RebootMachine(ctx context.Context, in *RebootMachineMsg, opts ...grpc.CallOption) (*RebootMachineReply, error) {
+	out := new(RebootMachineReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.api.Cluster/RebootMachine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) GetCapacity(ctx context.Context, in *GetCapacityMsg, opts ...grpc.CallOption) (*GetCapacityReply, error) {
+	out := new(GetCapacityReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.api.Cluster/GetCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) ListImages(ctx context.Context, in *ListImagesMsg, opts ...grpc.CallOption) (*ListImagesReply, error) {
+	out := new(ListImagesReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.api.Cluster/ListImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServer is the server API for Cluster service.
 type ClusterServer interface {
 	// Will provision a cluster
@@ -1912,6 +3109,12 @@ type ClusterServer interface {
 	GetUpgradeClusterInformation(context.Context, *GetUpgradeClusterInformationMsg) (*GetUpgradeClusterInformationReply, error)
 	// Will attempt to upgrade a cluster
 	UpgradeCluster(context.Context, *UpgradeClusterMsg) (*UpgradeClusterReply, error)
+	// Will power cycle a machine of a provisioned cluster
+	RebootMachine(context.Context, *RebootMachineMsg) (*RebootMachineReply, error)
+	// Will return the MaaS machines available for each instance type, zone and pool
+	GetCapacity(context.Context, *GetCapacityMsg) (*GetCapacityReply, error)
+	// Will return the images uploaded to MaaS, and the uploaded boot resources which are not usable images
+	ListImages(context.Context, *ListImagesMsg) (*ListImagesReply, error)
 }
 
 func RegisterClusterServer(s *grpc.Server, srv ClusterServer) {
@@ -2116,6 +3319,60 @@ func _Cluster_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_RebootMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootMachineMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).RebootMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.api.Cluster/RebootMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).RebootMachine(ctx, req.(*RebootMachineMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_GetCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapacityMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).GetCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.api.Cluster/GetCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetCapacity(ctx, req.(*GetCapacityMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_ListImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImagesMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.api.Cluster/ListImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListImages(ctx, req.(*ListImagesMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cluster_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cnct.kaas.api.Cluster",
 	HandlerType: (*ClusterServer)(nil),
@@ -2164,6 +3421,18 @@ var _Cluster_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradeCluster",
 			Handler:    _Cluster_UpgradeCluster_Handler,
 		},
+		{
+			MethodName: "RebootMachine",
+			Handler:    _Cluster_RebootMachine_Handler,
+		},
+		{
+			MethodName: "GetCapacity",
+			Handler:    _Cluster_GetCapacity_Handler,
+		},
+		{
+			MethodName: "ListImages",
+			Handler:    _Cluster_ListImages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
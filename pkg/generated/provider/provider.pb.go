@@ -0,0 +1,2178 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: provider.proto
+
+package provider
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// MachineStatus is the deployment state of an allocated machine.
+type MachineStatus int32
+
+const (
+	// Not set
+	MachineStatus_STATUS_UNSPECIFIED MachineStatus = 0
+	// The machine is allocated and not deployed yet.
+	MachineStatus_ALLOCATED MachineStatus = 1
+	// The machine is being deployed.
+	MachineStatus_DEPLOYING MachineStatus = 2
+	// The machine is deployed and boots with the userdata.
+	MachineStatus_DEPLOYED MachineStatus = 3
+	// The deployment failed, status_message tells why.
+	MachineStatus_FAILED_DEPLOYMENT MachineStatus = 4
+)
+
+var MachineStatus_name = map[int32]string{
+	0: "STATUS_UNSPECIFIED",
+	1: "ALLOCATED",
+	2: "DEPLOYING",
+	3: "DEPLOYED",
+	4: "FAILED_DEPLOYMENT",
+}
+
+var MachineStatus_value = map[string]int32{
+	"STATUS_UNSPECIFIED": 0,
+	"ALLOCATED":          1,
+	"DEPLOYING":          2,
+	"DEPLOYED":           3,
+	"FAILED_DEPLOYMENT":  4,
+}
+
+func (x MachineStatus) String() string {
+	return proto.EnumName(MachineStatus_name, int32(x))
+}
+
+func (MachineStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{0}
+}
+
+type AllocateMsg struct {
+	// Unique id of the machine chosen by cma-ssh. A machine already allocated for it is returned.
+	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// The instance type of the machine, any machine if empty
+	InstanceType string `protobuf:"bytes,2,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	// Further restrictions on the machine
+	Constraints          *Constraints `protobuf:"bytes,3,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AllocateMsg) Reset()         { *m = AllocateMsg{} }
+func (m *AllocateMsg) String() string { return proto.CompactTextString(m) }
+func (*AllocateMsg) ProtoMessage()    {}
+func (*AllocateMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{0}
+}
+
+func (m *AllocateMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllocateMsg.Unmarshal(m, b)
+}
+func (m *AllocateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllocateMsg.Marshal(b, m, deterministic)
+}
+func (m *AllocateMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocateMsg.Merge(m, src)
+}
+func (m *AllocateMsg) XXX_Size() int {
+	return xxx_messageInfo_AllocateMsg.Size(m)
+}
+func (m *AllocateMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocateMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocateMsg proto.InternalMessageInfo
+
+func (m *AllocateMsg) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *AllocateMsg) GetInstanceType() string {
+	if m != nil {
+		return m.InstanceType
+	}
+	return ""
+}
+
+func (m *AllocateMsg) GetConstraints() *Constraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+type AllocateReply struct {
+	// The allocated machine
+	Machine              *Machine `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AllocateReply) Reset()         { *m = AllocateReply{} }
+func (m *AllocateReply) String() string { return proto.CompactTextString(m) }
+func (*AllocateReply) ProtoMessage()    {}
+func (*AllocateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{1}
+}
+
+func (m *AllocateReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllocateReply.Unmarshal(m, b)
+}
+func (m *AllocateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllocateReply.Marshal(b, m, deterministic)
+}
+func (m *AllocateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocateReply.Merge(m, src)
+}
+func (m *AllocateReply) XXX_Size() int {
+	return xxx_messageInfo_AllocateReply.Size(m)
+}
+func (m *AllocateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocateReply proto.InternalMessageInfo
+
+func (m *AllocateReply) GetMachine() *Machine {
+	if m != nil {
+		return m.Machine
+	}
+	return nil
+}
+
+type Constraints struct {
+	// The minimum number of cpu cores
+	MinCpuCount int32 `protobuf:"varint,1,opt,name=min_cpu_count,json=minCpuCount,proto3" json:"min_cpu_count,omitempty"`
+	// The minimum amount of memory in MiB
+	MinMemory int32 `protobuf:"varint,2,opt,name=min_memory,json=minMemory,proto3" json:"min_memory,omitempty"`
+	// The architecture of the machine, e.g. amd64/generic
+	Architecture string `protobuf:"bytes,3,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// The availability zone of the machine
+	Zone string `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
+	// The resource pool of the machine
+	Pool string `protobuf:"bytes,5,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Tags the machine must have
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Tags the machine must not have
+	NotTags []string `protobuf:"bytes,7,rep,name=not_tags,json=notTags,proto3" json:"not_tags,omitempty"`
+	// Disks the machine must have, the first one is the root disk
+	Storage []*StorageConstraint `protobuf:"bytes,8,rep,name=storage,proto3" json:"storage,omitempty"`
+	// Interfaces the machine must have
+	Interfaces           []*InterfaceConstraint `protobuf:"bytes,9,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Constraints) Reset()         { *m = Constraints{} }
+func (m *Constraints) String() string { return proto.CompactTextString(m) }
+func (*Constraints) ProtoMessage()    {}
+func (*Constraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{2}
+}
+
+func (m *Constraints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Constraints.Unmarshal(m, b)
+}
+func (m *Constraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Constraints.Marshal(b, m, deterministic)
+}
+func (m *Constraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Constraints.Merge(m, src)
+}
+func (m *Constraints) XXX_Size() int {
+	return xxx_messageInfo_Constraints.Size(m)
+}
+func (m *Constraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_Constraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Constraints proto.InternalMessageInfo
+
+func (m *Constraints) GetMinCpuCount() int32 {
+	if m != nil {
+		return m.MinCpuCount
+	}
+	return 0
+}
+
+func (m *Constraints) GetMinMemory() int32 {
+	if m != nil {
+		return m.MinMemory
+	}
+	return 0
+}
+
+func (m *Constraints) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *Constraints) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *Constraints) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *Constraints) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Constraints) GetNotTags() []string {
+	if m != nil {
+		return m.NotTags
+	}
+	return nil
+}
+
+func (m *Constraints) GetStorage() []*StorageConstraint {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *Constraints) GetInterfaces() []*InterfaceConstraint {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+type StorageConstraint struct {
+	// Identifies the disk
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// The minimum size of the disk in GB
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Tags the disk must have
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageConstraint) Reset()         { *m = StorageConstraint{} }
+func (m *StorageConstraint) String() string { return proto.CompactTextString(m) }
+func (*StorageConstraint) ProtoMessage()    {}
+func (*StorageConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{3}
+}
+
+func (m *StorageConstraint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageConstraint.Unmarshal(m, b)
+}
+func (m *StorageConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageConstraint.Marshal(b, m, deterministic)
+}
+func (m *StorageConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageConstraint.Merge(m, src)
+}
+func (m *StorageConstraint) XXX_Size() int {
+	return xxx_messageInfo_StorageConstraint.Size(m)
+}
+func (m *StorageConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageConstraint proto.InternalMessageInfo
+
+func (m *StorageConstraint) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *StorageConstraint) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *StorageConstraint) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type InterfaceConstraint struct {
+	// Identifies the interface
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// The space the interface is attached to
+	Space string `protobuf:"bytes,2,opt,name=space,proto3" json:"space,omitempty"`
+	// The name or cidr of the subnet the interface is attached to
+	Subnet string `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// The fabric the interface is attached to
+	Fabric string `protobuf:"bytes,4,opt,name=fabric,proto3" json:"fabric,omitempty"`
+	// The vlan id the interface is attached to, any vlan if not set
+	HasVid               bool     `protobuf:"varint,5,opt,name=has_vid,json=hasVid,proto3" json:"has_vid,omitempty"`
+	Vid                  int32    `protobuf:"varint,6,opt,name=vid,proto3" json:"vid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InterfaceConstraint) Reset()         { *m = InterfaceConstraint{} }
+func (m *InterfaceConstraint) String() string { return proto.CompactTextString(m) }
+func (*InterfaceConstraint) ProtoMessage()    {}
+func (*InterfaceConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{4}
+}
+
+func (m *InterfaceConstraint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InterfaceConstraint.Unmarshal(m, b)
+}
+func (m *InterfaceConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InterfaceConstraint.Marshal(b, m, deterministic)
+}
+func (m *InterfaceConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterfaceConstraint.Merge(m, src)
+}
+func (m *InterfaceConstraint) XXX_Size() int {
+	return xxx_messageInfo_InterfaceConstraint.Size(m)
+}
+func (m *InterfaceConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterfaceConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterfaceConstraint proto.InternalMessageInfo
+
+func (m *InterfaceConstraint) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *InterfaceConstraint) GetSpace() string {
+	if m != nil {
+		return m.Space
+	}
+	return ""
+}
+
+func (m *InterfaceConstraint) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *InterfaceConstraint) GetFabric() string {
+	if m != nil {
+		return m.Fabric
+	}
+	return ""
+}
+
+func (m *InterfaceConstraint) GetHasVid() bool {
+	if m != nil {
+		return m.HasVid
+	}
+	return false
+}
+
+func (m *InterfaceConstraint) GetVid() int32 {
+	if m != nil {
+		return m.Vid
+	}
+	return 0
+}
+
+type DeployMsg struct {
+	// The system id returned by Allocate
+	SystemId string `protobuf:"bytes,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// The provider id passed to Allocate
+	ProviderId string `protobuf:"bytes,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// The name of the image to install, see ListImages
+	Distro string `protobuf:"bytes,3,opt,name=distro,proto3" json:"distro,omitempty"`
+	// The cloud-init configuration of the machine
+	Userdata string `protobuf:"bytes,4,opt,name=userdata,proto3" json:"userdata,omitempty"`
+	// The network layout to apply before the machine is deployed, if any
+	Network *Network `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	// The storage layout to apply before the machine is deployed, if any
+	Storage              *Storage `protobuf:"bytes,6,opt,name=storage,proto3" json:"storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeployMsg) Reset()         { *m = DeployMsg{} }
+func (m *DeployMsg) String() string { return proto.CompactTextString(m) }
+func (*DeployMsg) ProtoMessage()    {}
+func (*DeployMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{5}
+}
+
+func (m *DeployMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployMsg.Unmarshal(m, b)
+}
+func (m *DeployMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployMsg.Marshal(b, m, deterministic)
+}
+func (m *DeployMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployMsg.Merge(m, src)
+}
+func (m *DeployMsg) XXX_Size() int {
+	return xxx_messageInfo_DeployMsg.Size(m)
+}
+func (m *DeployMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployMsg proto.InternalMessageInfo
+
+func (m *DeployMsg) GetSystemId() string {
+	if m != nil {
+		return m.SystemId
+	}
+	return ""
+}
+
+func (m *DeployMsg) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *DeployMsg) GetDistro() string {
+	if m != nil {
+		return m.Distro
+	}
+	return ""
+}
+
+func (m *DeployMsg) GetUserdata() string {
+	if m != nil {
+		return m.Userdata
+	}
+	return ""
+}
+
+func (m *DeployMsg) GetNetwork() *Network {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *DeployMsg) GetStorage() *Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+type DeployReply struct {
+	// The block devices of the machine as they will be deployed
+	BlockDevices         []*BlockDevice `protobuf:"bytes,1,rep,name=block_devices,json=blockDevices,proto3" json:"block_devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DeployReply) Reset()         { *m = DeployReply{} }
+func (m *DeployReply) String() string { return proto.CompactTextString(m) }
+func (*DeployReply) ProtoMessage()    {}
+func (*DeployReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{6}
+}
+
+func (m *DeployReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployReply.Unmarshal(m, b)
+}
+func (m *DeployReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployReply.Marshal(b, m, deterministic)
+}
+func (m *DeployReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployReply.Merge(m, src)
+}
+func (m *DeployReply) XXX_Size() int {
+	return xxx_messageInfo_DeployReply.Size(m)
+}
+func (m *DeployReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployReply proto.InternalMessageInfo
+
+func (m *DeployReply) GetBlockDevices() []*BlockDevice {
+	if m != nil {
+		return m.BlockDevices
+	}
+	return nil
+}
+
+type Network struct {
+	// Bonds to create from physical interfaces
+	Bonds []*Bond `protobuf:"bytes,1,rep,name=bonds,proto3" json:"bonds,omitempty"`
+	// Interfaces to link to subnets
+	Interfaces           []*Interface `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Network) Reset()         { *m = Network{} }
+func (m *Network) String() string { return proto.CompactTextString(m) }
+func (*Network) ProtoMessage()    {}
+func (*Network) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{7}
+}
+
+func (m *Network) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Network.Unmarshal(m, b)
+}
+func (m *Network) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Network.Marshal(b, m, deterministic)
+}
+func (m *Network) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Network.Merge(m, src)
+}
+func (m *Network) XXX_Size() int {
+	return xxx_messageInfo_Network.Size(m)
+}
+func (m *Network) XXX_DiscardUnknown() {
+	xxx_messageInfo_Network.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Network proto.InternalMessageInfo
+
+func (m *Network) GetBonds() []*Bond {
+	if m != nil {
+		return m.Bonds
+	}
+	return nil
+}
+
+func (m *Network) GetInterfaces() []*Interface {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+type Bond struct {
+	// The name of the bond interface
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The names of the bonded interfaces
+	Parents []string `protobuf:"bytes,2,rep,name=parents,proto3" json:"parents,omitempty"`
+	// The bonding mode, the plugin default if empty
+	Mode                 string   `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Bond) Reset()         { *m = Bond{} }
+func (m *Bond) String() string { return proto.CompactTextString(m) }
+func (*Bond) ProtoMessage()    {}
+func (*Bond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{8}
+}
+
+func (m *Bond) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bond.Unmarshal(m, b)
+}
+func (m *Bond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bond.Marshal(b, m, deterministic)
+}
+func (m *Bond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bond.Merge(m, src)
+}
+func (m *Bond) XXX_Size() int {
+	return xxx_messageInfo_Bond.Size(m)
+}
+func (m *Bond) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bond proto.InternalMessageInfo
+
+func (m *Bond) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Bond) GetParents() []string {
+	if m != nil {
+		return m.Parents
+	}
+	return nil
+}
+
+func (m *Bond) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+type Interface struct {
+	// The name of a physical interface or bond
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The vlan id of a vlan interface on top of the interface, 0 for none
+	Vlan int32 `protobuf:"varint,2,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	// The name or cidr of the subnet to link
+	Subnet string `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// The link mode, one of AUTO, STATIC, DHCP or LINK_UP
+	Mode string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// The address of a STATIC link
+	IpAddress string `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Whether the address of the interface is the node ip
+	NodeIp               bool     `protobuf:"varint,6,opt,name=node_ip,json=nodeIp,proto3" json:"node_ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Interface) Reset()         { *m = Interface{} }
+func (m *Interface) String() string { return proto.CompactTextString(m) }
+func (*Interface) ProtoMessage()    {}
+func (*Interface) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{9}
+}
+
+func (m *Interface) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Interface.Unmarshal(m, b)
+}
+func (m *Interface) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Interface.Marshal(b, m, deterministic)
+}
+func (m *Interface) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Interface.Merge(m, src)
+}
+func (m *Interface) XXX_Size() int {
+	return xxx_messageInfo_Interface.Size(m)
+}
+func (m *Interface) XXX_DiscardUnknown() {
+	xxx_messageInfo_Interface.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Interface proto.InternalMessageInfo
+
+func (m *Interface) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Interface) GetVlan() int32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *Interface) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *Interface) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *Interface) GetIpAddress() string {
+	if m != nil {
+		return m.IpAddress
+	}
+	return ""
+}
+
+func (m *Interface) GetNodeIp() bool {
+	if m != nil {
+		return m.NodeIp
+	}
+	return false
+}
+
+type Storage struct {
+	// The layout of the root filesystem, one of flat, lvm, bcache or raid1
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Disks formatted and mounted on their own
+	DedicatedDisks       []*DedicatedDisk `protobuf:"bytes,2,rep,name=dedicated_disks,json=dedicatedDisks,proto3" json:"dedicated_disks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Storage) Reset()         { *m = Storage{} }
+func (m *Storage) String() string { return proto.CompactTextString(m) }
+func (*Storage) ProtoMessage()    {}
+func (*Storage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{10}
+}
+
+func (m *Storage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Storage.Unmarshal(m, b)
+}
+func (m *Storage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Storage.Marshal(b, m, deterministic)
+}
+func (m *Storage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Storage.Merge(m, src)
+}
+func (m *Storage) XXX_Size() int {
+	return xxx_messageInfo_Storage.Size(m)
+}
+func (m *Storage) XXX_DiscardUnknown() {
+	xxx_messageInfo_Storage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Storage proto.InternalMessageInfo
+
+func (m *Storage) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *Storage) GetDedicatedDisks() []*DedicatedDisk {
+	if m != nil {
+		return m.DedicatedDisks
+	}
+	return nil
+}
+
+type DedicatedDisk struct {
+	// The mount point of the disk
+	MountPoint string `protobuf:"bytes,1,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	// The name of the disk, the smallest free disk with the tags if empty
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Tags the disk must have
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DedicatedDisk) Reset()         { *m = DedicatedDisk{} }
+func (m *DedicatedDisk) String() string { return proto.CompactTextString(m) }
+func (*DedicatedDisk) ProtoMessage()    {}
+func (*DedicatedDisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{11}
+}
+
+func (m *DedicatedDisk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DedicatedDisk.Unmarshal(m, b)
+}
+func (m *DedicatedDisk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DedicatedDisk.Marshal(b, m, deterministic)
+}
+func (m *DedicatedDisk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DedicatedDisk.Merge(m, src)
+}
+func (m *DedicatedDisk) XXX_Size() int {
+	return xxx_messageInfo_DedicatedDisk.Size(m)
+}
+func (m *DedicatedDisk) XXX_DiscardUnknown() {
+	xxx_messageInfo_DedicatedDisk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DedicatedDisk proto.InternalMessageInfo
+
+func (m *DedicatedDisk) GetMountPoint() string {
+	if m != nil {
+		return m.MountPoint
+	}
+	return ""
+}
+
+func (m *DedicatedDisk) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DedicatedDisk) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type BlockDevice struct {
+	// The name of the device, e.g. sda
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The model of a physical disk
+	Model string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// The size of the device in GB
+	Size int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Tags of the device
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// What the device is used for
+	UsedFor string `protobuf:"bytes,5,opt,name=used_for,json=usedFor,proto3" json:"used_for,omitempty"`
+	// The mount points of the device and its partitions
+	MountPoints          []string `protobuf:"bytes,6,rep,name=mount_points,json=mountPoints,proto3" json:"mount_points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockDevice) Reset()         { *m = BlockDevice{} }
+func (m *BlockDevice) String() string { return proto.CompactTextString(m) }
+func (*BlockDevice) ProtoMessage()    {}
+func (*BlockDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{12}
+}
+
+func (m *BlockDevice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockDevice.Unmarshal(m, b)
+}
+func (m *BlockDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockDevice.Marshal(b, m, deterministic)
+}
+func (m *BlockDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDevice.Merge(m, src)
+}
+func (m *BlockDevice) XXX_Size() int {
+	return xxx_messageInfo_BlockDevice.Size(m)
+}
+func (m *BlockDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDevice proto.InternalMessageInfo
+
+func (m *BlockDevice) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BlockDevice) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *BlockDevice) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *BlockDevice) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *BlockDevice) GetUsedFor() string {
+	if m != nil {
+		return m.UsedFor
+	}
+	return ""
+}
+
+func (m *BlockDevice) GetMountPoints() []string {
+	if m != nil {
+		return m.MountPoints
+	}
+	return nil
+}
+
+type ReleaseMsg struct {
+	// The provider id passed to Allocate
+	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// The system id returned by Allocate
+	SystemId             string   `protobuf:"bytes,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseMsg) Reset()         { *m = ReleaseMsg{} }
+func (m *ReleaseMsg) String() string { return proto.CompactTextString(m) }
+func (*ReleaseMsg) ProtoMessage()    {}
+func (*ReleaseMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{13}
+}
+
+func (m *ReleaseMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseMsg.Unmarshal(m, b)
+}
+func (m *ReleaseMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseMsg.Marshal(b, m, deterministic)
+}
+func (m *ReleaseMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseMsg.Merge(m, src)
+}
+func (m *ReleaseMsg) XXX_Size() int {
+	return xxx_messageInfo_ReleaseMsg.Size(m)
+}
+func (m *ReleaseMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseMsg proto.InternalMessageInfo
+
+func (m *ReleaseMsg) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *ReleaseMsg) GetSystemId() string {
+	if m != nil {
+		return m.SystemId
+	}
+	return ""
+}
+
+type ReleaseReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseReply) Reset()         { *m = ReleaseReply{} }
+func (m *ReleaseReply) String() string { return proto.CompactTextString(m) }
+func (*ReleaseReply) ProtoMessage()    {}
+func (*ReleaseReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{14}
+}
+
+func (m *ReleaseReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseReply.Unmarshal(m, b)
+}
+func (m *ReleaseReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseReply.Marshal(b, m, deterministic)
+}
+func (m *ReleaseReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseReply.Merge(m, src)
+}
+func (m *ReleaseReply) XXX_Size() int {
+	return xxx_messageInfo_ReleaseReply.Size(m)
+}
+func (m *ReleaseReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseReply proto.InternalMessageInfo
+
+type StatusMsg struct {
+	// The provider id passed to Allocate
+	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// The system id returned by Allocate
+	SystemId             string   `protobuf:"bytes,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusMsg) Reset()         { *m = StatusMsg{} }
+func (m *StatusMsg) String() string { return proto.CompactTextString(m) }
+func (*StatusMsg) ProtoMessage()    {}
+func (*StatusMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{15}
+}
+
+func (m *StatusMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusMsg.Unmarshal(m, b)
+}
+func (m *StatusMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusMsg.Marshal(b, m, deterministic)
+}
+func (m *StatusMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusMsg.Merge(m, src)
+}
+func (m *StatusMsg) XXX_Size() int {
+	return xxx_messageInfo_StatusMsg.Size(m)
+}
+func (m *StatusMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusMsg proto.InternalMessageInfo
+
+func (m *StatusMsg) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *StatusMsg) GetSystemId() string {
+	if m != nil {
+		return m.SystemId
+	}
+	return ""
+}
+
+type StatusReply struct {
+	// The machine allocated for the provider or system id, every allocated machine if both are empty
+	Machines             []*Machine `protobuf:"bytes,1,rep,name=machines,proto3" json:"machines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *StatusReply) Reset()         { *m = StatusReply{} }
+func (m *StatusReply) String() string { return proto.CompactTextString(m) }
+func (*StatusReply) ProtoMessage()    {}
+func (*StatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{16}
+}
+
+func (m *StatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusReply.Unmarshal(m, b)
+}
+func (m *StatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusReply.Marshal(b, m, deterministic)
+}
+func (m *StatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusReply.Merge(m, src)
+}
+func (m *StatusReply) XXX_Size() int {
+	return xxx_messageInfo_StatusReply.Size(m)
+}
+func (m *StatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusReply proto.InternalMessageInfo
+
+func (m *StatusReply) GetMachines() []*Machine {
+	if m != nil {
+		return m.Machines
+	}
+	return nil
+}
+
+type Machine struct {
+	// The provider id the machine was allocated for
+	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// Unique id of the machine chosen by the plugin
+	SystemId string `protobuf:"bytes,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// The hostname of the machine
+	Hostname string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The deployment state of the machine
+	Status MachineStatus `protobuf:"varint,4,opt,name=status,proto3,enum=cnct.kaas.provider.MachineStatus" json:"status,omitempty"`
+	// A human readable detail of the status, e.g. why the deployment failed
+	StatusMessage string `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	// The addresses of the machine, the first one is the node ip by default
+	IpAddresses []string `protobuf:"bytes,6,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	// The addresses of each interface of the machine
+	Interfaces []*InterfaceAddresses `protobuf:"bytes,7,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	// The availability zone of the machine
+	Zone                 string   `protobuf:"bytes,8,opt,name=zone,proto3" json:"zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Machine) Reset()         { *m = Machine{} }
+func (m *Machine) String() string { return proto.CompactTextString(m) }
+func (*Machine) ProtoMessage()    {}
+func (*Machine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{17}
+}
+
+func (m *Machine) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Machine.Unmarshal(m, b)
+}
+func (m *Machine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Machine.Marshal(b, m, deterministic)
+}
+func (m *Machine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Machine.Merge(m, src)
+}
+func (m *Machine) XXX_Size() int {
+	return xxx_messageInfo_Machine.Size(m)
+}
+func (m *Machine) XXX_DiscardUnknown() {
+	xxx_messageInfo_Machine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Machine proto.InternalMessageInfo
+
+func (m *Machine) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *Machine) GetSystemId() string {
+	if m != nil {
+		return m.SystemId
+	}
+	return ""
+}
+
+func (m *Machine) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *Machine) GetStatus() MachineStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MachineStatus_STATUS_UNSPECIFIED
+}
+
+func (m *Machine) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
+func (m *Machine) GetIpAddresses() []string {
+	if m != nil {
+		return m.IpAddresses
+	}
+	return nil
+}
+
+func (m *Machine) GetInterfaces() []*InterfaceAddresses {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+func (m *Machine) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+type InterfaceAddresses struct {
+	// The name of the interface
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The addresses of the interface
+	IpAddresses          []string `protobuf:"bytes,2,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InterfaceAddresses) Reset()         { *m = InterfaceAddresses{} }
+func (m *InterfaceAddresses) String() string { return proto.CompactTextString(m) }
+func (*InterfaceAddresses) ProtoMessage()    {}
+func (*InterfaceAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{18}
+}
+
+func (m *InterfaceAddresses) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InterfaceAddresses.Unmarshal(m, b)
+}
+func (m *InterfaceAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InterfaceAddresses.Marshal(b, m, deterministic)
+}
+func (m *InterfaceAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterfaceAddresses.Merge(m, src)
+}
+func (m *InterfaceAddresses) XXX_Size() int {
+	return xxx_messageInfo_InterfaceAddresses.Size(m)
+}
+func (m *InterfaceAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterfaceAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterfaceAddresses proto.InternalMessageInfo
+
+func (m *InterfaceAddresses) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InterfaceAddresses) GetIpAddresses() []string {
+	if m != nil {
+		return m.IpAddresses
+	}
+	return nil
+}
+
+type ListImagesMsg struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListImagesMsg) Reset()         { *m = ListImagesMsg{} }
+func (m *ListImagesMsg) String() string { return proto.CompactTextString(m) }
+func (*ListImagesMsg) ProtoMessage()    {}
+func (*ListImagesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{19}
+}
+
+func (m *ListImagesMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListImagesMsg.Unmarshal(m, b)
+}
+func (m *ListImagesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListImagesMsg.Marshal(b, m, deterministic)
+}
+func (m *ListImagesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListImagesMsg.Merge(m, src)
+}
+func (m *ListImagesMsg) XXX_Size() int {
+	return xxx_messageInfo_ListImagesMsg.Size(m)
+}
+func (m *ListImagesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListImagesMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListImagesMsg proto.InternalMessageInfo
+
+type ListImagesReply struct {
+	// The images machines can be deployed with
+	Images               []*Image `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListImagesReply) Reset()         { *m = ListImagesReply{} }
+func (m *ListImagesReply) String() string { return proto.CompactTextString(m) }
+func (*ListImagesReply) ProtoMessage()    {}
+func (*ListImagesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{20}
+}
+
+func (m *ListImagesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListImagesReply.Unmarshal(m, b)
+}
+func (m *ListImagesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListImagesReply.Marshal(b, m, deterministic)
+}
+func (m *ListImagesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListImagesReply.Merge(m, src)
+}
+func (m *ListImagesReply) XXX_Size() int {
+	return xxx_messageInfo_ListImagesReply.Size(m)
+}
+func (m *ListImagesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListImagesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListImagesReply proto.InternalMessageInfo
+
+func (m *ListImagesReply) GetImages() []*Image {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+type Image struct {
+	// The name of the image, e.g. os=ubuntu-xenial,k8s=1.13.5,standard
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The architecture the image was built for, e.g. amd64/generic
+	Architecture string `protobuf:"bytes,2,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// The day the image was uploaded, as an RFC 3339 date, empty if unknown
+	Uploaded             string   `protobuf:"bytes,3,opt,name=uploaded,proto3" json:"uploaded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Image) Reset()         { *m = Image{} }
+func (m *Image) String() string { return proto.CompactTextString(m) }
+func (*Image) ProtoMessage()    {}
+func (*Image) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{21}
+}
+
+func (m *Image) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Image.Unmarshal(m, b)
+}
+func (m *Image) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Image.Marshal(b, m, deterministic)
+}
+func (m *Image) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Image.Merge(m, src)
+}
+func (m *Image) XXX_Size() int {
+	return xxx_messageInfo_Image.Size(m)
+}
+func (m *Image) XXX_DiscardUnknown() {
+	xxx_messageInfo_Image.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Image proto.InternalMessageInfo
+
+func (m *Image) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Image) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *Image) GetUploaded() string {
+	if m != nil {
+		return m.Uploaded
+	}
+	return ""
+}
+
+type CapacityMsg struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CapacityMsg) Reset()         { *m = CapacityMsg{} }
+func (m *CapacityMsg) String() string { return proto.CompactTextString(m) }
+func (*CapacityMsg) ProtoMessage()    {}
+func (*CapacityMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{22}
+}
+
+func (m *CapacityMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityMsg.Unmarshal(m, b)
+}
+func (m *CapacityMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityMsg.Marshal(b, m, deterministic)
+}
+func (m *CapacityMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityMsg.Merge(m, src)
+}
+func (m *CapacityMsg) XXX_Size() int {
+	return xxx_messageInfo_CapacityMsg.Size(m)
+}
+func (m *CapacityMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapacityMsg proto.InternalMessageInfo
+
+type CapacityReply struct {
+	// The machines ready to be allocated
+	Available []*Hardware `protobuf:"bytes,1,rep,name=available,proto3" json:"available,omitempty"`
+	// Every machine of the inventory whatever its state
+	Hosts []*Host `protobuf:"bytes,2,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	// The availability zones machines can be allocated in
+	Zones                []string `protobuf:"bytes,3,rep,name=zones,proto3" json:"zones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CapacityReply) Reset()         { *m = CapacityReply{} }
+func (m *CapacityReply) String() string { return proto.CompactTextString(m) }
+func (*CapacityReply) ProtoMessage()    {}
+func (*CapacityReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{23}
+}
+
+func (m *CapacityReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityReply.Unmarshal(m, b)
+}
+func (m *CapacityReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityReply.Marshal(b, m, deterministic)
+}
+func (m *CapacityReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityReply.Merge(m, src)
+}
+func (m *CapacityReply) XXX_Size() int {
+	return xxx_messageInfo_CapacityReply.Size(m)
+}
+func (m *CapacityReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapacityReply proto.InternalMessageInfo
+
+func (m *CapacityReply) GetAvailable() []*Hardware {
+	if m != nil {
+		return m.Available
+	}
+	return nil
+}
+
+func (m *CapacityReply) GetHosts() []*Host {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
+func (m *CapacityReply) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+type Hardware struct {
+	// Unique id of the machine chosen by the plugin
+	SystemId string `protobuf:"bytes,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// The hostname of the machine
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The availability zone of the machine
+	Zone string `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	// The resource pool of the machine
+	Pool string `protobuf:"bytes,4,opt,name=pool,proto3" json:"pool,omitempty"`
+	// The architecture of the machine, e.g. amd64/generic
+	Architecture string `protobuf:"bytes,5,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// Tags of the machine, the instance types it can be allocated as
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The number of cpu cores
+	CpuCount int32 `protobuf:"varint,7,opt,name=cpu_count,json=cpuCount,proto3" json:"cpu_count,omitempty"`
+	// The amount of memory in MiB
+	Memory int32 `protobuf:"varint,8,opt,name=memory,proto3" json:"memory,omitempty"`
+	// The physical disks of the machine
+	Disks                []*Disk  `protobuf:"bytes,9,rep,name=disks,proto3" json:"disks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Hardware) Reset()         { *m = Hardware{} }
+func (m *Hardware) String() string { return proto.CompactTextString(m) }
+func (*Hardware) ProtoMessage()    {}
+func (*Hardware) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{24}
+}
+
+func (m *Hardware) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hardware.Unmarshal(m, b)
+}
+func (m *Hardware) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hardware.Marshal(b, m, deterministic)
+}
+func (m *Hardware) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hardware.Merge(m, src)
+}
+func (m *Hardware) XXX_Size() int {
+	return xxx_messageInfo_Hardware.Size(m)
+}
+func (m *Hardware) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hardware.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hardware proto.InternalMessageInfo
+
+func (m *Hardware) GetSystemId() string {
+	if m != nil {
+		return m.SystemId
+	}
+	return ""
+}
+
+func (m *Hardware) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *Hardware) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *Hardware) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *Hardware) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *Hardware) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Hardware) GetCpuCount() int32 {
+	if m != nil {
+		return m.CpuCount
+	}
+	return 0
+}
+
+func (m *Hardware) GetMemory() int32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *Hardware) GetDisks() []*Disk {
+	if m != nil {
+		return m.Disks
+	}
+	return nil
+}
+
+type Disk struct {
+	// The name of the disk, e.g. sda
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The model of the disk
+	Model string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// The size of the disk in GB
+	Size int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Tags of the disk, e.g. ssd
+	Tags                 []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Disk) Reset()         { *m = Disk{} }
+func (m *Disk) String() string { return proto.CompactTextString(m) }
+func (*Disk) ProtoMessage()    {}
+func (*Disk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{25}
+}
+
+func (m *Disk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Disk.Unmarshal(m, b)
+}
+func (m *Disk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Disk.Marshal(b, m, deterministic)
+}
+func (m *Disk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Disk.Merge(m, src)
+}
+func (m *Disk) XXX_Size() int {
+	return xxx_messageInfo_Disk.Size(m)
+}
+func (m *Disk) XXX_DiscardUnknown() {
+	xxx_messageInfo_Disk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Disk proto.InternalMessageInfo
+
+func (m *Disk) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Disk) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *Disk) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Disk) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type Host struct {
+	// The hardware of the machine
+	Hardware *Hardware `protobuf:"bytes,1,opt,name=hardware,proto3" json:"hardware,omitempty"`
+	// The inventory state of the machine, e.g. Ready or Deployed
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The power state of the machine, e.g. on or off
+	PowerState string `protobuf:"bytes,3,opt,name=power_state,json=powerState,proto3" json:"power_state,omitempty"`
+	// The user the machine is allocated to, empty if it is free
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The provider id the machine was allocated for
+	ProviderId string `protobuf:"bytes,5,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// The network interfaces of the machine
+	Nics                 []*NIC   `protobuf:"bytes,6,rep,name=nics,proto3" json:"nics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Host) Reset()         { *m = Host{} }
+func (m *Host) String() string { return proto.CompactTextString(m) }
+func (*Host) ProtoMessage()    {}
+func (*Host) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{26}
+}
+
+func (m *Host) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Host.Unmarshal(m, b)
+}
+func (m *Host) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Host.Marshal(b, m, deterministic)
+}
+func (m *Host) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Host.Merge(m, src)
+}
+func (m *Host) XXX_Size() int {
+	return xxx_messageInfo_Host.Size(m)
+}
+func (m *Host) XXX_DiscardUnknown() {
+	xxx_messageInfo_Host.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Host proto.InternalMessageInfo
+
+func (m *Host) GetHardware() *Hardware {
+	if m != nil {
+		return m.Hardware
+	}
+	return nil
+}
+
+func (m *Host) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Host) GetPowerState() string {
+	if m != nil {
+		return m.PowerState
+	}
+	return ""
+}
+
+func (m *Host) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Host) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *Host) GetNics() []*NIC {
+	if m != nil {
+		return m.Nics
+	}
+	return nil
+}
+
+type NIC struct {
+	// The name of the interface
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The mac address of the interface
+	MacAddress string `protobuf:"bytes,2,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	// The vlan id of the interface, 0 for none
+	Vlan int32 `protobuf:"varint,3,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	// The addresses of the interface
+	IpAddresses          []string `protobuf:"bytes,4,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NIC) Reset()         { *m = NIC{} }
+func (m *NIC) String() string { return proto.CompactTextString(m) }
+func (*NIC) ProtoMessage()    {}
+func (*NIC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{27}
+}
+
+func (m *NIC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NIC.Unmarshal(m, b)
+}
+func (m *NIC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NIC.Marshal(b, m, deterministic)
+}
+func (m *NIC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NIC.Merge(m, src)
+}
+func (m *NIC) XXX_Size() int {
+	return xxx_messageInfo_NIC.Size(m)
+}
+func (m *NIC) XXX_DiscardUnknown() {
+	xxx_messageInfo_NIC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NIC proto.InternalMessageInfo
+
+func (m *NIC) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NIC) GetMacAddress() string {
+	if m != nil {
+		return m.MacAddress
+	}
+	return ""
+}
+
+func (m *NIC) GetVlan() int32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *NIC) GetIpAddresses() []string {
+	if m != nil {
+		return m.IpAddresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cnct.kaas.provider.MachineStatus", MachineStatus_name, MachineStatus_value)
+	proto.RegisterType((*AllocateMsg)(nil), "cnct.kaas.provider.AllocateMsg")
+	proto.RegisterType((*AllocateReply)(nil), "cnct.kaas.provider.AllocateReply")
+	proto.RegisterType((*Constraints)(nil), "cnct.kaas.provider.Constraints")
+	proto.RegisterType((*StorageConstraint)(nil), "cnct.kaas.provider.StorageConstraint")
+	proto.RegisterType((*InterfaceConstraint)(nil), "cnct.kaas.provider.InterfaceConstraint")
+	proto.RegisterType((*DeployMsg)(nil), "cnct.kaas.provider.DeployMsg")
+	proto.RegisterType((*DeployReply)(nil), "cnct.kaas.provider.DeployReply")
+	proto.RegisterType((*Network)(nil), "cnct.kaas.provider.Network")
+	proto.RegisterType((*Bond)(nil), "cnct.kaas.provider.Bond")
+	proto.RegisterType((*Interface)(nil), "cnct.kaas.provider.Interface")
+	proto.RegisterType((*Storage)(nil), "cnct.kaas.provider.Storage")
+	proto.RegisterType((*DedicatedDisk)(nil), "cnct.kaas.provider.DedicatedDisk")
+	proto.RegisterType((*BlockDevice)(nil), "cnct.kaas.provider.BlockDevice")
+	proto.RegisterType((*ReleaseMsg)(nil), "cnct.kaas.provider.ReleaseMsg")
+	proto.RegisterType((*ReleaseReply)(nil), "cnct.kaas.provider.ReleaseReply")
+	proto.RegisterType((*StatusMsg)(nil), "cnct.kaas.provider.StatusMsg")
+	proto.RegisterType((*StatusReply)(nil), "cnct.kaas.provider.StatusReply")
+	proto.RegisterType((*Machine)(nil), "cnct.kaas.provider.Machine")
+	proto.RegisterType((*InterfaceAddresses)(nil), "cnct.kaas.provider.InterfaceAddresses")
+	proto.RegisterType((*ListImagesMsg)(nil), "cnct.kaas.provider.ListImagesMsg")
+	proto.RegisterType((*ListImagesReply)(nil), "cnct.kaas.provider.ListImagesReply")
+	proto.RegisterType((*Image)(nil), "cnct.kaas.provider.Image")
+	proto.RegisterType((*CapacityMsg)(nil), "cnct.kaas.provider.CapacityMsg")
+	proto.RegisterType((*CapacityReply)(nil), "cnct.kaas.provider.CapacityReply")
+	proto.RegisterType((*Hardware)(nil), "cnct.kaas.provider.Hardware")
+	proto.RegisterType((*Disk)(nil), "cnct.kaas.provider.Disk")
+	proto.RegisterType((*Host)(nil), "cnct.kaas.provider.Host")
+	proto.RegisterType((*NIC)(nil), "cnct.kaas.provider.NIC")
+}
+
+func init() { proto.RegisterFile("provider.proto", fileDescriptor_c6a9f3c02af3d1c8) }
+
+var fileDescriptor_c6a9f3c02af3d1c8 = []byte{
+	// 1509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0x8e, 0xfe, 0xc9, 0x91, 0x65, 0x3b, 0x7b, 0x72, 0x1c, 0xc5, 0x39, 0x3e, 0xb6, 0x19, 0xe4,
+	0x9c, 0xa0, 0x05, 0x1c, 0xd4, 0x45, 0xd1, 0x1f, 0xa0, 0x28, 0x1c, 0xc9, 0x4e, 0x94, 0xda, 0x8e,
+	0x4b, 0x3b, 0x41, 0xda, 0x5e, 0x10, 0x2b, 0x72, 0x6d, 0x6f, 0x4d, 0x71, 0x59, 0xee, 0xca, 0xae,
+	0xf3, 0x04, 0xbd, 0xed, 0x4d, 0x7b, 0xd3, 0xbe, 0x4d, 0x5f, 0xa1, 0x97, 0x7d, 0x82, 0xde, 0xf6,
+	0x01, 0x8a, 0xfd, 0x93, 0x28, 0x8b, 0x12, 0x0a, 0xa4, 0x77, 0x3b, 0xc3, 0xd9, 0xd9, 0xf9, 0xfd,
+	0x66, 0x24, 0x58, 0x4c, 0x33, 0x76, 0x49, 0x23, 0x92, 0x6d, 0xa5, 0x19, 0x13, 0x0c, 0xa1, 0x30,
+	0x09, 0xc5, 0xd6, 0x05, 0xc6, 0x7c, 0xcb, 0x7e, 0xf1, 0x7e, 0x2c, 0x41, 0x73, 0x27, 0x8e, 0x59,
+	0x88, 0x05, 0x39, 0xe0, 0x67, 0x68, 0x1d, 0x9a, 0xf6, 0x5b, 0x40, 0xa3, 0x76, 0x69, 0xa3, 0xf4,
+	0xc8, 0xf5, 0xc1, 0xb2, 0x7a, 0x11, 0x7a, 0x00, 0x2d, 0x9a, 0x70, 0x81, 0x93, 0x90, 0x04, 0xe2,
+	0x3a, 0x25, 0xed, 0xb2, 0x12, 0x59, 0xb0, 0xcc, 0x93, 0xeb, 0x94, 0xa0, 0x1d, 0x68, 0x86, 0x2c,
+	0xe1, 0x22, 0xc3, 0x34, 0x11, 0xbc, 0x5d, 0xd9, 0x28, 0x3d, 0x6a, 0x6e, 0xaf, 0x6f, 0x4d, 0xbf,
+	0xbf, 0xd5, 0x19, 0x8b, 0xf9, 0xf9, 0x3b, 0xde, 0x1e, 0xb4, 0xac, 0x5d, 0x3e, 0x49, 0xe3, 0x6b,
+	0xf4, 0x01, 0x34, 0x06, 0x38, 0x3c, 0xa7, 0x09, 0x51, 0x56, 0x35, 0xb7, 0xef, 0x17, 0xe9, 0x3b,
+	0xd0, 0x22, 0xbe, 0x95, 0xf5, 0x7e, 0x2b, 0x43, 0x33, 0xf7, 0x08, 0xf2, 0xa0, 0x35, 0xa0, 0x49,
+	0x10, 0xa6, 0xc3, 0x20, 0x64, 0xc3, 0x44, 0x28, 0x65, 0x35, 0xbf, 0x39, 0xa0, 0x49, 0x27, 0x1d,
+	0x76, 0x24, 0x0b, 0xad, 0x01, 0x48, 0x99, 0x01, 0x19, 0xb0, 0xec, 0x5a, 0x39, 0x58, 0xf3, 0xdd,
+	0x01, 0x4d, 0x0e, 0x14, 0x03, 0x79, 0xb0, 0x80, 0xb3, 0xf0, 0x9c, 0x0a, 0x12, 0x8a, 0x61, 0x46,
+	0x94, 0x7b, 0xae, 0x3f, 0xc1, 0x43, 0x08, 0xaa, 0x6f, 0x58, 0x42, 0xda, 0x55, 0xf5, 0x4d, 0x9d,
+	0x25, 0x2f, 0x65, 0x2c, 0x6e, 0xd7, 0x34, 0x4f, 0x9e, 0x25, 0x4f, 0xe0, 0x33, 0xde, 0xae, 0x6f,
+	0x54, 0x24, 0x4f, 0x9e, 0xd1, 0x3d, 0x70, 0x12, 0x26, 0x02, 0xc5, 0x6f, 0x28, 0x7e, 0x23, 0x61,
+	0xe2, 0x44, 0x7e, 0xfa, 0x0c, 0x1a, 0x5c, 0xb0, 0x0c, 0x9f, 0x91, 0xb6, 0xb3, 0x51, 0x79, 0xd4,
+	0xdc, 0x7e, 0x58, 0x14, 0x84, 0x63, 0x2d, 0x32, 0x76, 0xdb, 0xb7, 0xb7, 0xd0, 0x53, 0x00, 0x9a,
+	0x08, 0x92, 0x9d, 0xe2, 0x90, 0xf0, 0xb6, 0xab, 0x74, 0xfc, 0xbf, 0x48, 0x47, 0xcf, 0x4a, 0xe5,
+	0xb4, 0xe4, 0xae, 0x7a, 0x5f, 0xc0, 0xed, 0xa9, 0x67, 0xd0, 0x1d, 0xa8, 0xc5, 0xb8, 0x4f, 0x62,
+	0x53, 0x37, 0x9a, 0x90, 0x3e, 0x72, 0xfa, 0x86, 0x98, 0x40, 0xaa, 0xf3, 0xc8, 0xef, 0xca, 0xd8,
+	0x6f, 0xef, 0xe7, 0x12, 0xfc, 0xab, 0xe0, 0xd9, 0x19, 0x5a, 0xef, 0x40, 0x8d, 0xa7, 0x38, 0xb4,
+	0x05, 0xa8, 0x09, 0xb4, 0x02, 0x75, 0x3e, 0xec, 0x27, 0x44, 0x98, 0xac, 0x18, 0x4a, 0xf2, 0x4f,
+	0x71, 0x3f, 0xa3, 0xa1, 0xc9, 0x88, 0xa1, 0xd0, 0x5d, 0x68, 0x9c, 0x63, 0x1e, 0x5c, 0xd2, 0x48,
+	0xa5, 0xc5, 0xf1, 0xeb, 0xe7, 0x98, 0xbf, 0xa2, 0x11, 0x5a, 0x86, 0x8a, 0x64, 0xd6, 0x95, 0xcd,
+	0xf2, 0xe8, 0xfd, 0x51, 0x02, 0xb7, 0x4b, 0xd2, 0x98, 0x5d, 0xcb, 0x46, 0xb9, 0x0f, 0x2e, 0xbf,
+	0xe6, 0x82, 0x0c, 0xc6, 0x6d, 0xe2, 0x68, 0x46, 0x2f, 0xba, 0xd9, 0x45, 0xe5, 0xa9, 0x2e, 0x5a,
+	0x81, 0x7a, 0x44, 0xb9, 0xc8, 0x98, 0x35, 0x53, 0x53, 0x68, 0x15, 0x9c, 0x21, 0x27, 0x59, 0x84,
+	0x05, 0x36, 0x86, 0x8e, 0x68, 0xd9, 0x00, 0x09, 0x11, 0x57, 0x2c, 0xbb, 0x68, 0xd7, 0x66, 0x37,
+	0xc0, 0xa1, 0x16, 0xf1, 0xad, 0xac, 0xbc, 0x66, 0x4b, 0xa6, 0x3e, 0xfb, 0x9a, 0xc9, 0xe5, 0xa8,
+	0x50, 0xbc, 0x63, 0x68, 0x6a, 0x67, 0x75, 0xf7, 0x75, 0xa1, 0xd5, 0x8f, 0x59, 0x78, 0x11, 0x44,
+	0xe4, 0x92, 0xca, 0xd2, 0x29, 0x6d, 0x54, 0x66, 0xf5, 0xf4, 0x13, 0x29, 0xd8, 0x55, 0x72, 0xfe,
+	0x42, 0x7f, 0x4c, 0x70, 0xef, 0x3b, 0x68, 0x18, 0xfb, 0xd0, 0x16, 0xd4, 0xfa, 0x2c, 0x89, 0xac,
+	0xa2, 0x76, 0xa1, 0x22, 0x96, 0x44, 0xbe, 0x16, 0x43, 0x9f, 0x4e, 0x14, 0x6e, 0x59, 0x5d, 0x5a,
+	0x9b, 0x5b, 0xb8, 0x13, 0xe5, 0xfa, 0x0c, 0xaa, 0x52, 0x9b, 0xac, 0xbb, 0x04, 0x0f, 0x88, 0xc9,
+	0x98, 0x3a, 0xa3, 0x36, 0x34, 0x52, 0x9c, 0x91, 0x44, 0x68, 0xbd, 0xae, 0x6f, 0x49, 0x29, 0x3d,
+	0x60, 0x91, 0xed, 0x70, 0x75, 0xf6, 0x7e, 0x2a, 0x81, 0x3b, 0x7a, 0xa3, 0x50, 0x1f, 0x82, 0xea,
+	0x65, 0x8c, 0x13, 0x5b, 0xef, 0xf2, 0x3c, 0xb3, 0x2e, 0xed, 0x0b, 0xd5, 0xf1, 0x0b, 0x12, 0x7e,
+	0x68, 0x1a, 0xe0, 0x28, 0xca, 0x08, 0xe7, 0x06, 0x2d, 0x5c, 0x9a, 0xee, 0x68, 0x86, 0x2c, 0xd9,
+	0x84, 0x45, 0x24, 0xa0, 0xa9, 0x4a, 0xa8, 0xe3, 0xd7, 0x25, 0xd9, 0x4b, 0x3d, 0x06, 0x0d, 0x93,
+	0x46, 0xe5, 0x52, 0xc6, 0x4e, 0x69, 0x6c, 0x2d, 0xb3, 0x24, 0x7a, 0x0e, 0x4b, 0x11, 0x89, 0xa8,
+	0xc4, 0xd5, 0x28, 0x88, 0x28, 0xbf, 0xb0, 0xc1, 0xdc, 0x2c, 0x0a, 0x66, 0xd7, 0x8a, 0x76, 0x29,
+	0xbf, 0xf0, 0x17, 0xa3, 0x3c, 0xc9, 0xbd, 0xd7, 0xd0, 0x9a, 0x10, 0x90, 0x75, 0x3f, 0x90, 0x08,
+	0x1a, 0xa4, 0x8c, 0x1a, 0x68, 0x75, 0x7d, 0x50, 0xac, 0x23, 0xc9, 0x19, 0x85, 0xab, 0x3c, 0x19,
+	0xae, 0x29, 0x28, 0xf8, 0xa5, 0x04, 0xcd, 0x5c, 0x19, 0x15, 0x86, 0xf9, 0x0e, 0xd4, 0x64, 0xb8,
+	0x62, 0x0b, 0x00, 0x8a, 0x18, 0x81, 0x4d, 0xa5, 0x00, 0x6c, 0xaa, 0x93, 0x20, 0x3b, 0xe4, 0x24,
+	0x0a, 0x4e, 0x59, 0x66, 0x42, 0xdc, 0x90, 0xf4, 0x1e, 0xcb, 0xd0, 0x26, 0x2c, 0xe4, 0xbc, 0xb0,
+	0xd8, 0xdc, 0x1c, 0xbb, 0xc1, 0xbd, 0xe7, 0x00, 0x3e, 0x89, 0x09, 0xe6, 0x7f, 0x6f, 0x68, 0x4e,
+	0x80, 0x45, 0x79, 0x12, 0x2c, 0xbc, 0x45, 0x58, 0x30, 0xba, 0x54, 0xab, 0x79, 0x3d, 0x70, 0x8f,
+	0x05, 0x16, 0x43, 0xfe, 0xf6, 0xaa, 0xf7, 0xa0, 0xa9, 0x55, 0xe9, 0x26, 0xfe, 0x10, 0x1c, 0x33,
+	0x16, 0x6d, 0xdb, 0xcd, 0x9d, 0xa1, 0x23, 0x61, 0xef, 0xd7, 0x32, 0x34, 0x0c, 0xf7, 0xed, 0x2c,
+	0x92, 0x00, 0x77, 0xce, 0xb8, 0x50, 0xc9, 0xd4, 0x9d, 0x30, 0xa2, 0xd1, 0xc7, 0x50, 0xe7, 0xca,
+	0x5a, 0xd5, 0x0d, 0x8b, 0xc5, 0x15, 0x69, 0xcc, 0x30, 0x6e, 0x99, 0x0b, 0xe8, 0x21, 0x2c, 0xea,
+	0x53, 0x30, 0x20, 0x9c, 0x4b, 0xac, 0xd3, 0x39, 0x6d, 0x69, 0xee, 0x81, 0x66, 0xca, 0xcc, 0x8e,
+	0x3b, 0x8b, 0x8c, 0x32, 0x3b, 0xea, 0x2d, 0xc2, 0xd1, 0xde, 0x04, 0xce, 0x34, 0x54, 0x94, 0xfe,
+	0x37, 0x17, 0x67, 0x46, 0x77, 0xf3, 0x80, 0x33, 0x5a, 0x00, 0x9c, 0xf1, 0x02, 0xe0, 0x7d, 0x0e,
+	0x68, 0xfa, 0x56, 0x61, 0x6d, 0xdf, 0x34, 0xb4, 0x3c, 0x65, 0xa8, 0xb7, 0x04, 0xad, 0x7d, 0xca,
+	0x45, 0x6f, 0x80, 0xcf, 0x88, 0x2c, 0x15, 0xaf, 0x0b, 0x4b, 0x63, 0x86, 0x4e, 0xf8, 0x7b, 0x50,
+	0xa7, 0x8a, 0x34, 0xe9, 0xbe, 0x57, 0xe8, 0x88, 0x94, 0xf0, 0x8d, 0xa0, 0xf7, 0x35, 0xd4, 0x14,
+	0xa3, 0xd0, 0xac, 0x9b, 0x9b, 0x4f, 0xb9, 0x60, 0xf3, 0x91, 0x23, 0x2c, 0x8d, 0x19, 0x8e, 0x48,
+	0x64, 0x33, 0x6c, 0x69, 0xaf, 0x05, 0xcd, 0x0e, 0x4e, 0x71, 0x48, 0x85, 0x9c, 0xa1, 0xde, 0x0f,
+	0x25, 0x68, 0x59, 0x5a, 0x1b, 0xfc, 0x09, 0xb8, 0xf8, 0x12, 0xd3, 0x18, 0xf7, 0x63, 0x62, 0x6c,
+	0xfe, 0x4f, 0x91, 0xcd, 0xcf, 0x70, 0x16, 0x5d, 0xe1, 0x8c, 0xf8, 0x63, 0x71, 0x39, 0x51, 0x64,
+	0x29, 0x59, 0x3c, 0x2b, 0x9c, 0x28, 0xcf, 0x18, 0x17, 0xbe, 0x16, 0x93, 0xf8, 0x21, 0xb3, 0x62,
+	0x81, 0x47, 0x13, 0xde, 0xf7, 0x65, 0x70, 0xac, 0xf6, 0xf9, 0x43, 0x3e, 0x5f, 0xca, 0xe5, 0x1b,
+	0xa5, 0x6c, 0xb3, 0x5f, 0x29, 0x58, 0xff, 0xaa, 0xb9, 0xf5, 0xef, 0x66, 0x40, 0x6b, 0xc5, 0xab,
+	0xe4, 0xd4, 0x8a, 0x78, 0x1f, 0xdc, 0xf1, 0x06, 0xdb, 0x50, 0x50, 0xe7, 0x84, 0x76, 0x7d, 0x5d,
+	0x81, 0xba, 0x59, 0x5d, 0x1d, 0xf5, 0xc5, 0x50, 0x32, 0x40, 0x1a, 0xf0, 0xdd, 0xd9, 0x01, 0x52,
+	0x38, 0xaf, 0xc5, 0xbc, 0xd7, 0x50, 0x95, 0xe4, 0x3f, 0x0f, 0xbe, 0xde, 0xef, 0x25, 0xa8, 0xca,
+	0x54, 0xa0, 0x8f, 0xc0, 0x39, 0x37, 0xc1, 0x36, 0x5b, 0xfd, 0xfc, 0x74, 0x8f, 0xa4, 0xd5, 0x40,
+	0xd5, 0x60, 0x51, 0x36, 0x03, 0x55, 0x51, 0x0a, 0x9e, 0xd8, 0x15, 0xc9, 0x02, 0x49, 0xdb, 0x04,
+	0x80, 0x62, 0x49, 0xcc, 0x50, 0x96, 0xb3, 0xab, 0x84, 0x64, 0x26, 0x0f, 0x9a, 0xb8, 0x89, 0x6a,
+	0xb5, 0x29, 0x54, 0x7b, 0x17, 0xaa, 0x09, 0x0d, 0x75, 0x16, 0x9a, 0xdb, 0x77, 0x0b, 0x57, 0xaf,
+	0x5e, 0xc7, 0x57, 0x42, 0xde, 0xb7, 0x50, 0x39, 0xec, 0x75, 0x0a, 0x03, 0x27, 0x47, 0x24, 0x0e,
+	0x47, 0xd3, 0xdd, 0xac, 0x86, 0x03, 0x1c, 0xda, 0xf1, 0x6e, 0xb7, 0x87, 0x4a, 0x6e, 0x7b, 0xb8,
+	0x09, 0x07, 0xd5, 0x29, 0x38, 0x78, 0xe7, 0x1b, 0x68, 0x4d, 0x40, 0x23, 0x5a, 0x01, 0x74, 0x7c,
+	0xb2, 0x73, 0xf2, 0xf2, 0x38, 0x78, 0x79, 0x78, 0x7c, 0xb4, 0xdb, 0xe9, 0xed, 0xf5, 0x76, 0xbb,
+	0xcb, 0xb7, 0x50, 0x0b, 0xdc, 0x9d, 0xfd, 0xfd, 0x17, 0x9d, 0x9d, 0x93, 0xdd, 0xee, 0x72, 0x49,
+	0x92, 0xdd, 0xdd, 0xa3, 0xfd, 0x17, 0x5f, 0xf6, 0x0e, 0x9f, 0x2e, 0x97, 0xd1, 0x02, 0x38, 0x9a,
+	0xdc, 0xed, 0x2e, 0x57, 0xd0, 0xbf, 0xe1, 0xf6, 0xde, 0x4e, 0x6f, 0x7f, 0xb7, 0x1b, 0x68, 0xe6,
+	0xc1, 0xee, 0xe1, 0xc9, 0x72, 0x75, 0xfb, 0xcf, 0x0a, 0x2c, 0x99, 0xc7, 0x8e, 0x8c, 0xf3, 0xe8,
+	0x08, 0x1c, 0xfb, 0x7b, 0x0d, 0x15, 0x6e, 0x85, 0xb9, 0x5f, 0x99, 0xab, 0x9b, 0xf3, 0x04, 0xf4,
+	0x14, 0xbc, 0x85, 0x9e, 0x43, 0x5d, 0x6f, 0xa0, 0x68, 0xad, 0x78, 0x35, 0x31, 0xab, 0xf8, 0xea,
+	0xfa, 0xec, 0xcf, 0x56, 0xd7, 0x01, 0x34, 0xcc, 0x8c, 0x45, 0xff, 0x2d, 0x92, 0x1e, 0x0f, 0xf3,
+	0xd5, 0x8d, 0x39, 0xdf, 0x73, 0xa6, 0x99, 0x28, 0xaf, 0x15, 0x2f, 0xd3, 0x66, 0x7c, 0xaf, 0xae,
+	0xcf, 0xfe, 0x6c, 0x75, 0xbd, 0x02, 0x18, 0xc3, 0x36, 0x2a, 0x8c, 0xcc, 0x04, 0xce, 0xaf, 0x3e,
+	0x98, 0x2f, 0x62, 0xf5, 0x1e, 0x81, 0x63, 0xb1, 0xb5, 0x38, 0x21, 0x39, 0x24, 0x5e, 0xdd, 0x9c,
+	0x27, 0x60, 0x34, 0x3e, 0x69, 0x7f, 0xb5, 0x92, 0x5e, 0x9c, 0x3d, 0x3e, 0x23, 0x09, 0xc9, 0xb0,
+	0x20, 0xd1, 0x63, 0x2b, 0xd9, 0xaf, 0xab, 0x3f, 0x18, 0xde, 0xff, 0x6b, 0x00, 0xd4, 0x7a, 0x7b,
+	0x34, 0x72, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MachineProviderClient is the client API for MachineProvider service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MachineProviderClient interface {
+	// Allocate reserves a machine matching the request for provider_id.
+	Allocate(ctx context.Context, in *AllocateMsg, opts ...grpc.CallOption) (*AllocateReply, error)
+	// Deploy installs an image on an allocated machine and boots it with
+	// the userdata. It returns once the deployment is started.
+	Deploy(ctx context.Context, in *DeployMsg, opts ...grpc.CallOption) (*DeployReply, error)
+	// Release stops a machine and returns it to the inventory.
+	Release(ctx context.Context, in *ReleaseMsg, opts ...grpc.CallOption) (*ReleaseReply, error)
+	// Status returns allocated machines.
+	Status(ctx context.Context, in *StatusMsg, opts ...grpc.CallOption) (*StatusReply, error)
+	// ListImages returns the images machines can be deployed with.
+	ListImages(ctx context.Context, in *ListImagesMsg, opts ...grpc.CallOption) (*ListImagesReply, error)
+	// Capacity returns the machines of the inventory.
+	Capacity(ctx context.Context, in *CapacityMsg, opts ...grpc.CallOption) (*CapacityReply, error)
+}
+
+type machineProviderClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMachineProviderClient(cc *grpc.ClientConn) MachineProviderClient {
+	return &machineProviderClient{cc}
+}
+
+func (c *machineProviderClient) Allocate(ctx context.Context, in *AllocateMsg, opts ...grpc.CallOption) (*AllocateReply, error) {
+	out := new(AllocateReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.provider.MachineProvider/Allocate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineProviderClient) Deploy(ctx context.Context, in *DeployMsg, opts ...grpc.CallOption) (*DeployReply, error) {
+	out := new(DeployReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.provider.MachineProvider/Deploy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineProviderClient) Release(ctx context.Context, in *ReleaseMsg, opts ...grpc.CallOption) (*ReleaseReply, error) {
+	out := new(ReleaseReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.provider.MachineProvider/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineProviderClient) Status(ctx context.Context, in *StatusMsg, opts ...grpc.CallOption) (*StatusReply, error) {
+	out := new(StatusReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.provider.MachineProvider/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineProviderClient) ListImages(ctx context.Context, in *ListImagesMsg, opts ...grpc.CallOption) (*ListImagesReply, error) {
+	out := new(ListImagesReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.provider.MachineProvider/ListImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineProviderClient) Capacity(ctx context.Context, in *CapacityMsg, opts ...grpc.CallOption) (*CapacityReply, error) {
+	out := new(CapacityReply)
+	err := c.cc.Invoke(ctx, "/cnct.kaas.provider.MachineProvider/Capacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MachineProviderServer is the server API for MachineProvider service.
+type MachineProviderServer interface {
+	// Allocate reserves a machine matching the request for provider_id.
+	Allocate(context.Context, *AllocateMsg) (*AllocateReply, error)
+	// Deploy installs an image on an allocated machine and boots it with
+	// the userdata. It returns once the deployment is started.
+	Deploy(context.Context, *DeployMsg) (*DeployReply, error)
+	// Release stops a machine and returns it to the inventory.
+	Release(context.Context, *ReleaseMsg) (*ReleaseReply, error)
+	// Status returns allocated machines.
+	Status(context.Context, *StatusMsg) (*StatusReply, error)
+	// ListImages returns the images machines can be deployed with.
+	ListImages(context.Context, *ListImagesMsg) (*ListImagesReply, error)
+	// Capacity returns the machines of the inventory.
+	Capacity(context.Context, *CapacityMsg) (*CapacityReply, error)
+}
+
+func RegisterMachineProviderServer(s *grpc.Server, srv MachineProviderServer) {
+	s.RegisterService(&_MachineProvider_serviceDesc, srv)
+}
+
+func _MachineProvider_Allocate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocateMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineProviderServer).Allocate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.provider.MachineProvider/Allocate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineProviderServer).Allocate(ctx, req.(*AllocateMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineProvider_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineProviderServer).Deploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.provider.MachineProvider/Deploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineProviderServer).Deploy(ctx, req.(*DeployMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineProvider_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineProviderServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.provider.MachineProvider/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineProviderServer).Release(ctx, req.(*ReleaseMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineProvider_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineProviderServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.provider.MachineProvider/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineProviderServer).Status(ctx, req.(*StatusMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineProvider_ListImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImagesMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineProviderServer).ListImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.provider.MachineProvider/ListImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineProviderServer).ListImages(ctx, req.(*ListImagesMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineProvider_Capacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapacityMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineProviderServer).Capacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnct.kaas.provider.MachineProvider/Capacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineProviderServer).Capacity(ctx, req.(*CapacityMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MachineProvider_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cnct.kaas.provider.MachineProvider",
+	HandlerType: (*MachineProviderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Allocate",
+			Handler:    _MachineProvider_Allocate_Handler,
+		},
+		{
+			MethodName: "Deploy",
+			Handler:    _MachineProvider_Deploy_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _MachineProvider_Release_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _MachineProvider_Status_Handler,
+		},
+		{
+			MethodName: "ListImages",
+			Handler:    _MachineProvider_ListImages_Handler,
+		},
+		{
+			MethodName: "Capacity",
+			Handler:    _MachineProvider_Capacity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provider.proto",
+}
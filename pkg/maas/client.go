@@ -19,21 +19,65 @@ package maas
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"k8s.io/klog"
 
 	"github.com/juju/gomaasapi"
+	"github.com/pkg/errors"
+)
+
+// OwnerDataProviderIDKey is the owner data key set to the ProviderID on every
+// machine allocated by cma-ssh.
+const OwnerDataProviderIDKey = "cma-ssh-provider-id"
+
+// DefaultAgentName is the MAAS agent name of the machines allocated by
+// cma-ssh when Client.AgentName is not set.
+const DefaultAgentName = "cma-ssh"
+
+// MAAS machine status names.
+const (
+	StatusReady            = "Ready"
+	StatusAllocated        = "Allocated"
+	StatusDeploying        = "Deploying"
+	StatusDeployed         = "Deployed"
+	StatusFailedDeployment = "Failed deployment"
 )
 
 type Client struct {
 	Controller gomaasapi.Controller
+
+	// MAAS is the root of the MAAS API. It is used for the operations that
+	// are not supported by Controller.
+	MAAS *gomaasapi.MAASObject
+
+	// API signs the requests the MAAS object can not send, like the chunks of
+	// image uploads.
+	API *gomaasapi.Client
+
+	// AgentName is set on every machine when it is allocated and tells the
+	// machines of this cma-ssh apart from those of other MAAS users,
+	// including other cma-ssh instances. DefaultAgentName is used if it is
+	// empty.
+	AgentName string
+
+	// uploads caches the upload days of the boot resources, nothing is
+	// cached if it is nil.
+	uploads *uploadDays
 }
 
 type NewClientParams struct {
 	ApiURL     string
 	ApiVersion string
 	ApiKey     string
+	// AgentName is the agent name of the machines allocated by the client.
+	AgentName string
 }
 
 func NewClient(params *NewClientParams) (Client, error) {
@@ -44,16 +88,34 @@ func NewClient(params *NewClientParams) (Client, error) {
 		return Client{}, fmt.Errorf("error creating controller with version: %v", err)
 	}
 
-	return Client{Controller: controller}, nil
+	apiVersion := params.ApiVersion
+	if apiVersion == "" {
+		apiVersion = "2.0"
+	}
+	authClient, err := gomaasapi.NewAuthenticatedClient(
+		gomaasapi.AddAPIVersionToURL(params.ApiURL, apiVersion), params.ApiKey)
+	if err != nil {
+		return Client{}, fmt.Errorf("error creating api client with version %s: %v", apiVersion, err)
+	}
+
+	return Client{Controller: controller, MAAS: gomaasapi.NewMAAS(*authClient), API: authClient, AgentName: params.AgentName, uploads: newUploadDays()}, nil
+}
+
+// agentName returns the agent name of the machines allocated by c.
+func (c Client) agentName() string {
+	if c.AgentName == "" {
+		return DefaultAgentName
+	}
+	return c.AgentName
 }
 
 type CreateRequest struct {
 	// ProviderID is a unique value created by the k8s controller and used
-	// to identify the machine allocated by MAAS. Before a machine is
-	// allocated, a MAAS tag containing the ProviderID must be set. This
-	// ensures the machine can be managed even if the k8s controller fails
-	// after allocation.
-	// TODO: Implement. Cf. https://samsung-cnct.atlassian.net/browse/HS19-158
+	// to identify the machine allocated by MAAS. The ProviderID is set as
+	// owner data of the machine once it is allocated, so that the machine
+	// is adopted if the k8s controller fails afterwards. A machine left
+	// without owner data still has the agent name of the client and is
+	// released by the garbage collector.
 	ProviderID string
 
 	// Distro is the name of the OS image and kernel to install/boot.
@@ -68,6 +130,15 @@ type CreateRequest struct {
 	// Userdata is passed to the machine on boot and contains cloud-init
 	// configuration.
 	Userdata string
+
+	// Constraints further restrict the machines which can be allocated.
+	Constraints Constraints
+
+	// Network, if set, is applied to the machine before it is deployed.
+	Network *Network
+
+	// Storage, if set, is applied to the machine before it is deployed.
+	Storage *Storage
 }
 
 type CreateResponse struct {
@@ -91,18 +162,82 @@ type CreateResponse struct {
 	// assigned. It is the human readable machine name surfaced by maas DNS
 	// for quick machine location.
 	Hostname string
+
+	// Zone is the name of the availability zone the machine was allocated
+	// in.
+	Zone string
+
+	// BlockDevices are the block devices of the machine as they will be
+	// deployed.
+	BlockDevices []BlockDevice
 }
 
-// Create creates a machine
+// Create creates a machine. If a machine has already been allocated for the
+// ProviderID it is adopted instead of allocating another one. Create returns
+// once MAAS has started the deployment, Status reports when it is finished.
 func (c Client) Create(ctx context.Context, request *CreateRequest) (*CreateResponse, error) {
 	klog.Infof("Creating machine %s", request.ProviderID)
+	if request.ProviderID == "" {
+		return nil, fmt.Errorf("error creating machine: providerID not set")
+	}
 
-	// Allocate MAAS machine
-	allocateArgs := gomaasapi.AllocateMachineArgs{Tags: []string{request.InstanceType}}
-	m, _, err := c.Controller.AllocateMachine(allocateArgs)
+	m, err := c.findMachine(request.ProviderID)
 	if err != nil {
-		klog.Errorf("Create failed to allocate machine %s: %v", request.ProviderID, err)
-		return nil, fmt.Errorf("error allocating machine %s: %v", request.ProviderID, err)
+		return nil, err
+	}
+	if m != nil {
+		klog.Infof("Adopting machine %s (%s) with status %q", request.ProviderID, m.SystemID(), m.StatusName())
+		switch m.StatusName() {
+		case StatusDeploying, StatusDeployed:
+			return c.newCreateResponse(request.ProviderID, m), nil
+		case StatusAllocated:
+		default:
+			// The machine can not be deployed from its current state so
+			// give it back and allocate another one.
+			errDelete := c.Delete(ctx, &DeleteRequest{ProviderID: request.ProviderID,
+				SystemID: m.SystemID()})
+			if errDelete != nil {
+				return nil, errDelete
+			}
+			m = nil
+		}
+	}
+
+	if m == nil {
+		// Allocate MAAS machine. The agent name is set atomically with the
+		// allocation so the machine is always listed as owned by cma-ssh.
+		m, err = c.allocate(request)
+		if err != nil {
+			klog.Errorf("Create failed to allocate machine %s: %v", request.ProviderID, err)
+			return nil, errors.Wrapf(err, "error allocating machine %s", request.ProviderID)
+		}
+
+		// Without owner data the machine could not be found by its
+		// ProviderID, so it is given back and the Create fails.
+		err = m.SetOwnerData(map[string]string{OwnerDataProviderIDKey: request.ProviderID})
+		if err != nil {
+			klog.Errorf("Create failed to set owner data on machine %s (%s): %v", request.ProviderID, m.SystemID(), err)
+			errDelete := c.Delete(ctx, &DeleteRequest{ProviderID: request.ProviderID,
+				SystemID: m.SystemID()})
+			if errDelete != nil {
+				klog.Errorf("Create failed to release machine %s: %v", request.ProviderID, errDelete)
+			}
+			return nil, errors.Wrapf(err, "error setting owner data of machine %s", request.ProviderID)
+		}
+	}
+
+	err = c.applyNetwork(m.SystemID(), request.Network)
+	if err == nil {
+		err = c.applyStorage(m.SystemID(), request.Storage)
+	}
+	if err != nil {
+		klog.Errorf("Create failed to configure machine %s: %v", request.ProviderID, err)
+		errDelete := c.Delete(ctx, &DeleteRequest{ProviderID: request.ProviderID,
+			SystemID: m.SystemID()})
+		if errDelete != nil {
+			klog.Errorf("Create failed to release machine %s: %v", request.ProviderID, errDelete)
+		}
+		return nil, err
 	}
 
 	// Deploy MAAS machine
@@ -123,12 +258,108 @@ func (c Client) Create(ctx context.Context, request *CreateRequest) (*CreateResp
 
 	klog.Infof("Created machine %s (%s)", request.ProviderID, m.SystemID())
 
+	return c.newCreateResponse(request.ProviderID, m), nil
+}
+
+func (c Client) newCreateResponse(providerID string, m gomaasapi.Machine) *CreateResponse {
+	// The block devices are informational, the machine is deploying
+	// whether they can be read or not.
+	blockDevices, err := c.blockDevices(m.SystemID())
+	if err != nil {
+		klog.Warningf("Create failed to read the block devices of machine %s (%s): %v", providerID, m.SystemID(), err)
+	}
 	return &CreateResponse{
-		ProviderID:  request.ProviderID,
-		IPAddresses: m.IPAddresses(),
-		SystemID:    m.SystemID(),
-		Hostname:    m.Hostname(),
-	}, nil
+		ProviderID:   providerID,
+		IPAddresses:  m.IPAddresses(),
+		SystemID:     m.SystemID(),
+		Hostname:     m.Hostname(),
+		Zone:         zoneName(m),
+		BlockDevices: blockDevices,
+	}
+}
+
+func interfaceAddresses(m gomaasapi.Machine) []InterfaceAddresses {
+	var interfaces []InterfaceAddresses
+	for _, i := range m.InterfaceSet() {
+		addresses := InterfaceAddresses{Name: i.Name()}
+		for _, link := range i.Links() {
+			if link.IPAddress() != "" {
+				addresses.IPAddresses = append(addresses.IPAddresses, link.IPAddress())
+			}
+		}
+		interfaces = append(interfaces, addresses)
+	}
+	return interfaces
+}
+
+func zoneName(m gomaasapi.Machine) string {
+	if m.Zone() == nil {
+		return ""
+	}
+	return m.Zone().Name()
+}
+
+// allocate allocates a machine matching the request. The MAAS API is used
+// directly since gomaasapi.AllocateMachineArgs does not support resource pools
+// or subnet, fabric and vlan interface constraints.
+func (c Client) allocate(request *CreateRequest) (gomaasapi.Machine, error) {
+	if err := request.Constraints.Validate(); err != nil {
+		return nil, err
+	}
+	params := request.Constraints.params()
+	if request.InstanceType != "" {
+		params.Add("tags", request.InstanceType)
+	}
+	params.Set("agent_name", c.agentName())
+	params.Set("comment", fmt.Sprintf("allocated by cma-ssh for %s", request.ProviderID))
+
+	result, err := c.MAAS.GetSubObject("machines").CallPost("allocate", params)
+	if err != nil {
+		if svrErr, ok := gomaasapi.GetServerError(err); ok && svrErr.StatusCode == http.StatusConflict {
+			return nil, fmt.Errorf("no machine matches the constraints: %s", svrErr.BodyMessage)
+		}
+		return nil, err
+	}
+	obj, err := result.GetMAASObject()
+	if err != nil {
+		return nil, err
+	}
+	systemID, err := obj.GetField("system_id")
+	if err != nil {
+		return nil, err
+	}
+
+	machines, err := c.Controller.Machines(gomaasapi.MachinesArgs{SystemIDs: []string{systemID}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting allocated machine %s", systemID)
+	}
+	if len(machines) != 1 {
+		return nil, fmt.Errorf("expected 1 machine %s, found %d", systemID, len(machines))
+	}
+	return machines[0], nil
+}
+
+// findMachine returns the machine allocated for providerID or nil if there is
+// none.
+func (c Client) findMachine(providerID string) (gomaasapi.Machine, error) {
+	if providerID == "" {
+		return nil, nil
+	}
+	machines, err := c.Controller.Machines(gomaasapi.MachinesArgs{
+		AgentName: c.agentName(),
+		OwnerData: map[string]string{OwnerDataProviderIDKey: providerID},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing machine %s", providerID)
+	}
+	switch len(machines) {
+	case 0:
+		return nil, nil
+	case 1:
+		return machines[0], nil
+	default:
+		return nil, fmt.Errorf("expected 1 machine %s, found %d", providerID, len(machines))
+	}
 }
 
 type DeleteRequest struct {
@@ -141,54 +372,378 @@ type DeleteRequest struct {
 type DeleteResponse struct {
 }
 
-// Delete deletes a machine
+// Delete deletes a machine. If SystemID is not set the machine allocated for
+// ProviderID, if any, is released.
 func (c Client) Delete(ctx context.Context, request *DeleteRequest) error {
 	if request.SystemID == "" {
-		klog.Warningf("can not delete  machine %s, providerID not set", request.ProviderID)
-		return fmt.Errorf("machine %s has not been created", request.ProviderID)
+		if request.ProviderID == "" {
+			klog.Warningf("can not delete machine, neither providerID nor systemID are set")
+			return fmt.Errorf("machine has not been created")
+		}
+		m, err := c.findMachine(request.ProviderID)
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			klog.Infof("no machine allocated for %s, nothing to release", request.ProviderID)
+			return nil
+		}
+		request = &DeleteRequest{ProviderID: request.ProviderID, SystemID: m.SystemID()}
 	}
 
-	// Release MAAS machine
+	// Release MAAS machine. A machine MAAS no longer knows has nothing left
+	// to release.
 	releaseArgs := gomaasapi.ReleaseMachinesArgs{SystemIDs: []string{request.SystemID}}
-	if err := c.Controller.ReleaseMachines(releaseArgs); err != nil {
-		klog.Warningf("error releasing machine %s (%s): %v", request.ProviderID, request.SystemID, err)
+	err := c.Controller.ReleaseMachines(releaseArgs)
+	if svrErr, ok := serverError(err); ok && svrErr.StatusCode == http.StatusNotFound {
+		klog.Infof("machine %s (%s) not found, nothing to release", request.ProviderID, request.SystemID)
 		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "error releasing machine %s (%s)", request.ProviderID, request.SystemID)
 	}
 
 	return nil
 }
 
+// serverError returns the MAAS error response wrapped in err. The Controller
+// replaces the cause of the errors it returns, e.g. with an UnexpectedError,
+// so gomaasapi.GetServerError does not find the response; the juju errors
+// are followed through their underlying error instead.
+func serverError(err error) (gomaasapi.ServerError, bool) {
+	for ; err != nil; err = nextError(err) {
+		if svrErr, ok := err.(gomaasapi.ServerError); ok {
+			return svrErr, true
+		}
+	}
+	return gomaasapi.ServerError{}, false
+}
+
+// nextError returns the error wrapped by err or nil if err does not wrap an
+// error.
+func nextError(err error) error {
+	switch wrapper := err.(type) {
+	case interface{ Underlying() error }:
+		return wrapper.Underlying()
+	case interface{ Cause() error }:
+		return wrapper.Cause()
+	case *url.Error:
+		return wrapper.Err
+	}
+	return nil
+}
+
+// PowerAction is a power operation on a machine.
+type PowerAction string
+
+const (
+	// PowerCycle powers a machine off and on again.
+	PowerCycle PowerAction = "cycle"
+	// PowerOff powers a machine off.
+	PowerOff PowerAction = "off"
+	// PowerOn powers a machine on.
+	PowerOn PowerAction = "on"
+)
+
+// Valid reports whether a is a known power action.
+func (a PowerAction) Valid() bool {
+	switch a {
+	case PowerCycle, PowerOff, PowerOn:
+		return true
+	}
+	return false
+}
+
 type UpdateRequest struct {
 	// ProviderID is the unique value passed in CreateRequest.
 	ProviderID string
+	// SystemID is the unique value passed in CreateResponse. If it is not
+	// set the machine allocated for ProviderID is updated.
+	SystemID string
+
+	// Hostname, if set, renames the machine.
+	Hostname string
+	// Description, if set, replaces the description of the machine.
+	Description string
+	// Tags are added to the machine. Tags which do not exist yet are
+	// created.
+	Tags []string
+	// RemoveTags are removed from the machine.
+	RemoveTags []string
+	// Power, if set, is performed after the other changes.
+	Power PowerAction
 }
 
-// Update updates a machine
+// Update updates a machine. The MAAS API is used directly since
+// gomaasapi.Machine can not rename, tag or power a machine.
 func (c Client) Update(ctx context.Context, request *UpdateRequest) error {
+	if request.Power != "" && !request.Power.Valid() {
+		return fmt.Errorf("unknown power action %q", request.Power)
+	}
+	systemID := request.SystemID
+	if systemID == "" {
+		m, err := c.findMachine(request.ProviderID)
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			return ErrMachineNotFound
+		}
+		systemID = m.SystemID()
+	}
+	machine := c.MAAS.GetSubObject("machines").GetSubObject(systemID)
+
+	params := url.Values{}
+	if request.Hostname != "" {
+		params.Set("hostname", request.Hostname)
+	}
+	if request.Description != "" {
+		params.Set("description", request.Description)
+	}
+	if len(params) > 0 {
+		klog.Infof("Updating machine %s (%s): %v", request.ProviderID, systemID, params)
+		if _, err := machine.Update(params); err != nil {
+			return errors.Wrapf(err, "error updating machine %s", systemID)
+		}
+	}
+
+	for _, tag := range request.Tags {
+		if err := c.updateTag(tag, "add", systemID); err != nil {
+			return err
+		}
+	}
+	for _, tag := range request.RemoveTags {
+		if err := c.updateTag(tag, "remove", systemID); err != nil {
+			return err
+		}
+	}
+
+	if request.Power == PowerOff || request.Power == PowerCycle {
+		klog.Infof("Powering off machine %s (%s)", request.ProviderID, systemID)
+		if _, err := machine.CallPost("power_off", url.Values{"stop_mode": {"hard"}}); err != nil {
+			return errors.Wrapf(err, "error powering off machine %s", systemID)
+		}
+	}
+	// MAAS rejects a power change while another one is in progress so
+	// power_on is only sent once the machine is off.
+	if request.Power == PowerCycle {
+		if err := waitPoweredOff(ctx, machine); err != nil {
+			return errors.Wrapf(err, "error powering off machine %s", systemID)
+		}
+	}
+	if request.Power == PowerOn || request.Power == PowerCycle {
+		klog.Infof("Powering on machine %s (%s)", request.ProviderID, systemID)
+		if _, err := machine.CallPost("power_on", url.Values{}); err != nil {
+			return errors.Wrapf(err, "error powering on machine %s", systemID)
+		}
+	}
+
 	return nil
 }
 
-type ExistsRequest struct {
+// powerPollInterval is how often the power state of a machine being power
+// cycled is read. powerOffTimeout is how long it may take to power off.
+var (
+	powerPollInterval = 2 * time.Second
+	powerOffTimeout   = 2 * time.Minute
+)
+
+// waitPoweredOff waits until MAAS reports the machine as powered off.
+func waitPoweredOff(ctx context.Context, machine gomaasapi.MAASObject) error {
+	ctx, cancel := context.WithTimeout(ctx, powerOffTimeout)
+	defer cancel()
+	for {
+		obj, err := machine.Get()
+		if err != nil {
+			return err
+		}
+		state, err := obj.GetField("power_state")
+		if err != nil {
+			return err
+		}
+		if state == "off" {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("machine is still powered %s", state)
+		case <-time.After(powerPollInterval):
+		}
+	}
+}
+
+// updateTag adds or removes the machine from the tag. A missing tag is
+// created before the machine is added.
+func (c Client) updateTag(name, op, systemID string) error {
+	tag := c.MAAS.GetSubObject("tags").GetSubObject(name)
+	_, err := tag.CallPost("update_nodes", url.Values{op: {systemID}})
+	if svrErr, ok := gomaasapi.GetServerError(err); ok && svrErr.StatusCode == http.StatusNotFound {
+		if op == "remove" {
+			return nil
+		}
+		klog.Infof("Creating tag %s", name)
+		if _, err := c.MAAS.GetSubObject("tags").Post(url.Values{"name": {name}}); err != nil {
+			return errors.Wrapf(err, "error creating tag %s", name)
+		}
+		_, err = tag.CallPost("update_nodes", url.Values{op: {systemID}})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error updating tag %s of machine %s", name, systemID)
+	}
+	return nil
+}
+
+type StatusRequest struct {
 	// ProviderID is the unique value passed in CreateRequest.
 	ProviderID string
+	// SystemID is the unique value passed in CreateResponse. It is only
+	// used if ProviderID is not set.
+	SystemID string
 }
 
-// Exists test for the existence of a machine
-func (c Client) Exist(ctx context.Context, request *ExistsRequest) (bool, error) {
-	// ProviderID will be nil until Create completes successfully
-	if request.ProviderID == "" {
-		return false, nil
+// Status returns the machine allocated for the request. ErrMachineNotFound is
+// returned if the machine has been released.
+func (c Client) Status(ctx context.Context, request *StatusRequest) (*Machine, error) {
+	var m gomaasapi.Machine
+	if request.ProviderID != "" {
+		var err error
+		m, err = c.findMachine(request.ProviderID)
+		if err != nil {
+			return nil, err
+		}
+	} else if request.SystemID != "" {
+		machines, err := c.Controller.Machines(gomaasapi.MachinesArgs{SystemIDs: []string{request.SystemID}})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting machine %s", request.SystemID)
+		}
+		if len(machines) == 1 {
+			m = machines[0]
+		}
+	}
+	if m == nil {
+		return nil, ErrMachineNotFound
+	}
+
+	return &Machine{
+		ProviderID:    request.ProviderID,
+		SystemID:      m.SystemID(),
+		Hostname:      m.Hostname(),
+		Status:        m.StatusName(),
+		StatusMessage: m.StatusMessage(),
+		IPAddresses:   m.IPAddresses(),
+		Interfaces:    interfaceAddresses(m),
+		Zone:          zoneName(m),
+	}, nil
+}
+
+// List returns the machines allocated by cma-ssh, which are those with the
+// agent name of the client. The ProviderID of a machine whose owner data
+// could not be set is empty.
+func (c Client) List(ctx context.Context) ([]Machine, error) {
+	machines, err := c.Controller.Machines(gomaasapi.MachinesArgs{AgentName: c.agentName()})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing machines")
+	}
+
+	var owned []Machine
+	for _, m := range machines {
+		owned = append(owned, Machine{
+			ProviderID:    m.OwnerData()[OwnerDataProviderIDKey],
+			SystemID:      m.SystemID(),
+			Hostname:      m.Hostname(),
+			Status:        m.StatusName(),
+			StatusMessage: m.StatusMessage(),
+			IPAddresses:   m.IPAddresses(),
+			Interfaces:    interfaceAddresses(m),
+			Zone:          zoneName(m),
+		})
 	}
 
-	// Get list of machines with tag
-	machineArgs := gomaasapi.MachinesArgs{SystemIDs: []string{request.ProviderID}}
-	machines, err := c.Controller.Machines(machineArgs)
+	return owned, nil
+}
+
+// ListImages returns the boot resources available in MAAS
+func (c Client) ListImages(ctx context.Context) ([]BootResource, error) {
+	resources, err := c.Controller.BootResources()
 	if err != nil {
-		return false, fmt.Errorf("error listing machine %s: %v", request.ProviderID, err)
+		return nil, errors.Wrap(err, "error listing boot resources")
 	}
-	if len(machines) != 1 {
-		return false, fmt.Errorf("expected 1 machine %s, found %d", request.ProviderID, len(machines))
+
+	images := make([]BootResource, 0, len(resources))
+	listed := map[int]bool{}
+	for _, r := range resources {
+		image := BootResource{
+			ID:           r.ID(),
+			Name:         r.Name(),
+			Type:         r.Type(),
+			Architecture: r.Architecture(),
+		}
+		listed[image.ID] = true
+		if image.Type == BootResourceUploaded {
+			image.Uploaded = c.uploadDay(image)
+		}
+		images = append(images, image)
+	}
+	c.uploads.keep(listed)
+
+	return images, nil
+}
+
+// uploadDay returns the upload day of an uploaded boot resource, from the
+// cache if it was read before. The day is informational, e.g. a resource
+// deleted since it was listed must not hide the other images, so it is zero
+// if it can not be read.
+func (c Client) uploadDay(image BootResource) time.Time {
+	if day, ok := c.uploads.get(image.ID); ok {
+		return day
+	}
+	day, err := c.bootResourceUploaded(image.ID)
+	if err != nil {
+		klog.Warningf("could not read upload day of boot resource %s (%d): %v", image.Name, image.ID, err)
+		return time.Time{}
+	}
+	c.uploads.set(image.ID, day)
+	return day
+}
+
+// bootResourceUploaded returns the day the newest set of an uploaded boot
+// resource was uploaded. MAAS names the sets of uploaded resources after the
+// upload day, e.g. "20190404" or "20190404.1" for the second upload that day.
+func (c Client) bootResourceUploaded(id int) (time.Time, error) {
+	result, err := c.MAAS.GetSubObject("boot-resources").GetSubObject(strconv.Itoa(id)).CallGet("", url.Values{})
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "error reading boot resource %d", id)
+	}
+	var resource struct {
+		Sets map[string]json.RawMessage `json:"sets"`
+	}
+	if err := decode(result, &resource); err != nil {
+		return time.Time{}, err
+	}
+	var uploaded time.Time
+	for name := range resource.Sets {
+		day := strings.SplitN(name, ".", 2)[0]
+		t, err := time.Parse("20060102", day)
+		if err != nil {
+			continue
+		}
+		if t.After(uploaded) {
+			uploaded = t
+		}
+	}
+	return uploaded, nil
+}
+
+// Zones returns the names of the availability zones in MAAS
+func (c Client) Zones(ctx context.Context) ([]string, error) {
+	zones, err := c.Controller.Zones()
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing zones")
+	}
+
+	names := make([]string, 0, len(zones))
+	for _, z := range zones {
+		names = append(names, z.Name())
 	}
 
-	return true, nil
+	return names, nil
 }
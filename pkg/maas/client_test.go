@@ -0,0 +1,390 @@
+package maas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/gomaasapi"
+)
+
+// stubController fails ReleaseMachines with err, lists resources as boot
+// resources and machines as machines.
+type stubController struct {
+	gomaasapi.Controller
+	err          error
+	released     []string
+	resources    []gomaasapi.BootResource
+	machines     []gomaasapi.Machine
+	machinesArgs []gomaasapi.MachinesArgs
+}
+
+func (c *stubController) BootResources() ([]gomaasapi.BootResource, error) {
+	return c.resources, nil
+}
+
+// stubBootResource is an uploaded boot resource.
+type stubBootResource struct {
+	gomaasapi.BootResource
+	id   int
+	name string
+}
+
+func (r stubBootResource) ID() int              { return r.id }
+func (r stubBootResource) Name() string         { return r.name }
+func (r stubBootResource) Type() string         { return BootResourceUploaded }
+func (r stubBootResource) Architecture() string { return "amd64/generic" }
+
+func (c *stubController) ReleaseMachines(args gomaasapi.ReleaseMachinesArgs) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.released = append(c.released, args.SystemIDs...)
+	return nil
+}
+
+func (c *stubController) Machines(args gomaasapi.MachinesArgs) ([]gomaasapi.Machine, error) {
+	c.machinesArgs = append(c.machinesArgs, args)
+	var machines []gomaasapi.Machine
+	for _, m := range c.machines {
+		if len(args.SystemIDs) > 0 && m.SystemID() != args.SystemIDs[0] {
+			continue
+		}
+		if id, ok := args.OwnerData[OwnerDataProviderIDKey]; ok && m.OwnerData()[OwnerDataProviderIDKey] != id {
+			continue
+		}
+		machines = append(machines, m)
+	}
+	return machines, nil
+}
+
+// stubMachine is an allocated machine with owner data. Setting the owner
+// data fails with ownerDataErr.
+type stubMachine struct {
+	gomaasapi.Machine
+	systemID     string
+	ownerData    map[string]string
+	ownerDataErr error
+}
+
+func (m stubMachine) SetOwnerData(data map[string]string) error {
+	return m.ownerDataErr
+}
+
+func (m stubMachine) SystemID() string                    { return m.systemID }
+func (m stubMachine) Hostname() string                    { return m.systemID }
+func (m stubMachine) StatusName() string                  { return StatusDeployed }
+func (m stubMachine) StatusMessage() string               { return "" }
+func (m stubMachine) IPAddresses() []string               { return nil }
+func (m stubMachine) InterfaceSet() []gomaasapi.Interface { return nil }
+func (m stubMachine) Zone() gomaasapi.Zone                { return nil }
+func (m stubMachine) OwnerData() map[string]string        { return m.ownerData }
+
+func TestClient_List(t *testing.T) {
+	controller := &stubController{machines: []gomaasapi.Machine{
+		stubMachine{systemID: "abc123", ownerData: map[string]string{OwnerDataProviderIDKey: "provider-id"}},
+		// owner data is set after the allocation
+		stubMachine{systemID: "def456"},
+	}}
+	c := Client{Controller: controller, AgentName: "cma-ssh-test"}
+
+	machines, err := c.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(controller.machinesArgs) != 1 || controller.machinesArgs[0].AgentName != "cma-ssh-test" {
+		t.Errorf("machines listed with %+v, want agent name cma-ssh-test", controller.machinesArgs)
+	}
+	var got []string
+	for _, m := range machines {
+		got = append(got, m.SystemID+"="+m.ProviderID)
+	}
+	if want := []string{"abc123=provider-id", "def456="}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List() = %v, want %v", got, want)
+	}
+}
+
+func TestClient_Create_ownerDataFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Query().Get("op") != "allocate" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"resource_uri": "/api/2.0/machines/abc123/", "system_id": "abc123"}`)
+	}))
+	defer server.Close()
+	api, err := gomaasapi.NewAnonymousClient(server.URL, "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	controller := &stubController{machines: []gomaasapi.Machine{
+		stubMachine{systemID: "abc123", ownerDataErr: errors.New("maas is unavailable")},
+	}}
+	c := Client{Controller: controller, MAAS: gomaasapi.NewMAAS(*api)}
+
+	if _, err := c.Create(context.Background(), &CreateRequest{ProviderID: "provider-id"}); err == nil {
+		t.Fatal("Create() expected an error")
+	}
+	if want := []string{"abc123"}; !reflect.DeepEqual(controller.released, want) {
+		t.Errorf("released = %v, want %v", controller.released, want)
+	}
+}
+
+func TestClient_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{name: "released"},
+		{
+			name:    "release failed",
+			err:     gomaasapi.NewUnexpectedError(gomaasapi.ServerError{StatusCode: http.StatusInternalServerError}),
+			wantErr: true,
+		},
+		{
+			name: "machine not found",
+			err:  gomaasapi.NewUnexpectedError(gomaasapi.ServerError{StatusCode: http.StatusNotFound}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := &stubController{err: tt.err}
+			c := Client{Controller: controller}
+			err := c.Delete(context.Background(), &DeleteRequest{ProviderID: "provider-id", SystemID: "abc123"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.err == nil && len(controller.released) != 1 {
+				t.Errorf("released = %v, want abc123", controller.released)
+			}
+		})
+	}
+}
+
+func TestClient_Update_powerCycle(t *testing.T) {
+	powerPollInterval = time.Millisecond
+	var ops []string
+	state := "on"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/machines/abc123/" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodGet {
+			// power_off finishes after the machine has been read once
+			fmt.Fprintf(w, `{"resource_uri": "/api/2.0/machines/abc123/", "power_state": %q}`, state)
+			if len(ops) > 0 {
+				state = "off"
+			}
+			return
+		}
+		op := r.URL.Query().Get("op")
+		if op == "power_on" && state != "off" {
+			http.Error(w, "power action in progress", http.StatusConflict)
+			return
+		}
+		ops = append(ops, op)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+	api, err := gomaasapi.NewAnonymousClient(server.URL, "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Client{MAAS: gomaasapi.NewMAAS(*api)}
+
+	if err := c.Update(context.Background(), &UpdateRequest{SystemID: "abc123", Power: PowerCycle}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if want := []string{"power_off", "power_on"}; !reflect.DeepEqual(ops, want) {
+		t.Errorf("power actions = %v, want %v", ops, want)
+	}
+}
+
+func TestRetryProvider_Update_powerCycle(t *testing.T) {
+	powerPollInterval = 5 * time.Millisecond
+	var mu sync.Mutex
+	var ops []string
+	var poweredOff time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == http.MethodGet {
+			// powering off takes longer than the call deadline
+			state := "on"
+			if !poweredOff.IsZero() && time.Since(poweredOff) > 100*time.Millisecond {
+				state = "off"
+			}
+			fmt.Fprintf(w, `{"resource_uri": "/api/2.0/machines/abc123/", "power_state": %q}`, state)
+			return
+		}
+		op := r.URL.Query().Get("op")
+		if op == "power_off" {
+			poweredOff = time.Now()
+		}
+		ops = append(ops, op)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+	api, err := gomaasapi.NewAnonymousClient(server.URL, "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := testRetryOptions()
+	options.Timeout = 20 * time.Millisecond
+	p := NewRetryProvider(Client{MAAS: gomaasapi.NewMAAS(*api)}, options)
+
+	if err := p.Update(context.Background(), &UpdateRequest{SystemID: "abc123", Power: PowerCycle}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"power_off", "power_on"}; !reflect.DeepEqual(ops, want) {
+		t.Errorf("power actions = %v, want %v", ops, want)
+	}
+}
+
+func TestClient_ListImages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// boot resource 2 was deleted after it was listed
+		if r.URL.Path != "/api/2.0/boot-resources/1/" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"sets": {"20190404": {}, "20190501.1": {}}}`)
+	}))
+	defer server.Close()
+	api, err := gomaasapi.NewAnonymousClient(server.URL, "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Client{
+		Controller: &stubController{resources: []gomaasapi.BootResource{
+			stubBootResource{id: 1, name: "os=ubuntu-xenial,k8s=1.13.5,standard"},
+			stubBootResource{id: 2, name: "os=ubuntu-xenial,k8s=1.14.1,standard"},
+		}},
+		MAAS: gomaasapi.NewMAAS(*api),
+	}
+
+	images, err := c.ListImages(context.Background())
+	if err != nil {
+		t.Fatalf("ListImages() error = %v", err)
+	}
+	if len(images) != 2 {
+		t.Fatalf("ListImages() = %+v, want both images", images)
+	}
+	if want := time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC); !images[0].Uploaded.Equal(want) {
+		t.Errorf("uploaded = %v, want %v", images[0].Uploaded, want)
+	}
+	if !images[1].Uploaded.IsZero() {
+		t.Errorf("uploaded of an unreadable boot resource = %v, want zero", images[1].Uploaded)
+	}
+}
+
+func TestClient_ListImages_cached(t *testing.T) {
+	reads := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			reads[r.URL.Path]++
+		}
+		if r.URL.Path != "/api/2.0/boot-resources/1/" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"sets": {"20190404": {}}}`)
+	}))
+	defer server.Close()
+	api, err := gomaasapi.NewAnonymousClient(server.URL, "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Client{
+		Controller: &stubController{resources: []gomaasapi.BootResource{
+			stubBootResource{id: 1, name: "os=ubuntu-xenial,k8s=1.13.5,standard"},
+			stubBootResource{id: 2, name: "os=ubuntu-xenial,k8s=1.14.1,standard"},
+		}},
+		MAAS:    gomaasapi.NewMAAS(*api),
+		uploads: newUploadDays(),
+	}
+
+	for i := 0; i < 2; i++ {
+		images, err := c.ListImages(context.Background())
+		if err != nil {
+			t.Fatalf("ListImages() error = %v", err)
+		}
+		if want := time.Date(2019, 4, 4, 0, 0, 0, 0, time.UTC); !images[0].Uploaded.Equal(want) {
+			t.Errorf("uploaded = %v, want %v", images[0].Uploaded, want)
+		}
+	}
+	// the upload day of an unreadable boot resource is not cached
+	if want := map[string]int{"/api/2.0/boot-resources/1/": 1, "/api/2.0/boot-resources/2/": 2}; !reflect.DeepEqual(reads, want) {
+		t.Errorf("boot resource reads = %v, want %v", reads, want)
+	}
+
+	if err := c.DeleteImage(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListImages(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if reads["/api/2.0/boot-resources/1/"] != 2 {
+		t.Errorf("upload day of a deleted boot resource was cached")
+	}
+}
+
+func TestClient_UploadImage_failed(t *testing.T) {
+	tests := []struct {
+		name        string
+		sets        string
+		wantDeleted bool
+	}{
+		{
+			name:        "new boot resource",
+			sets:        `{"20190501": {"files": {"root-tgz": {"upload_uri": "/api/2.0/boot-resources/5/upload/1/"}}}}`,
+			wantDeleted: true,
+		},
+		{
+			// MAAS keeps deploying the complete older version
+			name: "uploaded before",
+			sets: `{"20190404": {"complete": true}, "20190501": {"files": {"root-tgz": {"upload_uri": "/api/2.0/boot-resources/5/upload/2/"}}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/api/2.0/boot-resources/":
+					fmt.Fprintf(w, `{"id": 5, "name": "ubuntu", "sets": %s}`, tt.sets)
+				case r.Method == http.MethodDelete && r.URL.Path == "/api/2.0/boot-resources/5/":
+					deleted = true
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					http.Error(w, "upload failed", http.StatusInternalServerError)
+				}
+			}))
+			defer server.Close()
+			api, err := gomaasapi.NewAnonymousClient(server.URL, "2.0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := Client{MAAS: gomaasapi.NewMAAS(*api), API: api}
+
+			request := &UploadImageRequest{Name: "ubuntu", Size: 5, Content: strings.NewReader("image")}
+			if _, err := c.UploadImage(context.Background(), request, nil); err == nil {
+				t.Fatal("UploadImage() succeeded, want the chunk upload to fail")
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("boot resource deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Constraints restrict which machines can be allocated. The zero value
+// allows any machine.
+type Constraints struct {
+	// MinCPUCount is the minimum number of cpu cores.
+	MinCPUCount int
+	// MinMemory is the minimum amount of memory in MiB.
+	MinMemory int
+	// Architecture is the architecture of the machine, e.g. "amd64/generic".
+	Architecture string
+	// Zone is the name of the availability zone to allocate from.
+	Zone string
+	// Pool is the name of the resource pool to allocate from.
+	Pool string
+	// Tags the machine must have.
+	Tags []string
+	// NotTags the machine must not have.
+	NotTags []string
+	// Storage the machine must have. The first entry is used for the root
+	// disk.
+	Storage []StorageConstraint
+	// Interfaces the machine must have.
+	Interfaces []InterfaceConstraint
+}
+
+// StorageConstraint requires a disk of a minimum size.
+type StorageConstraint struct {
+	// Label is optional and identifies the disk in the allocation results.
+	Label string
+	// Size is the minimum size of the disk in GB.
+	Size int
+	// Tags the disk must have.
+	Tags []string
+}
+
+// InterfaceConstraint requires a network interface attached to a network.
+type InterfaceConstraint struct {
+	// Label is required and identifies the interface in the allocation
+	// results.
+	Label string
+	// Space is the name of the space the interface is attached to.
+	Space string
+	// Subnet is the name or cidr of the subnet the interface is attached
+	// to.
+	Subnet string
+	// Fabric is the name of the fabric the interface is attached to.
+	Fabric string
+	// VID is the vlan id the interface is attached to.
+	VID *int
+}
+
+// Validate checks that MAAS will accept the constraints.
+func (c *Constraints) Validate() error {
+	if c.MinCPUCount < 0 {
+		return fmt.Errorf("invalid minimum cpu count %d", c.MinCPUCount)
+	}
+	if c.MinMemory < 0 {
+		return fmt.Errorf("invalid minimum memory %d", c.MinMemory)
+	}
+	labels := map[string]bool{}
+	for _, s := range c.Storage {
+		if s.Size <= 0 {
+			return fmt.Errorf("invalid storage size %d", s.Size)
+		}
+		if s.Label != "" && labels[s.Label] {
+			return fmt.Errorf("duplicate storage label %q", s.Label)
+		}
+		labels[s.Label] = true
+		for _, t := range s.Tags {
+			if t == "" {
+				return fmt.Errorf("empty tag in storage %q", s.Label)
+			}
+		}
+	}
+	labels = map[string]bool{}
+	for _, i := range c.Interfaces {
+		if i.Label == "" {
+			return fmt.Errorf("interface label is required")
+		}
+		if labels[i.Label] {
+			return fmt.Errorf("duplicate interface label %q", i.Label)
+		}
+		labels[i.Label] = true
+		if i.Space == "" && i.Subnet == "" && i.Fabric == "" && i.VID == nil {
+			return fmt.Errorf("interface %q has no constraints", i.Label)
+		}
+	}
+	return nil
+}
+
+// params encodes the constraints as parameters of the machines allocate
+// operation.
+func (c *Constraints) params() url.Values {
+	params := url.Values{}
+	if c.MinCPUCount > 0 {
+		params.Set("cpu_count", strconv.Itoa(c.MinCPUCount))
+	}
+	if c.MinMemory > 0 {
+		params.Set("mem", strconv.Itoa(c.MinMemory))
+	}
+	if c.Architecture != "" {
+		params.Set("arch", c.Architecture)
+	}
+	if c.Zone != "" {
+		params.Set("zone", c.Zone)
+	}
+	if c.Pool != "" {
+		params.Set("pool", c.Pool)
+	}
+	for _, t := range c.Tags {
+		if t != "" {
+			params.Add("tags", t)
+		}
+	}
+	for _, t := range c.NotTags {
+		if t != "" {
+			params.Add("not_tags", t)
+		}
+	}
+
+	// storage is of the form "label:size(tag,tag),size"
+	var storage []string
+	for _, s := range c.Storage {
+		var spec strings.Builder
+		if s.Label != "" {
+			spec.WriteString(s.Label + ":")
+		}
+		spec.WriteString(strconv.Itoa(s.Size))
+		if len(s.Tags) > 0 {
+			spec.WriteString("(" + strings.Join(s.Tags, ",") + ")")
+		}
+		storage = append(storage, spec.String())
+	}
+	if len(storage) > 0 {
+		params.Set("storage", strings.Join(storage, ","))
+	}
+
+	// interfaces is of the form "label:key=value,key=value;label:key=value"
+	var interfaces []string
+	for _, i := range c.Interfaces {
+		var values []string
+		if i.Space != "" {
+			values = append(values, "space="+i.Space)
+		}
+		if i.Subnet != "" {
+			key := "subnet"
+			if strings.Contains(i.Subnet, "/") {
+				key = "subnet_cidr"
+			}
+			values = append(values, key+"="+i.Subnet)
+		}
+		if i.Fabric != "" {
+			values = append(values, "fabric="+i.Fabric)
+		}
+		if i.VID != nil {
+			values = append(values, "vid="+strconv.Itoa(*i.VID))
+		}
+		interfaces = append(interfaces, i.Label+":"+strings.Join(values, ","))
+	}
+	if len(interfaces) > 0 {
+		params.Set("interfaces", strings.Join(interfaces, ";"))
+	}
+
+	return params
+}
@@ -0,0 +1,85 @@
+package maas
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestConstraints_params(t *testing.T) {
+	vid := 100
+	tests := []struct {
+		name        string
+		constraints Constraints
+		want        url.Values
+	}{
+		{name: "empty", constraints: Constraints{}, want: url.Values{}},
+		{
+			name: "machine",
+			constraints: Constraints{
+				MinCPUCount:  8,
+				MinMemory:    16384,
+				Architecture: "amd64/generic",
+				Zone:         "rack-1",
+				Pool:         "lab",
+				Tags:         []string{"ssd", ""},
+				NotTags:      []string{"broken"},
+			},
+			want: url.Values{
+				"cpu_count": {"8"},
+				"mem":       {"16384"},
+				"arch":      {"amd64/generic"},
+				"zone":      {"rack-1"},
+				"pool":      {"lab"},
+				"tags":      {"ssd"},
+				"not_tags":  {"broken"},
+			},
+		},
+		{
+			name: "storage",
+			constraints: Constraints{Storage: []StorageConstraint{
+				{Label: "root", Size: 100, Tags: []string{"ssd"}},
+				{Size: 500},
+			}},
+			want: url.Values{"storage": {"root:100(ssd),500"}},
+		},
+		{
+			name: "interfaces",
+			constraints: Constraints{Interfaces: []InterfaceConstraint{
+				{Label: "eth0", Space: "default", Subnet: "10.0.0.0/24"},
+				{Label: "eth1", Subnet: "storage", Fabric: "fabric-1", VID: &vid},
+			}},
+			want: url.Values{"interfaces": {"eth0:space=default,subnet_cidr=10.0.0.0/24;eth1:subnet=storage,fabric=fabric-1,vid=100"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.constraints.params(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("params() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraints_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints Constraints
+		wantErr     bool
+	}{
+		{name: "empty", constraints: Constraints{}, wantErr: false},
+		{name: "negative cpu", constraints: Constraints{MinCPUCount: -1}, wantErr: true},
+		{name: "zero storage", constraints: Constraints{Storage: []StorageConstraint{{Size: 0}}}, wantErr: true},
+		{name: "duplicate storage label", constraints: Constraints{Storage: []StorageConstraint{{Label: "a", Size: 1}, {Label: "a", Size: 1}}}, wantErr: true},
+		{name: "interface without label", constraints: Constraints{Interfaces: []InterfaceConstraint{{Space: "default"}}}, wantErr: true},
+		{name: "interface without constraints", constraints: Constraints{Interfaces: []InterfaceConstraint{{Label: "eth0"}}}, wantErr: true},
+		{name: "interface", constraints: Constraints{Interfaces: []InterfaceConstraint{{Label: "eth0", Space: "default"}}}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.constraints.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
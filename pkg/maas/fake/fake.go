@@ -0,0 +1,690 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory maas.MachineProvider for tests.
+package fake
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// Machine is a machine in the fake inventory.
+type Machine struct {
+	SystemID     string
+	Hostname     string
+	Tags         []string
+	IPAddresses  []string
+	Interfaces   []maas.InterfaceAddresses
+	Zone         string
+	Pool         string
+	Architecture string
+	CPUCount     int
+	Memory       int
+	Disks        []maas.Disk
+	// BlockDevices are returned in the CreateResponse. Dedicated disks of
+	// the request's Storage are mounted on the devices with their name.
+	BlockDevices []maas.BlockDevice
+
+	// Allocated, Deploying, Deployed and DeployFailed track the lifecycle
+	// of the machine. A released machine is none of them. Create leaves a
+	// machine deploying until CompleteDeploy or FailDeploy is called.
+	Allocated    bool
+	Deploying    bool
+	Deployed     bool
+	DeployFailed bool
+
+	// StatusMessage is returned as the status message of the machine.
+	StatusMessage string
+
+	// Description, PoweredOff and PowerCycles are changed by Update.
+	Description string
+	PoweredOff  bool
+	PowerCycles int
+
+	// AgentName is the agent name the machine was allocated with. Create
+	// sets it to maas.DefaultAgentName, which is also the default of
+	// machines added allocated with a ProviderID. List only returns the
+	// machines with maas.DefaultAgentName.
+	AgentName string
+
+	// ProviderID, Distro, Userdata, Network and Storage are recorded from
+	// the CreateRequest which allocated the machine. The static addresses of
+	// the network are set on Interfaces.
+	ProviderID string
+	Distro     string
+	Userdata   string
+	Network    *maas.Network
+	Storage    *maas.Storage
+
+	// DeployError, if set, is returned when this machine is deployed.
+	DeployError error
+}
+
+// Provider is an in-memory maas.MachineProvider. The exported error fields
+// can be set to inject failures into the corresponding operation.
+type Provider struct {
+	mu            sync.Mutex
+	machines      []*Machine
+	bootResources []maas.BootResource
+	// uploads are the contents and states of the uploaded boot resources
+	uploads map[int]*upload
+
+	AllocateError    error
+	DeployError      error
+	ReleaseError     error
+	StatusError      error
+	UpdateError      error
+	ListError        error
+	AvailableError   error
+	HostsError       error
+	ListImagesError  error
+	UploadImageError error
+	ImageStateError  error
+	DeleteImageError error
+	ZonesError       error
+}
+
+type upload struct {
+	content []byte
+	state   maas.ImageState
+}
+
+var _ maas.MachineProvider = &Provider{}
+
+// New returns a Provider with the given machine inventory.
+func New(machines ...Machine) *Provider {
+	p := &Provider{}
+	for _, m := range machines {
+		p.AddMachine(m)
+	}
+	return p
+}
+
+// AddMachine adds a machine to the inventory.
+func (p *Provider) AddMachine(m Machine) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m.Allocated && m.ProviderID != "" && m.AgentName == "" {
+		m.AgentName = maas.DefaultAgentName
+	}
+	p.machines = append(p.machines, &m)
+}
+
+// AddBootResource adds a boot resource to the list returned by ListImages.
+func (p *Provider) AddBootResource(r maas.BootResource) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.bootResources = append(p.bootResources, r)
+}
+
+// Upload returns the content of an uploaded boot resource.
+func (p *Provider) Upload(id int) ([]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	u, ok := p.uploads[id]
+	if !ok {
+		return nil, false
+	}
+	return u.content, true
+}
+
+// SetImageState sets the state returned by ImageState for an uploaded boot
+// resource. Uploaded boot resources are complete unless it is changed.
+func (p *Provider) SetImageState(id int, state maas.ImageState) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if u, ok := p.uploads[id]; ok {
+		u.state = state
+	}
+}
+
+// Machine returns a copy of the machine with the given system id.
+func (p *Provider) Machine(systemID string) (Machine, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	m := p.find(systemID)
+	if m == nil {
+		return Machine{}, false
+	}
+	return *m, true
+}
+
+// Machines returns a copy of the inventory.
+func (p *Provider) Machines() []Machine {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	machines := make([]Machine, 0, len(p.machines))
+	for _, m := range p.machines {
+		machines = append(machines, *m)
+	}
+	return machines
+}
+
+func (p *Provider) find(systemID string) *Machine {
+	for _, m := range p.machines {
+		if m.SystemID == systemID {
+			return m
+		}
+	}
+	return nil
+}
+
+func hasTag(m *Machine, tag string) bool {
+	if tag == "" {
+		return true
+	}
+	for _, t := range m.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether m satisfies the machine level constraints. Storage
+// and interface constraints are not modeled.
+func matches(m *Machine, instanceType string, c *maas.Constraints) bool {
+	if !hasTag(m, instanceType) {
+		return false
+	}
+	for _, t := range c.Tags {
+		if !hasTag(m, t) {
+			return false
+		}
+	}
+	for _, t := range c.NotTags {
+		if t != "" && hasTag(m, t) {
+			return false
+		}
+	}
+	return m.CPUCount >= c.MinCPUCount &&
+		m.Memory >= c.MinMemory &&
+		(c.Architecture == "" || c.Architecture == m.Architecture) &&
+		(c.Zone == "" || c.Zone == m.Zone) &&
+		(c.Pool == "" || c.Pool == m.Pool)
+}
+
+func (p *Provider) findProviderID(providerID string) *Machine {
+	for _, m := range p.machines {
+		if m.Allocated && m.AgentName == maas.DefaultAgentName && m.ProviderID == providerID {
+			return m
+		}
+	}
+	return nil
+}
+
+// Create allocates the first free machine tagged with the request's instance
+// type and matching its constraints and starts deploying it. A machine
+// already allocated for the request's ProviderID is adopted instead. A failed
+// deploy releases the machine, as maas.Client does.
+func (p *Provider) Create(ctx context.Context, request *maas.CreateRequest) (*maas.CreateResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if request.ProviderID == "" {
+		return nil, fmt.Errorf("error creating machine: providerID not set")
+	}
+	m := p.findProviderID(request.ProviderID)
+	if m != nil && (m.Deploying || m.Deployed) {
+		return newCreateResponse(m), nil
+	}
+	if m == nil {
+		if p.AllocateError != nil {
+			return nil, p.AllocateError
+		}
+		if err := request.Constraints.Validate(); err != nil {
+			return nil, err
+		}
+		if err := request.Network.Validate(); err != nil {
+			return nil, err
+		}
+		if err := request.Storage.Validate(); err != nil {
+			return nil, err
+		}
+		for _, candidate := range p.machines {
+			if !candidate.Allocated && matches(candidate, request.InstanceType, &request.Constraints) {
+				m = candidate
+				break
+			}
+		}
+		if m == nil {
+			return nil, fmt.Errorf("error allocating machine %s: no machine available", request.ProviderID)
+		}
+		m.Allocated = true
+		m.AgentName = maas.DefaultAgentName
+		m.ProviderID = request.ProviderID
+	}
+
+	deployErr := p.DeployError
+	if m.DeployError != nil {
+		deployErr = m.DeployError
+	}
+	if deployErr != nil {
+		p.release(m)
+		return nil, deployErr
+	}
+	m.Deploying = true
+	m.DeployFailed = false
+	m.StatusMessage = ""
+	m.Distro = request.Distro
+	m.Userdata = request.Userdata
+	m.Network = request.Network
+	if request.Network != nil {
+		for _, i := range request.Network.Interfaces {
+			if i.Mode == maas.IPModeStatic {
+				setInterfaceAddress(m, i.InterfaceName(), i.IPAddress)
+			}
+		}
+	}
+	m.Storage = request.Storage
+	if request.Storage != nil {
+		for _, d := range request.Storage.DedicatedDisks {
+			for i := range m.BlockDevices {
+				if m.BlockDevices[i].Name == d.Name {
+					m.BlockDevices[i].MountPoints = []string{d.MountPoint}
+				}
+			}
+		}
+	}
+
+	return newCreateResponse(m), nil
+}
+
+func setInterfaceAddress(m *Machine, name, address string) {
+	for i := range m.Interfaces {
+		if m.Interfaces[i].Name == name {
+			m.Interfaces[i].IPAddresses = []string{address}
+			return
+		}
+	}
+	m.Interfaces = append(m.Interfaces, maas.InterfaceAddresses{Name: name, IPAddresses: []string{address}})
+}
+
+func newCreateResponse(m *Machine) *maas.CreateResponse {
+	return &maas.CreateResponse{
+		ProviderID:   m.ProviderID,
+		IPAddresses:  append([]string(nil), m.IPAddresses...),
+		SystemID:     m.SystemID,
+		Hostname:     m.Hostname,
+		Zone:         m.Zone,
+		BlockDevices: append([]maas.BlockDevice(nil), m.BlockDevices...),
+	}
+}
+
+// Delete releases the machine with the request's system id, or the machine
+// allocated for the request's provider id if the system id is not set.
+func (p *Provider) Delete(ctx context.Context, request *maas.DeleteRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if request.SystemID == "" && request.ProviderID == "" {
+		return fmt.Errorf("machine has not been created")
+	}
+	if p.ReleaseError != nil {
+		return p.ReleaseError
+	}
+	var m *Machine
+	if request.SystemID != "" {
+		m = p.find(request.SystemID)
+		if m == nil {
+			return fmt.Errorf("machine %s not found", request.SystemID)
+		}
+	} else {
+		m = p.findProviderID(request.ProviderID)
+		if m == nil {
+			return nil
+		}
+	}
+	p.release(m)
+	return nil
+}
+
+// CompleteDeploy finishes the deployment of the machine with the given
+// system id.
+func (p *Provider) CompleteDeploy(systemID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m := p.find(systemID); m != nil && m.Deploying {
+		m.Deploying = false
+		m.Deployed = true
+		m.StatusMessage = "Deployed"
+	}
+}
+
+// FailDeploy fails the deployment of the machine with the given system id.
+func (p *Provider) FailDeploy(systemID, message string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m := p.find(systemID); m != nil && m.Deploying {
+		m.Deploying = false
+		m.DeployFailed = true
+		m.StatusMessage = message
+	}
+}
+
+func status(m *Machine) string {
+	switch {
+	case m.Deployed:
+		return maas.StatusDeployed
+	case m.DeployFailed:
+		return maas.StatusFailedDeployment
+	case m.Deploying:
+		return maas.StatusDeploying
+	default:
+		return maas.StatusAllocated
+	}
+}
+
+func newMachine(m *Machine) *maas.Machine {
+	return &maas.Machine{
+		ProviderID:    m.ProviderID,
+		SystemID:      m.SystemID,
+		Hostname:      m.Hostname,
+		Status:        status(m),
+		StatusMessage: m.StatusMessage,
+		IPAddresses:   append([]string(nil), m.IPAddresses...),
+		Interfaces:    append([]maas.InterfaceAddresses(nil), m.Interfaces...),
+		Zone:          m.Zone,
+	}
+}
+
+func (p *Provider) release(m *Machine) {
+	m.Allocated = false
+	m.Deploying = false
+	m.Deployed = false
+	m.DeployFailed = false
+	m.StatusMessage = ""
+	m.AgentName = ""
+	m.ProviderID = ""
+	m.Distro = ""
+	m.Userdata = ""
+	m.Network = nil
+	m.Storage = nil
+}
+
+// Update renames, tags and powers the machine with the request's system id,
+// or the machine allocated for the request's provider id if the system id is
+// not set.
+func (p *Provider) Update(ctx context.Context, request *maas.UpdateRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.UpdateError != nil {
+		return p.UpdateError
+	}
+	if request.Power != "" && !request.Power.Valid() {
+		return fmt.Errorf("unknown power action %q", request.Power)
+	}
+	var m *Machine
+	if request.SystemID != "" {
+		m = p.find(request.SystemID)
+	} else {
+		m = p.findProviderID(request.ProviderID)
+	}
+	if m == nil {
+		return maas.ErrMachineNotFound
+	}
+
+	if request.Hostname != "" {
+		m.Hostname = request.Hostname
+	}
+	if request.Description != "" {
+		m.Description = request.Description
+	}
+	for _, tag := range request.Tags {
+		if !hasTag(m, tag) {
+			m.Tags = append(m.Tags, tag)
+		}
+	}
+	for _, tag := range request.RemoveTags {
+		for i, t := range m.Tags {
+			if t == tag {
+				m.Tags = append(m.Tags[:i], m.Tags[i+1:]...)
+				break
+			}
+		}
+	}
+	switch request.Power {
+	case maas.PowerCycle:
+		m.PoweredOff = false
+		m.PowerCycles++
+	case maas.PowerOff:
+		m.PoweredOff = true
+	case maas.PowerOn:
+		m.PoweredOff = false
+	}
+	return nil
+}
+
+// Status returns the machine allocated for the request's provider id, or
+// the allocated machine with the request's system id if the provider id is
+// not set.
+func (p *Provider) Status(ctx context.Context, request *maas.StatusRequest) (*maas.Machine, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.StatusError != nil {
+		return nil, p.StatusError
+	}
+	var m *Machine
+	if request.ProviderID != "" {
+		m = p.findProviderID(request.ProviderID)
+	} else if candidate := p.find(request.SystemID); candidate != nil && candidate.Allocated {
+		m = candidate
+	}
+	if m == nil {
+		return nil, maas.ErrMachineNotFound
+	}
+	return newMachine(m), nil
+}
+
+// List returns the machines allocated with maas.DefaultAgentName.
+func (p *Provider) List(ctx context.Context) ([]maas.Machine, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ListError != nil {
+		return nil, p.ListError
+	}
+	var machines []maas.Machine
+	for _, m := range p.machines {
+		if !m.Allocated || m.AgentName != maas.DefaultAgentName {
+			continue
+		}
+		machines = append(machines, *newMachine(m))
+	}
+	return machines, nil
+}
+
+// Available returns the machines which are not allocated.
+func (p *Provider) Available(ctx context.Context) ([]maas.Hardware, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.AvailableError != nil {
+		return nil, p.AvailableError
+	}
+	var available []maas.Hardware
+	for _, m := range p.machines {
+		if m.Allocated {
+			continue
+		}
+		available = append(available, hardware(m))
+	}
+	return available, nil
+}
+
+func hardware(m *Machine) maas.Hardware {
+	return maas.Hardware{
+		SystemID:     m.SystemID,
+		Hostname:     m.Hostname,
+		Zone:         m.Zone,
+		Pool:         m.Pool,
+		Architecture: m.Architecture,
+		Tags:         append([]string(nil), m.Tags...),
+		CPUCount:     m.CPUCount,
+		Memory:       m.Memory,
+		Disks:        append([]maas.Disk(nil), m.Disks...),
+	}
+}
+
+// Hosts returns the whole inventory. The interfaces of a machine are its
+// NICs.
+func (p *Provider) Hosts(ctx context.Context) ([]maas.Host, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.HostsError != nil {
+		return nil, p.HostsError
+	}
+	hosts := make([]maas.Host, 0, len(p.machines))
+	for _, m := range p.machines {
+		h := maas.Host{
+			Hardware:   hardware(m),
+			Status:     status(m),
+			PowerState: "on",
+		}
+		if m.PoweredOff {
+			h.PowerState = "off"
+		}
+		if m.Allocated {
+			h.ProviderID = m.ProviderID
+		} else {
+			h.Status = maas.StatusReady
+		}
+		for _, i := range m.Interfaces {
+			h.NICs = append(h.NICs, maas.NIC{Name: i.Name, IPAddresses: append([]string(nil), i.IPAddresses...)})
+		}
+		hosts = append(hosts, h)
+	}
+	return hosts, nil
+}
+
+// ListImages returns the boot resources added with AddBootResource.
+func (p *Provider) ListImages(ctx context.Context) ([]maas.BootResource, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ListImagesError != nil {
+		return nil, p.ListImagesError
+	}
+	return append([]maas.BootResource(nil), p.bootResources...), nil
+}
+
+// UploadImage adds an uploaded boot resource. The content must match the
+// size and checksum of the request.
+func (p *Provider) UploadImage(ctx context.Context, request *maas.UploadImageRequest, progress func(uploaded int64)) (*maas.BootResource, error) {
+	content, err := ioutil.ReadAll(request.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.UploadImageError != nil {
+		return nil, p.UploadImageError
+	}
+	sum := sha256.Sum256(content)
+	if int64(len(content)) != request.Size || hex.EncodeToString(sum[:]) != request.SHA256 {
+		return nil, fmt.Errorf("content of %q does not match its size and sha256", request.Name)
+	}
+	id := 1
+	for _, r := range p.bootResources {
+		if r.ID >= id {
+			id = r.ID + 1
+		}
+	}
+	resource := maas.BootResource{
+		ID:           id,
+		Name:         request.Name,
+		Type:         maas.BootResourceUploaded,
+		Architecture: request.Architecture,
+		Uploaded:     time.Now().UTC().Truncate(24 * time.Hour),
+	}
+	p.bootResources = append(p.bootResources, resource)
+	if p.uploads == nil {
+		p.uploads = map[int]*upload{}
+	}
+	p.uploads[id] = &upload{content: content, state: maas.ImageState{Complete: true, Progress: 100}}
+	if progress != nil {
+		progress(int64(len(content)))
+	}
+	return &resource, nil
+}
+
+// ImageState returns the state of an uploaded boot resource.
+func (p *Provider) ImageState(ctx context.Context, id int) (*maas.ImageState, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ImageStateError != nil {
+		return nil, p.ImageStateError
+	}
+	u, ok := p.uploads[id]
+	if !ok {
+		return nil, maas.ErrImageNotFound
+	}
+	state := u.state
+	return &state, nil
+}
+
+// DeleteImage removes a boot resource.
+func (p *Provider) DeleteImage(ctx context.Context, id int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.DeleteImageError != nil {
+		return p.DeleteImageError
+	}
+	for i, r := range p.bootResources {
+		if r.ID == id {
+			p.bootResources = append(p.bootResources[:i], p.bootResources[i+1:]...)
+			break
+		}
+	}
+	delete(p.uploads, id)
+	return nil
+}
+
+// Zones returns the sorted zones of the machines in the inventory.
+func (p *Provider) Zones(ctx context.Context) ([]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ZonesError != nil {
+		return nil, p.ZonesError
+	}
+	seen := map[string]bool{}
+	var zones []string
+	for _, m := range p.machines {
+		if m.Zone != "" && !seen[m.Zone] {
+			seen[m.Zone] = true
+			zones = append(zones, m.Zone)
+		}
+	}
+	sort.Strings(zones)
+	return zones, nil
+}
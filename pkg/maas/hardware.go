@@ -0,0 +1,323 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// Hardware describes a machine that is ready to be allocated.
+type Hardware struct {
+	SystemID     string
+	Hostname     string
+	Zone         string
+	Pool         string
+	Architecture string
+	Tags         []string
+	// CPUCount is the number of cpu cores.
+	CPUCount int
+	// Memory is the amount of memory in MiB.
+	Memory int
+	// Disks are the physical disks of the machine.
+	Disks []Disk
+}
+
+// Disk is a physical disk of a machine.
+type Disk struct {
+	// Name is the name of the disk, e.g. "sda".
+	Name  string
+	Model string
+	// Size is the size of the disk in GB.
+	Size int
+	Tags []string
+}
+
+// Host is a machine of the MAAS inventory in any state.
+type Host struct {
+	Hardware
+	// Status is the MAAS status name of the machine, e.g. "Ready".
+	Status string
+	// PowerState is "on", "off" or "unknown".
+	PowerState string
+	// Owner is the MAAS user the machine is allocated to.
+	Owner string
+	// ProviderID is set if the machine was allocated by cma-ssh.
+	ProviderID string
+	// NICs are the network interfaces of the machine.
+	NICs []NIC
+}
+
+// NIC is a network interface of a machine.
+type NIC struct {
+	// Name is the MAAS name of the interface, e.g. "eth0" or "bond0".
+	Name       string
+	MACAddress string
+	// VLAN is the vlan id of the interface, 0 if it is untagged.
+	VLAN        int
+	IPAddresses []string
+}
+
+// Storage returns the total size of the disks in GB.
+func (h *Hardware) Storage() int {
+	var size int
+	for _, d := range h.Disks {
+		size += d.Size
+	}
+	return size
+}
+
+// HasTag reports whether the machine has the tag. Every machine has the
+// empty tag.
+func (h *Hardware) HasTag(tag string) bool {
+	return tag == "" || containsString(h.Tags, tag)
+}
+
+// Matches reports whether MAAS could allocate the machine for the instance
+// type and constraints. Interface constraints depend on the network
+// configuration of the machine and are not checked.
+func (h *Hardware) Matches(instanceType string, c *Constraints) bool {
+	if !h.HasTag(instanceType) {
+		return false
+	}
+	if c == nil {
+		return true
+	}
+	for _, t := range c.Tags {
+		if !h.HasTag(t) {
+			return false
+		}
+	}
+	for _, t := range c.NotTags {
+		if t != "" && h.HasTag(t) {
+			return false
+		}
+	}
+	if h.CPUCount < c.MinCPUCount || h.Memory < c.MinMemory ||
+		(c.Architecture != "" && c.Architecture != h.Architecture) ||
+		(c.Zone != "" && c.Zone != h.Zone) ||
+		(c.Pool != "" && c.Pool != h.Pool) {
+		return false
+	}
+
+	// Every storage constraint needs a disk of its own.
+	used := make([]bool, len(h.Disks))
+	for _, s := range c.Storage {
+		found := false
+		for i, d := range h.Disks {
+			if !used[i] && d.Size >= s.Size && containsAll(d.Tags, s.Tags) {
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAll(list, want []string) bool {
+	for _, s := range want {
+		if !containsString(list, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// maasHardware is the part of the MAAS machine resource needed for Host.
+type maasHardware struct {
+	SystemID     string            `json:"system_id"`
+	Hostname     string            `json:"hostname"`
+	StatusName   string            `json:"status_name"`
+	PowerState   string            `json:"power_state"`
+	Owner        string            `json:"owner"`
+	OwnerData    map[string]string `json:"owner_data"`
+	Architecture string            `json:"architecture"`
+	CPUCount     int               `json:"cpu_count"`
+	Memory       int               `json:"memory"`
+	TagNames     []string          `json:"tag_names"`
+	Zone         struct {
+		Name string `json:"name"`
+	} `json:"zone"`
+	Pool struct {
+		Name string `json:"name"`
+	} `json:"pool"`
+	BlockDevices []struct {
+		Name  string `json:"name"`
+		Model string `json:"model"`
+		// Size is in bytes.
+		Size int64    `json:"size"`
+		Tags []string `json:"tags"`
+	} `json:"physicalblockdevice_set"`
+	Interfaces []struct {
+		Name       string `json:"name"`
+		MACAddress string `json:"mac_address"`
+		VLAN       *struct {
+			VID int `json:"vid"`
+		} `json:"vlan"`
+		Links []struct {
+			IPAddress string `json:"ip_address"`
+		} `json:"links"`
+	} `json:"interface_set"`
+}
+
+// Available returns the machines in the Ready state.
+func (c Client) Available(ctx context.Context) ([]Hardware, error) {
+	hosts, err := c.Hosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var available []Hardware
+	for _, h := range hosts {
+		if h.Status == StatusReady {
+			available = append(available, h.Hardware)
+		}
+	}
+	return available, nil
+}
+
+// Hosts returns every machine of the MAAS inventory. The machines are read
+// from the MAAS API directly since gomaasapi.Machine does not expose
+// resource pools or block device tags.
+func (c Client) Hosts(ctx context.Context) ([]Host, error) {
+	result, err := c.MAAS.GetSubObject("machines").CallGet("", url.Values{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing machines")
+	}
+	data, err := result.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var machines []maasHardware
+	if err := json.Unmarshal(data, &machines); err != nil {
+		return nil, fmt.Errorf("error decoding machines: %v", err)
+	}
+
+	hosts := make([]Host, 0, len(machines))
+	for _, m := range machines {
+		h := Host{
+			Hardware: Hardware{
+				SystemID:     m.SystemID,
+				Hostname:     m.Hostname,
+				Zone:         m.Zone.Name,
+				Pool:         m.Pool.Name,
+				Architecture: m.Architecture,
+				Tags:         m.TagNames,
+				CPUCount:     m.CPUCount,
+				Memory:       m.Memory,
+			},
+			Status:     m.StatusName,
+			PowerState: m.PowerState,
+			Owner:      m.Owner,
+			ProviderID: m.OwnerData[OwnerDataProviderIDKey],
+		}
+		for _, d := range m.BlockDevices {
+			h.Disks = append(h.Disks, Disk{
+				Name:  d.Name,
+				Model: d.Model,
+				Size:  int(d.Size / 1000 / 1000 / 1000),
+				Tags:  d.Tags,
+			})
+		}
+		for _, i := range m.Interfaces {
+			nic := NIC{Name: i.Name, MACAddress: i.MACAddress}
+			if i.VLAN != nil {
+				nic.VLAN = i.VLAN.VID
+			}
+			for _, link := range i.Links {
+				if link.IPAddress != "" {
+					nic.IPAddresses = append(nic.IPAddresses, link.IPAddress)
+				}
+			}
+			h.NICs = append(h.NICs, nic)
+		}
+		hosts = append(hosts, h)
+	}
+
+	return hosts, nil
+}
+
+// Demand is a number of machines of an instance type to allocate.
+type Demand struct {
+	InstanceType string
+	Constraints  *Constraints
+	Count        int
+}
+
+// Shortfall assigns the available machines to the demands and returns how
+// many machines each demand is missing. A machine is assigned to at most one
+// demand. Machines matching several demands are moved between them whenever
+// that lets another machine be assigned, so a demand is only short if no
+// assignment can satisfy it; when the demands can not all be met the earlier
+// ones are served first.
+func Shortfall(available []Hardware, demands []Demand) []int {
+	candidates := make([][]int, len(demands))
+	for i, d := range demands {
+		for j := range available {
+			if available[j].Matches(d.InstanceType, d.Constraints) {
+				candidates[i] = append(candidates[i], j)
+			}
+		}
+	}
+	owner := make([]int, len(available))
+	for j := range owner {
+		owner[j] = -1
+	}
+	missing := make([]int, len(demands))
+	for i, d := range demands {
+		for n := 0; n < d.Count; n++ {
+			if !assign(i, candidates, owner, make([]bool, len(available))) {
+				missing[i] = d.Count - n
+				break
+			}
+		}
+	}
+	return missing
+}
+
+// assign assigns a machine to the demand, moving the machines of other
+// demands to other candidates if needed. owner holds the demand each machine
+// is assigned to or -1. It returns false if no machine can be assigned.
+func assign(demand int, candidates [][]int, owner []int, visited []bool) bool {
+	for _, j := range candidates[demand] {
+		if visited[j] {
+			continue
+		}
+		visited[j] = true
+		if owner[j] < 0 || assign(owner[j], candidates, owner, visited) {
+			owner[j] = demand
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package maas
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHardware_Matches(t *testing.T) {
+	h := Hardware{
+		Zone:         "rack-1",
+		Pool:         "lab",
+		Architecture: "amd64/generic",
+		Tags:         []string{"gpu", "ssd"},
+		CPUCount:     8,
+		Memory:       16384,
+		Disks:        []Disk{{Size: 100, Tags: []string{"ssd"}}, {Size: 500}},
+	}
+	tests := []struct {
+		name         string
+		instanceType string
+		constraints  *Constraints
+		want         bool
+	}{
+		{name: "no constraints", instanceType: "gpu", want: true},
+		{name: "instance type", instanceType: "standard", want: false},
+		{name: "machine", instanceType: "gpu", constraints: &Constraints{MinCPUCount: 8, MinMemory: 16384, Zone: "rack-1", Pool: "lab", Tags: []string{"ssd"}}, want: true},
+		{name: "cpu", constraints: &Constraints{MinCPUCount: 16}, want: false},
+		{name: "zone", constraints: &Constraints{Zone: "rack-2"}, want: false},
+		{name: "not tags", constraints: &Constraints{NotTags: []string{"ssd"}}, want: false},
+		{name: "storage", constraints: &Constraints{Storage: []StorageConstraint{{Size: 50, Tags: []string{"ssd"}}, {Size: 200}}}, want: true},
+		{name: "storage needs separate disks", constraints: &Constraints{Storage: []StorageConstraint{{Size: 200}, {Size: 200}}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.Matches(tt.instanceType, tt.constraints); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortfall(t *testing.T) {
+	available := []Hardware{
+		{SystemID: "a", Tags: []string{"standard"}},
+		{SystemID: "b", Tags: []string{"standard", "gpu"}},
+		{SystemID: "c", Tags: []string{"gpu"}},
+	}
+	demands := []Demand{
+		{InstanceType: "standard", Count: 2},
+		{InstanceType: "gpu", Count: 2},
+		{InstanceType: "standard", Constraints: &Constraints{Zone: "rack-1"}, Count: 0},
+	}
+	if got, want := Shortfall(available, demands), []int{0, 1, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Shortfall() = %v, want %v", got, want)
+	}
+}
+
+func TestShortfall_reassign(t *testing.T) {
+	// b is the only gpu machine so standard has to take a
+	available := []Hardware{
+		{SystemID: "b", Tags: []string{"standard", "gpu"}},
+		{SystemID: "a", Tags: []string{"standard"}},
+	}
+	demands := []Demand{
+		{InstanceType: "standard", Count: 1},
+		{InstanceType: "gpu", Count: 1},
+	}
+	if got, want := Shortfall(available, demands), []int{0, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Shortfall() = %v, want %v", got, want)
+	}
+}
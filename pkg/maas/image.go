@@ -0,0 +1,303 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/juju/gomaasapi"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// ImageChunkSize is the size of the chunks an image is uploaded in, the chunk
+// size of the maas cli.
+const ImageChunkSize = 4 * 1024 * 1024
+
+// uploadDayTTL is how long the upload day of a boot resource is cached. The
+// cache only misses uploads made outside of the client, for which the day is
+// informational.
+const uploadDayTTL = time.Hour
+
+// uploadDays caches the upload days of the uploaded boot resources by id, so
+// that listing the images does not read every boot resource every time.
+type uploadDays struct {
+	mu   sync.Mutex
+	days map[int]uploadDay
+}
+
+type uploadDay struct {
+	day     time.Time
+	expires time.Time
+}
+
+func newUploadDays() *uploadDays {
+	return &uploadDays{days: map[int]uploadDay{}}
+}
+
+// get returns the cached upload day of a boot resource. Nothing is cached by
+// a nil cache.
+func (u *uploadDays) get(id int) (time.Time, bool) {
+	if u == nil {
+		return time.Time{}, false
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	d, ok := u.days[id]
+	if !ok || time.Now().After(d.expires) {
+		return time.Time{}, false
+	}
+	return d.day, true
+}
+
+func (u *uploadDays) set(id int, day time.Time) {
+	if u == nil {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.days[id] = uploadDay{day: day, expires: time.Now().Add(uploadDayTTL)}
+}
+
+// forget drops the upload day of a boot resource uploaded again or deleted.
+func (u *uploadDays) forget(id int) {
+	if u == nil {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.days, id)
+}
+
+// keep drops the upload days of the boot resources which are not listed.
+func (u *uploadDays) keep(ids map[int]bool) {
+	if u == nil {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for id := range u.days {
+		if !ids[id] {
+			delete(u.days, id)
+		}
+	}
+}
+
+// UploadImageRequest describes an image to upload as a boot resource.
+type UploadImageRequest struct {
+	// Name of the boot resource, e.g. "os=ubuntu-xenial,k8s=1.13.5,type=standard"
+	Name string
+	// Title shown in the MAAS UI, the name if empty
+	Title string
+	// Architecture of the image, e.g. "amd64/generic"
+	Architecture string
+	// FileType is the format of the image, e.g. "tgz" or "ddtgz"
+	FileType string
+	// Size of the content in bytes
+	Size int64
+	// SHA256 of the content, hex encoded
+	SHA256 string
+	// Content of the image, Size bytes are read from it
+	Content io.Reader
+}
+
+// ImageState is the state of a boot resource.
+type ImageState struct {
+	// Complete is true once MAAS has received and processed every file of
+	// the newest version of the boot resource. Rack controllers sync it
+	// from then on.
+	Complete bool
+	// Progress of the processing, in percent
+	Progress float64
+}
+
+// ErrImageNotFound is returned by ImageState if the boot resource does not
+// exist.
+var ErrImageNotFound = errors.New("boot resource not found")
+
+// maasBootResource is the boot resource read from the MAAS API.
+type maasBootResource struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Architecture string `json:"architecture"`
+	Sets         map[string]struct {
+		Complete bool    `json:"complete"`
+		Progress float64 `json:"progress"`
+		Files    map[string]struct {
+			UploadURI string `json:"upload_uri"`
+			Complete  bool   `json:"complete"`
+		} `json:"files"`
+	} `json:"sets"`
+}
+
+// newestSet returns the name of the newest set of the boot resource. MAAS
+// names sets after their creation day so the names sort by age.
+func (r maasBootResource) newestSet() string {
+	var newest string
+	for name := range r.Sets {
+		if name > newest {
+			newest = name
+		}
+	}
+	return newest
+}
+
+// UploadImage creates a boot resource for the request and uploads its
+// content in chunks, calling progress with the number of bytes uploaded
+// after every chunk. MAAS processes the content asynchronously afterwards,
+// see ImageState.
+func (c Client) UploadImage(ctx context.Context, request *UploadImageRequest, progress func(uploaded int64)) (*BootResource, error) {
+	title := request.Title
+	if title == "" {
+		title = request.Name
+	}
+	params := url.Values{
+		"name":         {request.Name},
+		"title":        {title},
+		"architecture": {request.Architecture},
+		"filetype":     {request.FileType},
+		"size":         {strconv.FormatInt(request.Size, 10)},
+		"sha256":       {request.SHA256},
+	}
+	result, err := c.MAAS.GetSubObject("boot-resources").CallPost("", params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating boot resource %q", request.Name)
+	}
+	var resource maasBootResource
+	if err := decode(result, &resource); err != nil {
+		return nil, err
+	}
+	c.uploads.forget(resource.ID)
+
+	var uploadURI string
+	for _, file := range resource.Sets[resource.newestSet()].Files {
+		if !file.Complete {
+			uploadURI = file.UploadURI
+		}
+	}
+	if uploadURI != "" {
+		if err := c.uploadChunks(ctx, uploadURI, request.Content, progress); err != nil {
+			// A new boot resource would be picked by its name although
+			// it can not be deployed so it is deleted again. A resource
+			// uploaded before keeps its complete older versions, MAAS
+			// deploys the newest complete one.
+			if len(resource.Sets) == 1 {
+				if err := c.DeleteImage(context.Background(), resource.ID); err != nil {
+					klog.Warningf("could not delete incomplete boot resource %q (%d): %v", request.Name, resource.ID, err)
+				}
+			}
+			return nil, errors.Wrapf(err, "error uploading boot resource %q", request.Name)
+		}
+	}
+	return &BootResource{
+		ID:           resource.ID,
+		Name:         resource.Name,
+		Type:         resource.Type,
+		Architecture: resource.Architecture,
+	}, nil
+}
+
+// uploadChunks puts the content to the upload uri of a boot resource file.
+// The requests are signed like the ones of the API client, which can only
+// send form values.
+func (c Client) uploadChunks(ctx context.Context, uploadURI string, content io.Reader, progress func(uploaded int64)) error {
+	uri, err := url.Parse(uploadURI)
+	if err != nil {
+		return err
+	}
+	target := c.API.GetURL(uri).String()
+	buf := make([]byte, ImageChunkSize)
+	var uploaded int64
+	for {
+		n, err := io.ReadFull(content, buf)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+
+		request, err := http.NewRequest(http.MethodPut, target, bytes.NewReader(buf[:n]))
+		if err != nil {
+			return err
+		}
+		request = request.WithContext(ctx)
+		request.Header.Set("Content-Type", "application/octet-stream")
+		request.ContentLength = int64(n)
+		if err := c.API.Signer.OAuthSign(request); err != nil {
+			return err
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return err
+		}
+		body, _ := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("chunk upload failed with %s: %s", response.Status, body)
+		}
+
+		uploaded += int64(n)
+		if progress != nil {
+			progress(uploaded)
+		}
+	}
+}
+
+// ImageState returns the state of the newest version of a boot resource.
+func (c Client) ImageState(ctx context.Context, id int) (*ImageState, error) {
+	result, err := c.MAAS.GetSubObject("boot-resources").GetSubObject(strconv.Itoa(id)).CallGet("", url.Values{})
+	if err != nil {
+		if svrErr, ok := gomaasapi.GetServerError(err); ok && svrErr.StatusCode == http.StatusNotFound {
+			return nil, ErrImageNotFound
+		}
+		return nil, errors.Wrapf(err, "error reading boot resource %d", id)
+	}
+	var resource maasBootResource
+	if err := decode(result, &resource); err != nil {
+		return nil, err
+	}
+	set, ok := resource.Sets[resource.newestSet()]
+	if !ok {
+		return &ImageState{}, nil
+	}
+	return &ImageState{Complete: set.Complete, Progress: set.Progress}, nil
+}
+
+// DeleteImage deletes a boot resource. Deleting a boot resource which does not
+// exist is not an error.
+func (c Client) DeleteImage(ctx context.Context, id int) error {
+	c.uploads.forget(id)
+	err := c.MAAS.GetSubObject("boot-resources").GetSubObject(strconv.Itoa(id)).Delete()
+	if err != nil {
+		if svrErr, ok := gomaasapi.GetServerError(err); ok && svrErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return errors.Wrapf(err, "error deleting boot resource %d", id)
+	}
+	return nil
+}
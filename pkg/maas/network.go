@@ -0,0 +1,357 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// IPMode is how an interface gets its address.
+type IPMode string
+
+const (
+	// IPModeAuto assigns an address of the subnet when the machine is
+	// deployed.
+	IPModeAuto IPMode = "AUTO"
+	// IPModeStatic assigns Interface.IPAddress.
+	IPModeStatic IPMode = "STATIC"
+	// IPModeDHCP configures the interface with dhcp.
+	IPModeDHCP IPMode = "DHCP"
+	// IPModeLinkUp brings the interface up without an address.
+	IPModeLinkUp IPMode = "LINK_UP"
+)
+
+// Network is the interfaces layout applied to a machine after it is allocated
+// and before it is deployed. Interfaces which are not listed keep their MAAS
+// configuration.
+type Network struct {
+	// Bonds are created from physical interfaces.
+	Bonds []Bond
+	// Interfaces are linked to subnets.
+	Interfaces []Interface
+}
+
+// Bond bonds physical interfaces.
+type Bond struct {
+	// Name is the name of the bond interface.
+	Name string
+	// Parents are the names of the bonded interfaces.
+	Parents []string
+	// Mode is the bonding mode, the MAAS default if empty.
+	Mode string
+}
+
+// Interface links an interface to a subnet.
+type Interface struct {
+	// Name is the name of a physical interface or bond.
+	Name string
+	// VLAN, if not zero, links the vlan interface with this vlan id on top
+	// of Name instead. The vlan interface is created if needed.
+	VLAN int
+	// Subnet is the name or cidr of the subnet.
+	Subnet string
+	// Mode is how the interface gets its address, IPModeAuto if empty.
+	Mode IPMode
+	// IPAddress is the address used with IPModeStatic.
+	IPAddress string
+	// NodeIP marks the interface whose address is the node ip.
+	NodeIP bool
+}
+
+// InterfaceName returns the MAAS name of the linked interface.
+func (i *Interface) InterfaceName() string {
+	if i.VLAN != 0 {
+		return fmt.Sprintf("%s.%d", i.Name, i.VLAN)
+	}
+	return i.Name
+}
+
+func (i *Interface) mode() IPMode {
+	if i.Mode == "" {
+		return IPModeAuto
+	}
+	return i.Mode
+}
+
+// NodeInterface returns the interface whose address is the node ip or nil if
+// there is none.
+func (n *Network) NodeInterface() *Interface {
+	if n == nil {
+		return nil
+	}
+	for i := range n.Interfaces {
+		if n.Interfaces[i].NodeIP {
+			return &n.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+// Validate checks that the network can be applied to a machine.
+func (n *Network) Validate() error {
+	if n == nil {
+		return nil
+	}
+	names := map[string]bool{}
+	parents := map[string]bool{}
+	for _, b := range n.Bonds {
+		if b.Name == "" {
+			return fmt.Errorf("bond name is required")
+		}
+		if names[b.Name] {
+			return fmt.Errorf("duplicate bond %q", b.Name)
+		}
+		names[b.Name] = true
+		if len(b.Parents) == 0 {
+			return fmt.Errorf("bond %q has no parents", b.Name)
+		}
+		for _, p := range b.Parents {
+			if parents[p] {
+				return fmt.Errorf("interface %q is bonded twice", p)
+			}
+			parents[p] = true
+		}
+	}
+
+	names = map[string]bool{}
+	var nodeIP bool
+	for _, i := range n.Interfaces {
+		if i.Name == "" {
+			return fmt.Errorf("interface name is required")
+		}
+		if parents[i.Name] {
+			return fmt.Errorf("interface %q is bonded and can not be linked", i.Name)
+		}
+		if names[i.InterfaceName()] {
+			return fmt.Errorf("duplicate interface %q", i.InterfaceName())
+		}
+		names[i.InterfaceName()] = true
+		if i.VLAN < 0 || i.VLAN > 4094 {
+			return fmt.Errorf("invalid vlan %d of interface %q", i.VLAN, i.Name)
+		}
+		if i.Subnet == "" {
+			return fmt.Errorf("interface %q has no subnet", i.InterfaceName())
+		}
+		switch i.mode() {
+		case IPModeStatic:
+			if net.ParseIP(i.IPAddress) == nil {
+				return fmt.Errorf("invalid static ip address %q of interface %q", i.IPAddress, i.InterfaceName())
+			}
+		case IPModeAuto, IPModeDHCP, IPModeLinkUp:
+			if i.IPAddress != "" {
+				return fmt.Errorf("interface %q sets an ip address but is not static", i.InterfaceName())
+			}
+		default:
+			return fmt.Errorf("unknown ip mode %q of interface %q", i.Mode, i.InterfaceName())
+		}
+		if i.NodeIP {
+			if nodeIP {
+				return fmt.Errorf("more than one interface is the node ip")
+			}
+			if i.mode() == IPModeLinkUp {
+				return fmt.Errorf("interface %q has no address and can not be the node ip", i.InterfaceName())
+			}
+			nodeIP = true
+		}
+	}
+	return nil
+}
+
+type maasInterface struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Links []struct {
+		ID int `json:"id"`
+	} `json:"links"`
+	VLAN *maasVLAN `json:"vlan"`
+}
+
+type maasVLAN struct {
+	ID  int `json:"id"`
+	VID int `json:"vid"`
+}
+
+type maasSubnet struct {
+	ID   int      `json:"id"`
+	Name string   `json:"name"`
+	CIDR string   `json:"cidr"`
+	VLAN maasVLAN `json:"vlan"`
+}
+
+// applyNetwork configures the interfaces of an allocated machine. It can be
+// applied again to a machine it was applied to before, e.g. when a machine is
+// adopted.
+func (c Client) applyNetwork(systemID string, network *Network) error {
+	if network == nil {
+		return nil
+	}
+	if err := network.Validate(); err != nil {
+		return err
+	}
+	node := c.MAAS.GetSubObject("nodes").GetSubObject(systemID).GetSubObject("interfaces")
+
+	interfaces, err := c.interfaces(node)
+	if err != nil {
+		return err
+	}
+	for _, b := range network.Bonds {
+		if _, ok := interfaces[b.Name]; ok {
+			continue
+		}
+		params := url.Values{"name": {b.Name}}
+		for _, name := range b.Parents {
+			parent, ok := interfaces[name]
+			if !ok {
+				return fmt.Errorf("machine %s has no interface %q to bond", systemID, name)
+			}
+			// links are set on the bond, not its parents
+			if err := c.unlink(node, parent); err != nil {
+				return err
+			}
+			params.Add("parents", strconv.Itoa(parent.ID))
+		}
+		if b.Mode != "" {
+			params.Set("bond_mode", b.Mode)
+		}
+		klog.Infof("Creating bond %s on machine %s", b.Name, systemID)
+		if _, err := node.CallPost("create_bond", params); err != nil {
+			return errors.Wrapf(err, "error creating bond %s on machine %s", b.Name, systemID)
+		}
+	}
+
+	if len(network.Interfaces) == 0 {
+		return nil
+	}
+	if interfaces, err = c.interfaces(node); err != nil {
+		return err
+	}
+	subnets, err := c.subnets()
+	if err != nil {
+		return err
+	}
+	for _, i := range network.Interfaces {
+		subnet, ok := subnets[i.Subnet]
+		if !ok {
+			return fmt.Errorf("subnet %q not found", i.Subnet)
+		}
+		target, ok := interfaces[i.InterfaceName()]
+		if !ok && i.VLAN != 0 {
+			parent, ok := interfaces[i.Name]
+			if !ok {
+				return fmt.Errorf("machine %s has no interface %q", systemID, i.Name)
+			}
+			if subnet.VLAN.VID != i.VLAN {
+				return fmt.Errorf("subnet %q is on vlan %d, not %d", i.Subnet, subnet.VLAN.VID, i.VLAN)
+			}
+			klog.Infof("Creating vlan interface %s on machine %s", i.InterfaceName(), systemID)
+			result, err := node.CallPost("create_vlan", url.Values{
+				"parent": {strconv.Itoa(parent.ID)},
+				"vlan":   {strconv.Itoa(subnet.VLAN.ID)},
+			})
+			if err != nil {
+				return errors.Wrapf(err, "error creating vlan interface %s on machine %s", i.InterfaceName(), systemID)
+			}
+			if err := decode(result, &target); err != nil {
+				return err
+			}
+		} else if !ok {
+			return fmt.Errorf("machine %s has no interface %q", systemID, i.Name)
+		}
+
+		if err := c.unlink(node, target); err != nil {
+			return err
+		}
+		params := url.Values{
+			"mode":   {string(i.mode())},
+			"subnet": {strconv.Itoa(subnet.ID)},
+		}
+		if i.mode() == IPModeStatic {
+			params.Set("ip_address", i.IPAddress)
+		}
+		klog.Infof("Linking interface %s of machine %s to subnet %s (%s)", i.InterfaceName(), systemID, subnet.CIDR, i.mode())
+		_, err = node.GetSubObject(strconv.Itoa(target.ID)).CallPost("link_subnet", params)
+		if err != nil {
+			return errors.Wrapf(err, "error linking interface %s of machine %s to subnet %s", i.InterfaceName(), systemID, i.Subnet)
+		}
+	}
+	return nil
+}
+
+// interfaces returns the interfaces of a machine by name.
+func (c Client) interfaces(node gomaasapi.MAASObject) (map[string]maasInterface, error) {
+	result, err := node.CallGet("", url.Values{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing interfaces")
+	}
+	var list []maasInterface
+	if err := decode(result, &list); err != nil {
+		return nil, err
+	}
+	interfaces := make(map[string]maasInterface, len(list))
+	for _, i := range list {
+		interfaces[i.Name] = i
+	}
+	return interfaces, nil
+}
+
+// subnets returns the subnets by name and by cidr.
+func (c Client) subnets() (map[string]maasSubnet, error) {
+	result, err := c.MAAS.GetSubObject("subnets").CallGet("", url.Values{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing subnets")
+	}
+	var list []maasSubnet
+	if err := decode(result, &list); err != nil {
+		return nil, err
+	}
+	subnets := make(map[string]maasSubnet, 2*len(list))
+	for _, s := range list {
+		subnets[s.CIDR] = s
+		subnets[s.Name] = s
+	}
+	return subnets, nil
+}
+
+// unlink removes the links of an interface to subnets.
+func (c Client) unlink(node gomaasapi.MAASObject, i maasInterface) error {
+	for _, link := range i.Links {
+		_, err := node.GetSubObject(strconv.Itoa(i.ID)).CallPost("unlink_subnet", url.Values{"id": {strconv.Itoa(link.ID)}})
+		if err != nil {
+			return errors.Wrapf(err, "error unlinking interface %s", i.Name)
+		}
+	}
+	return nil
+}
+
+func decode(result gomaasapi.JSONObject, v interface{}) error {
+	data, err := result.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding maas response: %v", err)
+	}
+	return nil
+}
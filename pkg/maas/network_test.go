@@ -0,0 +1,70 @@
+package maas
+
+import "testing"
+
+func TestNetwork_Validate(t *testing.T) {
+	subnet := "10.0.0.0/24"
+	tests := []struct {
+		name    string
+		network *Network
+		wantErr bool
+	}{
+		{name: "nil"},
+		{
+			name: "bond and vlan",
+			network: &Network{
+				Bonds: []Bond{{Name: "bond0", Parents: []string{"eth0", "eth1"}, Mode: "802.3ad"}},
+				Interfaces: []Interface{
+					{Name: "bond0", Subnet: subnet, Mode: IPModeStatic, IPAddress: "10.0.0.5", NodeIP: true},
+					{Name: "bond0", VLAN: 100, Subnet: "storage"},
+				},
+			},
+		},
+		{name: "bond without parents", network: &Network{Bonds: []Bond{{Name: "bond0"}}}, wantErr: true},
+		{
+			name: "parent bonded twice",
+			network: &Network{Bonds: []Bond{
+				{Name: "bond0", Parents: []string{"eth0"}},
+				{Name: "bond1", Parents: []string{"eth0"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "bonded interface linked",
+			network: &Network{
+				Bonds:      []Bond{{Name: "bond0", Parents: []string{"eth0"}}},
+				Interfaces: []Interface{{Name: "eth0", Subnet: subnet}},
+			},
+			wantErr: true,
+		},
+		{name: "no subnet", network: &Network{Interfaces: []Interface{{Name: "eth0"}}}, wantErr: true},
+		{name: "invalid vlan", network: &Network{Interfaces: []Interface{{Name: "eth0", VLAN: 4095, Subnet: subnet}}}, wantErr: true},
+		{name: "static without address", network: &Network{Interfaces: []Interface{{Name: "eth0", Subnet: subnet, Mode: IPModeStatic}}}, wantErr: true},
+		{name: "auto with address", network: &Network{Interfaces: []Interface{{Name: "eth0", Subnet: subnet, IPAddress: "10.0.0.5"}}}, wantErr: true},
+		{name: "unknown mode", network: &Network{Interfaces: []Interface{{Name: "eth0", Subnet: subnet, Mode: "Bogus"}}}, wantErr: true},
+		{
+			name: "duplicate interface",
+			network: &Network{Interfaces: []Interface{
+				{Name: "eth0", VLAN: 10, Subnet: subnet},
+				{Name: "eth0", VLAN: 10, Subnet: "other"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "two node ips",
+			network: &Network{Interfaces: []Interface{
+				{Name: "eth0", Subnet: subnet, NodeIP: true},
+				{Name: "eth1", Subnet: "other", NodeIP: true},
+			}},
+			wantErr: true,
+		},
+		{name: "link up node ip", network: &Network{Interfaces: []Interface{{Name: "eth0", Subnet: subnet, Mode: IPModeLinkUp, NodeIP: true}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.network.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrMachineNotFound is returned by Status if no machine is allocated for the
+// request.
+var ErrMachineNotFound = errors.New("machine not found")
+
+// ErrNotSupported is returned by providers which do not implement a call,
+// e.g. provider plugins cannot upload images.
+var ErrNotSupported = errors.New("not supported by the machine provider")
+
+// MachineProvider allocates, deploys and releases the machines backing
+// CnctMachine objects. Client implements it with the MAAS API, the provider
+// plugins and redfish regions implement it without MAAS.
+type MachineProvider interface {
+	// Create allocates a machine matching the request and deploys it with
+	// the requested distro and userdata.
+	Create(ctx context.Context, request *CreateRequest) (*CreateResponse, error)
+	// Delete releases a previously created machine.
+	Delete(ctx context.Context, request *DeleteRequest) error
+	// Update renames, tags or powers a previously created machine.
+	Update(ctx context.Context, request *UpdateRequest) error
+	// Status returns the current state of a previously created machine.
+	// Deployment happens asynchronously after Create returns so callers
+	// poll Status until the machine is deployed.
+	Status(ctx context.Context, request *StatusRequest) (*Machine, error)
+	// List returns the machines allocated by cma-ssh.
+	List(ctx context.Context) ([]Machine, error)
+	// Available returns the machines which are ready to be allocated.
+	Available(ctx context.Context) ([]Hardware, error)
+	// Hosts returns every machine of the inventory whatever its state.
+	Hosts(ctx context.Context) ([]Host, error)
+	// ListImages returns the boot resources known to the provider.
+	ListImages(ctx context.Context) ([]BootResource, error)
+	// UploadImage uploads an image as a boot resource. A failed upload does
+	// not leave an incomplete new boot resource behind.
+	UploadImage(ctx context.Context, request *UploadImageRequest, progress func(uploaded int64)) (*BootResource, error)
+	// ImageState returns the state of an uploaded boot resource.
+	ImageState(ctx context.Context, id int) (*ImageState, error)
+	// DeleteImage deletes a boot resource.
+	DeleteImage(ctx context.Context, id int) error
+	// Zones returns the names of the availability zones machines can be
+	// allocated in.
+	Zones(ctx context.Context) ([]string, error)
+}
+
+// Machine describes a machine allocated by cma-ssh.
+type Machine struct {
+	// ProviderID is the unique value passed in CreateRequest.
+	ProviderID string
+	// SystemID is the unique value returned in CreateResponse.
+	SystemID string
+	// Hostname is the MAAS hostname of the machine.
+	Hostname string
+	// Status is the MAAS status name of the machine, e.g. "Deployed".
+	Status string
+	// StatusMessage is the last MAAS event of the machine, e.g. "Rebooting"
+	// or the reason of a failed deployment.
+	StatusMessage string
+	// IPAddresses is a list of IP addresses assigned to the machine.
+	IPAddresses []string
+	// Interfaces are the addresses of each interface of the machine.
+	Interfaces []InterfaceAddresses
+	// Zone is the name of the availability zone of the machine.
+	Zone string
+}
+
+// InterfaceAddresses are the IP addresses of an interface of a machine.
+type InterfaceAddresses struct {
+	// Name is the MAAS name of the interface, e.g. "bond0.100".
+	Name string
+	// IPAddresses are the addresses of the interface.
+	IPAddresses []string
+}
+
+// InterfaceAddress returns the first address of the named interface or the
+// empty string if the interface has no address.
+func (m *Machine) InterfaceAddress(name string) string {
+	for _, i := range m.Interfaces {
+		if i.Name == name && len(i.IPAddresses) > 0 {
+			return i.IPAddresses[0]
+		}
+	}
+	return ""
+}
+
+// BootResourceUploaded is the type of the boot resources uploaded to MAAS, as
+// opposed to the ones synced from an image stream.
+const BootResourceUploaded = "Uploaded"
+
+// BootResource describes an image that machines can be deployed with.
+type BootResource struct {
+	// ID of the boot resource in MAAS
+	ID int
+	// Name is the name of the image, e.g. "os=ubuntu-xenial,k8s=1.13.5,standard".
+	Name string
+	// Type is the origin of the image. Images built for cma-ssh are
+	// BootResourceUploaded.
+	Type string
+	// Architecture is the architecture the image was built for, e.g.
+	// "amd64/generic".
+	Architecture string
+	// Uploaded is the day the newest version of an uploaded image was
+	// uploaded, zero for other images.
+	Uploaded time.Time
+}
+
+var _ MachineProvider = Client{}
@@ -0,0 +1,273 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+)
+
+// ProviderCloseDelay is how long a replaced provider is kept open. Reconciles
+// which got it before it was replaced finish their calls in the meantime.
+const ProviderCloseDelay = 5 * time.Minute
+
+// ProviderRetryDelay is how long the error of a provider which could not be
+// created is returned before creating it is tried again, unless the region
+// changes.
+const ProviderRetryDelay = 30 * time.Second
+
+// Regions returns the MachineProvider of a MAAS region. The empty region name
+// is the default region of the operator.
+type Regions interface {
+	// Region returns the provider of the named region.
+	Region(ctx context.Context, name string) (MachineProvider, error)
+	// Names returns the names of all regions.
+	Names(ctx context.Context) ([]string, error)
+}
+
+// SingleRegion serves Provider as the default region and has no other
+// regions.
+type SingleRegion struct {
+	Provider MachineProvider
+}
+
+var _ Regions = SingleRegion{}
+
+// Region returns Provider for the default region.
+func (r SingleRegion) Region(ctx context.Context, name string) (MachineProvider, error) {
+	if name != "" {
+		return nil, fmt.Errorf("maas region %s not found", name)
+	}
+	return r.Provider, nil
+}
+
+// Names returns the default region.
+func (r SingleRegion) Names(ctx context.Context) ([]string, error) {
+	return []string{""}, nil
+}
+
+// NewProviderFunc returns the provider of a MAAS region API.
+type NewProviderFunc func(params *NewClientParams) (MachineProvider, error)
+
+// NewPluginFunc returns the provider of a region served by the provider plugin
+// listening on address.
+type NewPluginFunc func(address string) (MachineProvider, error)
+
+// NewRedfishFunc returns the provider of a region whose CnctRedfishHost servers
+// are driven through their BMC.
+type NewRedfishFunc func(name string, region *clusterv1alpha1.RedfishRegion) (MachineProvider, error)
+
+// Registry serves the default region and the regions defined by
+// CnctMaasRegion objects. A provider is created for every region when it is
+// first used and replaced when the region or its credentials secret change.
+// Providers are created without holding up the lookups of other regions,
+// since creating a MAAS client calls MAAS.
+type Registry struct {
+	// NewPlugin creates the providers of the regions served by a provider
+	// plugin. Plugin regions cannot be used if it is nil.
+	NewPlugin NewPluginFunc
+	// NewRedfish creates the providers of the redfish regions. Redfish
+	// regions cannot be used if it is nil.
+	NewRedfish NewRedfishFunc
+
+	client          client.Reader
+	defaultProvider MachineProvider
+	newProvider     NewProviderFunc
+
+	// closeDelay is how long a replaced provider is kept open.
+	closeDelay time.Duration
+	// retryDelay is how long a failure to create a provider is kept.
+	retryDelay time.Duration
+	now        func() time.Time
+
+	mu        sync.Mutex
+	providers map[string]regionProvider
+	failures  map[string]regionFailure
+}
+
+type regionProvider struct {
+	MachineProvider
+	// version is the resource version of the region and its secret the
+	// provider was created from.
+	version string
+}
+
+// regionFailure is the error creating the provider of a region version
+// returned until retryAt.
+type regionFailure struct {
+	version string
+	err     error
+	retryAt time.Time
+}
+
+var _ Regions = &Registry{}
+
+// NewRegistry returns a Registry reading regions with k8sClient. The default
+// region is served by defaultProvider, if it is nil only the CnctMaasRegion
+// regions can be used.
+func NewRegistry(k8sClient client.Reader, defaultProvider MachineProvider, newProvider NewProviderFunc) *Registry {
+	return &Registry{
+		client:          k8sClient,
+		defaultProvider: defaultProvider,
+		newProvider:     newProvider,
+		closeDelay:      ProviderCloseDelay,
+		retryDelay:      ProviderRetryDelay,
+		now:             time.Now,
+		providers:       map[string]regionProvider{},
+		failures:        map[string]regionFailure{},
+	}
+}
+
+// Region returns the provider of the named region.
+func (r *Registry) Region(ctx context.Context, name string) (MachineProvider, error) {
+	if name == "" {
+		if r.defaultProvider == nil {
+			return nil, errors.New("no default maas region is configured")
+		}
+		return r.defaultProvider, nil
+	}
+
+	var region clusterv1alpha1.CnctMaasRegion
+	if err := r.client.Get(ctx, client.ObjectKey{Name: name}, &region); err != nil {
+		return nil, errors.Wrapf(err, "could not get maas region %s", name)
+	}
+	if region.Spec.Redfish != nil {
+		return r.redfishRegion(&region)
+	}
+	if region.Spec.Plugin != nil {
+		return r.pluginRegion(&region)
+	}
+	ref := region.Spec.CredentialsSecret
+	var secret corev1.Secret
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, &secret); err != nil {
+		return nil, errors.Wrapf(err, "could not get credentials of maas region %s", name)
+	}
+	version := region.ResourceVersion + "/" + secret.ResourceVersion
+
+	key := ref.Key
+	if key == "" {
+		key = clusterv1alpha1.DefaultMaasAPIKeySecretKey
+	}
+	apiKey, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("credentials secret %s/%s of maas region %s has no %s", ref.Namespace, ref.Name, name, key)
+	}
+	return r.provider(name, version, func() (MachineProvider, error) {
+		provider, err := r.newProvider(&NewClientParams{
+			ApiURL:     region.Spec.APIURL,
+			ApiVersion: region.Spec.APIVersion,
+			ApiKey:     string(apiKey),
+		})
+		return provider, errors.Wrapf(err, "could not create client of maas region %s", name)
+	})
+}
+
+// pluginRegion returns the provider of a region served by a provider plugin.
+func (r *Registry) pluginRegion(region *clusterv1alpha1.CnctMaasRegion) (MachineProvider, error) {
+	if r.NewPlugin == nil {
+		return nil, fmt.Errorf("maas region %s is served by a provider plugin, which are not enabled", region.Name)
+	}
+	if region.Spec.Plugin.Address == "" {
+		return nil, fmt.Errorf("maas region %s has no provider plugin address", region.Name)
+	}
+	return r.provider(region.Name, region.ResourceVersion, func() (MachineProvider, error) {
+		provider, err := r.NewPlugin(region.Spec.Plugin.Address)
+		return provider, errors.Wrapf(err, "could not create client of maas region %s", region.Name)
+	})
+}
+
+// redfishRegion returns the provider of a region of CnctRedfishHost servers.
+func (r *Registry) redfishRegion(region *clusterv1alpha1.CnctMaasRegion) (MachineProvider, error) {
+	if r.NewRedfish == nil {
+		return nil, fmt.Errorf("maas region %s is a redfish region, which are not enabled", region.Name)
+	}
+	return r.provider(region.Name, region.ResourceVersion, func() (MachineProvider, error) {
+		provider, err := r.NewRedfish(region.Name, region.Spec.Redfish)
+		return provider, errors.Wrapf(err, "could not create provider of redfish region %s", region.Name)
+	})
+}
+
+// provider returns the provider of the named region created from version,
+// creating it if there is none. r.mu is not held while it is created. When
+// creating it fails the error is returned for retryDelay without trying
+// again. A provider created meanwhile by a concurrent call is kept.
+func (r *Registry) provider(name, version string, create func() (MachineProvider, error)) (MachineProvider, error) {
+	r.mu.Lock()
+	if p, ok := r.providers[name]; ok && p.version == version {
+		r.mu.Unlock()
+		return p.MachineProvider, nil
+	}
+	if f, ok := r.failures[name]; ok && f.version == version && r.now().Before(f.retryAt) {
+		r.mu.Unlock()
+		return nil, f.err
+	}
+	r.mu.Unlock()
+
+	provider, err := create()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.failures[name] = regionFailure{version: version, err: err, retryAt: r.now().Add(r.retryDelay)}
+		return nil, err
+	}
+	delete(r.failures, name)
+	if p, ok := r.providers[name]; ok && p.version == version {
+		if closer, ok := provider.(io.Closer); ok {
+			closer.Close()
+		}
+		return p.MachineProvider, nil
+	}
+	r.replace(name, regionProvider{MachineProvider: provider, version: version})
+	return provider, nil
+}
+
+// replace sets the provider of a region. The previous provider is closed
+// after closeDelay if it holds a connection, e.g. to a provider plugin, since
+// callers may still be using it. r.mu must be held.
+func (r *Registry) replace(name string, provider regionProvider) {
+	if old, ok := r.providers[name].MachineProvider.(io.Closer); ok {
+		time.AfterFunc(r.closeDelay, func() { old.Close() })
+	}
+	r.providers[name] = provider
+}
+
+// Names returns the names of the CnctMaasRegion regions, preceded by the
+// default region if there is one.
+func (r *Registry) Names(ctx context.Context) ([]string, error) {
+	var regions clusterv1alpha1.CnctMaasRegionList
+	if err := r.client.List(ctx, &client.ListOptions{}, &regions); err != nil {
+		return nil, errors.Wrap(err, "could not list maas regions")
+	}
+	var names []string
+	if r.defaultProvider != nil {
+		names = append(names, "")
+	}
+	for _, region := range regions.Items {
+		names = append(names, region.Name)
+	}
+	return names, nil
+}
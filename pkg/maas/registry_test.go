@@ -0,0 +1,303 @@
+package maas
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/util/fakeclient"
+)
+
+func testRegistry(t *testing.T, defaultProvider MachineProvider) (*Registry, client.Client, *[]*NewClientParams) {
+	k8sClient := fakeclient.New(
+		&clusterv1alpha1.CnctMaasRegion{
+			ObjectMeta: metav1.ObjectMeta{Name: "region-2", ResourceVersion: "1"},
+			Spec: clusterv1alpha1.MaasRegionSpec{
+				APIURL:            "http://region-2/MAAS",
+				APIVersion:        "2.0",
+				CredentialsSecret: clusterv1alpha1.MaasCredentialsSecret{Name: "region-2", Namespace: "cma-ssh"},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "region-2", Namespace: "cma-ssh", ResourceVersion: "1"},
+			Data:       map[string][]byte{"apiKey": []byte("a:b:c")},
+		},
+	)
+	var created []*NewClientParams
+	r := NewRegistry(k8sClient, defaultProvider, func(params *NewClientParams) (MachineProvider, error) {
+		created = append(created, params)
+		return &stubProvider{}, nil
+	})
+	return r, k8sClient, &created
+}
+
+func TestRegistry_Region(t *testing.T) {
+	defaultProvider := &stubProvider{}
+	r, k8sClient, created := testRegistry(t, defaultProvider)
+
+	if p, err := r.Region(context.Background(), ""); err != nil || p != defaultProvider {
+		t.Errorf("Region(\"\") = %v, %v, want the default provider", p, err)
+	}
+	if _, err := r.Region(context.Background(), "missing"); err == nil {
+		t.Errorf("Region(missing) expected an error")
+	}
+
+	first, err := r.Region(context.Background(), "region-2")
+	if err != nil {
+		t.Fatalf("Region(region-2) error = %v", err)
+	}
+	if len(*created) != 1 || (*created)[0].ApiURL != "http://region-2/MAAS" || (*created)[0].ApiKey != "a:b:c" {
+		t.Fatalf("created clients = %+v", *created)
+	}
+	if p, _ := r.Region(context.Background(), "region-2"); p != first || len(*created) != 1 {
+		t.Errorf("Region(region-2) did not reuse the client")
+	}
+
+	var secret corev1.Secret
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "cma-ssh", Name: "region-2"}, &secret); err != nil {
+		t.Fatal(err)
+	}
+	secret.Data["apiKey"] = []byte("d:e:f")
+	secret.ResourceVersion = "2"
+	if err := k8sClient.Update(context.Background(), &secret); err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := r.Region(context.Background(), "region-2"); p == first || len(*created) != 2 || (*created)[1].ApiKey != "d:e:f" {
+		t.Errorf("Region(region-2) did not recreate the client after the secret changed")
+	}
+}
+
+func TestRegistry_Names(t *testing.T) {
+	tests := []struct {
+		name            string
+		defaultProvider MachineProvider
+		want            []string
+	}{
+		{name: "default", defaultProvider: &stubProvider{}, want: []string{"", "region-2"}},
+		{name: "no default", want: []string{"region-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _, _ := testRegistry(t, tt.defaultProvider)
+			got, err := r.Names(context.Background())
+			if err != nil {
+				t.Fatalf("Names() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Names() = %q, want %q", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Names() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+type closingProvider struct {
+	stubProvider
+	closed chan struct{}
+}
+
+func (p *closingProvider) Close() error {
+	close(p.closed)
+	return nil
+}
+
+func TestRegistry_pluginRegion(t *testing.T) {
+	r, k8sClient, _ := testRegistry(t, nil)
+	region := &clusterv1alpha1.CnctMaasRegion{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory", ResourceVersion: "1"},
+		Spec: clusterv1alpha1.MaasRegionSpec{
+			Plugin: &clusterv1alpha1.ProviderPlugin{Address: "unix:///run/inventory.sock"},
+		},
+	}
+	if err := k8sClient.Create(context.Background(), region); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Region(context.Background(), "inventory"); err == nil {
+		t.Errorf("Region(inventory) without plugin support expected an error")
+	}
+
+	var created []*closingProvider
+	var addresses []string
+	r.NewPlugin = func(address string) (MachineProvider, error) {
+		p := &closingProvider{closed: make(chan struct{})}
+		created = append(created, p)
+		addresses = append(addresses, address)
+		return p, nil
+	}
+	first, err := r.Region(context.Background(), "inventory")
+	if err != nil {
+		t.Fatalf("Region(inventory) error = %v", err)
+	}
+	if len(addresses) != 1 || addresses[0] != "unix:///run/inventory.sock" {
+		t.Fatalf("created plugin clients = %q", addresses)
+	}
+	if p, _ := r.Region(context.Background(), "inventory"); p != first || len(created) != 1 {
+		t.Errorf("Region(inventory) did not reuse the client")
+	}
+
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Name: "inventory"}, region); err != nil {
+		t.Fatal(err)
+	}
+	region.Spec.Plugin.Address = "inventory:9000"
+	region.ResourceVersion = "2"
+	if err := k8sClient.Update(context.Background(), region); err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := r.Region(context.Background(), "inventory"); p == first || len(created) != 2 || addresses[1] != "inventory:9000" {
+		t.Errorf("Region(inventory) did not recreate the client after the region changed")
+	}
+	select {
+	case <-created[0].closed:
+		t.Errorf("the replaced plugin client was closed while it may still be in use")
+	default:
+	}
+
+	r.closeDelay = time.Millisecond
+	region.Spec.Plugin.Address = "inventory:9001"
+	region.ResourceVersion = "3"
+	if err := k8sClient.Update(context.Background(), region); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Region(context.Background(), "inventory"); err != nil || len(created) != 3 {
+		t.Fatalf("Region(inventory) error = %v, created = %d", err, len(created))
+	}
+	select {
+	case <-created[1].closed:
+	case <-time.After(time.Second):
+		t.Errorf("the replaced plugin client was not closed after the delay")
+	}
+}
+
+func TestRegistry_redfishRegion(t *testing.T) {
+	r, k8sClient, _ := testRegistry(t, nil)
+	region := &clusterv1alpha1.CnctMaasRegion{
+		ObjectMeta: metav1.ObjectMeta{Name: "lab", ResourceVersion: "1"},
+		Spec: clusterv1alpha1.MaasRegionSpec{
+			Redfish: &clusterv1alpha1.RedfishRegion{
+				Images: []clusterv1alpha1.RedfishImage{{Name: "ubuntu", URL: "http://images/ubuntu.iso"}},
+			},
+		},
+	}
+	if err := k8sClient.Create(context.Background(), region); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Region(context.Background(), "lab"); err == nil {
+		t.Errorf("Region(lab) without redfish support expected an error")
+	}
+
+	var names []string
+	r.NewRedfish = func(name string, spec *clusterv1alpha1.RedfishRegion) (MachineProvider, error) {
+		names = append(names, name)
+		return &closingProvider{}, nil
+	}
+	first, err := r.Region(context.Background(), "lab")
+	if err != nil {
+		t.Fatalf("Region(lab) error = %v", err)
+	}
+	if p, _ := r.Region(context.Background(), "lab"); p != first || len(names) != 1 || names[0] != "lab" {
+		t.Errorf("Region(lab) did not reuse the provider, created %q", names)
+	}
+}
+
+func TestRegistry_Region_failed(t *testing.T) {
+	r, k8sClient, _ := testRegistry(t, nil)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	calls := 0
+	r.newProvider = func(params *NewClientParams) (MachineProvider, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("maas is unreachable")
+		}
+		return &stubProvider{}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := r.Region(context.Background(), "region-2"); err == nil {
+			t.Fatalf("Region(region-2) expected an error")
+		}
+	}
+	if calls != 1 {
+		t.Errorf("failed client created %d times within the retry delay, want once", calls)
+	}
+
+	now = now.Add(ProviderRetryDelay)
+	if _, err := r.Region(context.Background(), "region-2"); err != nil || calls != 2 {
+		t.Errorf("Region(region-2) after the retry delay = %v, created %d times", err, calls)
+	}
+
+	// a change of the region is tried right away
+	calls = 0
+	var region clusterv1alpha1.CnctMaasRegion
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Name: "region-2"}, &region); err != nil {
+		t.Fatal(err)
+	}
+	region.ResourceVersion = "2"
+	if err := k8sClient.Update(context.Background(), &region); err != nil {
+		t.Fatal(err)
+	}
+	r.Region(context.Background(), "region-2")
+	region.ResourceVersion = "3"
+	if err := k8sClient.Update(context.Background(), &region); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Region(context.Background(), "region-2"); err != nil || calls != 2 {
+		t.Errorf("Region(region-2) after the region changed = %v, created %d times", err, calls)
+	}
+}
+
+func TestRegistry_Region_slow(t *testing.T) {
+	r, k8sClient, _ := testRegistry(t, nil)
+	creating, unblock := make(chan struct{}), make(chan struct{})
+	r.newProvider = func(params *NewClientParams) (MachineProvider, error) {
+		close(creating)
+		<-unblock
+		return &stubProvider{}, nil
+	}
+	r.NewPlugin = func(address string) (MachineProvider, error) {
+		return &stubProvider{}, nil
+	}
+	region := &clusterv1alpha1.CnctMaasRegion{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory", ResourceVersion: "1"},
+		Spec: clusterv1alpha1.MaasRegionSpec{
+			Plugin: &clusterv1alpha1.ProviderPlugin{Address: "unix:///run/inventory.sock"},
+		},
+	}
+	if err := k8sClient.Create(context.Background(), region); err != nil {
+		t.Fatal(err)
+	}
+
+	slow := make(chan error)
+	go func() {
+		_, err := r.Region(context.Background(), "region-2")
+		slow <- err
+	}()
+	<-creating
+	done := make(chan error)
+	go func() {
+		_, err := r.Region(context.Background(), "inventory")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Region(inventory) error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Region(inventory) waited for the client of region-2")
+	}
+	close(unblock)
+	if err := <-slow; err != nil {
+		t.Errorf("Region(region-2) error = %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrNoCredentials is returned by a ReloadingProvider which has not been given
+// a provider yet, because the MAAS credentials are missing or invalid.
+var ErrNoCredentials = errors.New("no valid maas credentials are loaded")
+
+// ReloadingProvider forwards calls to a provider which is replaced when the
+// MAAS credentials change. Calls in flight when the provider is swapped finish
+// with the provider they started with. The zero value has no provider.
+type ReloadingProvider struct {
+	mu       sync.RWMutex
+	provider MachineProvider
+}
+
+var _ MachineProvider = &ReloadingProvider{}
+
+// Swap replaces the provider calls are forwarded to.
+func (p *ReloadingProvider) Swap(provider MachineProvider) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.provider = provider
+}
+
+func (p *ReloadingProvider) current() (MachineProvider, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.provider == nil {
+		return nil, ErrNoCredentials
+	}
+	return p.provider, nil
+}
+
+// Create allocates and deploys a machine.
+func (p *ReloadingProvider) Create(ctx context.Context, request *CreateRequest) (*CreateResponse, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.Create(ctx, request)
+}
+
+// Delete releases a machine.
+func (p *ReloadingProvider) Delete(ctx context.Context, request *DeleteRequest) error {
+	provider, err := p.current()
+	if err != nil {
+		return err
+	}
+	return provider.Delete(ctx, request)
+}
+
+// Update renames, tags or powers a machine.
+func (p *ReloadingProvider) Update(ctx context.Context, request *UpdateRequest) error {
+	provider, err := p.current()
+	if err != nil {
+		return err
+	}
+	return provider.Update(ctx, request)
+}
+
+// Status returns the state of a machine.
+func (p *ReloadingProvider) Status(ctx context.Context, request *StatusRequest) (*Machine, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.Status(ctx, request)
+}
+
+// List returns the machines allocated by cma-ssh.
+func (p *ReloadingProvider) List(ctx context.Context) ([]Machine, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.List(ctx)
+}
+
+// Available returns the machines which are ready to be allocated.
+func (p *ReloadingProvider) Available(ctx context.Context) ([]Hardware, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.Available(ctx)
+}
+
+// Hosts returns every machine of the inventory.
+func (p *ReloadingProvider) Hosts(ctx context.Context) ([]Host, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.Hosts(ctx)
+}
+
+// ListImages returns the boot resources.
+func (p *ReloadingProvider) ListImages(ctx context.Context) ([]BootResource, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.ListImages(ctx)
+}
+
+// UploadImage uploads an image as a boot resource.
+func (p *ReloadingProvider) UploadImage(ctx context.Context, request *UploadImageRequest, progress func(uploaded int64)) (*BootResource, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.UploadImage(ctx, request, progress)
+}
+
+// ImageState returns the state of an uploaded boot resource.
+func (p *ReloadingProvider) ImageState(ctx context.Context, id int) (*ImageState, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.ImageState(ctx, id)
+}
+
+// DeleteImage deletes a boot resource.
+func (p *ReloadingProvider) DeleteImage(ctx context.Context, id int) error {
+	provider, err := p.current()
+	if err != nil {
+		return err
+	}
+	return provider.DeleteImage(ctx, id)
+}
+
+// Zones returns the names of the availability zones.
+func (p *ReloadingProvider) Zones(ctx context.Context) ([]string, error) {
+	provider, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	return provider.Zones(ctx)
+}
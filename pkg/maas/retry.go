@@ -0,0 +1,449 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog"
+)
+
+// RetryOptions configure how RetryProvider calls MAAS.
+type RetryOptions struct {
+	// Timeout is the deadline of a single call to MAAS.
+	Timeout time.Duration
+	// Retries is how many times a call failing with a transient error is
+	// retried.
+	Retries int
+	// InitialBackoff is the delay before the first retry. The delay
+	// doubles with every retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between retries.
+	MaxBackoff time.Duration
+	// QPS is the rate at which calls are made to MAAS on average.
+	QPS float64
+	// Burst is the number of calls which can be made to MAAS at once.
+	Burst int
+	// MaxConcurrent is the number of calls which can be in progress at
+	// once. Later calls wait for one of them to finish.
+	MaxConcurrent int
+	// ImageCacheTTL is how long the boot resources returned by ListImages
+	// are cached. Zero disables the cache.
+	ImageCacheTTL time.Duration
+}
+
+// DefaultRetryOptions are used for the fields of RetryOptions which are not
+// set.
+var DefaultRetryOptions = RetryOptions{
+	Timeout:        time.Minute,
+	Retries:        4,
+	InitialBackoff: time.Second,
+	MaxBackoff:     30 * time.Second,
+	QPS:            5,
+	Burst:          10,
+	MaxConcurrent:  8,
+	ImageCacheTTL:  time.Minute,
+}
+
+func (o RetryOptions) withDefaults() RetryOptions {
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultRetryOptions.Timeout
+	}
+	if o.Retries < 0 {
+		o.Retries = 0
+	}
+	if o.InitialBackoff <= 0 {
+		o.InitialBackoff = DefaultRetryOptions.InitialBackoff
+	}
+	if o.MaxBackoff < o.InitialBackoff {
+		o.MaxBackoff = o.InitialBackoff
+	}
+	if o.QPS <= 0 {
+		o.QPS = DefaultRetryOptions.QPS
+	}
+	if o.Burst <= 0 {
+		o.Burst = DefaultRetryOptions.Burst
+	}
+	if o.MaxConcurrent <= 0 {
+		o.MaxConcurrent = DefaultRetryOptions.MaxConcurrent
+	}
+	return o
+}
+
+// RetryProvider wraps a MachineProvider so that every call is rate limited,
+// has a deadline and is retried with exponential backoff when MAAS fails
+// with a transient error. Calls which change MAAS are only retried when the
+// request never reached it, and power actions are never retried. The boot
+// resources are cached since they are needed for every machine created.
+type RetryProvider struct {
+	provider MachineProvider
+	options  RetryOptions
+	limiter  *rate.Limiter
+	// slots holds a value for every call in progress
+	slots chan struct{}
+
+	mu           sync.Mutex
+	images       []BootResource
+	imagesExpire time.Time
+	// imagesGeneration is incremented when the cache is invalidated so that
+	// a listing started before is not cached.
+	imagesGeneration int
+}
+
+var _ MachineProvider = &RetryProvider{}
+
+// NewRetryProvider returns a RetryProvider calling provider.
+func NewRetryProvider(provider MachineProvider, options RetryOptions) *RetryProvider {
+	options = options.withDefaults()
+	return &RetryProvider{
+		provider: provider,
+		options:  options,
+		limiter:  rate.NewLimiter(rate.Limit(options.QPS), options.Burst),
+		slots:    make(chan struct{}, options.MaxConcurrent),
+	}
+}
+
+// errTimeout is returned when a call to MAAS does not finish within
+// RetryOptions.Timeout. The call may still succeed in MAAS so it is not
+// retried.
+type errTimeout struct {
+	op string
+}
+
+func (e errTimeout) Error() string {
+	return fmt.Sprintf("maas %s timed out", e.op)
+}
+
+// transient reports whether err is a failure to reach MAAS or a provider
+// plugin, or a response telling the caller to come back later. MAAS may
+// still have carried out the request, so only calls which do not change
+// anything are retried on it.
+func transient(err error) bool {
+	if svrErr, ok := serverError(err); ok {
+		switch svrErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	for ; err != nil; err = nextError(err) {
+		if _, ok := err.(net.Error); ok {
+			return true
+		}
+		// errors of provider plugins are wrapped grpc statuses
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.Unavailable, codes.ResourceExhausted:
+				return true
+			}
+			return false
+		}
+	}
+	return false
+}
+
+// unsent reports whether err tells that the request was turned away before
+// MAAS or a provider plugin acted on it: the connection could not be made or
+// the caller was told to slow down. Calls which change MAAS can be retried
+// on it.
+func unsent(err error) bool {
+	if svrErr, ok := serverError(err); ok {
+		return svrErr.StatusCode == http.StatusTooManyRequests
+	}
+	for ; err != nil; err = nextError(err) {
+		if opErr, ok := err.(*net.OpError); ok {
+			return opErr.Op == "dial"
+		}
+		if s, ok := status.FromError(err); ok {
+			return s.Code() == codes.ResourceExhausted
+		}
+	}
+	return false
+}
+
+// never is the retry policy of calls which must be made at most once.
+func never(error) bool {
+	return false
+}
+
+// call runs fn until it succeeds, fails with an error retry does not accept
+// or the retries are used up, and returns the result of the last attempt.
+func (p *RetryProvider) call(ctx context.Context, op string, retry func(error) bool, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	backoff := p.options.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		if err := p.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		result, err := p.attempt(ctx, op, fn)
+		if err == nil || !retry(err) || attempt >= p.options.Retries {
+			return result, err
+		}
+
+		// full jitter keeps many reconcilers from retrying in step
+		delay := time.Duration(rand.Int63n(int64(backoff))) + 1
+		klog.V(2).Infof("maas %s failed, retrying in %s: %v", op, delay, err)
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+		if backoff *= 2; backoff > p.options.MaxBackoff {
+			backoff = p.options.MaxBackoff
+		}
+	}
+}
+
+type result struct {
+	value interface{}
+	err   error
+}
+
+// attempt runs fn with the call deadline once a slot is free. Plugins are
+// cancelled through the context but the MAAS client does not take one, so
+// fn is abandoned when the deadline passes. An abandoned call keeps its slot
+// until it returns so that calls stuck in MAAS can not pile up.
+func (p *RetryProvider) attempt(ctx context.Context, op string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	if err := p.acquire(ctx); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.options.Timeout)
+	defer cancel()
+	done := make(chan result, 1)
+	go func() {
+		defer p.release()
+		value, err := fn(ctx)
+		done <- result{value, err}
+	}()
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return nil, errTimeout{op: op}
+	}
+}
+
+// once runs fn once when the rate limit and a free slot allow it, without
+// deadline or retries.
+func (p *RetryProvider) once(ctx context.Context, fn func() error) error {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	if err := p.acquire(ctx); err != nil {
+		return err
+	}
+	defer p.release()
+	return fn()
+}
+
+// acquire waits for a free slot for a call.
+func (p *RetryProvider) acquire(ctx context.Context) error {
+	select {
+	case p.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees the slot of a finished call.
+func (p *RetryProvider) release() {
+	<-p.slots
+}
+
+// Create allocates and deploys a machine. It is retried only when MAAS was
+// not reached, a later Create adopts the machine allocated by an earlier one
+// for the same ProviderID.
+func (p *RetryProvider) Create(ctx context.Context, request *CreateRequest) (*CreateResponse, error) {
+	response, err := p.call(ctx, "create", unsent, func(ctx context.Context) (interface{}, error) {
+		return p.provider.Create(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.(*CreateResponse), nil
+}
+
+// Delete releases a machine.
+func (p *RetryProvider) Delete(ctx context.Context, request *DeleteRequest) error {
+	_, err := p.call(ctx, "delete", unsent, func(ctx context.Context) (interface{}, error) {
+		return nil, p.provider.Delete(ctx, request)
+	})
+	return err
+}
+
+// Update renames, tags or powers a machine. Power actions are never
+// retried. A power cycle waits for the machine to be off before powering it
+// on again, which can take longer than the call deadline, so it is made
+// without one. Cutting it short would leave the machine off.
+func (p *RetryProvider) Update(ctx context.Context, request *UpdateRequest) error {
+	if request.Power == PowerCycle {
+		return p.once(ctx, func() error {
+			return p.provider.Update(ctx, request)
+		})
+	}
+	retry := unsent
+	if request.Power != "" {
+		retry = never
+	}
+	_, err := p.call(ctx, "update", retry, func(ctx context.Context) (interface{}, error) {
+		return nil, p.provider.Update(ctx, request)
+	})
+	return err
+}
+
+// Status returns the state of a machine.
+func (p *RetryProvider) Status(ctx context.Context, request *StatusRequest) (*Machine, error) {
+	machine, err := p.call(ctx, "status", transient, func(ctx context.Context) (interface{}, error) {
+		return p.provider.Status(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return machine.(*Machine), nil
+}
+
+// List returns the machines allocated by cma-ssh.
+func (p *RetryProvider) List(ctx context.Context) ([]Machine, error) {
+	machines, err := p.call(ctx, "list", transient, func(ctx context.Context) (interface{}, error) {
+		return p.provider.List(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return machines.([]Machine), nil
+}
+
+// Available returns the machines which are ready to be allocated.
+func (p *RetryProvider) Available(ctx context.Context) ([]Hardware, error) {
+	available, err := p.call(ctx, "available", transient, func(ctx context.Context) (interface{}, error) {
+		return p.provider.Available(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return available.([]Hardware), nil
+}
+
+// Hosts returns every machine of the inventory.
+func (p *RetryProvider) Hosts(ctx context.Context) ([]Host, error) {
+	hosts, err := p.call(ctx, "hosts", transient, func(ctx context.Context) (interface{}, error) {
+		return p.provider.Hosts(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hosts.([]Host), nil
+}
+
+// ListImages returns the boot resources, from the cache if they were listed
+// less than ImageCacheTTL ago.
+func (p *RetryProvider) ListImages(ctx context.Context) ([]BootResource, error) {
+	p.mu.Lock()
+	if p.images != nil && time.Now().Before(p.imagesExpire) {
+		images := append([]BootResource(nil), p.images...)
+		p.mu.Unlock()
+		return images, nil
+	}
+	generation := p.imagesGeneration
+	p.mu.Unlock()
+
+	value, err := p.call(ctx, "list images", transient, func(ctx context.Context) (interface{}, error) {
+		return p.provider.ListImages(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	images := value.([]BootResource)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.options.ImageCacheTTL > 0 && generation == p.imagesGeneration {
+		p.images = append([]BootResource{}, images...)
+		p.imagesExpire = time.Now().Add(p.options.ImageCacheTTL)
+	}
+	return images, nil
+}
+
+// UploadImage uploads an image. The upload is neither retried nor bound by
+// the call deadline since the content can only be read once and takes as
+// long as its size requires. The boot resources are listed again afterwards.
+func (p *RetryProvider) UploadImage(ctx context.Context, request *UploadImageRequest, progress func(uploaded int64)) (*BootResource, error) {
+	var resource *BootResource
+	err := p.once(ctx, func() error {
+		var err error
+		resource, err = p.provider.UploadImage(ctx, request, progress)
+		return err
+	})
+	p.invalidateImages()
+	return resource, err
+}
+
+// ImageState returns the state of an uploaded boot resource.
+func (p *RetryProvider) ImageState(ctx context.Context, id int) (*ImageState, error) {
+	state, err := p.call(ctx, "image state", transient, func(ctx context.Context) (interface{}, error) {
+		return p.provider.ImageState(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return state.(*ImageState), nil
+}
+
+// DeleteImage deletes a boot resource.
+func (p *RetryProvider) DeleteImage(ctx context.Context, id int) error {
+	_, err := p.call(ctx, "delete image", unsent, func(ctx context.Context) (interface{}, error) {
+		return nil, p.provider.DeleteImage(ctx, id)
+	})
+	p.invalidateImages()
+	return err
+}
+
+// invalidateImages drops the cached boot resources.
+func (p *RetryProvider) invalidateImages() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.images = nil
+	p.imagesGeneration++
+}
+
+// Zones returns the names of the availability zones.
+func (p *RetryProvider) Zones(ctx context.Context) ([]string, error) {
+	zones, err := p.call(ctx, "zones", transient, func(ctx context.Context) (interface{}, error) {
+		return p.provider.Zones(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return zones.([]string), nil
+}
+
+// Close closes the wrapped provider if it holds a connection.
+func (p *RetryProvider) Close() error {
+	if closer, ok := p.provider.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
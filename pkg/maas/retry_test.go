@@ -0,0 +1,229 @@
+package maas
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/gomaasapi"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubProvider fails the first failures calls to Zones, Delete and Update
+// with err.
+type stubProvider struct {
+	MachineProvider
+	err      error
+	failures int
+	calls    int
+	delay    time.Duration
+}
+
+func (p *stubProvider) Zones(ctx context.Context) ([]string, error) {
+	p.calls++
+	time.Sleep(p.delay)
+	if p.calls <= p.failures {
+		return nil, p.err
+	}
+	return []string{"default"}, nil
+}
+
+func (p *stubProvider) Delete(ctx context.Context, request *DeleteRequest) error {
+	p.calls++
+	if p.calls <= p.failures {
+		return p.err
+	}
+	return nil
+}
+
+func (p *stubProvider) Update(ctx context.Context, request *UpdateRequest) error {
+	p.calls++
+	if p.calls <= p.failures {
+		return p.err
+	}
+	return nil
+}
+
+func (p *stubProvider) ListImages(ctx context.Context) ([]BootResource, error) {
+	p.calls++
+	return []BootResource{{Name: "ubuntu"}}, nil
+}
+
+func testRetryOptions() RetryOptions {
+	return RetryOptions{
+		Timeout:        time.Second,
+		Retries:        2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		QPS:            1000,
+		Burst:          1000,
+		ImageCacheTTL:  time.Minute,
+	}
+}
+
+func TestRetryProvider_call(t *testing.T) {
+	unavailable := gomaasapi.ServerError{StatusCode: http.StatusServiceUnavailable}
+	tests := []struct {
+		name      string
+		err       error
+		failures  int
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "success", wantCalls: 1},
+		{name: "transient", err: unavailable, failures: 2, wantCalls: 3},
+		{name: "retries used up", err: unavailable, failures: 3, wantCalls: 3, wantErr: true},
+		{name: "network", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, failures: 1, wantCalls: 2},
+		// the gomaasapi Controller replaces the cause of its errors
+		{name: "controller", err: gomaasapi.NewUnexpectedError(unavailable), failures: 1, wantCalls: 2},
+		{name: "not transient", err: gomaasapi.ServerError{StatusCode: http.StatusConflict}, failures: 1, wantCalls: 1, wantErr: true},
+		{name: "plugin unavailable", err: errors.Wrap(status.Error(codes.Unavailable, "connection refused"), "provider plugin failed"), failures: 1, wantCalls: 2},
+		{name: "plugin exhausted", err: errors.Wrap(status.Error(codes.ResourceExhausted, "too many calls"), "provider plugin failed"), failures: 1, wantCalls: 2},
+		{name: "plugin invalid", err: errors.Wrap(status.Error(codes.InvalidArgument, "bad request"), "provider plugin failed"), failures: 1, wantCalls: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubProvider{err: tt.err, failures: tt.failures}
+			p := NewRetryProvider(stub, testRetryOptions())
+			_, err := p.Zones(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Zones() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stub.calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", stub.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryProvider_writes(t *testing.T) {
+	unavailable := gomaasapi.ServerError{StatusCode: http.StatusServiceUnavailable}
+	refused := &url.Error{Op: "Post", URL: "http://maas/", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	tests := []struct {
+		name      string
+		call      func(p *RetryProvider) error
+		err       error
+		wantCalls int
+	}{
+		// MAAS may have released the machine before failing
+		{name: "delete unavailable", call: deleteMachine, err: unavailable, wantCalls: 1},
+		{name: "delete timeout", call: deleteMachine, err: &net.OpError{Op: "read", Err: timeoutError{}}, wantCalls: 1},
+		{name: "delete refused", call: deleteMachine, err: gomaasapi.NewUnexpectedError(refused), wantCalls: 2},
+		{name: "delete too many requests", call: deleteMachine, err: gomaasapi.ServerError{StatusCode: http.StatusTooManyRequests}, wantCalls: 2},
+		{name: "delete plugin unavailable", call: deleteMachine, err: status.Error(codes.Unavailable, "connection reset"), wantCalls: 1},
+		{name: "delete plugin exhausted", call: deleteMachine, err: status.Error(codes.ResourceExhausted, "too many calls"), wantCalls: 2},
+		{name: "tag refused", call: func(p *RetryProvider) error {
+			return p.Update(context.Background(), &UpdateRequest{SystemID: "abc", Tags: []string{"pool"}})
+		}, err: refused, wantCalls: 2},
+		{name: "power off refused", call: func(p *RetryProvider) error {
+			return p.Update(context.Background(), &UpdateRequest{SystemID: "abc", Power: PowerOff})
+		}, err: refused, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubProvider{err: tt.err, failures: 1}
+			p := NewRetryProvider(stub, testRetryOptions())
+			tt.call(p)
+			if stub.calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", stub.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func deleteMachine(p *RetryProvider) error {
+	return p.Delete(context.Background(), &DeleteRequest{SystemID: "abc"})
+}
+
+// timeoutError is a net.Error which timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRetryProvider_timeout(t *testing.T) {
+	stub := &stubProvider{delay: 100 * time.Millisecond}
+	options := testRetryOptions()
+	options.Timeout = 10 * time.Millisecond
+	p := NewRetryProvider(stub, options)
+
+	_, err := p.Zones(context.Background())
+	if _, ok := err.(errTimeout); !ok {
+		t.Errorf("Zones() error = %v, want a timeout", err)
+	}
+}
+
+// concurrentProvider records the largest number of calls to Zones in
+// progress at once.
+type concurrentProvider struct {
+	MachineProvider
+	mu      sync.Mutex
+	calls   int
+	maxCall int
+}
+
+func (p *concurrentProvider) Zones(ctx context.Context) ([]string, error) {
+	p.mu.Lock()
+	p.calls++
+	if p.calls > p.maxCall {
+		p.maxCall = p.calls
+	}
+	p.mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	p.mu.Lock()
+	p.calls--
+	p.mu.Unlock()
+	return nil, nil
+}
+
+func TestRetryProvider_maxConcurrent(t *testing.T) {
+	stub := &concurrentProvider{}
+	options := testRetryOptions()
+	options.MaxConcurrent = 2
+	p := NewRetryProvider(stub, options)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := p.Zones(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if stub.maxCall > 2 {
+		t.Errorf("calls in progress at once = %d, want at most 2", stub.maxCall)
+	}
+}
+
+func TestRetryProvider_ListImages(t *testing.T) {
+	stub := &stubProvider{}
+	p := NewRetryProvider(stub, testRetryOptions())
+
+	for i := 0; i < 3; i++ {
+		images, err := p.ListImages(context.Background())
+		if err != nil || len(images) != 1 {
+			t.Fatalf("ListImages() = %v, %v", images, err)
+		}
+	}
+	if stub.calls != 1 {
+		t.Errorf("calls = %d, want boot resources to be cached", stub.calls)
+	}
+
+	p.imagesExpire = time.Now()
+	if _, err := p.ListImages(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if stub.calls != 2 {
+		t.Errorf("calls = %d, want expired boot resources to be listed again", stub.calls)
+	}
+}
@@ -0,0 +1,435 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maas
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/juju/gomaasapi"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// StorageProfile is the layout of the root filesystem.
+type StorageProfile string
+
+const (
+	// StorageFlat puts the root filesystem on a partition of the boot disk.
+	StorageFlat StorageProfile = "flat"
+	// StorageLVM puts the root filesystem on a logical volume of the boot
+	// disk.
+	StorageLVM StorageProfile = "lvm"
+	// StorageBcache puts the root filesystem on the boot disk cached by an
+	// ssd.
+	StorageBcache StorageProfile = "bcache"
+	// StorageRAID1 mirrors the root filesystem on the boot disk and a
+	// second disk.
+	StorageRAID1 StorageProfile = "raid1"
+)
+
+// Mount points which can be given a dedicated disk.
+const (
+	ContainerdMountPoint = "/var/lib/containerd"
+	EtcdMountPoint       = "/var/lib/etcd"
+)
+
+// ssdTag is the tag MAAS sets on solid state disks.
+const ssdTag = "ssd"
+
+// espSize is the size in bytes of the EFI system partition created on the
+// disks of a mirrored root filesystem, the size MAAS uses for its layouts.
+const espSize = 512 * 1024 * 1024
+
+// Storage is the storage layout applied to a machine after it is allocated and
+// before it is deployed.
+type Storage struct {
+	// Profile is the layout of the root filesystem, StorageFlat if empty.
+	Profile StorageProfile
+	// DedicatedDisks are formatted and mounted on their own.
+	DedicatedDisks []DedicatedDisk
+}
+
+// DedicatedDisk is a disk used for a single mount point.
+type DedicatedDisk struct {
+	// MountPoint is ContainerdMountPoint or EtcdMountPoint.
+	MountPoint string
+	// Name is the name of the disk, e.g. "sdb". If empty the smallest free
+	// disk with all the Tags is used.
+	Name string
+	// Tags the disk must have, e.g. "ssd".
+	Tags []string
+}
+
+// BlockDevice is a block device of a deployed machine.
+type BlockDevice struct {
+	// Name is the MAAS name of the device, e.g. "sda" or "md0".
+	Name string
+	// Model is the model of a physical disk.
+	Model string
+	// Size is the size of the device in GB.
+	Size int
+	Tags []string
+	// UsedFor describes what the device is used for, e.g. "ext4 formatted
+	// filesystem mounted at /var/lib/etcd".
+	UsedFor string
+	// MountPoints are the mount points of the device and its partitions.
+	MountPoints []string
+}
+
+func (s *Storage) profile() StorageProfile {
+	if s.Profile == "" {
+		return StorageFlat
+	}
+	return s.Profile
+}
+
+// Validate checks that the storage layout can be applied to a machine.
+func (s *Storage) Validate() error {
+	if s == nil {
+		return nil
+	}
+	switch s.profile() {
+	case StorageFlat, StorageLVM, StorageBcache, StorageRAID1:
+	default:
+		return fmt.Errorf("unknown storage profile %q", s.Profile)
+	}
+	mountPoints := map[string]bool{}
+	names := map[string]bool{}
+	for _, d := range s.DedicatedDisks {
+		if d.MountPoint != ContainerdMountPoint && d.MountPoint != EtcdMountPoint {
+			return fmt.Errorf("a dedicated disk can not be mounted at %q", d.MountPoint)
+		}
+		if mountPoints[d.MountPoint] {
+			return fmt.Errorf("more than one dedicated disk is mounted at %s", d.MountPoint)
+		}
+		mountPoints[d.MountPoint] = true
+		if d.Name != "" {
+			if names[d.Name] {
+				return fmt.Errorf("disk %q is dedicated twice", d.Name)
+			}
+			names[d.Name] = true
+		}
+	}
+	return nil
+}
+
+type maasFilesystem struct {
+	FSType     string `json:"fstype"`
+	MountPoint string `json:"mount_point"`
+}
+
+type maasBlockDevice struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// Type is "physical" or "virtual".
+	Type  string   `json:"type"`
+	Model string   `json:"model"`
+	Size  int64    `json:"size"`
+	Tags  []string `json:"tags"`
+	// UsedFor is the description MAAS gives of the use of the device.
+	UsedFor    string          `json:"used_for"`
+	Filesystem *maasFilesystem `json:"filesystem"`
+	Partitions []struct {
+		Filesystem *maasFilesystem `json:"filesystem"`
+	} `json:"partitions"`
+}
+
+type maasStorage struct {
+	// BIOSBootMethod is "uefi" for machines booting with UEFI.
+	BIOSBootMethod string `json:"bios_boot_method"`
+	BootDisk       *struct {
+		ID int `json:"id"`
+	} `json:"boot_disk"`
+	Disks        []maasBlockDevice `json:"physicalblockdevice_set"`
+	BlockDevices []maasBlockDevice `json:"blockdevice_set"`
+}
+
+// storagePlan assigns the disks of a machine to a storage layout.
+type storagePlan struct {
+	boot maasBlockDevice
+	// dedicated are the disks of Storage.DedicatedDisks, in order.
+	dedicated []maasBlockDevice
+	// mirror is the second disk of StorageRAID1.
+	mirror *maasBlockDevice
+	// cache is the ssd caching the boot disk with StorageBcache.
+	cache *maasBlockDevice
+}
+
+// planStorage picks the disks for the storage layout. Dedicated disks are
+// picked first, the disks the root filesystem needs besides the boot disk
+// are picked from the ones left. Disks are picked by size, smallest first, so
+// the same layout picks the same disks on identical machines.
+func planStorage(storage *Storage, disks []maasBlockDevice, bootID int) (*storagePlan, error) {
+	sorted := append([]maasBlockDevice(nil), disks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Size != sorted[j].Size {
+			return sorted[i].Size < sorted[j].Size
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	plan := &storagePlan{}
+	used := map[int]bool{bootID: true}
+	found := false
+	for _, d := range sorted {
+		if d.ID == bootID {
+			plan.boot = d
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("boot disk %d not found", bootID)
+	}
+	pick := func(match func(d *maasBlockDevice) bool) *maasBlockDevice {
+		for i := range sorted {
+			if !used[sorted[i].ID] && match(&sorted[i]) {
+				used[sorted[i].ID] = true
+				return &sorted[i]
+			}
+		}
+		return nil
+	}
+
+	// Disks picked by name go first so a tag does not pick them.
+	plan.dedicated = make([]maasBlockDevice, len(storage.DedicatedDisks))
+	for i, dd := range storage.DedicatedDisks {
+		if dd.Name == "" {
+			continue
+		}
+		if dd.Name == plan.boot.Name {
+			return nil, fmt.Errorf("boot disk %s can not be dedicated to %s", dd.Name, dd.MountPoint)
+		}
+		d := pick(func(d *maasBlockDevice) bool { return d.Name == dd.Name && containsAll(d.Tags, dd.Tags) })
+		if d == nil {
+			return nil, fmt.Errorf("no disk %s with tags %v for %s", dd.Name, dd.Tags, dd.MountPoint)
+		}
+		plan.dedicated[i] = *d
+	}
+	for i, dd := range storage.DedicatedDisks {
+		if dd.Name != "" {
+			continue
+		}
+		d := pick(func(d *maasBlockDevice) bool { return containsAll(d.Tags, dd.Tags) })
+		if d == nil {
+			return nil, fmt.Errorf("no free disk with tags %v for %s", dd.Tags, dd.MountPoint)
+		}
+		plan.dedicated[i] = *d
+	}
+
+	switch storage.profile() {
+	case StorageRAID1:
+		plan.mirror = pick(func(d *maasBlockDevice) bool { return d.Size >= plan.boot.Size })
+		if plan.mirror == nil {
+			return nil, fmt.Errorf("no free disk as large as boot disk %s to mirror it", plan.boot.Name)
+		}
+	case StorageBcache:
+		plan.cache = pick(func(d *maasBlockDevice) bool { return containsString(d.Tags, ssdTag) })
+		if plan.cache == nil {
+			return nil, fmt.Errorf("no free ssd to cache boot disk %s", plan.boot.Name)
+		}
+	}
+	return plan, nil
+}
+
+// applyStorage sets the storage layout of an allocated machine. Setting the
+// layout removes the previous storage configuration of the machine, so it can
+// be applied again to a machine it was applied to before.
+func (c Client) applyStorage(systemID string, storage *Storage) error {
+	if storage == nil {
+		return nil
+	}
+	if err := storage.Validate(); err != nil {
+		return err
+	}
+	machine := c.MAAS.GetSubObject("machines").GetSubObject(systemID)
+	blockDevices := c.MAAS.GetSubObject("nodes").GetSubObject(systemID).GetSubObject("blockdevices")
+
+	current, err := c.storage(systemID)
+	if err != nil {
+		return err
+	}
+	if current.BootDisk == nil {
+		return fmt.Errorf("machine %s has no boot disk", systemID)
+	}
+	plan, err := planStorage(storage, current.Disks, current.BootDisk.ID)
+	if err != nil {
+		return errors.Wrapf(err, "error planning storage of machine %s", systemID)
+	}
+
+	params := url.Values{"root_device": {strconv.Itoa(plan.boot.ID)}}
+	switch storage.profile() {
+	case StorageRAID1:
+		// The mirror is built on an empty layout.
+		params = url.Values{"storage_layout": {"blank"}}
+	case StorageBcache:
+		params.Set("storage_layout", string(StorageBcache))
+		params.Set("cache_device", strconv.Itoa(plan.cache.ID))
+	default:
+		params.Set("storage_layout", string(storage.profile()))
+	}
+	klog.Infof("Setting %s storage layout of machine %s", storage.profile(), systemID)
+	if _, err := machine.CallPost("set_storage_layout", params); err != nil {
+		return errors.Wrapf(err, "error setting storage layout of machine %s", systemID)
+	}
+
+	if plan.mirror != nil {
+		// The blank layout has no EFI system partition, UEFI machines get
+		// one on both disks so either can boot. Only the one of the boot
+		// disk is mounted, MAAS mounts a single /boot/efi.
+		uefi := current.BIOSBootMethod == "uefi"
+		var partitions []string
+		for _, d := range []maasBlockDevice{plan.boot, *plan.mirror} {
+			disk := blockDevices.GetSubObject(strconv.Itoa(d.ID))
+			if uefi {
+				esp, err := c.partition(disk, espSize)
+				if err != nil {
+					return errors.Wrapf(err, "error creating efi system partition on disk %s of machine %s", d.Name, systemID)
+				}
+				mountPoint := ""
+				if d.ID == plan.boot.ID {
+					mountPoint = "/boot/efi"
+				}
+				if err := c.formatPartition(disk, esp, "fat32", mountPoint); err != nil {
+					return errors.Wrapf(err, "error formatting efi system partition on disk %s of machine %s", d.Name, systemID)
+				}
+			}
+			partition, err := c.partition(disk, 0)
+			if err != nil {
+				return errors.Wrapf(err, "error partitioning disk %s of machine %s", d.Name, systemID)
+			}
+			partitions = append(partitions, strconv.Itoa(partition))
+		}
+		klog.Infof("Mirroring disks %s and %s of machine %s", plan.boot.Name, plan.mirror.Name, systemID)
+		result, err := c.MAAS.GetSubObject("nodes").GetSubObject(systemID).GetSubObject("raids").CallPost("", url.Values{
+			"name":       {"md0"},
+			"level":      {"raid-1"},
+			"partitions": partitions,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error creating raid of machine %s", systemID)
+		}
+		var raid struct {
+			VirtualDevice struct {
+				ID int `json:"id"`
+			} `json:"virtual_device"`
+		}
+		if err := decode(result, &raid); err != nil {
+			return err
+		}
+		if err := c.mount(systemID, raid.VirtualDevice.ID, "/"); err != nil {
+			return err
+		}
+	}
+
+	for i, d := range plan.dedicated {
+		klog.Infof("Dedicating disk %s of machine %s to %s", d.Name, systemID, storage.DedicatedDisks[i].MountPoint)
+		if err := c.mount(systemID, d.ID, storage.DedicatedDisks[i].MountPoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// partition creates a partition of size bytes on the disk, or one using the
+// rest of the disk if size is 0, and returns its id.
+func (c Client) partition(disk gomaasapi.MAASObject, size int64) (int, error) {
+	params := url.Values{}
+	if size > 0 {
+		params.Set("size", strconv.FormatInt(size, 10))
+	}
+	result, err := disk.GetSubObject("partitions").CallPost("", params)
+	if err != nil {
+		return 0, err
+	}
+	var partition struct {
+		ID int `json:"id"`
+	}
+	if err := decode(result, &partition); err != nil {
+		return 0, err
+	}
+	return partition.ID, nil
+}
+
+// formatPartition formats a partition of the disk and mounts it unless
+// mountPoint is empty.
+func (c Client) formatPartition(disk gomaasapi.MAASObject, id int, fstype, mountPoint string) error {
+	partition := disk.GetSubObject("partition").GetSubObject(strconv.Itoa(id))
+	if _, err := partition.CallPost("format", url.Values{"fstype": {fstype}}); err != nil {
+		return err
+	}
+	if mountPoint == "" {
+		return nil
+	}
+	_, err := partition.CallPost("mount", url.Values{"mount_point": {mountPoint}})
+	return err
+}
+
+// mount formats a whole block device with ext4 and mounts it.
+func (c Client) mount(systemID string, id int, mountPoint string) error {
+	device := c.MAAS.GetSubObject("nodes").GetSubObject(systemID).GetSubObject("blockdevices").GetSubObject(strconv.Itoa(id))
+	if _, err := device.CallPost("format", url.Values{"fstype": {"ext4"}}); err != nil {
+		return errors.Wrapf(err, "error formatting block device %d of machine %s", id, systemID)
+	}
+	if _, err := device.CallPost("mount", url.Values{"mount_point": {mountPoint}}); err != nil {
+		return errors.Wrapf(err, "error mounting block device %d of machine %s at %s", id, systemID, mountPoint)
+	}
+	return nil
+}
+
+// storage reads the boot disk and block devices of a machine.
+func (c Client) storage(systemID string) (*maasStorage, error) {
+	result, err := c.MAAS.GetSubObject("machines").GetSubObject(systemID).CallGet("", url.Values{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting storage of machine %s", systemID)
+	}
+	var storage maasStorage
+	if err := decode(result, &storage); err != nil {
+		return nil, err
+	}
+	return &storage, nil
+}
+
+// blockDevices returns the physical and virtual block devices of a machine.
+func (c Client) blockDevices(systemID string) ([]BlockDevice, error) {
+	storage, err := c.storage(systemID)
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]BlockDevice, 0, len(storage.BlockDevices))
+	for _, d := range storage.BlockDevices {
+		device := BlockDevice{
+			Name:    d.Name,
+			Model:   d.Model,
+			Size:    int(d.Size / 1000 / 1000 / 1000),
+			Tags:    d.Tags,
+			UsedFor: d.UsedFor,
+		}
+		if d.Filesystem != nil && d.Filesystem.MountPoint != "" {
+			device.MountPoints = append(device.MountPoints, d.Filesystem.MountPoint)
+		}
+		for _, p := range d.Partitions {
+			if p.Filesystem != nil && p.Filesystem.MountPoint != "" {
+				device.MountPoints = append(device.MountPoints, p.Filesystem.MountPoint)
+			}
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
@@ -0,0 +1,200 @@
+package maas
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/juju/gomaasapi"
+)
+
+func TestStorage_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage *Storage
+		wantErr bool
+	}{
+		{name: "nil"},
+		{name: "default profile", storage: &Storage{}},
+		{
+			name: "raid1 with dedicated disks",
+			storage: &Storage{Profile: StorageRAID1, DedicatedDisks: []DedicatedDisk{
+				{MountPoint: EtcdMountPoint, Tags: []string{"ssd"}},
+				{MountPoint: ContainerdMountPoint, Name: "sdc"},
+			}},
+		},
+		{name: "unknown profile", storage: &Storage{Profile: "zfs"}, wantErr: true},
+		{name: "other mount point", storage: &Storage{DedicatedDisks: []DedicatedDisk{{MountPoint: "/home"}}}, wantErr: true},
+		{
+			name: "mount point twice",
+			storage: &Storage{DedicatedDisks: []DedicatedDisk{
+				{MountPoint: EtcdMountPoint},
+				{MountPoint: EtcdMountPoint},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "disk twice",
+			storage: &Storage{DedicatedDisks: []DedicatedDisk{
+				{MountPoint: EtcdMountPoint, Name: "sdb"},
+				{MountPoint: ContainerdMountPoint, Name: "sdb"},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.storage.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_planStorage(t *testing.T) {
+	const gb = 1000 * 1000 * 1000
+	disks := []maasBlockDevice{
+		{ID: 1, Name: "sda", Size: 500 * gb, Tags: []string{"rotary"}},
+		{ID: 2, Name: "sdb", Size: 500 * gb, Tags: []string{"rotary"}},
+		{ID: 3, Name: "sdc", Size: 2000 * gb, Tags: []string{"rotary"}},
+		{ID: 4, Name: "nvme0n1", Size: 400 * gb, Tags: []string{"ssd"}},
+		{ID: 5, Name: "nvme1n1", Size: 200 * gb, Tags: []string{"ssd"}},
+	}
+	tests := []struct {
+		name          string
+		storage       *Storage
+		wantDedicated []string
+		wantMirror    string
+		wantCache     string
+		wantErr       bool
+	}{
+		{name: "flat", storage: &Storage{}},
+		{name: "raid1 mirrors the smallest disk as large as the boot disk", storage: &Storage{Profile: StorageRAID1}, wantMirror: "sdb"},
+		{name: "bcache caches on the smallest ssd", storage: &Storage{Profile: StorageBcache}, wantCache: "nvme1n1"},
+		{
+			name: "dedicated disks are picked before the cache",
+			storage: &Storage{Profile: StorageBcache, DedicatedDisks: []DedicatedDisk{
+				{MountPoint: EtcdMountPoint, Tags: []string{"ssd"}},
+			}},
+			wantDedicated: []string{"nvme1n1"},
+			wantCache:     "nvme0n1",
+		},
+		{
+			name: "no free disk to mirror",
+			storage: &Storage{Profile: StorageRAID1, DedicatedDisks: []DedicatedDisk{
+				{MountPoint: ContainerdMountPoint, Name: "sdb"},
+				{MountPoint: EtcdMountPoint, Name: "sdc"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "named disks are picked before tagged disks",
+			storage: &Storage{DedicatedDisks: []DedicatedDisk{
+				{MountPoint: ContainerdMountPoint, Tags: []string{"rotary"}},
+				{MountPoint: EtcdMountPoint, Name: "sdb"},
+			}},
+			wantDedicated: []string{"sdc", "sdb"},
+		},
+		{name: "boot disk", storage: &Storage{DedicatedDisks: []DedicatedDisk{{MountPoint: EtcdMountPoint, Name: "sda"}}}, wantErr: true},
+		{name: "missing disk", storage: &Storage{DedicatedDisks: []DedicatedDisk{{MountPoint: EtcdMountPoint, Name: "sdz"}}}, wantErr: true},
+		{
+			name: "no free ssd for the cache",
+			storage: &Storage{Profile: StorageBcache, DedicatedDisks: []DedicatedDisk{
+				{MountPoint: EtcdMountPoint, Tags: []string{"ssd"}},
+				{MountPoint: ContainerdMountPoint, Tags: []string{"ssd"}},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := planStorage(tt.storage, disks, 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("planStorage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if plan.boot.Name != "sda" {
+				t.Errorf("boot = %s, want sda", plan.boot.Name)
+			}
+			if len(plan.dedicated) != len(tt.wantDedicated) {
+				t.Fatalf("dedicated = %v, want %v", plan.dedicated, tt.wantDedicated)
+			}
+			for i, d := range plan.dedicated {
+				if d.Name != tt.wantDedicated[i] {
+					t.Errorf("dedicated[%d] = %s, want %s", i, d.Name, tt.wantDedicated[i])
+				}
+			}
+			if name(plan.mirror) != tt.wantMirror {
+				t.Errorf("mirror = %q, want %q", name(plan.mirror), tt.wantMirror)
+			}
+			if name(plan.cache) != tt.wantCache {
+				t.Errorf("cache = %q, want %q", name(plan.cache), tt.wantCache)
+			}
+		})
+	}
+}
+
+func name(d *maasBlockDevice) string {
+	if d == nil {
+		return ""
+	}
+	return d.Name
+}
+
+func TestClient_applyStorage_raid1UEFI(t *testing.T) {
+	var requests []string
+	partitions := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"bios_boot_method": "uefi", "boot_disk": {"id": 1}, "physicalblockdevice_set": [
+				{"id": 1, "name": "sda", "size": 500000000000},
+				{"id": 2, "name": "sdb", "size": 500000000000}]}`)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/api/2.0/nodes/abc123/")
+		requests = append(requests, strings.Join(strings.Fields(path+" "+r.URL.Query().Get("op")+" "+r.PostForm.Encode()), " "))
+		switch {
+		case strings.HasSuffix(path, "/partitions/"):
+			partitions++
+			fmt.Fprintf(w, `{"id": %d}`, partitions)
+		case path == "raids/":
+			fmt.Fprint(w, `{"virtual_device": {"id": 10}}`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer server.Close()
+	api, err := gomaasapi.NewAnonymousClient(server.URL, "2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Client{MAAS: gomaasapi.NewMAAS(*api)}
+
+	if err := c.applyStorage("abc123", &Storage{Profile: StorageRAID1}); err != nil {
+		t.Fatalf("applyStorage() error = %v", err)
+	}
+	want := []string{
+		"/api/2.0/machines/abc123/ set_storage_layout storage_layout=blank",
+		"blockdevices/1/partitions/ size=536870912",
+		"blockdevices/1/partition/1/ format fstype=fat32",
+		"blockdevices/1/partition/1/ mount mount_point=%2Fboot%2Fefi",
+		"blockdevices/1/partitions/",
+		"blockdevices/2/partitions/ size=536870912",
+		"blockdevices/2/partition/3/ format fstype=fat32",
+		"blockdevices/2/partitions/",
+		"raids/ level=raid-1&name=md0&partitions=2&partitions=4",
+		"blockdevices/10/ format fstype=ext4",
+		"blockdevices/10/ mount mount_point=%2F",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
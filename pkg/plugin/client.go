@@ -0,0 +1,259 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugin calls out-of-process provider plugins implementing the
+// MachineProvider gRPC service of api/provider/provider.proto.
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog"
+
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/provider"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// Client is a maas.MachineProvider calling a provider plugin. Image uploads
+// and machine updates are not part of the plugin protocol, they fail with
+// maas.ErrNotSupported.
+type Client struct {
+	address string
+	conn    *grpc.ClientConn
+	client  pb.MachineProviderClient
+}
+
+var _ maas.MachineProvider = &Client{}
+
+// Dial returns a client of the plugin listening on address, either host:port
+// or unix:///path/to/socket. The connection is established in the background
+// and re-established when it breaks, calls fail while the plugin is
+// unreachable.
+func Dial(address string) (*Client, error) {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial provider plugin %s", address)
+	}
+	return NewClient(address, conn), nil
+}
+
+// NewClient returns a client calling the plugin over conn.
+func NewClient(address string, conn *grpc.ClientConn) *Client {
+	return &Client{address: address, conn: conn, client: pb.NewMachineProviderClient(conn)}
+}
+
+// Close closes the connection to the plugin.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// wrap turns the status of a failed call into an error of the maas package
+// where there is one, so callers handle plugins like MAAS.
+func (c *Client) wrap(err error, op string) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return maas.ErrMachineNotFound
+	case codes.Unimplemented:
+		return maas.ErrNotSupported
+	}
+	return errors.Wrapf(err, "provider plugin %s %s failed", c.address, op)
+}
+
+// Create allocates a machine for the request's provider id, or adopts the
+// machine already allocated for it, and starts its deployment. The machine
+// is released if the deployment cannot be started.
+func (c *Client) Create(ctx context.Context, request *maas.CreateRequest) (*maas.CreateResponse, error) {
+	if request.ProviderID == "" {
+		return nil, fmt.Errorf("error creating machine: providerID not set")
+	}
+	allocated, err := c.client.Allocate(ctx, &pb.AllocateMsg{
+		ProviderId:   request.ProviderID,
+		InstanceType: request.InstanceType,
+		Constraints:  toConstraints(&request.Constraints),
+	})
+	if err != nil {
+		return nil, c.wrap(err, "allocate")
+	}
+	m := allocated.Machine
+	if m == nil || m.SystemId == "" {
+		return nil, fmt.Errorf("provider plugin %s allocated no machine for %s", c.address, request.ProviderID)
+	}
+	klog.Infof("provider plugin %s allocated machine %s for %s", c.address, m.SystemId, request.ProviderID)
+
+	deployed, err := c.client.Deploy(ctx, &pb.DeployMsg{
+		SystemId:   m.SystemId,
+		ProviderId: request.ProviderID,
+		Distro:     request.Distro,
+		Userdata:   request.Userdata,
+		Network:    toNetwork(request.Network),
+		Storage:    toStorage(request.Storage),
+	})
+	if err != nil {
+		deployErr := c.wrap(err, "deploy")
+		if _, err := c.client.Release(ctx, &pb.ReleaseMsg{ProviderId: request.ProviderID, SystemId: m.SystemId}); err != nil {
+			klog.Warningf("could not release machine %s after failed deployment: %v", m.SystemId, err)
+		}
+		return nil, deployErr
+	}
+
+	return &maas.CreateResponse{
+		ProviderID:   request.ProviderID,
+		IPAddresses:  m.IpAddresses,
+		SystemID:     m.SystemId,
+		Hostname:     m.Hostname,
+		Zone:         m.Zone,
+		BlockDevices: fromBlockDevices(deployed.BlockDevices),
+	}, nil
+}
+
+// Delete releases a machine.
+func (c *Client) Delete(ctx context.Context, request *maas.DeleteRequest) error {
+	if request.ProviderID == "" && request.SystemID == "" {
+		return fmt.Errorf("machine has not been created")
+	}
+	_, err := c.client.Release(ctx, &pb.ReleaseMsg{ProviderId: request.ProviderID, SystemId: request.SystemID})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if err != nil {
+		return c.wrap(err, "release")
+	}
+	return nil
+}
+
+// Update is not part of the plugin protocol.
+func (c *Client) Update(ctx context.Context, request *maas.UpdateRequest) error {
+	return maas.ErrNotSupported
+}
+
+// Status returns the machine allocated for the request.
+func (c *Client) Status(ctx context.Context, request *maas.StatusRequest) (*maas.Machine, error) {
+	if request.ProviderID == "" && request.SystemID == "" {
+		return nil, maas.ErrMachineNotFound
+	}
+	reply, err := c.client.Status(ctx, &pb.StatusMsg{ProviderId: request.ProviderID, SystemId: request.SystemID})
+	if err != nil {
+		return nil, c.wrap(err, "status")
+	}
+	if len(reply.Machines) == 0 {
+		return nil, maas.ErrMachineNotFound
+	}
+	m := fromMachine(reply.Machines[0])
+	return &m, nil
+}
+
+// List returns every machine allocated by the plugin.
+func (c *Client) List(ctx context.Context) ([]maas.Machine, error) {
+	reply, err := c.client.Status(ctx, &pb.StatusMsg{})
+	if err != nil {
+		return nil, c.wrap(err, "status")
+	}
+	machines := make([]maas.Machine, 0, len(reply.Machines))
+	for _, m := range reply.Machines {
+		machines = append(machines, fromMachine(m))
+	}
+	return machines, nil
+}
+
+func (c *Client) capacity(ctx context.Context) (*pb.CapacityReply, error) {
+	reply, err := c.client.Capacity(ctx, &pb.CapacityMsg{})
+	if err != nil {
+		return nil, c.wrap(err, "capacity")
+	}
+	return reply, nil
+}
+
+// Available returns the machines ready to be allocated.
+func (c *Client) Available(ctx context.Context) ([]maas.Hardware, error) {
+	reply, err := c.capacity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hardware := make([]maas.Hardware, 0, len(reply.Available))
+	for _, h := range reply.Available {
+		hardware = append(hardware, fromHardware(h))
+	}
+	return hardware, nil
+}
+
+// Hosts returns every machine of the plugin inventory.
+func (c *Client) Hosts(ctx context.Context) ([]maas.Host, error) {
+	reply, err := c.capacity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hosts := make([]maas.Host, 0, len(reply.Hosts))
+	for _, h := range reply.Hosts {
+		hosts = append(hosts, fromHost(h))
+	}
+	return hosts, nil
+}
+
+// Zones returns the availability zones of the plugin inventory.
+func (c *Client) Zones(ctx context.Context) ([]string, error) {
+	reply, err := c.capacity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return reply.Zones, nil
+}
+
+// ListImages returns the images the plugin serves, numbered in the order of
+// the reply. The upload date is zero if the plugin does not know it.
+func (c *Client) ListImages(ctx context.Context) ([]maas.BootResource, error) {
+	reply, err := c.client.ListImages(ctx, &pb.ListImagesMsg{})
+	if err != nil {
+		return nil, c.wrap(err, "list images")
+	}
+	images := make([]maas.BootResource, 0, len(reply.Images))
+	for i, image := range reply.Images {
+		resource := maas.BootResource{
+			ID:           i + 1,
+			Name:         image.Name,
+			Type:         maas.BootResourceUploaded,
+			Architecture: image.Architecture,
+		}
+		if image.Uploaded != "" {
+			uploaded, err := time.Parse(time.RFC3339, image.Uploaded)
+			if err != nil {
+				return nil, errors.Wrapf(err, "provider plugin %s returned image %s with an invalid upload date", c.address, image.Name)
+			}
+			resource.Uploaded = uploaded
+		}
+		images = append(images, resource)
+	}
+	return images, nil
+}
+
+// UploadImage is not part of the plugin protocol.
+func (c *Client) UploadImage(ctx context.Context, request *maas.UploadImageRequest, progress func(uploaded int64)) (*maas.BootResource, error) {
+	return nil, maas.ErrNotSupported
+}
+
+// ImageState is not part of the plugin protocol.
+func (c *Client) ImageState(ctx context.Context, id int) (*maas.ImageState, error) {
+	return nil, maas.ErrNotSupported
+}
+
+// DeleteImage is not part of the plugin protocol.
+func (c *Client) DeleteImage(ctx context.Context, id int) error {
+	return maas.ErrNotSupported
+}
@@ -0,0 +1,146 @@
+package plugin
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/provider"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/plugin/fake"
+)
+
+// testPlugin serves server on a local port and returns a client of it.
+func testPlugin(t *testing.T, server *fake.Server) (*Client, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterMachineProviderServer(s, server)
+	go s.Serve(listener)
+	client, err := Dial(listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, func() {
+		client.Close()
+		s.Stop()
+	}
+}
+
+func testServer() *fake.Server {
+	server := fake.New(
+		fake.Machine{
+			Hardware: pb.Hardware{
+				SystemId: "node-1", Hostname: "node-1", Zone: "zone-a", Tags: []string{"standard"}, CpuCount: 8, Memory: 16384,
+				Disks: []*pb.Disk{{Name: "sda", Size: 500}},
+			},
+			IPAddresses: []string{"10.0.0.10"},
+		},
+		fake.Machine{
+			Hardware:    pb.Hardware{SystemId: "node-2", Hostname: "node-2", Zone: "zone-b", Tags: []string{"large"}},
+			IPAddresses: []string{"10.0.0.11"},
+		},
+	)
+	server.Images = []*pb.Image{{Name: "os=ubuntu-xenial,k8s=1.13.5,standard", Architecture: "amd64/generic", Uploaded: "2019-05-01T00:00:00Z"}}
+	return server
+}
+
+func TestClient(t *testing.T) {
+	server := testServer()
+	client, stop := testPlugin(t, server)
+	defer stop()
+	ctx := context.Background()
+
+	response, err := client.Create(ctx, &maas.CreateRequest{
+		ProviderID:   "provider-1",
+		InstanceType: "standard",
+		Distro:       "os=ubuntu-xenial,k8s=1.13.5,standard",
+		Userdata:     "#cloud-config",
+		Constraints:  maas.Constraints{MinCPUCount: 4, Zone: "zone-a"},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	want := &maas.CreateResponse{
+		ProviderID:   "provider-1",
+		SystemID:     "node-1",
+		Hostname:     "node-1",
+		Zone:         "zone-a",
+		IPAddresses:  []string{"10.0.0.10"},
+		BlockDevices: []maas.BlockDevice{{Name: "sda", Size: 500}},
+	}
+	if !reflect.DeepEqual(response, want) {
+		t.Errorf("Create() = %+v, want %+v", response, want)
+	}
+	if m, _ := server.Machine("node-1"); m.Distro != "os=ubuntu-xenial,k8s=1.13.5,standard" || m.Userdata != "#cloud-config" {
+		t.Errorf("deployed machine = %+v", m)
+	}
+
+	status, err := client.Status(ctx, &maas.StatusRequest{SystemID: "node-1"})
+	if err != nil || status.Status != maas.StatusDeployed || status.ProviderID != "provider-1" {
+		t.Errorf("Status() = %+v, %v, want a deployed machine", status, err)
+	}
+	if status, err := client.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"}); err != nil || status.SystemID != "node-1" {
+		t.Errorf("Status() by provider id = %+v, %v, want node-1", status, err)
+	}
+	if machines, err := client.List(ctx); err != nil || len(machines) != 1 || machines[0].SystemID != "node-1" {
+		t.Errorf("List() = %+v, %v, want node-1", machines, err)
+	}
+	if available, err := client.Available(ctx); err != nil || len(available) != 1 || available[0].SystemID != "node-2" {
+		t.Errorf("Available() = %+v, %v, want node-2", available, err)
+	}
+	if hosts, err := client.Hosts(ctx); err != nil || len(hosts) != 2 || hosts[0].ProviderID != "provider-1" {
+		t.Errorf("Hosts() = %+v, %v", hosts, err)
+	}
+	if zones, err := client.Zones(ctx); err != nil || !reflect.DeepEqual(zones, []string{"zone-a", "zone-b"}) {
+		t.Errorf("Zones() = %v, %v", zones, err)
+	}
+	images, err := client.ListImages(ctx)
+	if err != nil || len(images) != 1 || images[0].Type != maas.BootResourceUploaded ||
+		!images[0].Uploaded.Equal(time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ListImages() = %+v, %v", images, err)
+	}
+
+	if _, err := client.Create(ctx, &maas.CreateRequest{ProviderID: "provider-2", InstanceType: "standard"}); err == nil {
+		t.Errorf("Create() with no machine available succeeded")
+	}
+	if err := client.Update(ctx, &maas.UpdateRequest{SystemID: "node-1", Hostname: "master"}); err != maas.ErrNotSupported {
+		t.Errorf("Update() error = %v, want %v", err, maas.ErrNotSupported)
+	}
+
+	if err := client.Delete(ctx, &maas.DeleteRequest{ProviderID: "provider-1", SystemID: "node-1"}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := client.Status(ctx, &maas.StatusRequest{SystemID: "node-1"}); err != maas.ErrMachineNotFound {
+		t.Errorf("Status() of a released machine error = %v, want %v", err, maas.ErrMachineNotFound)
+	}
+	if _, err := client.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"}); err != maas.ErrMachineNotFound {
+		t.Errorf("Status() by provider id of a released machine error = %v, want %v", err, maas.ErrMachineNotFound)
+	}
+}
+
+func TestClient_deployFailed(t *testing.T) {
+	server := testServer()
+	server.DeployTime = time.Hour
+	client, stop := testPlugin(t, server)
+	defer stop()
+	ctx := context.Background()
+
+	if _, err := client.Create(ctx, &maas.CreateRequest{ProviderID: "provider-1", InstanceType: "large"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if status, err := client.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"}); err != nil || status.Status != maas.StatusDeploying {
+		t.Errorf("Status() = %+v, %v, want a deploying machine", status, err)
+	}
+	server.FailDeploy("node-2", "disk erase failed")
+	status, err := client.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"})
+	if err != nil || status.Status != maas.StatusFailedDeployment || status.StatusMessage != "disk erase failed" {
+		t.Errorf("Status() = %+v, %v, want a failed deployment", status, err)
+	}
+}
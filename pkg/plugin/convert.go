@@ -0,0 +1,170 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/provider"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+// statuses maps the plugin machine statuses to the MAAS status names the
+// machine controller waits for.
+var statuses = map[pb.MachineStatus]string{
+	pb.MachineStatus_ALLOCATED:         maas.StatusAllocated,
+	pb.MachineStatus_DEPLOYING:         maas.StatusDeploying,
+	pb.MachineStatus_DEPLOYED:          maas.StatusDeployed,
+	pb.MachineStatus_FAILED_DEPLOYMENT: maas.StatusFailedDeployment,
+}
+
+func toConstraints(c *maas.Constraints) *pb.Constraints {
+	constraints := &pb.Constraints{
+		MinCpuCount:  int32(c.MinCPUCount),
+		MinMemory:    int32(c.MinMemory),
+		Architecture: c.Architecture,
+		Zone:         c.Zone,
+		Pool:         c.Pool,
+		Tags:         c.Tags,
+		NotTags:      c.NotTags,
+	}
+	for _, s := range c.Storage {
+		constraints.Storage = append(constraints.Storage, &pb.StorageConstraint{
+			Label: s.Label,
+			Size:  int32(s.Size),
+			Tags:  s.Tags,
+		})
+	}
+	for _, i := range c.Interfaces {
+		constraint := &pb.InterfaceConstraint{
+			Label:  i.Label,
+			Space:  i.Space,
+			Subnet: i.Subnet,
+			Fabric: i.Fabric,
+		}
+		if i.VID != nil {
+			constraint.HasVid = true
+			constraint.Vid = int32(*i.VID)
+		}
+		constraints.Interfaces = append(constraints.Interfaces, constraint)
+	}
+	return constraints
+}
+
+func toNetwork(n *maas.Network) *pb.Network {
+	if n == nil {
+		return nil
+	}
+	network := &pb.Network{}
+	for _, b := range n.Bonds {
+		network.Bonds = append(network.Bonds, &pb.Bond{Name: b.Name, Parents: b.Parents, Mode: b.Mode})
+	}
+	for _, i := range n.Interfaces {
+		network.Interfaces = append(network.Interfaces, &pb.Interface{
+			Name:      i.Name,
+			Vlan:      int32(i.VLAN),
+			Subnet:    i.Subnet,
+			Mode:      string(i.Mode),
+			IpAddress: i.IPAddress,
+			NodeIp:    i.NodeIP,
+		})
+	}
+	return network
+}
+
+func toStorage(s *maas.Storage) *pb.Storage {
+	if s == nil {
+		return nil
+	}
+	storage := &pb.Storage{Profile: string(s.Profile)}
+	for _, d := range s.DedicatedDisks {
+		storage.DedicatedDisks = append(storage.DedicatedDisks, &pb.DedicatedDisk{
+			MountPoint: d.MountPoint,
+			Name:       d.Name,
+			Tags:       d.Tags,
+		})
+	}
+	return storage
+}
+
+func fromBlockDevices(devices []*pb.BlockDevice) []maas.BlockDevice {
+	var blockDevices []maas.BlockDevice
+	for _, d := range devices {
+		blockDevices = append(blockDevices, maas.BlockDevice{
+			Name:        d.Name,
+			Model:       d.Model,
+			Size:        int(d.Size),
+			Tags:        d.Tags,
+			UsedFor:     d.UsedFor,
+			MountPoints: d.MountPoints,
+		})
+	}
+	return blockDevices
+}
+
+func fromMachine(m *pb.Machine) maas.Machine {
+	machine := maas.Machine{
+		ProviderID:    m.ProviderId,
+		SystemID:      m.SystemId,
+		Hostname:      m.Hostname,
+		Status:        statuses[m.Status],
+		StatusMessage: m.StatusMessage,
+		IPAddresses:   m.IpAddresses,
+		Zone:          m.Zone,
+	}
+	for _, i := range m.Interfaces {
+		machine.Interfaces = append(machine.Interfaces, maas.InterfaceAddresses{Name: i.Name, IPAddresses: i.IpAddresses})
+	}
+	return machine
+}
+
+func fromHardware(h *pb.Hardware) maas.Hardware {
+	if h == nil {
+		return maas.Hardware{}
+	}
+	hardware := maas.Hardware{
+		SystemID:     h.SystemId,
+		Hostname:     h.Hostname,
+		Zone:         h.Zone,
+		Pool:         h.Pool,
+		Architecture: h.Architecture,
+		Tags:         h.Tags,
+		CPUCount:     int(h.CpuCount),
+		Memory:       int(h.Memory),
+	}
+	for _, d := range h.Disks {
+		hardware.Disks = append(hardware.Disks, maas.Disk{Name: d.Name, Model: d.Model, Size: int(d.Size), Tags: d.Tags})
+	}
+	return hardware
+}
+
+func fromHost(h *pb.Host) maas.Host {
+	host := maas.Host{
+		Hardware:   fromHardware(h.Hardware),
+		Status:     h.Status,
+		PowerState: h.PowerState,
+		Owner:      h.Owner,
+		ProviderID: h.ProviderId,
+	}
+	for _, n := range h.Nics {
+		host.NICs = append(host.NICs, maas.NIC{
+			Name:        n.Name,
+			MACAddress:  n.MacAddress,
+			VLAN:        int(n.Vlan),
+			IPAddresses: n.IpAddresses,
+		})
+	}
+	return host
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides a provider plugin with an in-memory inventory, for
+// tests and to run cma-ssh locally without MAAS.
+package fake
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/samsung-cnct/cma-ssh/pkg/generated/provider"
+)
+
+// Machine is a machine of the inventory.
+type Machine struct {
+	// Hardware describes the machine. Its tags are the instance types it
+	// can be allocated as.
+	Hardware pb.Hardware
+	// IPAddresses are the addresses of the machine once deployed.
+	IPAddresses []string
+
+	ProviderID    string
+	Status        pb.MachineStatus
+	StatusMessage string
+	Distro        string
+	Userdata      string
+	deployStarted time.Time
+}
+
+// Server is a MachineProvider plugin serving Machines. Deploying machines are
+// deployed once DeployTime has passed.
+type Server struct {
+	// DeployTime is how long a deployment takes.
+	DeployTime time.Duration
+	// Images are the images machines can be deployed with.
+	Images []*pb.Image
+
+	mu       sync.Mutex
+	machines []*Machine
+}
+
+var _ pb.MachineProviderServer = &Server{}
+
+// New returns a server with the machines.
+func New(machines ...Machine) *Server {
+	s := &Server{}
+	for i := range machines {
+		m := machines[i]
+		s.machines = append(s.machines, &m)
+	}
+	return s
+}
+
+// Machine returns a copy of the machine with the system id.
+func (s *Server) Machine(systemID string) (Machine, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if m := s.find("", systemID); m != nil {
+		return *m, true
+	}
+	return Machine{}, false
+}
+
+// FailDeploy fails the deployment of the machine with the system id.
+func (s *Server) FailDeploy(systemID, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if m := s.find("", systemID); m != nil {
+		m.Status = pb.MachineStatus_FAILED_DEPLOYMENT
+		m.StatusMessage = message
+	}
+}
+
+func (s *Server) find(providerID, systemID string) *Machine {
+	for _, m := range s.machines {
+		if m.Status == pb.MachineStatus_STATUS_UNSPECIFIED {
+			if systemID != "" && m.Hardware.SystemId == systemID {
+				return m
+			}
+			continue
+		}
+		if (providerID != "" && m.ProviderID == providerID) || (systemID != "" && m.Hardware.SystemId == systemID) {
+			return m
+		}
+	}
+	return nil
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(h *pb.Hardware, instanceType string, c *pb.Constraints) bool {
+	if instanceType != "" && !hasTag(h.Tags, instanceType) {
+		return false
+	}
+	if c == nil {
+		return true
+	}
+	if h.CpuCount < c.MinCpuCount || h.Memory < c.MinMemory {
+		return false
+	}
+	if (c.Zone != "" && h.Zone != c.Zone) || (c.Pool != "" && h.Pool != c.Pool) ||
+		(c.Architecture != "" && h.Architecture != c.Architecture) {
+		return false
+	}
+	for _, tag := range c.Tags {
+		if !hasTag(h.Tags, tag) {
+			return false
+		}
+	}
+	for _, tag := range c.NotTags {
+		if hasTag(h.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// Allocate allocates the first free machine matching the request.
+func (s *Server) Allocate(ctx context.Context, request *pb.AllocateMsg) (*pb.AllocateReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if request.ProviderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider_id is not set")
+	}
+	m := s.find(request.ProviderId, "")
+	if m == nil {
+		for _, candidate := range s.machines {
+			if candidate.Status == pb.MachineStatus_STATUS_UNSPECIFIED && matches(&candidate.Hardware, request.InstanceType, request.Constraints) {
+				m = candidate
+				break
+			}
+		}
+		if m == nil {
+			return nil, status.Errorf(codes.ResourceExhausted, "no machine available for %s", request.ProviderId)
+		}
+		m.ProviderID = request.ProviderId
+		m.Status = pb.MachineStatus_ALLOCATED
+	}
+	return &pb.AllocateReply{Machine: s.machine(m)}, nil
+}
+
+// Deploy starts the deployment of an allocated machine.
+func (s *Server) Deploy(ctx context.Context, request *pb.DeployMsg) (*pb.DeployReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := s.find(request.ProviderId, request.SystemId)
+	if m == nil || m.Status == pb.MachineStatus_STATUS_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "machine %s is not allocated", request.SystemId)
+	}
+	if m.Status == pb.MachineStatus_ALLOCATED || m.Status == pb.MachineStatus_FAILED_DEPLOYMENT {
+		m.Status = pb.MachineStatus_DEPLOYING
+		m.StatusMessage = ""
+		m.Distro = request.Distro
+		m.Userdata = request.Userdata
+		m.deployStarted = time.Now()
+	}
+	var devices []*pb.BlockDevice
+	for _, d := range m.Hardware.Disks {
+		devices = append(devices, &pb.BlockDevice{Name: d.Name, Model: d.Model, Size: d.Size, Tags: d.Tags})
+	}
+	return &pb.DeployReply{BlockDevices: devices}, nil
+}
+
+// Release returns a machine to the inventory.
+func (s *Server) Release(ctx context.Context, request *pb.ReleaseMsg) (*pb.ReleaseReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if m := s.find(request.ProviderId, request.SystemId); m != nil {
+		*m = Machine{Hardware: m.Hardware, IPAddresses: m.IPAddresses}
+	}
+	return &pb.ReleaseReply{}, nil
+}
+
+func (s *Server) machine(m *Machine) *pb.Machine {
+	if m.Status == pb.MachineStatus_DEPLOYING && time.Since(m.deployStarted) >= s.DeployTime {
+		m.Status = pb.MachineStatus_DEPLOYED
+	}
+	return &pb.Machine{
+		ProviderId:    m.ProviderID,
+		SystemId:      m.Hardware.SystemId,
+		Hostname:      m.Hardware.Hostname,
+		Status:        m.Status,
+		StatusMessage: m.StatusMessage,
+		IpAddresses:   m.IPAddresses,
+		Zone:          m.Hardware.Zone,
+	}
+}
+
+// Status returns the machine allocated for the request, or every allocated
+// machine.
+func (s *Server) Status(ctx context.Context, request *pb.StatusMsg) (*pb.StatusReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reply := &pb.StatusReply{}
+	if request.ProviderId == "" && request.SystemId == "" {
+		for _, m := range s.machines {
+			if m.Status != pb.MachineStatus_STATUS_UNSPECIFIED {
+				reply.Machines = append(reply.Machines, s.machine(m))
+			}
+		}
+		return reply, nil
+	}
+	m := s.find(request.ProviderId, request.SystemId)
+	if m == nil || m.Status == pb.MachineStatus_STATUS_UNSPECIFIED {
+		return nil, status.Error(codes.NotFound, "machine not found")
+	}
+	reply.Machines = append(reply.Machines, s.machine(m))
+	return reply, nil
+}
+
+// ListImages returns Images.
+func (s *Server) ListImages(ctx context.Context, request *pb.ListImagesMsg) (*pb.ListImagesReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.ListImagesReply{Images: s.Images}, nil
+}
+
+// Capacity returns the free machines, every machine and the zones of the
+// inventory.
+func (s *Server) Capacity(ctx context.Context, request *pb.CapacityMsg) (*pb.CapacityReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reply := &pb.CapacityReply{}
+	zones := map[string]bool{}
+	for _, m := range s.machines {
+		hardware := m.Hardware
+		host := &pb.Host{Hardware: &hardware, Status: "Ready", PowerState: "off"}
+		if m.Status == pb.MachineStatus_STATUS_UNSPECIFIED {
+			reply.Available = append(reply.Available, &hardware)
+		} else {
+			host.Status = pb.MachineStatus_name[int32(s.machine(m).Status)]
+			host.PowerState = "on"
+			host.ProviderId = m.ProviderID
+			host.Owner = "cma-ssh"
+		}
+		reply.Hosts = append(reply.Hosts, host)
+		if hardware.Zone != "" && !zones[hardware.Zone] {
+			zones[hardware.Zone] = true
+			reply.Zones = append(reply.Zones, hardware.Zone)
+		}
+	}
+	sort.Strings(reply.Zones)
+	return reply, nil
+}
@@ -0,0 +1,430 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redfish deploys servers through the Redfish API of their BMC instead
+// of MAAS.
+package redfish
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+)
+
+// Reset types of the ComputerSystem.Reset action.
+const (
+	ResetOn           = "On"
+	ResetForceOff     = "ForceOff"
+	ResetForceRestart = "ForceRestart"
+)
+
+// PowerStateOn is the power state of a system which is on.
+const PowerStateOn = "On"
+
+// RequestTimeout is the deadline of a request to a BMC.
+const RequestTimeout = 30 * time.Second
+
+// Config configures how to reach the Redfish API of a BMC.
+type Config struct {
+	// Endpoint is the url of the BMC, e.g. https://10.0.0.5.
+	Endpoint string
+	// SystemPath is the path of the system, e.g. /redfish/v1/Systems/1.
+	// The only system of the BMC is used if it is empty.
+	SystemPath         string
+	Username           string
+	Password           string
+	InsecureSkipVerify bool
+}
+
+// BMC drives a server through its BMC.
+type BMC interface {
+	// Inventory reads the hardware and power state of the system.
+	Inventory(ctx context.Context) (*clusterv1alpha1.RedfishInventory, error)
+	// SetBoot makes the system boot once with the method. The iso at
+	// imageURL is inserted as virtual media for RedfishBootVirtualMedia.
+	SetBoot(ctx context.Context, method clusterv1alpha1.RedfishBootMethod, imageURL string) error
+	// EjectMedia ejects the inserted virtual media, if any.
+	EjectMedia(ctx context.Context) error
+	// Reset powers the system with a reset type, e.g. ResetForceOff.
+	Reset(ctx context.Context, resetType string) error
+	// SetAssetTag sets the asset tag of the system, which the BMC exposes
+	// to the system through SMBIOS.
+	SetAssetTag(ctx context.Context, tag string) error
+}
+
+// DialFunc returns the BMC of config.
+type DialFunc func(config *Config) (BMC, error)
+
+// Client is a BMC calling the Redfish API.
+type Client struct {
+	config *Config
+	http   *http.Client
+}
+
+var _ BMC = &Client{}
+
+// Dial returns a Client of the BMC. No request is made until the client is
+// used.
+func Dial(config *Config) (BMC, error) {
+	if !strings.HasPrefix(config.Endpoint, "http://") && !strings.HasPrefix(config.Endpoint, "https://") {
+		return nil, fmt.Errorf("invalid redfish endpoint %q, want an http or https url", config.Endpoint)
+	}
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		TLSClientConfig:     &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify},
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	return &Client{config: config, http: &http.Client{Transport: transport, Timeout: RequestTimeout}}, nil
+}
+
+// odataID is a link to another resource.
+type odataID struct {
+	ID string `json:"@odata.id"`
+}
+
+type collection struct {
+	Members []odataID `json:"Members"`
+}
+
+type action struct {
+	Target string `json:"target"`
+}
+
+type system struct {
+	UUID             string
+	Manufacturer     string
+	Model            string
+	PowerState       string
+	ProcessorSummary struct {
+		Count int
+	}
+	MemorySummary struct {
+		TotalSystemMemoryGiB float64
+	}
+	EthernetInterfaces *odataID
+	SimpleStorage      *odataID
+	Links              struct {
+		ManagedBy []odataID
+	}
+	Actions struct {
+		Reset action `json:"#ComputerSystem.Reset"`
+	}
+}
+
+type ethernetInterface struct {
+	ID            string `json:"Id"`
+	Name          string
+	MACAddress    string
+	IPv4Addresses []struct {
+		Address string
+	}
+}
+
+type simpleStorage struct {
+	Devices []struct {
+		Name          string
+		CapacityBytes int64
+	}
+}
+
+type manager struct {
+	VirtualMedia *odataID
+}
+
+type virtualMedia struct {
+	MediaTypes []string
+	Inserted   bool
+	Image      string
+	Actions    struct {
+		InsertMedia action `json:"#VirtualMedia.InsertMedia"`
+		EjectMedia  action `json:"#VirtualMedia.EjectMedia"`
+	}
+}
+
+// do sends a request with a json body, if any, and decodes the json response
+// into out, if not nil. It returns the ETag of the response.
+func (c *Client) do(ctx context.Context, method, path string, header http.Header, body, out interface{}) (string, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return "", err
+		}
+		reader = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, strings.TrimSuffix(c.config.Endpoint, "/")+path, reader)
+	if err != nil {
+		return "", err
+	}
+	request = request.WithContext(ctx)
+	for key, values := range header {
+		request.Header[key] = values
+	}
+	request.SetBasicAuth(c.config.Username, c.config.Password)
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	response, err := c.http.Do(request)
+	if err != nil {
+		return "", errors.Wrapf(err, "redfish %s %s failed", method, path)
+	}
+	defer response.Body.Close()
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read redfish %s %s response", method, path)
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return "", fmt.Errorf("redfish %s %s failed with %s: %s", method, path, response.Status, strings.TrimSpace(string(data)))
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return "", errors.Wrapf(err, "could not decode redfish %s %s response", method, path)
+		}
+	}
+	return response.Header.Get("ETag"), nil
+}
+
+func (c *Client) get(ctx context.Context, path string, out interface{}) (string, error) {
+	return c.do(ctx, http.MethodGet, path, nil, nil, out)
+}
+
+func (c *Client) post(ctx context.Context, path string, body interface{}) error {
+	_, err := c.do(ctx, http.MethodPost, path, nil, body, nil)
+	return err
+}
+
+// systemPath returns the path of the configured system or of the only system
+// of the BMC.
+func (c *Client) systemPath(ctx context.Context) (string, error) {
+	if c.config.SystemPath != "" {
+		return c.config.SystemPath, nil
+	}
+	var systems collection
+	if _, err := c.get(ctx, "/redfish/v1/Systems", &systems); err != nil {
+		return "", err
+	}
+	if len(systems.Members) != 1 {
+		return "", fmt.Errorf("bmc %s has %d systems, set the system path", c.config.Endpoint, len(systems.Members))
+	}
+	return systems.Members[0].ID, nil
+}
+
+func (c *Client) system(ctx context.Context) (string, *system, string, error) {
+	path, err := c.systemPath(ctx)
+	if err != nil {
+		return "", nil, "", err
+	}
+	var s system
+	etag, err := c.get(ctx, path, &s)
+	if err != nil {
+		return "", nil, "", err
+	}
+	return path, &s, etag, nil
+}
+
+// Inventory reads the system, its ethernet interfaces and simple storage.
+func (c *Client) Inventory(ctx context.Context) (*clusterv1alpha1.RedfishInventory, error) {
+	_, s, _, err := c.system(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inventory := &clusterv1alpha1.RedfishInventory{
+		UUID:         s.UUID,
+		Manufacturer: s.Manufacturer,
+		Model:        s.Model,
+		PowerState:   s.PowerState,
+		CPUCount:     s.ProcessorSummary.Count,
+		Memory:       int(s.MemorySummary.TotalSystemMemoryGiB * 1024),
+	}
+	if s.EthernetInterfaces != nil {
+		var interfaces collection
+		if _, err := c.get(ctx, s.EthernetInterfaces.ID, &interfaces); err != nil {
+			return nil, err
+		}
+		for _, member := range interfaces.Members {
+			var i ethernetInterface
+			if _, err := c.get(ctx, member.ID, &i); err != nil {
+				return nil, err
+			}
+			name := i.ID
+			if name == "" {
+				name = i.Name
+			}
+			nic := clusterv1alpha1.RedfishNIC{Name: name, MACAddress: strings.ToLower(i.MACAddress)}
+			for _, a := range i.IPv4Addresses {
+				if a.Address != "" {
+					nic.IPAddresses = append(nic.IPAddresses, a.Address)
+				}
+			}
+			inventory.NICs = append(inventory.NICs, nic)
+		}
+	}
+	if s.SimpleStorage != nil {
+		var controllers collection
+		if _, err := c.get(ctx, s.SimpleStorage.ID, &controllers); err != nil {
+			return nil, err
+		}
+		for _, member := range controllers.Members {
+			var storage simpleStorage
+			if _, err := c.get(ctx, member.ID, &storage); err != nil {
+				return nil, err
+			}
+			for _, d := range storage.Devices {
+				inventory.Disks = append(inventory.Disks, clusterv1alpha1.RedfishDisk{Name: d.Name, Size: int(d.CapacityBytes / 1000000000)})
+			}
+		}
+	}
+	return inventory, nil
+}
+
+// cd returns the path and state of the virtual cd of the manager of the
+// system.
+func (c *Client) cd(ctx context.Context, s *system) (string, *virtualMedia, error) {
+	if len(s.Links.ManagedBy) == 0 {
+		return "", nil, fmt.Errorf("bmc %s does not link the manager of the system", c.config.Endpoint)
+	}
+	var m manager
+	if _, err := c.get(ctx, s.Links.ManagedBy[0].ID, &m); err != nil {
+		return "", nil, err
+	}
+	if m.VirtualMedia == nil {
+		return "", nil, fmt.Errorf("bmc %s has no virtual media", c.config.Endpoint)
+	}
+	var media collection
+	if _, err := c.get(ctx, m.VirtualMedia.ID, &media); err != nil {
+		return "", nil, err
+	}
+	for _, member := range media.Members {
+		var v virtualMedia
+		if _, err := c.get(ctx, member.ID, &v); err != nil {
+			return "", nil, err
+		}
+		for _, t := range v.MediaTypes {
+			if t == "CD" || t == "DVD" {
+				return member.ID, &v, nil
+			}
+		}
+	}
+	return "", nil, fmt.Errorf("bmc %s has no virtual cd", c.config.Endpoint)
+}
+
+func actionTarget(a action, path, name string) string {
+	if a.Target != "" {
+		return a.Target
+	}
+	return path + "/Actions/" + name
+}
+
+func (c *Client) eject(ctx context.Context, path string, v *virtualMedia) error {
+	if !v.Inserted {
+		return nil
+	}
+	return c.post(ctx, actionTarget(v.Actions.EjectMedia, path, "VirtualMedia.EjectMedia"), map[string]interface{}{})
+}
+
+// SetBoot inserts the image as virtual cd, replacing the inserted one, and
+// overrides the boot source of the next boot.
+func (c *Client) SetBoot(ctx context.Context, method clusterv1alpha1.RedfishBootMethod, imageURL string) error {
+	path, s, etag, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+	target := "Pxe"
+	if method == "" || method == clusterv1alpha1.RedfishBootVirtualMedia {
+		if imageURL == "" {
+			return fmt.Errorf("no image url to insert as virtual media")
+		}
+		target = "Cd"
+		mediaPath, v, err := c.cd(ctx, s)
+		if err != nil {
+			return err
+		}
+		if v.Image != imageURL || !v.Inserted {
+			if err := c.eject(ctx, mediaPath, v); err != nil {
+				return err
+			}
+			err = c.post(ctx, actionTarget(v.Actions.InsertMedia, mediaPath, "VirtualMedia.InsertMedia"), map[string]interface{}{
+				"Image":          imageURL,
+				"Inserted":       true,
+				"WriteProtected": true,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	} else if method != clusterv1alpha1.RedfishBootPxe {
+		return fmt.Errorf("unknown boot method %q", method)
+	}
+	header := http.Header{}
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+	_, err = c.do(ctx, http.MethodPatch, path, header, map[string]interface{}{
+		"Boot": map[string]string{
+			"BootSourceOverrideEnabled": "Once",
+			"BootSourceOverrideTarget":  target,
+		},
+	}, nil)
+	return err
+}
+
+// EjectMedia ejects the virtual cd if an image is inserted.
+func (c *Client) EjectMedia(ctx context.Context) error {
+	_, s, _, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+	path, v, err := c.cd(ctx, s)
+	if err != nil {
+		return err
+	}
+	return c.eject(ctx, path, v)
+}
+
+// Reset sends the ComputerSystem.Reset action.
+func (c *Client) Reset(ctx context.Context, resetType string) error {
+	path, s, _, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+	return c.post(ctx, actionTarget(s.Actions.Reset, path, "ComputerSystem.Reset"), map[string]string{"ResetType": resetType})
+}
+
+// SetAssetTag patches the AssetTag of the system.
+func (c *Client) SetAssetTag(ctx context.Context, tag string) error {
+	path, _, etag, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+	header := http.Header{}
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+	_, err = c.do(ctx, http.MethodPatch, path, header, map[string]string{"AssetTag": tag}, nil)
+	return err
+}
@@ -0,0 +1,224 @@
+package redfish
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+)
+
+// testBMC emulates the resources of a sushy-tools system.
+type testBMC struct {
+	mu       sync.Mutex
+	requests []string
+	boot     map[string]interface{}
+	image    string
+	assetTag string
+}
+
+func (b *testBMC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if user, password, _ := r.BasicAuth(); user != "admin" || password != "secret" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var body map[string]interface{}
+	if r.Body != nil && r.Method != http.MethodGet {
+		json.NewDecoder(r.Body).Decode(&body)
+	}
+	if r.Method != http.MethodGet {
+		b.requests = append(b.requests, r.Method+" "+r.URL.Path)
+	}
+
+	resources := map[string]interface{}{
+		"/redfish/v1/Systems": map[string]interface{}{
+			"Members": []interface{}{map[string]string{"@odata.id": "/redfish/v1/Systems/node-1"}},
+		},
+		"/redfish/v1/Systems/node-1": map[string]interface{}{
+			"UUID":               "0B2E3F4A-AAAA-BBBB-CCCC-000000000001",
+			"Manufacturer":       "Sushy Emulator",
+			"PowerState":         "Off",
+			"ProcessorSummary":   map[string]interface{}{"Count": 2},
+			"MemorySummary":      map[string]interface{}{"TotalSystemMemoryGiB": 1.5},
+			"EthernetInterfaces": map[string]string{"@odata.id": "/redfish/v1/Systems/node-1/EthernetInterfaces"},
+			"SimpleStorage":      map[string]string{"@odata.id": "/redfish/v1/Systems/node-1/SimpleStorage"},
+			"Links": map[string]interface{}{
+				"ManagedBy": []interface{}{map[string]string{"@odata.id": "/redfish/v1/Managers/bmc"}},
+			},
+			"Actions": map[string]interface{}{
+				"#ComputerSystem.Reset": map[string]string{"target": "/redfish/v1/Systems/node-1/Actions/ComputerSystem.Reset"},
+			},
+		},
+		"/redfish/v1/Systems/node-1/EthernetInterfaces": map[string]interface{}{
+			"Members": []interface{}{map[string]string{"@odata.id": "/redfish/v1/Systems/node-1/EthernetInterfaces/eth0"}},
+		},
+		"/redfish/v1/Systems/node-1/EthernetInterfaces/eth0": map[string]interface{}{
+			"Id":            "eth0",
+			"MACAddress":    "52:54:00:AA:BB:CC",
+			"IPv4Addresses": []interface{}{map[string]string{"Address": "10.0.0.31"}},
+		},
+		"/redfish/v1/Systems/node-1/SimpleStorage": map[string]interface{}{
+			"Members": []interface{}{map[string]string{"@odata.id": "/redfish/v1/Systems/node-1/SimpleStorage/1"}},
+		},
+		"/redfish/v1/Systems/node-1/SimpleStorage/1": map[string]interface{}{
+			"Devices": []interface{}{map[string]interface{}{"Name": "sda", "CapacityBytes": 100000000000}},
+		},
+		"/redfish/v1/Managers/bmc": map[string]interface{}{
+			"VirtualMedia": map[string]string{"@odata.id": "/redfish/v1/Managers/bmc/VirtualMedia"},
+		},
+		"/redfish/v1/Managers/bmc/VirtualMedia": map[string]interface{}{
+			"Members": []interface{}{
+				map[string]string{"@odata.id": "/redfish/v1/Managers/bmc/VirtualMedia/Floppy1"},
+				map[string]string{"@odata.id": "/redfish/v1/Managers/bmc/VirtualMedia/Cd"},
+			},
+		},
+		"/redfish/v1/Managers/bmc/VirtualMedia/Floppy1": map[string]interface{}{"MediaTypes": []string{"Floppy"}},
+		"/redfish/v1/Managers/bmc/VirtualMedia/Cd": map[string]interface{}{
+			"MediaTypes": []string{"CD", "DVD"},
+			"Image":      b.image,
+			"Inserted":   b.image != "",
+		},
+	}
+
+	switch r.Method + " " + r.URL.Path {
+	case "PATCH /redfish/v1/Systems/node-1":
+		if tag, ok := body["AssetTag"].(string); ok {
+			b.assetTag = tag
+		} else {
+			b.boot, _ = body["Boot"].(map[string]interface{})
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case "POST /redfish/v1/Managers/bmc/VirtualMedia/Cd/Actions/VirtualMedia.InsertMedia":
+		b.image, _ = body["Image"].(string)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case "POST /redfish/v1/Managers/bmc/VirtualMedia/Cd/Actions/VirtualMedia.EjectMedia":
+		b.image = ""
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case "POST /redfish/v1/Systems/node-1/Actions/ComputerSystem.Reset":
+		if body["ResetType"] != ResetOn {
+			http.Error(w, "unexpected reset type", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	resource, ok := resources[r.URL.Path]
+	if !ok || r.Method != http.MethodGet {
+		http.NotFound(w, r)
+		return
+	}
+	json.NewEncoder(w).Encode(resource)
+}
+
+func TestClient(t *testing.T) {
+	b := &testBMC{image: "http://images/old.iso"}
+	server := httptest.NewServer(b)
+	defer server.Close()
+	bmc, err := Dial(&Config{Endpoint: server.URL, Username: "admin", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	inventory, err := bmc.Inventory(ctx)
+	if err != nil {
+		t.Fatalf("Inventory() error = %v", err)
+	}
+	want := &clusterv1alpha1.RedfishInventory{
+		UUID:         "0B2E3F4A-AAAA-BBBB-CCCC-000000000001",
+		Manufacturer: "Sushy Emulator",
+		PowerState:   "Off",
+		CPUCount:     2,
+		Memory:       1536,
+		Disks:        []clusterv1alpha1.RedfishDisk{{Name: "sda", Size: 100}},
+		NICs:         []clusterv1alpha1.RedfishNIC{{Name: "eth0", MACAddress: "52:54:00:aa:bb:cc", IPAddresses: []string{"10.0.0.31"}}},
+	}
+	if !reflect.DeepEqual(inventory, want) {
+		t.Errorf("Inventory() = %+v, want %+v", inventory, want)
+	}
+
+	if err := bmc.SetAssetTag(ctx, "seed-token"); err != nil || b.assetTag != "seed-token" {
+		t.Fatalf("SetAssetTag() error = %v, asset tag = %q", err, b.assetTag)
+	}
+	if err := bmc.SetBoot(ctx, clusterv1alpha1.RedfishBootVirtualMedia, "http://images/ubuntu.iso"); err != nil {
+		t.Fatalf("SetBoot() error = %v", err)
+	}
+	if b.image != "http://images/ubuntu.iso" || b.boot["BootSourceOverrideTarget"] != "Cd" || b.boot["BootSourceOverrideEnabled"] != "Once" {
+		t.Errorf("after SetBoot() image = %q, boot = %v", b.image, b.boot)
+	}
+	if err := bmc.Reset(ctx, ResetOn); err != nil {
+		t.Errorf("Reset() error = %v", err)
+	}
+	if err := bmc.EjectMedia(ctx); err != nil || b.image != "" {
+		t.Errorf("EjectMedia() error = %v, image = %q", err, b.image)
+	}
+	wantRequests := []string{
+		"PATCH /redfish/v1/Systems/node-1",
+		"POST /redfish/v1/Managers/bmc/VirtualMedia/Cd/Actions/VirtualMedia.EjectMedia",
+		"POST /redfish/v1/Managers/bmc/VirtualMedia/Cd/Actions/VirtualMedia.InsertMedia",
+		"PATCH /redfish/v1/Systems/node-1",
+		"POST /redfish/v1/Systems/node-1/Actions/ComputerSystem.Reset",
+		"POST /redfish/v1/Managers/bmc/VirtualMedia/Cd/Actions/VirtualMedia.EjectMedia",
+	}
+	if !reflect.DeepEqual(b.requests, wantRequests) {
+		t.Errorf("requests = %q, want %q", b.requests, wantRequests)
+	}
+
+	if err := bmc.SetBoot(ctx, clusterv1alpha1.RedfishBootPxe, ""); err != nil || b.boot["BootSourceOverrideTarget"] != "Pxe" {
+		t.Errorf("SetBoot(Pxe) error = %v, boot = %v", err, b.boot)
+	}
+	if err := bmc.Reset(ctx, ResetForceRestart); err == nil {
+		t.Errorf("Reset() rejected by the bmc succeeded")
+	}
+
+	bmc, _ = Dial(&Config{Endpoint: server.URL, Username: "admin", Password: "wrong"})
+	if _, err := bmc.Inventory(ctx); err == nil {
+		t.Errorf("Inventory() with wrong credentials succeeded")
+	}
+}
+
+// TestDial_sushy drives a system of a Redfish emulator, e.g. the sushy-tools
+// container started by make test-redfish. It is skipped unless
+// CMA_REDFISH_TEST_ENDPOINT is set to the url of the emulator.
+func TestDial_sushy(t *testing.T) {
+	endpoint := os.Getenv("CMA_REDFISH_TEST_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("CMA_REDFISH_TEST_ENDPOINT is not set")
+	}
+	bmc, err := Dial(&Config{
+		Endpoint:   endpoint,
+		SystemPath: os.Getenv("CMA_REDFISH_TEST_SYSTEM"),
+		Username:   os.Getenv("CMA_REDFISH_TEST_USER"),
+		Password:   os.Getenv("CMA_REDFISH_TEST_PASSWORD"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if inventory, err := bmc.Inventory(ctx); err != nil || inventory.UUID == "" {
+		t.Fatalf("Inventory() = %+v, %v, want a system uuid", inventory, err)
+	}
+	if err := bmc.SetBoot(ctx, clusterv1alpha1.RedfishBootPxe, ""); err != nil {
+		t.Errorf("SetBoot() error = %v", err)
+	}
+	if err := bmc.Reset(ctx, ResetOn); err != nil {
+		t.Errorf("Reset(On) error = %v", err)
+	}
+	if inventory, err := bmc.Inventory(ctx); err != nil || inventory.PowerState != PowerStateOn {
+		t.Errorf("Inventory() after Reset(On) = %+v, %v, want the system on", inventory, err)
+	}
+	if err := bmc.Reset(ctx, ResetForceOff); err != nil {
+		t.Errorf("Reset(ForceOff) error = %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides in-memory Redfish BMCs for tests.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/redfish"
+)
+
+// BMC is a fake BMC. The actions sent to it are recorded.
+type BMC struct {
+	// Inventory is the hardware returned by Inventory. Its power state
+	// follows the resets.
+	Inventory clusterv1alpha1.RedfishInventory
+	// Error is returned by every call if it is set.
+	Error error
+
+	mu         sync.Mutex
+	actions    []string
+	config     redfish.Config
+	bootMethod clusterv1alpha1.RedfishBootMethod
+	media      string
+	assetTag   string
+}
+
+// Actions returns the actions sent to the BMC, e.g. "SetBoot Pxe" or
+// "Reset On".
+func (b *BMC) Actions() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.actions...)
+}
+
+// Config returns the config of the last connection to the BMC.
+func (b *BMC) Config() redfish.Config {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.config
+}
+
+// Boot returns the boot method set once and the inserted image.
+func (b *BMC) Boot() (clusterv1alpha1.RedfishBootMethod, string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.bootMethod, b.media
+}
+
+// AssetTag returns the asset tag of the system.
+func (b *BMC) AssetTag() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.assetTag
+}
+
+// PowerState returns the power state of the system.
+func (b *BMC) PowerState() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.Inventory.PowerState
+}
+
+// Dialer connects to fake BMCs by endpoint.
+type Dialer struct {
+	mu   sync.Mutex
+	bmcs map[string]*BMC
+}
+
+// New returns a dialer for the BMCs keyed by endpoint.
+func New(bmcs map[string]*BMC) *Dialer {
+	d := &Dialer{bmcs: map[string]*BMC{}}
+	for endpoint, b := range bmcs {
+		d.bmcs[endpoint] = b
+	}
+	return d
+}
+
+// Dial returns the BMC with the endpoint of the config.
+func (d *Dialer) Dial(config *redfish.Config) (redfish.BMC, error) {
+	d.mu.Lock()
+	b, ok := d.bmcs[config.Endpoint]
+	d.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("could not connect to %s: connection refused", config.Endpoint)
+	}
+	b.mu.Lock()
+	b.config = *config
+	b.mu.Unlock()
+	return &bmc{BMC: b}, nil
+}
+
+type bmc struct {
+	*BMC
+}
+
+func (b *bmc) record(action string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.Error != nil {
+		return b.Error
+	}
+	b.actions = append(b.actions, action)
+	return nil
+}
+
+func (b *bmc) Inventory(ctx context.Context) (*clusterv1alpha1.RedfishInventory, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.Error != nil {
+		return nil, b.Error
+	}
+	inventory := b.BMC.Inventory
+	return &inventory, nil
+}
+
+func (b *bmc) SetBoot(ctx context.Context, method clusterv1alpha1.RedfishBootMethod, imageURL string) error {
+	if method == "" {
+		method = clusterv1alpha1.RedfishBootVirtualMedia
+	}
+	if err := b.record("SetBoot " + string(method)); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bootMethod = method
+	if method == clusterv1alpha1.RedfishBootVirtualMedia {
+		b.media = imageURL
+	}
+	return nil
+}
+
+func (b *bmc) EjectMedia(ctx context.Context) error {
+	if err := b.record("EjectMedia"); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.media = ""
+	return nil
+}
+
+func (b *bmc) Reset(ctx context.Context, resetType string) error {
+	if err := b.record("Reset " + resetType); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if resetType == redfish.ResetForceOff {
+		b.BMC.Inventory.PowerState = "Off"
+	} else {
+		b.BMC.Inventory.PowerState = redfish.PowerStateOn
+	}
+	return nil
+}
+
+func (b *bmc) SetAssetTag(ctx context.Context, tag string) error {
+	if err := b.record("SetAssetTag"); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.assetTag = tag
+	return nil
+}
+
+var _ redfish.DialFunc = (&Dialer{}).Dial
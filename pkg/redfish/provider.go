@@ -0,0 +1,553 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redfish
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+)
+
+const (
+	// UsernameKey is the key of the BMC username in a credentials secret.
+	UsernameKey = "username"
+	// PasswordKey is the key of the BMC password in a credentials secret.
+	PasswordKey = "password"
+
+	// userdataKey is the key of the userdata in a seed secret.
+	userdataKey = "userdata"
+	// tokenKey is the key of the token a host fetches its seed with in a
+	// seed secret.
+	tokenKey = "token"
+
+	// architecture is the architecture reported for hosts and images.
+	architecture = "amd64/generic"
+)
+
+// Provider is a maas.MachineProvider deploying the CnctRedfishHost servers of
+// a region. The allocation state of a host is kept in its status and the
+// userdata it is deployed with in a seed secret, served to the host by
+// SeedHandler when it boots.
+type Provider struct {
+	client client.Client
+	region string
+	spec   clusterv1alpha1.RedfishRegion
+	dial   DialFunc
+	now    func() time.Time
+}
+
+var _ maas.MachineProvider = &Provider{}
+
+// NewProvider returns the provider of the redfish region named region.
+func NewProvider(k8sClient client.Client, region string, spec clusterv1alpha1.RedfishRegion, dial DialFunc) *Provider {
+	return &Provider{client: k8sClient, region: region, spec: spec, dial: dial, now: time.Now}
+}
+
+// seedSecret returns the key of the secret holding the userdata of a host. It
+// is kept next to the credentials of the host.
+func seedSecret(host *clusterv1alpha1.CnctRedfishHost) client.ObjectKey {
+	return client.ObjectKey{Namespace: host.Spec.CredentialsSecret.Namespace, Name: "redfish-seed-" + host.Name}
+}
+
+// BMCConfig returns the config of the BMC of host with the credentials read
+// from its secret.
+func BMCConfig(ctx context.Context, k8sClient client.Client, host *clusterv1alpha1.CnctRedfishHost) (*Config, error) {
+	ref := host.Spec.CredentialsSecret
+	var secret corev1.Secret
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, &secret); err != nil {
+		return nil, errors.Wrapf(err, "could not get bmc credentials of redfish host %s", host.Name)
+	}
+	return &Config{
+		Endpoint:           host.Spec.Endpoint,
+		SystemPath:         host.Spec.SystemPath,
+		Username:           string(secret.Data[UsernameKey]),
+		Password:           string(secret.Data[PasswordKey]),
+		InsecureSkipVerify: host.Spec.InsecureSkipVerify,
+	}, nil
+}
+
+func (p *Provider) bmc(ctx context.Context, host *clusterv1alpha1.CnctRedfishHost) (BMC, error) {
+	config, err := BMCConfig(ctx, p.client, host)
+	if err != nil {
+		return nil, err
+	}
+	return p.dial(config)
+}
+
+// hosts returns the hosts of the region sorted by name.
+func (p *Provider) hosts(ctx context.Context) ([]clusterv1alpha1.CnctRedfishHost, error) {
+	var list clusterv1alpha1.CnctRedfishHostList
+	if err := p.client.List(ctx, &client.ListOptions{}, &list); err != nil {
+		return nil, errors.Wrap(err, "could not list redfish hosts")
+	}
+	var hosts []clusterv1alpha1.CnctRedfishHost
+	for _, host := range list.Items {
+		if host.Spec.Region == p.region {
+			hosts = append(hosts, host)
+		}
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].Name < hosts[j].Name })
+	return hosts, nil
+}
+
+// find returns the host allocated for the provider id or, if it is empty, the
+// allocated host named systemID.
+func (p *Provider) find(ctx context.Context, providerID, systemID string) (*clusterv1alpha1.CnctRedfishHost, error) {
+	hosts, err := p.hosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range hosts {
+		host := &hosts[i]
+		if host.Status.State == "" {
+			continue
+		}
+		if (providerID != "" && host.Status.ProviderID == providerID) || (providerID == "" && host.Name == systemID) {
+			return host, nil
+		}
+	}
+	return nil, nil
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether a free host can be allocated for the request. Hosts
+// whose inventory was not read yet are not used since they could not be
+// identified when fetching their seed.
+func matches(host *clusterv1alpha1.CnctRedfishHost, request *maas.CreateRequest) bool {
+	c := &request.Constraints
+	inventory := &host.Status.Inventory
+	switch {
+	case host.Status.State != "" || inventory.UUID == "":
+		return false
+	case request.InstanceType != "" && !hasTag(host.Spec.Tags, request.InstanceType):
+		return false
+	case inventory.CPUCount < c.MinCPUCount || inventory.Memory < c.MinMemory:
+		return false
+	case c.Zone != "" && host.Spec.Zone != c.Zone, c.Pool != "" && host.Spec.Pool != c.Pool:
+		return false
+	}
+	for _, tag := range c.Tags {
+		if !hasTag(host.Spec.Tags, tag) {
+			return false
+		}
+	}
+	for _, tag := range c.NotTags {
+		if hasTag(host.Spec.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Provider) image(distro string) (*clusterv1alpha1.RedfishImage, error) {
+	for i := range p.spec.Images {
+		if p.spec.Images[i].Name == distro {
+			return &p.spec.Images[i], nil
+		}
+	}
+	return nil, fmt.Errorf("redfish region %s has no image %s", p.region, distro)
+}
+
+// Create allocates a free host for the request's provider id, or adopts the
+// host already allocated for it, stores the userdata and boots the host with
+// the image of the request's distro.
+func (p *Provider) Create(ctx context.Context, request *maas.CreateRequest) (*maas.CreateResponse, error) {
+	if request.ProviderID == "" {
+		return nil, fmt.Errorf("error creating machine: providerID not set")
+	}
+	host, err := p.find(ctx, request.ProviderID, "")
+	if err != nil {
+		return nil, err
+	}
+	if host != nil && host.Status.State != clusterv1alpha1.RedfishHostAllocated {
+		return newCreateResponse(host), nil
+	}
+	image, err := p.image(request.Distro)
+	if err != nil {
+		return nil, err
+	}
+	if host == nil {
+		if host, err = p.allocate(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.deploy(ctx, host, image, request.Userdata); err != nil {
+		p.release(ctx, host)
+		return nil, err
+	}
+	return newCreateResponse(host), nil
+}
+
+func (p *Provider) allocate(ctx context.Context, request *maas.CreateRequest) (*clusterv1alpha1.CnctRedfishHost, error) {
+	hosts, err := p.hosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range hosts {
+		host := &hosts[i]
+		if !matches(host, request) {
+			continue
+		}
+		host.Status.State = clusterv1alpha1.RedfishHostAllocated
+		host.Status.ProviderID = request.ProviderID
+		host.Status.LastUpdated = &metav1.Time{Time: p.now()}
+		if err := p.client.Update(ctx, host); err != nil {
+			return nil, errors.Wrapf(err, "could not allocate redfish host %s", host.Name)
+		}
+		klog.Infof("allocated redfish host %s for %s", host.Name, request.ProviderID)
+		return host, nil
+	}
+	return nil, fmt.Errorf("error allocating machine %s: no redfish host available in region %s", request.ProviderID, p.region)
+}
+
+// deploy stores the userdata in the seed secret with a new token, sets the
+// token as asset tag of the host, boots the host once from the image and
+// powers it on.
+func (p *Provider) deploy(ctx context.Context, host *clusterv1alpha1.CnctRedfishHost, image *clusterv1alpha1.RedfishImage, userdata string) error {
+	tokenBuf := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, tokenBuf); err != nil {
+		return errors.Wrap(err, "could not create seed token")
+	}
+	token := fmt.Sprintf("%x", tokenBuf)
+	key := seedSecret(host)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Data:       map[string][]byte{userdataKey: []byte(userdata), tokenKey: []byte(token)},
+	}
+	err := p.client.Create(ctx, secret)
+	if apierrors.IsAlreadyExists(err) {
+		err = p.client.Update(ctx, secret)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not store the userdata of redfish host %s", host.Name)
+	}
+
+	bmc, err := p.bmc(ctx, host)
+	if err != nil {
+		return err
+	}
+	if err := bmc.SetAssetTag(ctx, token); err != nil {
+		return errors.Wrapf(err, "could not set the seed token of redfish host %s", host.Name)
+	}
+	if err := bmc.SetBoot(ctx, host.Spec.BootMethod, image.URL); err != nil {
+		return errors.Wrapf(err, "could not set the boot of redfish host %s", host.Name)
+	}
+	reset := ResetOn
+	if host.Status.Inventory.PowerState == PowerStateOn {
+		reset = ResetForceRestart
+	}
+	if err := bmc.Reset(ctx, reset); err != nil {
+		return errors.Wrapf(err, "could not power on redfish host %s", host.Name)
+	}
+
+	host.Status.State = clusterv1alpha1.RedfishHostDeploying
+	host.Status.Distro = image.Name
+	host.Status.DeployStarted = &metav1.Time{Time: p.now()}
+	host.Status.SeedFetched = nil
+	host.Status.IPAddress = ""
+	host.Status.Inventory.PowerState = PowerStateOn
+	host.Status.LastUpdated = host.Status.DeployStarted
+	if err := p.client.Update(ctx, host); err != nil {
+		return errors.Wrapf(err, "could not update redfish host %s", host.Name)
+	}
+	klog.Infof("deploying redfish host %s with %s", host.Name, image.Name)
+	return nil
+}
+
+func newCreateResponse(host *clusterv1alpha1.CnctRedfishHost) *maas.CreateResponse {
+	response := &maas.CreateResponse{
+		ProviderID:  host.Status.ProviderID,
+		SystemID:    host.Name,
+		Hostname:    host.Name,
+		Zone:        host.Spec.Zone,
+		IPAddresses: ipAddresses(host),
+	}
+	for _, d := range host.Status.Inventory.Disks {
+		response.BlockDevices = append(response.BlockDevices, maas.BlockDevice{Name: d.Name, Size: d.Size})
+	}
+	return response
+}
+
+func ipAddresses(host *clusterv1alpha1.CnctRedfishHost) []string {
+	if host.Spec.IPAddress != "" {
+		return []string{host.Spec.IPAddress}
+	}
+	if host.Status.IPAddress != "" {
+		return []string{host.Status.IPAddress}
+	}
+	return nil
+}
+
+// release powers a host off, ejects its image and frees it. Failures to
+// reach the BMC are returned after the host is freed, so that it is not
+// leaked, since the host is booted again when it is next allocated.
+func (p *Provider) release(ctx context.Context, host *clusterv1alpha1.CnctRedfishHost) error {
+	var bmcErr error
+	bmc, err := p.bmc(ctx, host)
+	if err == nil {
+		err = bmc.Reset(ctx, ResetForceOff)
+	}
+	if err == nil && (host.Spec.BootMethod == "" || host.Spec.BootMethod == clusterv1alpha1.RedfishBootVirtualMedia) {
+		err = bmc.EjectMedia(ctx)
+	}
+	if err != nil {
+		bmcErr = errors.Wrapf(err, "could not power off redfish host %s", host.Name)
+		klog.Warning(bmcErr)
+	} else {
+		host.Status.Inventory.PowerState = "Off"
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: seedSecret(host).Namespace, Name: seedSecret(host).Name}}
+	if err := p.client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "could not delete the userdata of redfish host %s", host.Name)
+	}
+
+	host.Status.State = ""
+	host.Status.ProviderID = ""
+	host.Status.Distro = ""
+	host.Status.DeployStarted = nil
+	host.Status.SeedFetched = nil
+	host.Status.IPAddress = ""
+	host.Status.LastUpdated = &metav1.Time{Time: p.now()}
+	if err := p.client.Update(ctx, host); err != nil {
+		return errors.Wrapf(err, "could not release redfish host %s", host.Name)
+	}
+	klog.Infof("released redfish host %s", host.Name)
+	return bmcErr
+}
+
+// Delete powers off and frees the host allocated for the request.
+func (p *Provider) Delete(ctx context.Context, request *maas.DeleteRequest) error {
+	if request.ProviderID == "" && request.SystemID == "" {
+		return fmt.Errorf("machine has not been created")
+	}
+	host, err := p.find(ctx, request.ProviderID, request.SystemID)
+	if err != nil {
+		return err
+	}
+	if host == nil {
+		klog.Infof("no redfish host allocated for %s, nothing to release", request.ProviderID)
+		return nil
+	}
+	return p.release(ctx, host)
+}
+
+// Update sends the power action of the request. Redfish hosts are named after
+// their CnctRedfishHost and have no tags, so the other fields are ignored.
+func (p *Provider) Update(ctx context.Context, request *maas.UpdateRequest) error {
+	if request.Power == "" {
+		return nil
+	}
+	host, err := p.find(ctx, request.ProviderID, request.SystemID)
+	if err != nil {
+		return err
+	}
+	if host == nil {
+		return maas.ErrMachineNotFound
+	}
+	resets := map[maas.PowerAction]string{
+		maas.PowerCycle: ResetForceRestart,
+		maas.PowerOff:   ResetForceOff,
+		maas.PowerOn:    ResetOn,
+	}
+	reset, ok := resets[request.Power]
+	if !ok {
+		return fmt.Errorf("unknown power action %q", request.Power)
+	}
+	bmc, err := p.bmc(ctx, host)
+	if err != nil {
+		return err
+	}
+	return bmc.Reset(ctx, reset)
+}
+
+func newMachine(host *clusterv1alpha1.CnctRedfishHost) maas.Machine {
+	status := maas.StatusAllocated
+	switch host.Status.State {
+	case clusterv1alpha1.RedfishHostDeploying:
+		status = maas.StatusDeploying
+	case clusterv1alpha1.RedfishHostDeployed:
+		status = maas.StatusDeployed
+	}
+	return maas.Machine{
+		ProviderID:  host.Status.ProviderID,
+		SystemID:    host.Name,
+		Hostname:    host.Name,
+		Status:      status,
+		IPAddresses: ipAddresses(host),
+		Zone:        host.Spec.Zone,
+	}
+}
+
+// Status returns the host allocated for the request. It is deployed once it
+// has fetched its cloud-init seed.
+func (p *Provider) Status(ctx context.Context, request *maas.StatusRequest) (*maas.Machine, error) {
+	host, err := p.find(ctx, request.ProviderID, request.SystemID)
+	if err != nil {
+		return nil, err
+	}
+	if host == nil {
+		return nil, maas.ErrMachineNotFound
+	}
+	m := newMachine(host)
+	return &m, nil
+}
+
+// List returns the allocated hosts.
+func (p *Provider) List(ctx context.Context) ([]maas.Machine, error) {
+	hosts, err := p.hosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var machines []maas.Machine
+	for i := range hosts {
+		if hosts[i].Status.State != "" {
+			machines = append(machines, newMachine(&hosts[i]))
+		}
+	}
+	return machines, nil
+}
+
+func newHardware(host *clusterv1alpha1.CnctRedfishHost) maas.Hardware {
+	hardware := maas.Hardware{
+		SystemID:     host.Name,
+		Hostname:     host.Name,
+		Zone:         host.Spec.Zone,
+		Pool:         host.Spec.Pool,
+		Architecture: architecture,
+		Tags:         host.Spec.Tags,
+		CPUCount:     host.Status.Inventory.CPUCount,
+		Memory:       host.Status.Inventory.Memory,
+	}
+	for _, d := range host.Status.Inventory.Disks {
+		hardware.Disks = append(hardware.Disks, maas.Disk{Name: d.Name, Size: d.Size})
+	}
+	return hardware
+}
+
+// Available returns the free hosts whose inventory was read.
+func (p *Provider) Available(ctx context.Context) ([]maas.Hardware, error) {
+	hosts, err := p.hosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var hardware []maas.Hardware
+	for i := range hosts {
+		if hosts[i].Status.State == "" && hosts[i].Status.Inventory.UUID != "" {
+			hardware = append(hardware, newHardware(&hosts[i]))
+		}
+	}
+	return hardware, nil
+}
+
+// Hosts returns every host of the region.
+func (p *Provider) Hosts(ctx context.Context) ([]maas.Host, error) {
+	hosts, err := p.hosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []maas.Host
+	for i := range hosts {
+		host := &hosts[i]
+		h := maas.Host{
+			Hardware:   newHardware(host),
+			Status:     "Ready",
+			PowerState: strings.ToLower(host.Status.Inventory.PowerState),
+			ProviderID: host.Status.ProviderID,
+		}
+		if host.Status.State != "" {
+			h.Status = newMachine(host).Status
+			h.Owner = "cma-ssh"
+		}
+		for _, nic := range host.Status.Inventory.NICs {
+			h.NICs = append(h.NICs, maas.NIC{Name: nic.Name, MACAddress: nic.MACAddress})
+		}
+		result = append(result, h)
+	}
+	return result, nil
+}
+
+// ListImages returns the images listed in the spec of the region. The hosts
+// boot them from their url, nothing is uploaded, so they have no upload date.
+func (p *Provider) ListImages(ctx context.Context) ([]maas.BootResource, error) {
+	var images []maas.BootResource
+	for i, image := range p.spec.Images {
+		images = append(images, maas.BootResource{
+			ID:           i + 1,
+			Name:         image.Name,
+			Type:         maas.BootResourceUploaded,
+			Architecture: architecture,
+		})
+	}
+	return images, nil
+}
+
+// Zones returns the zones of the hosts of the region.
+func (p *Provider) Zones(ctx context.Context) ([]string, error) {
+	hosts, err := p.hosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var zones []string
+	for _, host := range hosts {
+		if host.Spec.Zone != "" && !seen[host.Spec.Zone] {
+			seen[host.Spec.Zone] = true
+			zones = append(zones, host.Spec.Zone)
+		}
+	}
+	sort.Strings(zones)
+	return zones, nil
+}
+
+// UploadImage is not supported, images are served from their url.
+func (p *Provider) UploadImage(ctx context.Context, request *maas.UploadImageRequest, progress func(uploaded int64)) (*maas.BootResource, error) {
+	return nil, maas.ErrNotSupported
+}
+
+// ImageState is not supported, images are served from their url.
+func (p *Provider) ImageState(ctx context.Context, id int) (*maas.ImageState, error) {
+	return nil, maas.ErrNotSupported
+}
+
+// DeleteImage is not supported, images are served from their url.
+func (p *Provider) DeleteImage(ctx context.Context, id int) error {
+	return maas.ErrNotSupported
+}
@@ -0,0 +1,201 @@
+package redfish_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+	"github.com/samsung-cnct/cma-ssh/pkg/maas"
+	"github.com/samsung-cnct/cma-ssh/pkg/redfish"
+	"github.com/samsung-cnct/cma-ssh/pkg/redfish/fake"
+	"github.com/samsung-cnct/cma-ssh/pkg/util/fakeclient"
+)
+
+const image = "os=ubuntu-xenial,k8s=1.13.5,standard"
+
+func testHost(name, uuid, instanceType string) *clusterv1alpha1.CnctRedfishHost {
+	return &clusterv1alpha1.CnctRedfishHost{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: clusterv1alpha1.RedfishHostSpec{
+			Endpoint:          "https://bmc-" + name,
+			CredentialsSecret: clusterv1alpha1.RedfishCredentialsSecret{Name: "bmc", Namespace: "cma-ssh"},
+			Region:            "lab",
+			Tags:              []string{instanceType},
+			Zone:              "rack-1",
+		},
+		Status: clusterv1alpha1.RedfishHostStatus{
+			Inventory: clusterv1alpha1.RedfishInventory{
+				UUID:       uuid,
+				PowerState: "Off",
+				CPUCount:   8,
+				Memory:     16384,
+				NICs:       []clusterv1alpha1.RedfishNIC{{Name: "eth0", IPAddresses: []string{"127.0.0.1"}}},
+			},
+		},
+	}
+}
+
+func testProvider(t *testing.T) (*redfish.Provider, client.Client, map[string]*fake.BMC) {
+	k8sClient := fakeclient.New(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "bmc", Namespace: "cma-ssh"},
+			Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+		},
+		testHost("server-1", "AAAA-0001", "standard"),
+		testHost("server-2", "AAAA-0002", "large"),
+		testHost("server-3", "", "standard"),
+	)
+	bmcs := map[string]*fake.BMC{
+		"https://bmc-server-1": {},
+		"https://bmc-server-2": {},
+		"https://bmc-server-3": {},
+	}
+	region := clusterv1alpha1.RedfishRegion{Images: []clusterv1alpha1.RedfishImage{{Name: image, URL: "http://images/xenial.iso"}}}
+	return redfish.NewProvider(k8sClient, "lab", region, fake.New(bmcs).Dial), k8sClient, bmcs
+}
+
+func TestProvider(t *testing.T) {
+	provider, k8sClient, bmcs := testProvider(t)
+	ctx := context.Background()
+
+	response, err := provider.Create(ctx, &maas.CreateRequest{
+		ProviderID:   "provider-1",
+		InstanceType: "standard",
+		Distro:       image,
+		Userdata:     "#cloud-config\n",
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if response.SystemID != "server-1" || response.Zone != "rack-1" || response.ProviderID != "provider-1" {
+		t.Errorf("Create() = %+v, want server-1", response)
+	}
+	bmc := bmcs["https://bmc-server-1"]
+	if actions := bmc.Actions(); !reflect.DeepEqual(actions, []string{"SetAssetTag", "SetBoot VirtualMedia", "Reset On"}) {
+		t.Errorf("bmc actions = %q", actions)
+	}
+	if _, media := bmc.Boot(); media != "http://images/xenial.iso" {
+		t.Errorf("inserted media = %q", media)
+	}
+	if again, err := provider.Create(ctx, &maas.CreateRequest{ProviderID: "provider-1", InstanceType: "standard", Distro: image}); err != nil || again.SystemID != "server-1" {
+		t.Errorf("Create() of an allocated machine = %+v, %v, want server-1 adopted", again, err)
+	}
+	if _, err := provider.Create(ctx, &maas.CreateRequest{ProviderID: "provider-2", InstanceType: "standard", Distro: image}); err == nil {
+		t.Errorf("Create() with no host available succeeded, server-3 has no inventory")
+	}
+	if status, err := provider.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"}); err != nil || status.Status != maas.StatusDeploying {
+		t.Errorf("Status() = %+v, %v, want a deploying machine", status, err)
+	}
+
+	token := bmc.AssetTag()
+	if len(token) != 32 {
+		t.Fatalf("asset tag = %q, want a seed token", token)
+	}
+	seed := httptest.NewServer(redfish.NewSeedHandler(k8sClient))
+	defer seed.Close()
+	if resp, err := http.Get(seed.URL + redfish.SeedPath + "aaaa-0001/wrong-token/user-data"); err != nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("user-data with a wrong token = %v, %v, want 403", resp, err)
+	}
+	if status, err := provider.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"}); err != nil || status.Status != maas.StatusDeploying {
+		t.Errorf("Status() after a refused seed = %+v, %v, want a deploying machine", status, err)
+	}
+	seedURL := seed.URL + redfish.SeedPath + "aaaa-0001/" + token + "/"
+	if body := get(t, seedURL+"meta-data"); body != "instance-id: provider-1\nlocal-hostname: server-1\n" {
+		t.Errorf("meta-data = %q", body)
+	}
+	if body := get(t, seedURL+"user-data"); body != "#cloud-config\n" {
+		t.Errorf("user-data = %q", body)
+	}
+	status, err := provider.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"})
+	if err != nil || status.Status != maas.StatusDeployed || !reflect.DeepEqual(status.IPAddresses, []string{"127.0.0.1"}) {
+		t.Errorf("Status() after the seed was fetched = %+v, %v, want deployed at 127.0.0.1", status, err)
+	}
+	if resp, err := http.Get(seedURL + "user-data"); err != nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("user-data fetched again = %v, %v, want 404", resp, err)
+	}
+	if resp, err := http.Get(seed.URL + redfish.SeedPath + "aaaa-0002/" + token + "/user-data"); err != nil || resp.StatusCode != 404 {
+		t.Errorf("user-data of a free host = %v, %v, want 404", resp, err)
+	}
+
+	if available, err := provider.Available(ctx); err != nil || len(available) != 1 || available[0].SystemID != "server-2" {
+		t.Errorf("Available() = %+v, %v, want server-2", available, err)
+	}
+	if images, err := provider.ListImages(ctx); err != nil || len(images) != 1 || images[0].Type != maas.BootResourceUploaded {
+		t.Errorf("ListImages() = %+v, %v", images, err)
+	}
+	if err := provider.Update(ctx, &maas.UpdateRequest{ProviderID: "provider-1", Power: maas.PowerCycle}); err != nil {
+		t.Errorf("Update() error = %v", err)
+	}
+
+	if err := provider.Delete(ctx, &maas.DeleteRequest{ProviderID: "provider-1", SystemID: "server-1"}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if state := bmc.PowerState(); state != "Off" {
+		t.Errorf("power state of the released host = %q, want Off", state)
+	}
+	if _, err := provider.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"}); err != maas.ErrMachineNotFound {
+		t.Errorf("Status() of a released machine error = %v, want %v", err, maas.ErrMachineNotFound)
+	}
+	var secrets corev1.SecretList
+	if err := k8sClient.List(ctx, &client.ListOptions{Namespace: "cma-ssh"}, &secrets); err != nil || len(secrets.Items) != 1 {
+		t.Errorf("secrets after Delete() = %d, %v, want only the credentials", len(secrets.Items), err)
+	}
+}
+
+func TestSeedHandler_address(t *testing.T) {
+	tests := []struct {
+		name string
+		nics []clusterv1alpha1.RedfishNIC
+	}{
+		{name: "address of another host", nics: []clusterv1alpha1.RedfishNIC{{Name: "eth0", IPAddresses: []string{"10.0.0.31"}}}},
+		{name: "no address reported", nics: []clusterv1alpha1.RedfishNIC{{Name: "eth0"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, k8sClient, bmcs := testProvider(t)
+			ctx := context.Background()
+			var host clusterv1alpha1.CnctRedfishHost
+			if err := k8sClient.Get(ctx, client.ObjectKey{Name: "server-1"}, &host); err != nil {
+				t.Fatal(err)
+			}
+			host.Status.Inventory.NICs = tt.nics
+			if err := k8sClient.Update(ctx, &host); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := provider.Create(ctx, &maas.CreateRequest{ProviderID: "provider-1", InstanceType: "standard", Distro: image}); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			seed := httptest.NewServer(redfish.NewSeedHandler(k8sClient))
+			defer seed.Close()
+			token := bmcs["https://bmc-server-1"].AssetTag()
+			if resp, err := http.Get(seed.URL + redfish.SeedPath + "aaaa-0001/" + token + "/user-data"); err != nil || resp.StatusCode != http.StatusForbidden {
+				t.Errorf("user-data = %v, %v, want 403", resp, err)
+			}
+			if status, err := provider.Status(ctx, &maas.StatusRequest{ProviderID: "provider-1"}); err != nil || status.Status != maas.StatusDeploying || len(status.IPAddresses) != 0 {
+				t.Errorf("Status() = %+v, %v, want a deploying machine without address", status, err)
+			}
+		})
+	}
+}
+
+func get(t *testing.T, url string) string {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
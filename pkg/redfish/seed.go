@@ -0,0 +1,148 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redfish
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
+)
+
+// SeedPath is the path the cloud-init nocloud-net seeds of the hosts are
+// served under, as
+// SeedPath/<system uuid>/<token>/{meta-data,user-data,vendor-data}. The token
+// is created for every deploy and set as asset tag of the host.
+const SeedPath = "/redfish/seed/"
+
+type seedHandler struct {
+	client client.Client
+	now    func() time.Time
+}
+
+// NewSeedHandler returns the handler serving the cloud-init seeds of the
+// deploying hosts to the requests with their token. A host is deployed once
+// it fetched its user-data, after which its seed is no longer served.
+func NewSeedHandler(k8sClient client.Client) http.Handler {
+	return &seedHandler{client: k8sClient, now: time.Now}
+}
+
+// host returns the deploying host with the system uuid.
+func (h *seedHandler) host(ctx context.Context, uuid string) (*clusterv1alpha1.CnctRedfishHost, error) {
+	var list clusterv1alpha1.CnctRedfishHostList
+	if err := h.client.List(ctx, &client.ListOptions{}, &list); err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		host := &list.Items[i]
+		if !strings.EqualFold(host.Status.Inventory.UUID, uuid) {
+			continue
+		}
+		if host.Status.State == clusterv1alpha1.RedfishHostDeploying {
+			return host, nil
+		}
+	}
+	return nil, nil
+}
+
+func (h *seedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, SeedPath), "/")
+	if r.Method != http.MethodGet || len(parts) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+	uuid, token, file := parts[0], parts[1], parts[2]
+
+	host, err := h.host(r.Context(), uuid)
+	if err != nil {
+		klog.Errorf("could not list redfish hosts: %q", err)
+		http.Error(w, "could not list redfish hosts", http.StatusInternalServerError)
+		return
+	}
+	if host == nil {
+		http.NotFound(w, r)
+		return
+	}
+	var secret corev1.Secret
+	if err := h.client.Get(r.Context(), seedSecret(host), &secret); err != nil {
+		klog.Errorf("could not get the seed of redfish host %s: %q", host.Name, err)
+		http.Error(w, "could not get the seed", http.StatusInternalServerError)
+		return
+	}
+	remoteIP, _, _ := net.SplitHostPort(r.RemoteAddr)
+	want := secret.Data[tokenKey]
+	if len(want) == 0 || subtle.ConstantTimeCompare([]byte(token), want) != 1 {
+		klog.Warningf("refusing the seed of redfish host %s to %s: invalid token", host.Name, remoteIP)
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	if !seedAddress(host, remoteIP) {
+		klog.Warningf("refusing the seed of redfish host %s to %s: not an address of the host", host.Name, remoteIP)
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	switch file {
+	case "meta-data":
+		fmt.Fprintf(w, "instance-id: %s\nlocal-hostname: %s\n", host.Status.ProviderID, host.Name)
+	case "vendor-data":
+	case "user-data":
+		// Deploying the host invalidates the token before the user-data is
+		// written. Of concurrent fetches only the one that updates the host
+		// gets it, the others fail on the conflict.
+		host.Status.State = clusterv1alpha1.RedfishHostDeployed
+		host.Status.SeedFetched = &metav1.Time{Time: h.now()}
+		host.Status.LastUpdated = host.Status.SeedFetched
+		host.Status.IPAddress = remoteIP
+		if err := h.client.Update(r.Context(), host); err != nil {
+			klog.Errorf("could not update redfish host %s: %q", host.Name, err)
+			http.Error(w, "could not update the host", http.StatusInternalServerError)
+			return
+		}
+		klog.Infof("redfish host %s fetched its seed from %s", host.Name, remoteIP)
+		w.Write(secret.Data[userdataKey])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// seedAddress reports whether a host may fetch its seed from ip: its
+// ipAddress if it is set, or else one of the addresses the BMC reports for its
+// NICs. No address is accepted if the BMC reports none.
+func seedAddress(host *clusterv1alpha1.CnctRedfishHost, ip string) bool {
+	if host.Spec.IPAddress != "" {
+		return ip == host.Spec.IPAddress
+	}
+	for _, nic := range host.Status.Inventory.NICs {
+		for _, address := range nic.IPAddresses {
+			if address == ip {
+				return true
+			}
+		}
+	}
+	return false
+}
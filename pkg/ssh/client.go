@@ -0,0 +1,181 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ssh runs commands on pre-existing hosts over ssh.
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// DialTimeout is how long connecting and authenticating to a host may take.
+const DialTimeout = 30 * time.Second
+
+// Config describes how to connect to a host.
+type Config struct {
+	// Address is the ip address or hostname of the host.
+	Address string
+	// Port of the ssh server.
+	Port uint32
+	// Username to log in as.
+	Username string
+	// PrivateKey is the PEM encoded key to log in with.
+	PrivateKey []byte
+	// HostKey is the expected public key of the host in authorized_keys
+	// format.
+	HostKey string
+	// TrustOnFirstUse accepts any key if HostKey is empty, see
+	// Conn.HostKey. Dial fails without either of them.
+	TrustOnFirstUse bool
+}
+
+// Conn is a connection to a host.
+type Conn interface {
+	// Run runs a command with stdin, which may be nil, and returns its
+	// combined output. A command exiting with a non-zero status returns
+	// an error with the output.
+	Run(ctx context.Context, cmd string, stdin io.Reader) ([]byte, error)
+	// HostKey returns the public key presented by the host in
+	// authorized_keys format.
+	HostKey() string
+	// Close closes the connection.
+	Close() error
+}
+
+// Dialer connects to hosts. The machine controller reaches the CnctSshHost
+// servers through the Dialer of its reconciler, DefaultDialer in the
+// operator.
+type Dialer interface {
+	Dial(ctx context.Context, config *Config) (Conn, error)
+}
+
+// DialerFunc is a function implementing Dialer.
+type DialerFunc func(ctx context.Context, config *Config) (Conn, error)
+
+// Dial calls f.
+func (f DialerFunc) Dial(ctx context.Context, config *Config) (Conn, error) {
+	return f(ctx, config)
+}
+
+// DefaultDialer connects to hosts over the network.
+var DefaultDialer Dialer = DialerFunc(Dial)
+
+// Dial connects to a host and authenticates with the private key.
+func Dial(ctx context.Context, config *Config) (Conn, error) {
+	if config.HostKey == "" && !config.TrustOnFirstUse {
+		return nil, errors.New("no host key to verify the host with")
+	}
+	signer, err := ssh.ParsePrivateKey(config.PrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse private key")
+	}
+	c := &conn{}
+	hostKeyCallback := func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		c.hostKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+		return nil
+	}
+	if config.HostKey != "" {
+		expected, _, _, _, err := ssh.ParseAuthorizedKey([]byte(config.HostKey))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse host key")
+		}
+		fixed := ssh.FixedHostKey(expected)
+		hostKeyCallback = func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			if err := fixed(hostname, remote, key); err != nil {
+				return err
+			}
+			c.hostKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+			return nil
+		}
+	}
+
+	address := net.JoinHostPort(config.Address, strconv.Itoa(int(config.Port)))
+	ctx, cancel := context.WithTimeout(ctx, DialTimeout)
+	defer cancel()
+	var d net.Dialer
+	netConn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to %s", address)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		netConn.SetDeadline(deadline)
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(netConn, address, &ssh.ClientConfig{
+		User:            config.Username,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+	})
+	if err != nil {
+		netConn.Close()
+		return nil, errors.Wrapf(err, "could not log in to %s as %s", address, config.Username)
+	}
+	netConn.SetDeadline(time.Time{})
+	c.client = ssh.NewClient(sshConn, chans, reqs)
+	return c, nil
+}
+
+type conn struct {
+	client  *ssh.Client
+	hostKey string
+}
+
+func (c *conn) Run(ctx context.Context, cmd string, stdin io.Reader) ([]byte, error) {
+	session, err := c.client.NewSession()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open ssh session")
+	}
+	defer session.Close()
+	var output bytes.Buffer
+	session.Stdin = stdin
+	session.Stdout = &output
+	session.Stderr = &output
+
+	done := make(chan error, 1)
+	go func() { done <- session.Run(cmd) }()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		session.Close()
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		return output.Bytes(), fmt.Errorf("%s: %v: %s", cmd, err, bytes.TrimSpace(output.Bytes()))
+	}
+	return output.Bytes(), nil
+}
+
+func (c *conn) HostKey() string {
+	return c.hostKey
+}
+
+func (c *conn) Close() error {
+	return c.client.Close()
+}
+
+// Quote quotes s for the shell.
+func Quote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,207 @@
+package ssh
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func testKey(t *testing.T) (*ecdsa.PrivateKey, ssh.Signer) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, signer
+}
+
+func encodeKey(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+// testServer runs an ssh server accepting clientKey which runs the commands
+// of exec requests with sh. It returns the listener of the server and its
+// host key.
+func testServer(t *testing.T, clientKey ssh.PublicKey) (net.Listener, ssh.Signer) {
+	_, hostKey := testKey(t)
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if string(key.Marshal()) != string(clientKey.Marshal()) {
+				return nil, errors.New("unknown key")
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostKey)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			netConn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serve(netConn, config)
+		}
+	}()
+	return listener, hostKey
+}
+
+func serve(netConn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(netConn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go func() {
+			defer channel.Close()
+			for req := range requests {
+				if req.Type != "exec" {
+					req.Reply(false, nil)
+					continue
+				}
+				var payload struct{ Command string }
+				ssh.Unmarshal(req.Payload, &payload)
+				req.Reply(true, nil)
+				cmd := exec.Command("sh", "-c", payload.Command)
+				cmd.Stdin = channel
+				cmd.Stdout = channel
+				cmd.Stderr = channel.Stderr()
+				status := 0
+				if err := cmd.Run(); err != nil {
+					status = 1
+					if exitErr, ok := err.(*exec.ExitError); ok {
+						status = exitErr.ExitCode()
+					}
+				}
+				exitStatus := make([]byte, 4)
+				binary.BigEndian.PutUint32(exitStatus, uint32(status))
+				channel.SendRequest("exit-status", false, exitStatus)
+				return
+			}
+		}()
+	}
+}
+
+func testConfig(t *testing.T, listener net.Listener, key *ecdsa.PrivateKey) *Config {
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := strconv.Atoi(port)
+	return &Config{Address: host, Port: uint32(p), Username: "test", PrivateKey: encodeKey(t, key)}
+}
+
+func TestDial(t *testing.T) {
+	clientKey, clientSigner := testKey(t)
+	listener, hostKey := testServer(t, clientSigner.PublicKey())
+	defer listener.Close()
+	wantHostKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey.PublicKey())))
+
+	config := testConfig(t, listener, clientKey)
+	if _, err := Dial(context.Background(), config); err == nil {
+		t.Errorf("Dial() without a host key succeeded")
+	}
+	config.TrustOnFirstUse = true
+	conn, err := Dial(context.Background(), config)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+	if conn.HostKey() != wantHostKey {
+		t.Errorf("HostKey() = %q, want %q", conn.HostKey(), wantHostKey)
+	}
+	out, err := conn.Run(context.Background(), "cat; echo "+Quote("it's done"), strings.NewReader("bootstrap\n"))
+	if err != nil || string(out) != "bootstrap\nit's done\n" {
+		t.Errorf("Run() = %q, %v", out, err)
+	}
+	if out, err := conn.Run(context.Background(), "echo failed >&2; exit 3", nil); err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("Run() = %q, %v, want an error with the output", out, err)
+	}
+
+	config.HostKey = wantHostKey
+	if conn, err := Dial(context.Background(), config); err != nil {
+		t.Errorf("Dial() with the host key error = %v", err)
+	} else {
+		conn.Close()
+	}
+
+	_, otherHostKey := testKey(t)
+	config.HostKey = string(ssh.MarshalAuthorizedKey(otherHostKey.PublicKey()))
+	if _, err := Dial(context.Background(), config); err == nil {
+		t.Errorf("Dial() with another host key succeeded")
+	}
+
+	otherKey, _ := testKey(t)
+	config = testConfig(t, listener, otherKey)
+	config.TrustOnFirstUse = true
+	if _, err := Dial(context.Background(), config); err == nil {
+		t.Errorf("Dial() with an unknown client key succeeded")
+	}
+}
+
+// TestDial_sshd runs commands on an sshd, e.g. the container started by
+// make test-sshd. It is skipped unless CMA_SSH_TEST_ADDRESS is set to the
+// host:port of the server, CMA_SSH_TEST_USER to a user which can run sudo
+// without a password and CMA_SSH_TEST_KEY to the path of its private key.
+func TestDial_sshd(t *testing.T) {
+	address := os.Getenv("CMA_SSH_TEST_ADDRESS")
+	if address == "" {
+		t.Skip("CMA_SSH_TEST_ADDRESS is not set")
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ioutil.ReadFile(os.Getenv("CMA_SSH_TEST_KEY"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &Config{Address: host, Port: uint32(p), Username: os.Getenv("CMA_SSH_TEST_USER"), PrivateKey: key, TrustOnFirstUse: true}
+
+	conn, err := Dial(context.Background(), config)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+	out, err := conn.Run(context.Background(), "sudo -n sh -c "+Quote("cat > /tmp/cma-ssh-test && cat /tmp/cma-ssh-test && id -u"), strings.NewReader("bootstrap\n"))
+	if err != nil || string(out) != "bootstrap\n0\n" {
+		t.Errorf("Run() = %q, %v, want the stdin written as root", out, err)
+	}
+}
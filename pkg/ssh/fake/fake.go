@@ -0,0 +1,146 @@
+/*
+Copyright 2019 Samsung SDS.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory ssh.Dialer for tests.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/samsung-cnct/cma-ssh/pkg/ssh"
+)
+
+// Command is a command run on a fake host.
+type Command struct {
+	Cmd   string
+	Stdin string
+}
+
+// Host is a fake host. Commands are recorded and answered by Handler.
+type Host struct {
+	// Key is the host key presented to clients.
+	Key string
+	// Handler answers commands, commands succeed without output if it is
+	// nil.
+	Handler func(cmd, stdin string) (string, error)
+	// DialError is returned by Dial if it is set.
+	DialError error
+
+	mu       sync.Mutex
+	commands []Command
+	config   ssh.Config
+}
+
+// Commands returns the commands run on the host.
+func (h *Host) Commands() []Command {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]Command(nil), h.commands...)
+}
+
+// Config returns the config of the last connection to the host.
+func (h *Host) Config() ssh.Config {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.config
+}
+
+// Ran reports whether a command containing s was run on the host.
+func (h *Host) Ran(s string) bool {
+	for _, c := range h.Commands() {
+		if strings.Contains(c.Cmd, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Dialer connects to fake hosts by address.
+type Dialer struct {
+	mu    sync.Mutex
+	hosts map[string]*Host
+}
+
+// New returns a dialer for the hosts keyed by address.
+func New(hosts map[string]*Host) *Dialer {
+	d := &Dialer{hosts: map[string]*Host{}}
+	for address, h := range hosts {
+		d.hosts[address] = h
+	}
+	return d
+}
+
+// Dial connects to the host with the address of the config. The host key is
+// checked like ssh.Dial does, the private key is not.
+func (d *Dialer) Dial(ctx context.Context, config *ssh.Config) (ssh.Conn, error) {
+	d.mu.Lock()
+	h, ok := d.hosts[config.Address]
+	d.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("could not connect to %s:%d: connection refused", config.Address, config.Port)
+	}
+	if h.DialError != nil {
+		return nil, h.DialError
+	}
+	if config.HostKey == "" && !config.TrustOnFirstUse {
+		return nil, fmt.Errorf("could not log in to %s:%d as %s: no host key to verify the host with", config.Address, config.Port, config.Username)
+	}
+	if config.HostKey != "" && config.HostKey != h.Key {
+		return nil, fmt.Errorf("could not log in to %s:%d as %s: host key mismatch", config.Address, config.Port, config.Username)
+	}
+	h.mu.Lock()
+	h.config = *config
+	h.mu.Unlock()
+	return &conn{host: h}, nil
+}
+
+type conn struct {
+	host *Host
+}
+
+func (c *conn) Run(ctx context.Context, cmd string, stdin io.Reader) ([]byte, error) {
+	var in []byte
+	if stdin != nil {
+		var err error
+		if in, err = ioutil.ReadAll(stdin); err != nil {
+			return nil, err
+		}
+	}
+	c.host.mu.Lock()
+	c.host.commands = append(c.host.commands, Command{Cmd: cmd, Stdin: string(in)})
+	handler := c.host.Handler
+	c.host.mu.Unlock()
+	if handler == nil {
+		return nil, nil
+	}
+	out, err := handler(cmd, string(in))
+	return []byte(out), err
+}
+
+func (c *conn) HostKey() string {
+	return c.host.Key
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+var _ ssh.Dialer = &Dialer{}
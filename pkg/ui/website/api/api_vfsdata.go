@@ -26,9 +26,20 @@ var Api = func() http.FileSystem {
 		"/api.proto": &vfsgen۰CompressedFileInfo{
 			name:             "api.proto",
 			modTime:          time.Time{},
-			uncompressedSize: 10426,
+			uncompressedSize: 17941,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3a\x5d\x73\xdb\xb6\x96\xef\xfa\x15\x67\xf4\xb2\xce\x4e\x22\x25\x4e\xdb\xcd\xd8\x9b\xdd\xeb\x2b\xbb\x89\xa6\x89\xec\xb1\x9c\x74\xfa\xa4\x81\xc8\x23\x0a\xd7\x24\xc0\x02\xa0\x15\x4d\x27\xff\xfd\xce\x01\x40\x12\xa0\x28\xc9\x49\xdc\x99\xab\x87\xd6\xe4\xf9\xc0\xf9\xc2\xf9\x62\xc6\x63\x98\xc8\x72\xab\x78\xb6\x36\x70\xfa\xf2\xd5\x1b\x98\xb3\x42\x57\x22\x83\xf9\xe5\x1c\x26\xb9\xac\x52\x98\x31\xc3\x1f\x10\x26\xb2\x28\x2b\xc3\x45\x06\x77\xc8\x0a\x60\x95\x59\x4b\xa5\x47\x83\xf1\x78\x30\x1e\xc3\x07\x9e\xa0\xd0\x98\x42\x25\x52\x54\x60\xd6\x08\x17\x25\x4b\xd6\x58\x43\x9e\xc3\x67\x54\x9a\x4b\x01\xa7\xa3\x97\x70\x42\x08\x43\x0f\x1a\x3e\x3b\x27\x16\x5b\x59\x41\xc1\xb6\x20\xa4\x81\x4a\x23\x98\x35\xd7\xb0\xe2\x39\x02\x7e\x49\xb0\x34\xc0\x05\x24\xb2\x28\x73\xce\x44\x82\xb0\xe1\x66\x6d\xcf\xf1\x5c\x48\x12\xf8\xc3\xf3\x90\x4b\xc3\xb8\x00\x06\x89\x2c\xb7\x20\x57\x21\x22\x30\xe3\x85\xa6\xdf\xda\x98\xf2\x6c\x3c\xde\x6c\x36\x23\x66\x05\x1e\x49\x95\x8d\x73\x87\xaa\xc7\x1f\xa6\x93\xab\xd9\xfc\xea\xc5\xe9\xe8\xa5\x27\xfa\x24\x72\xd4\x1a\x14\xfe\x59\x71\x85\x29\x2c\xb7\xc0\xca\x32\xe7\x09\x5b\xe6\x08\x39\xdb\x80\x54\xc0\x32\x85\x98\x82\x91\x24\xf4\x46\x71\xb2\xdb\x73\xd0\x72\x65\x36\x4c\x21\xb1\x49\xb9\x36\x8a\x2f\x2b\x13\xd9\xac\x16\x91\xeb\x08\x41\x0a\x60\x02\x86\x17\x73\x98\xce\x87\xf0\xcf\x8b\xf9\x74\xfe\x9c\x98\xfc\x3e\xbd\x7b\x7f\xfd\xe9\x0e\x7e\xbf\xb8\xbd\xbd\x98\xdd\x4d\xaf\xe6\x70\x7d\x0b\x93\xeb\xd9\xe5\xf4\x6e\x7a\x3d\x9b\xc3\xf5\xaf\x70\x31\xfb\x03\x7e\x9b\xce\x2e\x9f\x03\x72\xb3\x46\x05\xf8\xa5\x54\xa4\x81\x54\xc0\xc9\x9a\x98\x5a\xd3\xcd\x11\x23\x11\x56\xd2\x89\xa4\x4b\x4c\xf8\x8a\x27\x90\x33\x91\x55\x2c\x43\xc8\xe4\x03\x2a\x41\x91\x50\xa2\x2a\xb8\x26\xaf\x6a\x60\x22\x25\x36\x39\x2f\xb8\x61\xc6\xbe\xda\xd1\x6b\x34\xb0\x27\xf9\x10\x9b\xcc\x26\x77\xf0\xbf\xda\x3d\x8d\x12\x0a\x36\x61\x63\xed\x1f\x59\xc1\x78\x3e\x4a\x64\xf1\x7f\x83\x81\xde\x0a\xc3\xbe\xc0\x5b\x18\x96\x4a\x1a\xf9\x7a\x78\x3e\x18\x94\x2c\xb9\x27\x49\x12\x91\x98\xd1\x3d\x63\x7a\xc4\x4a\x7e\x3e\x18\xc8\x92\x0e\x86\x4c\x2e\x6a\x0c\x22\xbb\xcf\xc6\x19\x0a\x54\xcc\x60\x3a\x66\x25\x27\x0e\xbc\x28\xa5\x32\x30\xcc\xa4\xcc\x72\xa4\xb7\x63\x26\x84\xf4\x92\x8f\xec\x51\xc3\xf3\x06\xcd\x3e\x27\x2f\x32\x14\x2f\xf4\x86\x65\x19\xaa\xb1\x3b\x4b\xf7\x92\x35\x92\x9c\x64\xaa\x4c\x46\x19\x33\xb8\x61\x5b\x07\x4e\x16\x19\x8a\x85\xe7\x32\xf2\x5c\x46\xb2\x44\xc1\x4a\xfe\x70\x5a\x43\x9e\xc1\x5b\xf8\x6b\x00\xc0\xc5\x4a\x9e\xd9\xbf\x00\x0c\x37\x39\x9e\xc1\x70\x92\x57\xda\xa0\x82\x8f\x4c\xb0\x0c\x15\x5c\xdc\x4c\x61\x3e\x7f\x0f\xa5\x92\x0f\x3c\x45\x35\x3c\xb7\xe8\x0f\xee\xc2\x9d\xc1\xf0\xe1\xe5\xe8\xd5\xe8\xa5\x7f\x9d\x48\x61\x58\x62\x6a\xa6\xf4\x13\xac\x20\xbe\xa1\x63\x3c\x32\xfd\x2a\x95\x9f\xc1\x90\x2e\x8a\x3e\x1b\x8f\x33\x6e\xd6\xd5\x92\x9c\x33\xf6\xae\x7b\x41\x6e\x18\x27\x05\x7b\xa1\xf5\x3a\xa0\x43\xf2\xe2\x19\x0c\x0f\x7a\xd8\xe3\x7f\xa5\xff\xd9\xff\xe0\x17\x83\x4a\xb0\x7c\x91\xca\x44\xd7\x42\x7e\x8f\x08\x29\xea\x44\x71\x6b\xdf\x33\x18\x7e\x94\x0a\x81\x2d\x65\x65\xe0\x51\xe6\xfb\x3a\x00\xd0\xc9\x1a\x0b\xd4\x67\xf0\xfe\xee\xee\x66\x7e\xde\x7d\x43\x2f\x12\x29\x74\x65\xdf\x0c\x7d\x16\xa0\xf3\xc6\xff\xd2\x52\x58\x36\xa5\x92\x69\x95\xec\x83\x7f\x3d\x1f\x0c\x34\xaa\x07\x9e\x60\x23\x95\x53\x98\x2e\x37\xcf\x73\x27\x93\x4d\x9b\x0c\x12\x87\x61\xe1\xaa\x4c\x60\xa2\x90\x19\xac\xe9\x4e\xa2\xc7\x8f\x3a\x7b\x06\x0a\x4d\xa5\x84\xee\x80\x6e\xb1\xcc\xb7\xcf\x02\xef\x37\xb1\x6a\xef\x02\x5d\xa5\x11\x59\xba\x8e\xc0\xf6\x57\x4a\x6d\xe0\x0c\x86\xf6\xba\x3c\xbc\x1a\x7b\x81\x86\x11\xd2\x52\xa6\x5b\x42\xfa\xef\xf6\xf5\x57\xef\xe3\x48\x33\x85\x46\x71\x7c\x70\x49\x47\x1b\x66\x2a\x4d\x89\xba\x51\x93\x12\x0a\x70\xa3\xe1\xbe\x5a\x62\x22\xc5\x8a\x67\x36\x27\x25\x52\x08\x4c\x0c\x7f\xe0\x66\xdb\x98\xe2\x1d\x9a\xc6\x0e\xed\xdf\xb1\x11\xda\xf7\xdf\x6f\x81\x0c\x0f\x1b\xa0\x57\xd3\x14\x73\x34\xd8\xe3\xc0\x4b\x0b\x68\x04\x8f\x1e\x63\xd9\x23\xd0\xf7\x8b\xef\x25\xf9\x66\x0d\x1a\x5f\x31\xc8\xb9\x36\xe4\x27\x4f\xa8\x7b\x5c\xf0\x81\x50\x4e\xe2\xe7\x7d\xae\x20\xd8\x53\xbb\x63\x4c\x32\x1e\xd1\x88\x28\xeb\x40\x13\x32\x45\x5d\x87\x20\x85\x18\x6b\xaf\x1d\xa6\x3b\x5e\x6b\x85\x9f\x11\xe1\xdc\xd1\x9d\xf4\xbe\xde\xa7\x76\x80\xf2\xe4\xda\x5b\x75\x9c\x36\xc7\xdd\x5a\x29\x51\xd7\x09\x5b\x6a\x54\x61\x13\x94\xcf\x94\xac\xe4\x40\xf9\x29\xd6\xde\x37\x72\xd3\x00\xfd\xa4\x7d\xbd\xa3\xb2\x7f\xff\x64\x7a\x7a\x71\x8f\xe8\xc6\xd2\xd4\x3a\x16\x4a\x29\x73\x6a\xc4\x0e\x3b\xf5\x22\x4d\xc9\x27\x37\x84\x7c\x12\x3c\xc4\xda\x04\x80\x27\xcf\xa2\x63\x12\xf4\xfb\x52\x69\x93\x60\x5a\x85\x57\x4a\x16\x47\x54\x76\x39\xa5\xd5\x3a\x7e\xee\xcb\x3f\x3f\xae\xfb\xde\x04\xd4\xd1\xbe\x57\x4d\x9d\xb0\xdc\x95\x0b\x51\x15\x4b\x54\x94\x86\x0a\x96\xac\xb9\x40\x0d\xb6\xdb\x0f\xf4\x3f\x7a\x8d\xe7\xc4\xad\xd5\x3e\x7a\x8c\x95\x8f\x40\x3f\xe0\xf7\xea\x89\xdd\xee\xaf\x6f\x55\x66\x8a\xa5\xe8\x05\xa9\x33\x58\xc6\x1f\x50\xf4\xe5\xae\x4f\x0e\xdd\x27\xa2\xee\x25\xde\x0b\xdd\xb9\xd6\x7b\x31\x9f\x3c\xa1\x79\x05\x8f\x5d\x78\x63\xb0\x28\x0d\x5d\xf5\xda\x22\xbb\x15\x37\x16\x1a\x4e\xe2\xe7\x58\xc7\x18\xf6\xe4\x7e\xdf\xd1\xea\x98\xeb\xbf\xda\xe1\xc9\x8b\xe3\x6a\x87\x9d\xa6\xdc\x7c\x86\x1a\x92\x4a\x29\x14\x6d\x5d\xa3\x1a\x80\xa3\x01\x8a\xaa\x88\xc9\xda\x1e\x73\x26\x0d\x68\x34\xf6\x71\x7e\x77\x71\xf7\x69\xbe\xf8\x34\x9b\xdf\x5c\x4d\xa6\xbf\x4e\xaf\x2e\xe1\x2d\xbc\x3c\xaf\x51\xef\xd6\xd8\x70\xe6\x1a\x96\x48\x03\x60\x62\x1b\xcb\x74\x64\x91\x6e\x6e\xaf\x3f\x4f\xe7\xd3\xeb\xd9\x74\xf6\x0e\xde\xc2\xab\x5e\xd2\x35\x23\x5a\x0a\x4d\x47\xea\xda\x3c\x0d\xab\x2a\xcf\xb7\x50\x69\x9a\xa2\x1d\xbb\xdb\x4f\x33\xcf\xe9\xb4\xe1\x34\x97\x05\xc2\x46\xaa\x7b\x22\x61\xd4\x05\x62\xbe\xf5\xb2\xa4\x52\x20\x4d\xca\xa6\x3d\xed\x39\xe8\x2a\x59\x03\xd3\x3e\x24\x08\x8d\xc0\x05\xb3\xb2\x48\x57\xfb\x9b\xb9\xdc\x9f\x7b\x35\xb9\x9e\x4d\xa6\x1f\xdc\xd9\xaf\x0f\x1b\xc0\x25\xb4\xd4\x1b\xf0\xfa\xe6\xc6\x51\xfd\xd4\x4b\x55\x30\x92\x15\x2a\xe1\xd4\xb4\x28\x57\xb7\xb7\xd7\xb7\xf0\x16\x7e\xee\xa5\xf0\x5b\x06\x0d\x95\xa6\x8e\x38\xb1\x21\x67\x24\x28\xd4\x86\x06\x1a\xb2\x1a\xac\x2a\x61\x01\x2c\xaf\x5b\xe2\xcb\xab\x77\xb7\x17\x97\xd6\x81\xbf\x9c\xd7\x81\xd3\x19\x0f\x06\x05\x6a\x4d\x23\x72\x17\x10\x44\x07\x2b\xb0\x5e\x9e\xd4\x12\x19\x49\x2a\x04\x89\xd5\x22\x6b\xa3\xc8\x1a\x34\x47\xee\x78\xbe\x6e\x2f\xe4\x0a\x7e\xab\x96\xa8\x04\x1a\x74\x59\x8a\x1c\x59\xf7\x5f\x23\x98\x48\x61\x94\xcc\xa1\xcc\x99\x68\xa8\x34\x30\x85\x90\xa2\x41\x55\x70\xe1\xd6\x2d\x24\xce\x47\x97\xf7\x29\xf8\x47\xa1\x04\xf7\x6f\xf4\xa2\x3e\x30\x0c\x9c\x8f\x75\x9d\xd8\xac\x79\xb2\xb6\x7b\x24\xc5\x35\x46\xaa\x25\xa1\x00\x96\xd0\x8b\x74\x43\x2f\x82\x13\x6b\xcc\x85\xc5\x5c\xb8\xfe\x31\x0c\x95\x47\x9c\xe6\x52\x12\x96\xee\x12\x84\xcc\x9d\x55\x2c\xd7\x05\x15\x07\xed\xe2\xe9\xeb\xa0\xdf\x63\x36\x31\x05\x53\xe3\x1a\xed\x96\xc7\xc6\xb6\x89\xf4\xdb\x30\x1d\x55\x44\x6b\x4a\xee\x56\x59\xa8\x5d\x12\x58\x52\x31\x94\xf7\x3b\x4e\x4c\xd1\x30\x9e\xeb\x6e\x34\x78\x52\x8a\xc7\x52\x0a\xed\xad\xe6\x4b\x82\xc1\xa2\x41\xb4\xbe\x08\x54\x88\x86\xb4\xc7\x44\x5c\x2e\xe5\x3d\xa6\x50\x95\xfd\xf1\xd6\xcb\xba\x63\x9a\xa9\x8e\xf8\x72\x97\x2a\xf4\x56\x1b\x2c\x76\x95\x0f\x55\xb9\xb4\xda\x1f\x54\xa8\x3b\xbc\x85\x1e\x61\x86\x12\x46\x70\xf6\x7f\x69\x27\xba\x91\x90\xa2\x36\x4a\x6e\x8f\x6a\xb5\x3b\x01\xb6\x27\x4c\x64\x95\xa7\x91\x6e\x4b\xac\x19\xfb\x0b\xda\xe7\xd7\x79\x33\x74\x13\x69\x18\x05\x5e\x10\x3f\x12\xed\xf7\x9d\x9f\xec\xe0\xaf\xfd\xe0\x1f\xf2\x81\x27\xfa\xd0\x3b\x73\xd6\x77\xa7\x27\xdc\x76\x65\x0e\x91\x0e\x45\xdb\xc1\x6c\x76\x91\xa6\xdc\x25\xda\x9e\x59\x29\x5e\x63\xec\x61\xe9\x10\x16\xb5\x54\x61\x86\xba\x3b\x48\x1f\xd7\xef\xc6\x31\xaf\xfb\x94\x0c\xa2\xf5\x3f\x53\xd5\xf0\x46\x04\xdb\x1d\x23\xeb\xe5\x0e\xfd\xb9\x87\x6d\x80\xdf\x2d\xce\xdf\x6c\xbd\x38\xab\xb6\xc5\xe9\x03\x5b\x62\xde\xda\x8e\x78\x0b\x6f\x3f\x06\x39\x01\x8f\x17\x3d\x96\x57\xfb\x08\x1c\xac\x8e\xd0\x5a\x78\xbf\x66\x77\x76\x76\x3d\xbc\x46\x17\xf6\x51\x61\xac\x27\x9e\xd6\xeb\x7b\x8a\x54\x24\xbf\x15\x42\x37\x4b\xfd\x3d\x2c\xa3\x7b\xd5\xb5\x87\x67\x11\x69\xba\x2d\x31\x9a\xc2\x8c\x0c\x36\x96\x27\xda\x30\x91\x32\x95\x52\x31\xca\xca\xea\x59\x68\x04\x2e\x08\x9a\xa0\x65\xd1\xbd\x07\xbb\xf3\x9d\x85\x72\x61\x5e\x9f\x42\x22\x2b\x61\x9a\xd8\x3f\x6e\xbe\x1d\x83\xed\x35\x92\x08\x2e\x89\xa7\x6a\xba\xe3\x43\xce\xee\x18\xb7\x4b\x7a\xdc\xa2\xa7\x7f\x83\x45\x5f\x7f\xbb\x45\x7f\xaa\x2d\xfa\x0e\x4d\xd8\xb6\xd9\x05\xb9\x5b\x03\x05\x49\xbe\xdd\xf7\xb8\xfc\x3f\x1e\x83\x4b\xf6\x64\x87\x9a\xba\xae\x2a\xbb\x74\xdd\xc2\xb0\x02\x59\xa2\x72\xfe\xa3\x4e\xe5\xfa\xb7\x3d\x35\xb9\x66\xd5\xb3\x86\x6a\x07\x2f\xaf\xb6\x61\x59\x3d\x12\x64\x9c\xda\x94\x52\x6a\x6e\xa4\xda\x36\x88\xde\x78\x19\x37\x41\xdf\xf8\xea\xbc\xcb\x68\xcd\xf4\xba\x0e\x0d\xe2\x94\xc8\xa2\xe0\xa6\x8f\x8b\x83\xb4\x4e\xdd\xdf\x97\x19\x85\x68\x55\x4d\x72\x64\x02\x36\x6b\x14\xb0\xac\x78\xde\xcb\x96\x90\x17\x76\xa2\x6b\x7d\xeb\x59\x5f\xd2\x4b\xb9\xb2\xb4\x69\x97\xd6\xbe\x5c\xa4\x8e\xee\xa7\x88\xee\x73\xeb\xe1\x4c\xd2\x84\x91\xba\x14\x5c\x94\xdc\x0f\x27\xa1\x0c\x32\xb0\xcf\xcf\x11\x9f\x89\xa3\x50\x96\x45\x97\x2e\xa9\x81\x76\x14\x09\xa8\x6e\x72\x66\xc8\x73\xc0\x8d\x33\x82\x43\x4c\x6d\xf8\x8c\x41\x55\xc2\x7e\x62\x94\xa2\xcb\xb1\xac\x09\xdf\xc2\xff\xd4\xb3\xf1\xa0\xa3\x52\x10\x14\x16\xd4\x13\x2b\x5e\x9b\x45\x58\xde\x7a\x3a\x9d\x43\x0b\x91\x83\x3d\x1e\x18\x7a\xb7\x41\x3b\xc0\x24\x52\x68\x9e\xa2\x95\x9f\xf4\xf3\xc3\xff\x63\x7a\xd9\xc3\x7b\x96\xa0\x09\x64\xa2\xdb\x02\xfa\x53\xf6\x77\x80\x56\xec\x68\xd2\x2a\xa5\xd6\x7c\x99\x23\xb8\x7f\x12\x20\xe4\x26\x4e\x61\x75\x09\xab\x69\xba\x16\xdb\x59\xa8\xfc\x4d\x36\xea\x51\xc0\x32\xd9\x60\x38\xea\xcb\xff\x8f\xea\x6e\x38\x17\xee\x95\xb9\x3b\x4f\x31\xed\xa6\x24\x06\xba\x4a\x12\xd4\x7a\x55\xe5\xfb\x47\xa6\x80\x6d\xbc\x45\x3e\x62\x07\x19\x2f\xac\x29\xef\x87\xa2\x7b\xbc\x59\xaf\xfe\x1f\x83\x5a\x41\x5c\x7a\x9a\xa7\x47\x0f\x9a\xa7\xfb\x54\x38\x3e\x66\xb6\xcb\xd7\x6f\x1e\x34\x77\xe6\x9c\x6f\x31\x9c\xdf\x29\xb7\xb6\x7b\xb4\xe1\x3c\xaf\x56\x70\x8a\x2f\xdd\xf2\xec\x0d\xfd\xc6\x5c\x0b\x87\xbd\x7f\x3a\x8a\xbf\x03\xf5\xeb\x11\xb6\x1c\x93\x56\xa7\x3f\x2b\x54\xdb\x83\x7a\x34\x85\x7a\xcf\x17\xa5\xfa\x80\x7a\x32\x27\xae\xef\xd0\xd4\x86\x25\x62\xa9\x82\xcd\x94\x0f\x21\xd7\x1b\x1f\x56\xa6\x13\x0a\xdd\xce\xa9\xa7\x69\xdf\x31\xff\xc4\x76\x1c\x9d\xfd\x7d\x48\x18\x37\x26\xa7\xe7\x83\xfe\x46\x9f\xd5\x0c\xa2\xce\xa0\x0e\xf2\x70\xd9\x09\xf5\xc2\x33\x45\xb8\x7f\xd3\x28\xda\xa9\x2d\xf7\x6f\x34\x61\xcc\xeb\x09\x21\x6e\x04\x82\xf5\x95\x2f\x28\x3d\xf4\x9f\xbb\xdb\x27\xa8\x77\x42\x6c\xee\x8e\x77\xd3\xee\x82\xef\xd4\xca\x82\x31\x3d\xb7\xc0\x69\xba\x53\xad\x5b\xfa\xb5\xd4\x86\x0c\xde\x47\xfe\xde\xc3\x76\x8a\xb4\x25\x77\xdb\xce\x5e\xcd\x89\x38\x52\xfd\x97\x5d\xf2\xe9\x0d\x65\x04\x85\xba\x97\x7a\x7a\x43\xc0\xbe\xaa\xfc\x0e\x8d\x6e\x3e\x1c\xbb\x6d\x19\xdf\xf9\x07\x0d\xdd\x0c\xe5\xe4\x68\xe2\xa3\x3b\xeb\xf6\x7c\x91\x7a\x8a\xa4\xdd\xfd\x0c\x74\xfc\xd6\x06\x99\xc8\x7d\xa0\x0a\x3e\x43\x3d\x3a\x13\x45\x89\xdf\xf1\x89\xad\x12\xc9\x65\xb3\xf7\x81\xb4\xbd\x8b\xdc\xaf\x45\x94\xf7\xe2\x83\xf7\x15\xdc\xd9\xee\x97\xb7\x88\x6e\xe7\xde\xee\x13\xeb\x47\x3d\xf6\xef\x00\x00\x00\xff\xff\x28\x36\x83\xcc\xba\x28\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3b\x5d\x73\x1b\x37\x92\xef\xfa\x15\x5d\x7a\x39\xe5\x8a\x26\x6d\xd9\xc9\x7a\xad\xf3\xdd\x29\xb2\x63\xb3\x62\x4b\x2a\x51\x8e\x6b\xef\x85\x05\xce\x34\x87\x58\x0d\x81\x09\x80\xa1\xcc\x4d\xf9\xbf\x5f\x35\x3e\x66\x80\x99\x21\x29\x3b\x4a\xd5\xee\xa6\x12\x73\xd0\xdd\xe8\x6f\x34\x1a\xed\xc9\x04\x2e\x64\xb5\x55\xbc\x58\x19\x38\x7d\xfa\xec\x25\xcc\xd8\x5a\xd7\xa2\x80\xd9\x9b\x19\x5c\x94\xb2\xce\xe1\x92\x19\xbe\x41\xb8\x90\xeb\xaa\x36\x5c\x14\x70\x8b\x6c\x0d\xac\x36\x2b\xa9\xf4\xf8\x68\x32\x39\x9a\x4c\xe0\x03\xcf\x50\x68\xcc\xa1\x16\x39\x2a\x30\x2b\x84\xf3\x8a\x65\x2b\x0c\x2b\x23\xf8\x0d\x95\xe6\x52\xc0\xe9\xf8\x29\x9c\x10\xc0\xb1\x5f\x3a\xfe\xe1\x8c\x48\x6c\x65\x0d\x6b\xb6\x05\x21\x0d\xd4\x1a\xc1\xac\xb8\x86\x25\x2f\x11\xf0\x4b\x86\x95\x01\x2e\x20\x93\xeb\xaa\xe4\x4c\x64\x08\xf7\xdc\xac\xc0\xb4\x1b\x10\x27\xf0\x0f\x4f\x43\x2e\x0c\xe3\x02\x18\x64\xb2\xda\x82\x5c\xc6\x80\xc0\x8c\x67\x1a\x00\x60\x65\x4c\xf5\x6a\x32\xb9\xbf\xbf\x1f\x33\xcb\xf0\x58\xaa\x62\x52\x3a\x50\x3d\xf9\x30\xbd\x78\x7b\x39\x7b\xfb\xe4\x74\xfc\xd4\x23\x7d\x12\x25\x6a\x0d\x0a\x7f\xaf\xb9\xc2\x1c\x16\x5b\x60\x55\x55\xf2\x8c\x2d\x4a\x84\x92\xdd\x83\x54\xc0\x0a\x85\x98\x83\x91\xc4\xf4\xbd\xe2\xa4\xb7\x11\x68\xb9\x34\xf7\x4c\x21\x71\x9a\x73\x6d\x14\x5f\xd4\x26\xd1\x59\x60\x91\xeb\x04\x40\x0a\x60\x02\x8e\xcf\x67\x30\x9d\x1d\xc3\xcf\xe7\xb3\xe9\x6c\x44\x44\x3e\x4f\x6f\xdf\x5f\x7d\xba\x85\xcf\xe7\x37\x37\xe7\x97\xb7\xd3\xb7\x33\xb8\xba\x81\x8b\xab\xcb\x37\xd3\xdb\xe9\xd5\xe5\x0c\xae\x7e\x81\xf3\xcb\x7f\xc0\xaf\xd3\xcb\x37\x23\x40\x6e\x56\xa8\x00\xbf\x54\x8a\x24\x90\x0a\x38\x69\x13\x73\xab\xba\x19\x62\xc2\xc2\x52\x3a\x33\xea\x0a\x33\xbe\xe4\x19\x94\x4c\x14\x35\x2b\x10\x0a\xb9\x41\x25\xc8\x13\x2a\x54\x6b\xae\xc9\xaa\x1a\x98\xc8\x89\x4c\xc9\xd7\xdc\x30\x63\x3f\xf5\xe4\x1a\x1f\x11\x48\x70\xb1\x8b\xcb\x8b\x5b\xf8\x2f\xed\x7e\x8d\x33\x72\x36\x61\x7d\xed\x7f\x8b\x35\xe3\xe5\x38\x93\xeb\xff\x3e\x3a\xd2\x5b\x61\xd8\x17\x78\x0d\xc7\x95\x92\x46\x3e\x3f\x3e\x3b\x3a\xaa\x58\x76\x47\x9c\x64\x22\x33\xe3\x3b\xc6\xf4\x98\x55\xfc\xec\xe8\x48\x56\xb4\x31\x14\x72\x1e\x20\x08\xed\xae\x98\x14\x28\x50\x31\x83\xf9\x84\x55\x9c\x28\xf0\x75\x25\x95\x81\xe3\x42\xca\xa2\x44\xfa\x3a\x61\x42\x48\xcf\xf9\xd8\x6e\x75\x7c\xd6\x80\xd9\xdf\xd9\x93\x02\xc5\x13\x7d\xcf\x8a\x02\xd5\xc4\xed\xa5\x07\xd1\x1a\x4e\x4e\x0a\x55\x65\xe3\x82\x19\xbc\x67\x5b\xb7\x9c\xcd\x0b\x14\x73\x4f\x65\xec\xa9\x8c\x65\x85\x82\x55\x7c\x73\x1a\x56\x7e\x80\xd7\xf0\xc7\x11\x00\x17\x4b\xf9\xca\xfe\x09\xc0\x70\x53\xe2\x2b\x38\xbe\x28\x6b\x6d\x50\xc1\x47\x26\x58\x81\x0a\xce\xaf\xa7\x30\x9b\xbd\x87\x4a\xc9\x0d\xcf\x51\x1d\x9f\x59\xf0\x8d\x0b\xb8\x57\x70\xbc\x79\x3a\x7e\x36\x7e\xea\x3f\x67\x52\x18\x96\x99\x40\x94\xfe\x2f\xd8\x9a\xe8\xc6\x86\xf1\xc0\xf4\x4f\xad\xca\x57\x70\x4c\x81\xa2\x5f\x4d\x26\x05\x37\xab\x7a\x41\xc6\x99\x78\xd3\x3d\x21\x33\x4c\xb2\x35\x7b\xa2\xf5\x2a\xc2\x43\xb2\xe2\x2b\x38\xde\x6b\x61\x0f\xff\x95\xfe\x63\xff\x85\x5f\x0c\x2a\xc1\xca\x79\x2e\x33\x1d\x98\xfc\x1e\x16\x72\xd4\x99\xe2\x56\xbf\xaf\xe0\xf8\xa3\x54\x08\x6c\x21\x6b\x03\x0f\x52\xdf\xd7\x23\x00\x9d\xad\x70\x8d\xfa\x15\xbc\xbf\xbd\xbd\x9e\x9d\x75\xbf\xd0\x87\x4c\x0a\x5d\xdb\x2f\xc7\x3e\x0b\xd0\x7e\x93\x7f\x6a\x29\x2c\x99\x4a\xc9\xbc\xce\x76\xad\x7f\x3d\x3b\x3a\xd2\xa8\x36\x3c\xc3\x86\x2b\x27\x30\x05\x37\x2f\x4b\x67\x52\xb2\x22\xe5\x32\x07\x61\xd7\x55\x95\xc1\x85\x42\x66\x30\xe0\x9d\x24\x3f\x3f\xea\xe2\x07\x50\x68\x6a\x25\x74\x67\xe9\x06\xab\x72\xfb\x43\x64\xfd\xc6\x57\x6d\x2c\x50\x28\x8d\x49\xd3\xc1\x03\xdb\xff\x55\x52\x1b\x78\x05\xc7\x36\x5c\x36\xcf\x26\x9e\xa1\xe3\x04\x68\x21\xf3\x2d\x01\xfd\x67\xfb\xf9\xab\xb7\x71\x22\x99\x42\xa3\x38\x6e\x5c\xd2\xd1\x86\x99\x5a\x53\xa2\x6e\xc4\xa4\x84\x02\xdc\x68\xb8\xab\x17\x98\x49\xb1\xe4\x85\xcd\x49\x99\x14\x02\x33\xc3\x37\xdc\x6c\x1b\x55\xbc\x43\xe3\xa5\x83\x93\xf6\xcf\xa9\x12\xda\xef\xdf\xaf\x81\x02\xf7\x2b\x60\x50\xd2\x1c\x4b\x34\x38\x60\xc0\x37\x76\xc1\x33\x05\x27\xc9\xcf\x94\xf7\x64\xe9\xfb\xd9\xf7\x9c\x7c\xb3\x04\x8d\xad\x18\x94\x5c\x1b\xb2\x93\x47\xd4\x03\x26\xf8\x40\x20\x91\xba\xe9\xf7\x2e\x53\xd0\xda\x63\x9b\x63\x42\x3c\x1e\x90\x88\x30\x3d\x38\x08\x99\xa3\x0e\x2e\x48\x2e\xc6\xda\xb0\xc3\xbc\x67\xb5\x96\xf9\x4b\x42\x9c\x39\xbc\x93\xc1\xcf\xbb\xc4\x8e\x40\x1e\x5d\x7a\x2b\x8e\x93\xe6\xb0\x59\x6b\x25\xc2\x39\x61\x8f\x1a\xb5\xb6\x47\x99\xcf\x94\xac\xe2\x40\xf9\x29\x95\xde\x17\x72\xd3\x08\xfc\xa4\xfd\xdc\x13\xd9\x7f\x7f\x34\x39\x3d\xbb\x07\x64\x63\x79\x6e\x0d\x0b\x95\x94\x25\x15\x62\xfb\x8d\x7a\x9e\xe7\x64\x93\x6b\x02\x3e\x89\x7e\xa4\xd2\x44\x0b\x8f\x9e\x45\x27\xc4\xe8\xf7\xa5\xd2\x26\xc1\xb4\x02\x2f\x95\x5c\x1f\x10\xd9\xe5\x94\x20\x0f\x9c\xa4\xbf\x53\xc1\xd3\xb5\xbf\x20\x01\x75\xa4\x1f\x14\x53\x67\xac\x74\xc7\x85\xa8\xd7\x0b\x54\x94\x86\xd6\x2c\x5b\x71\x81\x9a\xea\xec\x44\xfe\x83\x61\x3c\x23\x6a\x41\x22\x38\x49\x7e\xa6\xc2\x27\x4b\x7f\xc2\xee\xf5\x23\x9b\xdd\x87\x6f\x5d\x15\x8a\xe5\xe8\x19\x09\x19\xac\xe0\x1b\x14\x3d\xa1\xdf\xa1\xf9\xe4\xc0\x7d\x22\xea\x06\xf1\xce\xd5\x54\x25\xfb\x20\x1f\x2d\xd0\x83\x86\xbc\x80\x07\xb4\xc1\x8c\xc1\x75\x65\x28\xd4\x83\x46\xfa\x27\x6e\xca\x34\x9c\xa4\xbf\x53\x19\xd3\xb5\x47\xb7\x7b\x4f\xaa\x6f\x31\x7d\x25\xef\x51\x41\xb6\xcd\x4a\x92\xd2\x07\x01\xc5\xc3\x7e\x9f\xbf\xc1\x85\x94\xe6\xa3\x07\x3f\x49\x7e\xa6\xc2\x27\x4b\x8f\x9f\xeb\x3c\xc7\x13\x65\xb7\xf9\x53\xee\x4f\xf9\xe0\x23\x63\xb3\x36\x15\xb0\x0d\xe3\xa5\xbd\x8d\x53\x28\x20\xcb\x56\xc0\x85\x36\xb6\x6b\x60\xb6\x15\x8e\xe0\x5f\x52\xa0\xad\x2f\x29\xeb\x34\xda\xa1\xe3\x99\x7a\x00\xdc\x6c\xe1\x24\xfa\x91\x6a\x26\x5a\x78\x3c\x4f\xf7\x04\x1f\x2e\x31\x5f\xb3\x02\x35\xd4\x55\x29\x59\xee\x3a\x0d\xa4\x84\x91\x95\x8a\x00\x9a\x15\x52\x30\x28\xd4\xb2\x56\x19\x6a\xb8\x5f\xf1\x6c\x05\x4c\xa1\xef\xb3\x58\x3d\x39\x6a\x8d\x22\xa8\x28\x9b\xda\x4f\x70\xd2\xfe\x39\x55\x43\xfb\xfd\xd1\xb4\xe0\xb8\x18\xd0\xc1\x57\xdb\x36\xf0\x81\xe8\x0a\x2b\xfa\x30\x73\x9d\x09\xd4\x90\xd5\x4a\xa1\x68\x2b\x3a\xaa\x7e\x70\x7c\x84\xa2\x5e\x87\x7b\x95\x2f\xd3\x9a\xdb\xd5\xa5\x34\xa0\xd1\xd8\x9f\xb3\xdb\xf3\xdb\x4f\xb3\xf9\xa7\xcb\xd9\xf5\xdb\x8b\xe9\x2f\xd3\xb7\x6f\xe0\x35\x3c\x3d\x0b\xa0\xb7\x2b\x6c\x28\x73\x0d\x0b\xa4\xd6\x47\x66\x6f\x5b\xf9\xd8\x02\x5d\xdf\x5c\xfd\x36\x9d\x4d\xaf\x2e\xa7\x97\xef\xe0\x35\x3c\x1b\x44\x5d\x31\xc2\xa5\xa4\xec\x50\xad\xa9\xa8\xc5\x55\x97\xe5\xd6\x5b\xc2\x91\xbb\xf9\x74\xe9\x29\x9d\x36\x94\x66\x72\x8d\x70\x2f\xd5\x1d\x70\x0d\x8c\xee\x3f\x58\x6e\x3d\x2f\x39\xb9\xb3\x74\x8e\xe1\x77\x1b\x81\xae\xc9\xce\xda\x27\x43\x62\x99\x96\xd7\xcc\xf2\x22\x95\x3b\x2b\x43\x47\xca\xef\xfb\xf6\xe2\xea\xf2\x62\xfa\xc1\xed\xfd\x7c\xbf\x02\xdc\x51\x9e\x7b\x05\x5e\x5d\x5f\x3b\xac\x17\x83\x58\xd4\xd7\x5b\x20\xd4\xc2\x89\x69\x41\xde\xde\xdc\x5c\xdd\xc0\x6b\xf8\x71\x10\xc3\xf7\xd7\x34\xb5\x02\x95\x15\x98\x04\x94\xe4\xc9\x86\xae\xf2\xcb\xba\x2c\x61\x59\x0b\xbb\xc0\xca\x70\x19\x7c\xf3\xf6\xdd\xcd\xf9\x1b\x6b\xc0\x9f\xce\x82\xe3\x74\x2e\xc6\x47\x6b\xd4\x9a\x9a\x43\xdd\x1b\xb3\x77\x4f\xf2\x0e\xb6\xc6\xd0\x36\x0c\x1c\x19\x09\x0b\x8c\xd3\xab\x05\xa6\x2e\x9e\x28\x6c\x07\xa5\x67\xf9\x50\x58\xcb\x25\xfc\x5a\x2f\x50\x09\x34\xe8\xce\x67\x32\x64\xb8\x79\x8c\xe1\x42\x0a\xa3\x64\x09\x55\xc9\x44\x83\xa5\x6d\x90\xe6\x68\xa8\xc7\x46\xc9\x7c\xb1\xb5\x06\xf6\x39\x99\x9c\x7f\x1c\x73\x70\xf7\x52\xcf\xc3\x86\xb1\xe3\x78\xf8\x10\xf9\xd4\x41\x55\x5c\x63\x22\x5a\x16\x33\x60\x11\x3d\x4b\xd7\xc4\x51\xb4\x63\x80\x9c\x5b\xc8\x39\xf9\x90\x4e\x5c\xe5\x01\xbb\x59\xfa\x0a\x2b\xd2\x7d\x1e\xd8\x23\x71\xbc\x56\x2c\xd5\x39\x65\x66\x9d\xf8\xd3\x0d\xfe\x13\x33\x63\xf9\x26\xe7\x40\x6d\x80\x2f\x6d\xd6\x83\x5c\xa2\xb6\xf9\x6c\xc5\x36\x08\x28\x64\x5d\xac\x06\xce\x03\x4b\x69\x41\xe5\x61\xa5\x70\x59\xda\xa6\x77\xd7\xff\x2e\x44\x66\x3e\x32\xa6\x6f\xb0\x20\x4d\xb6\x44\xa8\x7d\x54\x96\x32\xb3\x5c\x73\x31\xb2\x8c\xe4\xb8\x64\x75\x69\x40\x39\x68\xbe\x04\xaa\x41\xb6\xb1\x5d\xd6\x8c\xe9\xb9\x5f\x7f\x0d\x3f\x25\x9b\x49\x1d\x9c\xcc\x8a\xe1\x93\x3a\x11\x4e\xf6\xcd\xb1\x2a\xe5\x16\x73\xdb\xf3\x1e\x01\x8e\x8b\x31\xd4\x8b\x5a\x98\xfa\xc9\x82\x4b\xc1\xb3\x51\xf8\xf9\x05\x05\x67\xe5\x20\x1f\x52\xcf\x35\x2a\x8e\xa4\xd4\xbf\x25\x5c\x68\x43\x31\x09\x2c\xcf\x5d\x5f\xd8\xb9\x3d\xab\xb8\xbb\xfa\x69\x27\x6a\x67\x79\xc9\x95\x36\x90\x25\x9e\xeb\x99\xa6\xed\x6b\x11\x92\x6b\xec\x49\x6f\x45\x5e\x49\x2e\x4c\xc7\x8d\x30\x7c\x7e\x0d\x2f\x1b\xce\xde\x60\x4e\xdd\x34\xcc\x5b\x5d\xa8\x5a\xd8\xb6\x33\x13\x4d\x97\x10\xd0\x64\x4d\x9d\x33\x72\xbf\x54\x2d\x74\x93\x0e\x87\x18\xd4\xbb\x39\x8c\xdd\x91\x88\x35\x2e\xfe\xf7\x90\x4d\x6e\x53\x5d\x30\xa0\x73\x16\x16\xac\xa4\xfa\x42\x81\xbd\x77\x6c\xb8\x32\x35\xd9\xa1\xa2\x7b\xc9\x52\x49\x61\xfa\x6a\x6d\xd3\xd0\x90\x86\x9a\x54\x44\xfb\xf1\x8a\xe8\xe6\x42\xbb\x3c\xe3\x49\x05\xb5\x8d\x12\x85\xa4\xec\xd4\xee\xb5\x62\xc3\x2b\xca\xdc\x41\x62\xef\x12\x2b\xea\xe7\x75\x93\x96\xed\x8b\xf7\x76\xf8\xe9\xc5\x8b\xe7\xfe\x66\x91\x6e\x40\x87\xd8\xcb\x76\x31\x96\x3c\xd6\x31\x17\xe6\xf9\xa9\xa3\x9d\x9c\x6a\xe4\x61\x76\x2b\xcb\x0b\xd3\x29\x09\xcf\xc6\xa0\x97\x69\x1b\x0d\x70\x87\x58\xb1\x92\x6f\xfc\x79\xba\x62\x95\x92\x5f\xb6\x6d\xa4\x93\xe4\xdd\xb3\x8c\x0b\x83\x6a\xc9\xa8\x1c\x5c\x61\xbc\x1f\x1d\xad\x5a\xf3\x82\xd2\xad\x91\xce\xef\xc9\x01\x22\x0c\xff\x2a\x12\x7d\x58\xa6\x99\x40\xd6\x06\x07\x03\x70\xc3\xab\x79\x8b\xd6\x3d\x29\x37\x4a\x55\x0e\x59\x01\xcf\x03\xd5\x96\xb7\x11\xd4\x82\xff\x5e\xdb\x73\x9e\xba\xa2\x02\x0d\xa5\xcb\x11\xfc\xf8\x6c\x48\xd3\x1e\x71\xee\x28\xce\x79\xee\x0e\xda\xaf\x47\xc3\x87\x9f\xad\xe1\x5a\x9f\xfb\xbc\x42\xfb\x54\x64\xcb\x04\x93\x1c\x15\xf7\x4c\x27\x57\x0c\x7b\x2a\x71\xf7\x1e\x86\xda\xb4\x8a\x97\x77\x3d\xd7\xca\xd1\x30\x5e\x36\x29\x24\x90\x0c\xd9\x5c\xa1\xae\xa4\xd0\x68\x69\x78\xc6\xa6\x06\xd7\xcd\xde\xd6\x73\x22\x11\xda\x7e\xda\x03\x0f\xef\x52\xca\x3b\x7a\x6f\xab\x86\x8f\xee\x41\xd2\x1d\xd5\x4c\x75\x42\x97\xbb\x34\xa3\xb7\xda\xe0\xba\x2f\x7c\x2c\xca\x1b\x2b\xfd\x5e\x81\xba\x1d\xe0\x76\xdb\xcf\x2b\x66\x80\x27\x7b\xff\x87\xcf\x06\x46\x42\x8e\xda\x28\xb9\x3d\x28\x55\xbf\x8d\xdc\xee\x70\x21\xeb\x32\x4f\x64\x5b\x60\x20\x8c\x79\x5f\x34\x8f\xe6\xeb\x6a\xaf\xee\xd8\x0b\x3c\x23\xbe\xaf\xba\xdb\x76\xbe\x3d\x0c\x7f\xec\x5e\xfe\x53\x36\xf0\x48\x1f\x06\x1b\xd7\xa1\x0c\x19\x70\xb7\x3e\xcf\x31\xd0\x3e\x6f\x1b\xb6\x83\x87\x3f\xcf\x73\xee\x6a\xd6\x81\x86\x6b\xfa\x16\xb2\x83\xa4\x03\x98\x07\xae\xe2\x7c\x7a\xbb\x17\x3f\xbd\x0a\x79\x38\x9b\x1c\xfb\x42\x46\xde\xfa\xef\x29\x6a\x1c\x11\xd1\x13\x91\x91\xe1\x85\x88\x62\x7e\x07\xd9\x08\xbe\x7b\x36\x7c\xb3\xf6\x5e\x24\xda\x6b\xeb\xfc\x0f\x6c\x81\x65\xeb\x26\x44\x3b\x1c\xde\x0c\x4a\x5a\xdc\xab\x3b\x82\xdf\xb0\xb2\xde\x85\xe0\xd6\x82\x87\x7a\x84\xf0\x56\xef\xf4\xec\x4e\x64\x8d\xb6\xf6\x18\x3e\x43\x07\x6b\x90\xb8\x06\x4a\xf8\xb7\x4c\xe8\x66\x32\x60\x07\xc9\x24\xae\xba\xfa\xf0\x24\x12\x49\xb7\x15\x26\xad\x5c\x23\xdb\x13\x06\x4e\xa8\x71\x93\x33\x95\x53\x05\x54\x54\xf5\x0f\xb1\x12\x42\x5b\xe7\x76\x5b\xa5\xce\x71\x3b\xd8\x24\x1e\x41\x7b\x56\x8e\x61\x6a\x60\x5d\x6b\x43\x97\x3a\x99\xe7\xa0\xc9\x5b\x98\xf3\x4b\xaa\xfc\x02\xa9\x35\x12\x99\xb6\xf8\xf4\x85\x65\xc3\x2d\x15\x20\xf0\x7b\x2d\x55\xbd\x8e\xce\xdf\x4c\xd6\xc2\x74\x6e\x46\x6c\x16\x6e\x10\x64\x1d\x7a\x39\x36\x8a\x71\x61\x5a\x8d\x06\xa2\x16\xc7\x9b\xe1\x22\x82\x8b\x71\xe2\x02\xc2\xd2\xa6\xa6\xd6\xc0\xe5\x41\x57\x0a\x59\x0e\x2c\x53\x52\xdb\x36\xb9\x54\x39\xaa\xd4\x4a\x5e\x9d\x8e\x42\x7c\x23\xba\xda\xa0\x52\x3c\xf7\x74\x25\x55\x90\xf6\x0a\x91\x86\xc7\x43\x3c\x62\xe0\x16\xf2\xd3\x03\x5d\xb7\xe7\xac\x3b\x1d\x34\xae\x8e\x3d\x56\xb7\xe6\x1d\x0c\xb4\x8e\x63\x77\x51\x0f\x7b\xf3\xe9\x5f\xe0\xcd\xcf\x0f\x78\xf3\x80\xb7\xbd\xf8\x0b\xbd\xad\x75\x8a\xf7\xf2\xfe\x90\x9b\xb5\x0e\x69\x91\xfe\x4f\xd2\x0d\xdf\x02\x90\x8f\xcd\x3d\x70\x7c\x1d\xfe\x46\x4f\x6b\xf6\xf6\xeb\x1d\x2b\x77\x2e\xbb\xb1\x9b\xd9\x9d\xe5\xb2\xef\x5e\x03\xbc\x07\x87\x8b\xf8\x6f\xfc\xed\x67\x77\x03\xca\x3d\x49\xdd\xa3\xc3\xca\xd2\x91\x19\xc1\x35\x17\x74\xa5\xf0\x0b\x24\xa0\x7b\x9e\x69\x35\xe4\xf9\xae\x64\xc9\xb3\x6d\xcf\x3f\x7d\x68\xcb\xc0\x7e\x77\xab\x66\x16\xce\x6f\xe4\xc8\xec\x8d\xf1\xe4\xdc\x38\xe4\x2d\x07\x43\x31\x76\x9b\x46\x43\x1f\xb9\xe0\xeb\x7a\x1d\xb9\x6e\x56\xd5\x90\x49\xe5\x65\x76\x99\x72\xcd\xc5\x3c\xab\xea\x79\xf0\xe1\x67\x67\x5d\x7c\xb6\xb6\x4b\xb4\x3d\xae\xa5\xda\x52\x12\xfb\xc8\x7f\xee\xd0\xf0\x6b\x71\x2c\x9e\xab\x6c\xc5\x0d\x66\xa6\x56\xdd\xb4\xa0\x7d\x1b\x85\xad\xf3\x9f\x5e\xb8\xf1\x30\x9e\xc5\x96\x60\x31\x6e\x3f\x8f\xbb\x27\x06\x4e\x7d\x47\xab\x52\x0a\x73\xaf\x40\xb4\x8f\xaf\x31\x2d\x0b\xd0\x8b\xce\xd0\x93\x6f\x5f\xa8\x77\xe1\x5b\x80\x38\x02\x2d\xbe\x61\x45\x27\xdd\xdb\xe3\xcc\xb6\xc0\xe8\x35\xd4\x17\x5f\xa1\x0c\x4a\xb2\x8b\x61\xc5\xa0\x77\x58\x9a\x71\x58\xee\xdb\x29\x34\xdc\x06\x29\x09\x69\xe6\x9e\x5a\xdb\x6d\x7a\xc3\xf5\xdd\x2e\x9e\x47\x51\x5b\x89\xdb\xb6\x6f\xde\x44\xbb\xa2\x37\x8c\x9c\xeb\xbb\x74\xab\x99\x91\x8a\x15\x51\xce\x02\xed\xbe\x24\x8d\xa4\x4b\x77\x53\x86\xe6\xee\xbd\x8b\x83\x94\xf8\x34\x80\x47\xe4\x23\x12\x51\x47\xe8\x9c\x66\x35\xef\xc8\xc1\x18\x79\xa2\xf5\x79\xcd\xff\x85\x4d\x90\xf4\xf9\x6c\x62\xe4\xaa\xf2\x05\xb2\x3d\x82\x82\x93\x36\xa2\x7a\x65\xba\xc5\xa1\xd8\xa0\x7d\x62\x2c\x8a\x8d\x77\x71\x68\x58\x80\x38\x28\x52\x8b\x5a\x9c\x1d\x1a\x48\x7d\xe2\x79\x2b\xae\xe8\x6a\x14\x98\x31\x34\x3b\x4b\x5d\x13\x1a\x43\x70\xeb\x8d\xfc\x43\xaa\x6c\x34\xf0\x21\x16\xbc\xa1\x78\x40\x7a\x92\x41\x57\xa1\x83\xd3\xf2\xc1\x75\xcc\x4a\x4c\xc4\x41\xc7\x8a\xb8\xa4\x4a\x80\x06\xca\x78\xae\xc2\xfe\xba\x5e\x08\x34\x0f\x27\xea\xc0\x7b\xe9\x61\xc9\x16\x8a\x67\x0f\x26\xe3\xc1\xe3\x0c\xf1\xdb\x87\xf3\x4b\xea\x03\xed\x23\x31\x82\xa7\xb0\x46\x66\x67\x6f\xb7\x91\xc9\x37\x51\xb7\x67\x32\xa1\x56\x46\x78\x53\x20\x31\xed\xb4\x23\xb5\xdd\x54\x63\x9f\x76\x48\xa7\xb9\x87\xdb\x7e\x3b\x5d\xba\x29\x04\x03\x76\xb8\xdd\xf7\xf1\xba\x17\xf4\x25\xc8\x8a\x06\x6e\x09\x8b\x3a\x46\x57\xbf\xf6\xef\xe5\xf6\x4b\x20\xe5\xe9\x44\x63\x04\x9e\x9a\xa7\x48\x67\xb7\x61\x4d\xf5\x5d\x70\x6a\x17\x55\x52\x73\x23\xd5\xb6\x01\xf4\xfa\x2c\xb8\x89\x9e\x42\x9e\x9d\x75\x09\xad\x98\x5e\x05\x8b\x13\xa5\x4c\xae\xd7\xdc\x0c\x51\x71\x2b\xad\xdb\x78\x22\x03\xfd\x31\xa3\x10\xad\xa8\x59\x89\x4c\xc0\xfd\x0a\x05\x2c\x6a\x5e\x0e\x92\x25\xe0\x39\xdd\x20\xa3\xa3\xc5\x93\x7e\x43\x1f\xe5\xd2\xe2\xe6\x5d\x5c\xfb\x71\x9e\x33\x13\x1d\x27\x1e\xcf\x2b\x90\xc4\x2a\xa4\xcb\x9e\xf6\x2a\xbc\xae\x78\x89\x5d\x3a\x85\x8c\xf4\xf3\x63\x42\x87\xc6\xfc\x79\x89\xca\x92\xe8\xe2\x79\x72\xaa\x3d\x22\x3c\xd6\x75\xc9\x0c\x59\x0e\xb8\x71\x4a\x70\x80\x2e\x83\x4f\xa2\xbb\x53\x97\x62\x15\x10\x9b\x63\xe2\xeb\xd1\x51\x47\xa4\xc8\x29\xec\xd2\x80\xaf\x78\x69\xe6\x71\x9b\x21\xd4\x38\x91\xb7\xa6\x23\x1d\x11\x81\x43\xbd\x36\x77\x35\xbc\x47\x7b\xa3\xa2\xea\x88\xe6\x84\x89\x7f\x92\xcf\x4f\x72\x0c\xdf\x32\x1e\xc8\x40\x27\x80\x2e\x58\xf2\x9a\x4b\x77\x54\xbf\xcb\xee\x4e\xdc\x67\x62\xd1\x2b\xc2\xd5\xe4\x95\xd4\x9a\xd3\xd3\x8e\xfb\xfb\x1d\x42\xde\x0f\x26\xf8\x06\xa7\xab\xb1\x94\xdb\xbf\x4e\x47\x03\x02\x58\x22\xf7\x41\x6a\x02\x37\xf2\x7f\x62\xec\x00\xb7\x9f\xe7\x8e\x5a\x3f\x33\x3a\xf5\x28\x8b\xd2\xf3\x78\x86\x5a\x2f\xeb\xb2\x49\x6b\x3d\xc5\x46\x64\xd3\x91\xc0\x03\x7a\x90\xe9\xf4\xa1\xee\xa4\x7b\x0f\x77\x39\x28\xbf\x2f\xa8\x75\xa0\x32\xd0\xc4\x6a\xec\x77\xe8\xed\xf4\x74\x97\x08\x87\xdb\xfd\xed\x24\xdd\x37\x37\xfc\xa3\x2d\x7b\x23\x85\x07\x15\xe7\x07\x04\x5b\xdd\x3d\x58\x71\x5c\x77\x18\x27\xff\xd2\x2d\xcd\x41\xd7\x6f\xd4\x35\x77\xd0\x5d\x9d\x0d\x4e\xec\xee\x94\x23\x6e\x3f\x78\x34\xda\xff\xf7\x1a\xd5\x76\xaf\x1c\xcd\x41\xdd\xdf\xcc\x99\xca\x6f\x10\x5e\x48\x88\xea\x3b\x34\x41\xb1\x84\x2c\x55\xa3\xc6\xa6\xb2\xa5\x13\xa6\xd6\xfb\x85\xe9\xb8\x42\xb7\x8b\xe2\x69\xc6\xdc\xf7\xd4\x7f\xd1\x5c\xcf\xc2\xc6\x3c\x9d\x3c\x4c\x9b\x14\xa7\x67\x47\xf1\x6e\x6d\xc3\xb5\x19\x64\x4b\x2a\x83\xe0\xe4\xf1\xfc\x8e\x47\x27\x31\xe0\xee\x65\x23\x68\x58\xf2\x8c\xde\xbd\xd4\x04\xe1\x31\x1b\x8e\x3d\x72\xdb\xcb\x09\x07\xcd\x00\xbe\x5f\xe9\x15\x00\xb6\xca\x23\xe2\xfe\xd5\x61\xce\x7b\x67\x35\xbd\xfb\xcf\xec\xe2\x34\xef\x9d\xd6\x2d\x3e\xbd\x7b\x92\xc2\x87\xd0\xdf\xfb\xb5\xde\x21\x6d\xd1\xc9\x77\x77\x48\x4e\xc8\x89\xe8\xe9\x69\x6d\xd1\xa7\xd7\xd4\x9f\xa7\x17\xff\x21\xec\xe9\x35\x2d\x0e\x9d\xca\xef\xd0\xe8\xe6\x6f\x01\x10\x0f\x7e\xf6\x76\x6f\x86\xb2\x5c\xb6\xfe\xd1\x7d\x73\x18\x18\x2f\x7e\x8c\xa4\xdd\x9d\xe9\x3d\x1c\xb5\x5e\x08\x8a\x2f\x37\x6d\x1c\xcd\x14\xef\x8d\xe0\x98\x6e\x83\xa1\x1b\x3a\xa9\x56\x12\xbe\xec\xe4\x4b\x37\x6d\xff\xc5\x23\x2f\xcf\x77\xab\x29\x6d\xaa\x26\x7a\x0a\x62\x35\xcf\x8e\xad\x68\xbb\x8e\xf4\xcb\x5e\xd7\x32\xc5\xeb\x65\x86\x5d\x6c\x3d\x9a\x4f\x74\x67\x5e\x1f\xee\x13\xfe\xa7\x17\xe4\xc1\xde\x30\x40\xd1\x93\x20\x55\xb8\x01\xd8\x98\x98\x5f\xf4\xc4\x4e\x77\x33\xff\x68\x2a\x49\x47\x5d\x5b\x8a\x53\xdf\x31\xb2\x43\xb3\xd6\x97\xe9\xca\xa5\xcc\x88\x3a\x55\x81\x4f\xd7\x1e\x48\xe6\x1d\x1a\x3f\xef\xf4\xb5\xe7\x8e\x4e\xac\xa1\x2b\x51\x6e\x29\x2e\x68\x2e\xc4\x13\xb4\xe9\xc4\xc6\x7b\xd3\x88\x1d\xe8\xa5\x9d\x7e\x03\x89\xa4\xd1\x16\xd3\xb2\xce\x1c\x5f\xde\x9b\x5e\xa8\x9f\xd7\x8a\x44\xfe\xae\x69\xaf\x17\xbb\xf4\xdc\xb1\x1d\xed\xdb\x84\x6c\x2b\x45\xa1\x64\x5d\xb9\x19\x8b\xa0\xc3\xdd\x03\xcc\x41\xe9\x61\x0b\xfb\x74\x1b\x06\x8b\x7b\x36\x4f\xa0\x12\x3e\x3a\x3b\xf1\x31\x8e\xdb\x09\x35\xc3\x8a\x51\xc7\x8b\x75\x2c\x7e\x40\xb6\xa6\x4e\x2c\xdd\xa8\xd6\x1a\x70\x0f\x89\x9e\x81\x23\xa3\x44\x76\xdc\xc7\xc5\xa0\x5d\xfb\x0f\x28\x40\x0d\xfd\xad\x1f\x0d\x09\xcd\xd7\x7c\x20\x2f\xbd\x48\x08\x3d\x00\xbd\x31\x86\x0f\xd5\xf7\x4c\xe5\x34\x66\xdb\xe2\x76\xa7\x71\xba\x80\x89\x49\xac\xf4\xae\xd0\x88\x66\x83\x3c\xad\x58\xf0\xa6\x18\x19\x56\x7d\xa8\x35\xf6\x50\x68\x40\x1e\xd8\x47\xff\xee\x36\xfa\xe5\xde\x47\x81\xf8\x41\xa0\xd5\xfe\xf9\xe1\x87\x80\xf0\x08\xe0\xeb\x25\x92\x5e\x1a\x56\xf6\xfa\xa4\xba\xdf\x28\x6d\x5a\xc7\xbe\x58\x0a\x85\x92\x4d\x70\xa9\xd4\xa9\x91\xd3\x3e\xe9\xdf\x12\xbb\xb6\x63\xf2\x49\x6a\x6d\x4c\xf2\x48\x89\xe6\xd9\x8e\x4d\x07\xf2\x8c\x1f\x32\xf5\x92\x68\xc8\x98\x80\x45\x67\xc6\x34\x95\xcf\x92\xb2\x89\xc2\xe3\x76\x9d\x6b\xf7\xdf\x35\x90\xda\x9f\x7c\xdc\xcd\xe7\x32\xe1\x68\x8c\x82\x26\x5d\xa3\x42\xe0\x06\x55\xca\x02\xa9\x7b\xdd\xe1\x43\x6c\x58\xc9\xf3\x88\x1d\xf7\x61\xde\xb0\x95\x9e\x96\x2d\x60\xa2\x80\xde\xdb\xa2\xe7\x28\x1e\xab\x75\x73\xb4\xbb\x5e\x14\x53\xe9\xa3\x8b\x44\x68\x4a\xd8\x34\x58\x52\xff\xcb\xcf\x26\xd9\x2d\x1e\x32\xad\xdd\xcb\xc0\x2d\x3a\xd5\x26\xd4\x00\x34\xd4\x50\xd9\x97\x74\xd3\xbc\x97\x44\xe0\x80\xc4\x0f\x0d\xdd\x34\x09\xc6\x69\xc4\x3a\x72\x62\xfa\x98\x4c\x7a\x81\xf1\xe8\x39\xdb\x46\x82\x51\x8b\x21\xf8\xd0\x88\xfe\xce\x02\x13\x70\xf3\xcb\x05\x3c\x7f\xfe\xfc\xef\x40\xdd\xce\x98\x5e\xe3\x6c\x61\x80\xa0\x31\x77\xd7\x3d\xfe\xf8\x53\x0c\x3f\x3b\xa8\xc5\x84\xc2\x37\x6a\xf3\x74\x50\x1d\x09\xc5\xef\x56\x4b\x6b\xff\xcf\xab\x6d\x5b\x7c\x76\x42\x30\x46\x56\xc8\xb4\x14\xf0\x1a\x5e\x9c\x1d\x7d\x3d\xfa\xff\x01\x00\xc8\xea\x75\x4b\x15\x46\x00\x00"),
+		},
+		"/provider": &vfsgen۰DirInfo{
+			name:    "provider",
+			modTime: time.Time{},
+		},
+		"/provider/provider.proto": &vfsgen۰CompressedFileInfo{
+			name:             "provider.proto",
+			modTime:          time.Time{},
+			uncompressedSize: 10869,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x5f\x73\xdb\xb6\xb2\x7f\xd7\xa7\xd8\xc9\x53\x3a\xa3\xc8\x49\x9c\xf4\xb6\xcd\xf5\x9d\xab\xc8\x4a\xa3\xa9\x23\x67\x2c\xfb\xe4\xf4\x49\x03\x91\x2b\x11\x63\x12\x60\x01\xd0\x8a\xea\xc9\x77\x3f\xb3\x00\x48\x02\x24\x65\x27\x4d\x1e\xce\x53\x64\x10\xfb\xdb\xc5\xfe\xc7\x22\x27\x27\x30\x93\xe5\x41\xf1\x5d\x66\xe0\xe5\xf3\x17\xbf\xc2\x8a\x15\xba\x12\x3b\x58\x9d\xaf\x60\x96\xcb\x2a\x85\x25\x33\xfc\x0e\x61\x26\x8b\xb2\x32\x5c\xec\xe0\x1a\x59\x01\xac\x32\x99\x54\x7a\x32\x3a\x39\x19\x9d\x9c\xc0\x05\x4f\x50\x68\x4c\xa1\x12\x29\x2a\x30\x19\xc2\xb4\x64\x49\x86\xf5\x97\x31\xfc\x0b\x95\xe6\x52\xc0\xcb\xc9\x73\x78\x4a\x1b\x9e\xf8\x4f\x4f\x7e\x7a\x43\x10\x07\x59\x41\xc1\x0e\x20\xa4\x81\x4a\x23\x98\x8c\x6b\xd8\xf2\x1c\x01\x3f\x27\x58\x1a\xe0\x02\x12\x59\x94\x39\x67\x22\x41\xd8\x73\x93\x81\x69\x19\x90\x24\xf0\xa7\xc7\x90\x1b\xc3\xb8\x00\x06\x89\x2c\x0f\x20\xb7\xe1\x46\x60\xc6\x0b\x0d\x00\x90\x19\x53\xfe\x76\x72\xb2\xdf\xef\x27\xcc\x0a\x3c\x91\x6a\x77\x92\xbb\xad\xfa\xe4\x62\x31\x9b\x2f\x57\xf3\x67\x2f\x27\xcf\x3d\xd1\x8d\xc8\x51\x6b\x50\xf8\x57\xc5\x15\xa6\xb0\x39\x00\x2b\xcb\x9c\x27\x6c\x93\x23\xe4\x6c\x0f\x52\x01\xdb\x29\xc4\x14\x8c\x24\xa1\xf7\x8a\x93\xde\xc6\xa0\xe5\xd6\xec\x99\x42\x92\x34\xe5\xda\x28\xbe\xa9\x4c\xa4\xb3\x5a\x44\xae\xa3\x0d\x52\x00\x13\xf0\x64\xba\x82\xc5\xea\x09\xbc\x9d\xae\x16\xab\x31\x81\x7c\x5a\x5c\xbf\xbf\xbc\xb9\x86\x4f\xd3\xab\xab\xe9\xf2\x7a\x31\x5f\xc1\xe5\x15\xcc\x2e\x97\xe7\x8b\xeb\xc5\xe5\x72\x05\x97\xef\x60\xba\xfc\x13\xfe\x58\x2c\xcf\xc7\x80\xdc\x64\xa8\x00\x3f\x97\x8a\x4e\x20\x15\x70\xd2\x26\xa6\x56\x75\x2b\xc4\x48\x84\xad\x74\x66\xd4\x25\x26\x7c\xcb\x13\xc8\x99\xd8\x55\x6c\x87\xb0\x93\x77\xa8\x04\x79\x42\x89\xaa\xe0\x9a\xac\xaa\x81\x89\x94\x60\x72\x5e\x70\xc3\x8c\x5d\xea\x9d\x6b\x32\xa2\x2d\xb5\x8b\xcd\x96\xb3\x6b\xf8\x5f\xed\xfe\x9a\x24\xe4\x6c\xc2\xfa\xda\xff\xef\x0a\xc6\xf3\x49\x22\x8b\xff\x1b\x8d\xf4\x41\x18\xf6\x19\xce\xe0\x49\xa9\xa4\x91\xa7\x4f\xde\x8c\x46\x25\x4b\x6e\x49\x92\x44\x24\x66\x72\xcb\x98\x9e\x94\x4a\xde\xf1\x14\xd5\x9b\xd1\x48\x96\xc4\x1d\x76\x72\x5d\x6f\x23\xda\xdb\xdd\xc9\x0e\x05\x2a\x66\x30\x3d\xa9\x77\x13\xd6\xc9\x09\x7c\x60\x49\xc6\x05\x7e\xf4\xab\xc0\xb5\xd5\x0c\x16\x28\xc8\x3c\x9b\x03\xd4\x04\x50\xe6\xd5\x8e\x0b\x3d\x06\x59\x99\x67\x72\xfb\xac\x54\x32\x21\x65\x6a\x54\x77\xa8\x34\x1d\x6f\x9f\xf1\x24\x03\xb9\xb7\x36\xe3\xe2\x0e\x85\x91\xca\x3a\x61\xe1\xf8\x68\x48\x0a\xf6\x4c\xeb\x0c\x12\x26\x60\x53\xf1\x3c\x85\x24\xaf\xb4\x41\xa5\x41\x8a\x09\x5c\x67\xd6\x45\xfc\x76\x48\xa4\x30\x4a\xe6\x39\x2a\x48\x58\x9e\x6b\x60\x5e\x0c\xe0\x42\x1b\x64\x29\x61\x7f\x98\x4e\x57\x8d\xcd\x5a\xb4\x2d\x30\x82\x9a\x89\xc4\x7c\x60\x4c\x5f\xe1\x8e\x74\xb3\xcf\xa4\x76\x96\x9d\xd4\x48\x74\x04\x53\x47\xf3\xb4\xe1\xcd\x35\x24\x0a\x99\x57\xc3\x34\xcf\x65\xc2\x0c\x39\x47\x9e\xcb\xbd\x5b\x3c\xc7\x32\x97\x87\x31\x31\x16\xb0\x32\xcc\x54\x9a\xe0\x4a\x92\xd8\xba\x44\x25\x0c\xcf\xe9\x73\x88\x7a\x3e\xff\x78\x71\xf9\xe7\xfc\x1c\xa4\x82\x77\xd3\xc5\xc5\xfc\x7c\xed\x96\x3e\xcc\x97\xd7\x13\xb8\xc2\x1c\x99\x46\x50\x68\x2a\x25\x34\x98\x58\x25\x46\xd2\x4a\xab\xdd\x09\xcc\xef\x50\x1d\xac\x7e\x6c\x02\xd8\x20\x28\x2c\x9d\xe0\x6c\x6b\x50\x01\x83\x2d\xe3\x79\xa5\x90\x38\x5a\xa5\x28\xd4\x86\x29\x43\x4a\xf2\x06\xa1\x10\xad\x4d\x0c\x45\xa5\x0d\x14\xec\xd6\x86\x45\x01\x3c\xc5\xa2\x94\x06\x85\xf9\xad\xd5\x83\x17\x8f\xd0\xc2\xf3\xb1\x5c\x21\x4b\x0f\xc0\xfc\xbe\xd4\x5a\x86\x35\x6e\xb4\xe6\xe9\xd8\xeb\x8d\xb8\x33\x48\xed\x6f\x0a\x29\xa9\x08\xcc\xfd\x8d\x69\x8b\x28\xd2\x46\x27\x96\x42\xb9\x3f\xda\x1d\xba\x4a\x12\x74\xb1\x4c\x08\x73\xa5\xa4\xd2\xc0\x94\x55\x84\x54\xa4\x08\x9b\x32\x77\x57\x1f\x67\xa0\x9d\x99\x12\x99\xa2\x9e\xc0\xf2\xf2\x7a\xfd\xee\xf2\x66\x79\x0e\x06\xc9\xbf\x4c\xc6\x0c\x08\x59\x43\x13\x1c\xd7\xbd\xc3\x50\x06\x44\x6d\xc6\x70\x35\x5f\x5d\xde\x5c\xcd\xe6\xeb\xf9\xbf\xdf\x4f\x6f\x56\xd7\xf3\xf3\x2e\x02\x14\xcc\x90\x94\x3b\x60\x82\xd0\x02\xfd\x59\x0c\x72\x08\x76\xc7\x78\x4e\x39\x74\x32\xa2\x60\xe2\x09\xf6\xe2\xf2\x7e\x44\x19\x3b\xa6\xa7\xbd\x48\x21\xd1\x63\x65\xb2\x16\x9f\xd4\x1f\x28\x7f\x62\x81\x54\x99\xb4\x48\x4f\xeb\x5f\x1f\xf4\xee\xa7\xc6\xed\x9a\xd5\x2b\x2c\xf3\xc3\x4f\x70\xff\xa5\x16\xc1\x5b\x8f\x22\xd0\xc5\xa4\x00\x5e\x50\xb2\x71\xb9\xba\x55\x56\x2d\x17\x13\x29\x6c\xa4\x34\x1a\xb8\xb1\xc5\xab\x46\x22\x39\x2b\x8d\x2a\x65\x86\x4d\x60\x61\x1a\xe6\x92\xca\x1c\x7d\x75\xde\x40\xd9\x88\x14\x65\x9d\x16\x83\x33\x78\x51\x9e\xba\x7f\x63\xf9\xdd\x5a\x4f\xfa\xda\x95\xb4\x91\x65\xa8\x3c\x12\xb2\xa6\xe5\xa6\x1f\x66\x0d\xcf\x1a\xe0\xa9\xff\x11\x73\xf5\x8b\x3d\xb6\x3e\x3b\xd4\xfb\x7a\x4a\xd2\x2d\x03\xbf\xf5\xa9\xfb\x37\x86\x77\x6b\x3d\xf4\x0b\xae\xcd\x82\x6c\xd0\x72\xb0\xd2\xbb\xa5\x9a\x85\x4b\xba\xb5\x52\x7d\x54\xb4\x7c\x03\x90\xa7\xed\xef\x98\x7f\xbb\xde\x93\x61\x46\x4d\x04\x37\x87\x48\x82\x86\xb5\xdc\x1e\xd3\x67\x43\xf7\xb4\xfe\x15\xb3\xac\x57\x5b\x86\x5f\xc2\xd2\xe5\xb5\xc5\x75\xd7\x5f\x28\xd0\xd1\xe6\x98\x01\x9f\x9c\x8c\x50\x54\x45\x07\xa3\x09\xb2\xa5\x34\x54\x13\xec\x9f\xab\xeb\xe9\xf5\xcd\x6a\x7d\xb3\x5c\x7d\x9c\xcf\x16\xef\x16\xf3\x73\x38\x83\xe7\x6f\xea\xad\xd7\x41\xee\x8b\x32\x05\xf9\x13\xf5\x73\x8d\xb2\x0f\x68\xdc\xa1\xa7\x17\x17\x97\xb3\x29\xe5\x8a\x33\x78\x71\x0c\x68\x83\x94\x33\x6a\x62\x47\xe8\xaa\xc4\x62\xf9\x3b\x9c\xc1\xcb\x63\x84\x0d\xbf\x36\xea\x9a\x7e\xb1\x89\xb5\x00\xce\x8a\x71\x1a\xa1\x05\x4a\xa4\xc2\x81\xe9\xd8\x67\xcd\x75\x81\x5a\x53\xa4\xbb\x64\xb9\xcf\xbc\x19\x7b\x55\x0c\xce\xe0\xd5\x9b\xd1\x97\xd1\xa8\x26\x08\x52\x4c\xab\xe6\x1b\xc1\xff\xaa\x10\x78\x5a\xb7\xa9\xf5\x31\x12\x2a\xd2\x82\x2a\xac\xaf\x4e\x13\x98\x3e\x52\x61\xb8\xa1\xc3\x3b\xd7\xab\xf5\x45\x6d\xa6\xd8\x85\xd9\xaf\xa7\x71\x9b\xc4\x6c\xb2\x39\x94\xd8\x11\x63\x0c\x4c\x1c\x1a\xb6\x7c\x0b\x58\x94\xe6\x10\x22\xd7\xd4\x6b\x4b\x1d\x1a\xe5\x5d\xa5\x6c\xc7\x49\x85\x56\xf1\x84\xda\x32\x6a\x70\x42\x78\xbb\x75\x26\x85\x36\x8a\x71\x61\xa8\x24\xb5\xbf\xcf\xe0\x74\x50\x7f\x36\x08\x5a\x0d\x92\xf7\xb5\x7a\x08\x81\xbd\x67\xd7\x6b\xee\xe0\x01\x60\xc8\x38\x82\x2b\xb8\xe0\x45\x55\x80\xa8\x8a\x0d\x2a\x52\x49\x52\x56\x90\x48\x85\xda\x6e\xe3\xc2\x9c\xbe\x84\x82\x8b\x75\x52\x56\xeb\x44\x56\xc2\xf4\xfd\xd8\x63\xb0\xc2\x7e\xa6\xfe\x0f\x0b\xea\x04\xb9\x80\x0f\xfc\x6d\x07\xc7\x7f\xeb\xfa\x34\x53\x49\xc6\x0d\x26\xa6\x52\x7d\xcb\xe0\x64\x37\x01\x56\xa4\x3f\xbf\x72\xbd\x2d\x4f\x42\xbb\x44\xa4\x5d\xef\xf6\xd5\x96\xe7\x94\xab\xfe\x96\xa2\x0b\x1e\x02\xd9\xcf\xd6\x97\x03\x00\x85\x5a\x56\x2a\x41\x28\xa5\xcc\x1f\x20\xb6\x9f\xcf\xe0\x75\x4b\xcc\x76\x51\x56\x74\x7d\x56\xc6\xee\x1c\x59\xd3\xb5\x79\x7a\x43\xdb\xcf\xe0\xe7\x47\xe8\x85\x7c\x00\x43\x48\xb3\xf6\x38\xff\xd3\xe0\x9c\x73\x7d\x7b\x44\x10\xdb\xcb\xc2\x96\x2b\x6d\x80\xce\xee\xd3\xaa\x92\x94\xc9\xb8\xbe\x8d\x99\xac\x8c\x54\x6c\x87\xad\x2f\x81\x76\x2b\x70\x06\xbf\x34\xec\x16\xc2\xa0\xda\xb2\x04\x8f\xf0\x8c\x31\x9b\xdd\x01\x2a\x6f\x11\xce\xe0\xd7\xc8\x8f\xfb\x22\x34\xde\xbc\x48\x51\x18\xbe\xe5\x9e\x6f\x23\xbe\x57\x4d\xce\x36\x98\x1f\x75\x5d\xcd\xff\x6e\x1c\x83\x28\xe9\x36\xfb\x7b\xe8\xb9\x76\x43\xe4\xb3\xb5\x75\xec\xf6\x23\xa7\x8b\x4d\x1b\xc7\xf8\xd0\xc9\x8f\x1d\xa6\xd1\xc8\xe3\x27\xd2\x25\x4b\x30\x26\xa2\x54\xc9\x8c\xa1\x3b\x3f\xdd\xd4\x43\x0c\xb7\xbb\x1b\x8b\x82\x15\xf6\xf2\x90\xf0\x54\xd5\x5a\xd1\xd5\x46\xa0\xf9\x7a\x60\xb7\xbd\x1b\x8d\x5b\xb6\x51\x3c\xf9\x6a\x14\xbf\xbd\x1b\x92\x77\x39\x5d\x38\xd3\x07\x61\x5c\x3a\x77\x3b\xb7\x20\x82\x12\xbf\xa1\x40\xcd\x98\x5e\xdf\xf1\xb4\x0d\x58\x67\x66\xb7\xf4\x73\x64\xa8\xa6\xdb\x6c\xcd\x43\x42\xe8\x83\x36\xf6\xb6\xd4\x94\xa1\xf0\xe2\x18\xe9\xc2\xee\x1c\xaa\x47\x75\xad\x22\x94\x92\x69\x6d\x25\x1f\xc4\x88\xab\xda\x90\xb9\x7c\xc7\x45\x3d\x20\x81\xf8\x7e\x7d\x0c\x1a\x31\xe8\xf5\x42\x4c\x3b\x78\x91\x3d\x1b\xd9\xf9\xc4\x33\x2e\xb8\xa1\x1a\xb5\xe5\xbb\x4a\xd9\x31\x47\xcd\xc2\x47\x74\x88\x54\x77\x1a\x3d\x4b\x09\x34\x7b\xa9\x6e\x21\x67\x07\x59\xd9\x36\x9b\x46\x48\x07\xd8\xe0\x56\x2a\x04\x33\xdc\xcc\x8c\xc9\x64\x4c\xb8\xe2\xbb\xf4\x10\x35\x54\x94\x62\x33\x6c\x72\xd0\x77\xb0\xf0\x59\x25\x48\x67\x43\x1e\x30\x50\x8c\x37\xb9\x4c\x6e\x21\xc5\x3b\x9e\xb4\x4d\x6f\xcd\x8d\xd9\xd8\x3d\xc0\x9e\xe7\x79\xd8\x87\xc7\x29\xe2\x2d\x41\x9c\x5b\x04\x07\xb7\xae\xe1\xba\x35\xbc\x56\x44\x23\xc1\x5b\x29\x52\x4d\x07\x76\x83\x0b\xd8\x2a\x59\x40\x99\x1d\x34\x4f\x58\xde\x06\x86\xee\x30\x94\xb6\x53\x24\xd2\xd0\x1d\x9b\x74\x64\x11\x73\x2e\x6e\xe9\x5f\x17\xf5\x1d\x84\x66\x6b\xc0\xc3\xf5\xa8\x81\xb4\x24\x1c\xdc\x1f\x73\xd3\x0d\x7d\x6d\xa8\x43\x57\xb2\xbb\xba\x71\x42\x8b\x8d\x7e\x89\x16\xd3\xa3\xe7\xf3\x38\x25\x53\x48\xdd\x4e\x37\x58\x88\x9a\xf8\x14\x32\xf5\xe5\xcf\x0f\x86\x52\xdc\xb2\x2a\x37\x83\x9d\x1f\x6d\x3e\x9e\xc0\x87\x8f\xc9\x06\x4c\x41\x49\x95\x04\x78\xf4\xc0\x75\x86\xa3\xfb\x8c\x4f\x62\x2d\x86\x00\x23\xcb\x5a\x1b\x0d\xf6\x18\x9e\xdb\xd6\x58\x48\x81\x61\x46\x23\xe2\x6f\x49\xf0\xce\xf8\x5f\x91\xcb\x69\x9b\x57\xa3\x6f\xab\xa6\x37\xd7\x97\x63\x7b\x85\x5a\xcc\xc6\x70\xfe\x7e\xf6\x91\x98\x5c\x2c\x96\x7f\xac\x6f\x3e\x0e\xe8\x33\xce\x15\x2c\x4d\xdd\xb8\x96\xce\xec\x40\x7a\xa2\xf0\x72\x5d\x6f\x0b\xf3\xc0\xa7\x0c\x6d\xff\x6d\x62\x98\x5e\x7d\xa0\x05\x41\xb6\xe4\x65\x5b\x0a\x68\x61\xcd\xcb\x5e\xd4\xd7\x59\x21\xb2\xae\xcf\x32\x1e\xdc\x36\x4a\x34\xb9\x77\xc5\xa0\x51\xc4\x36\x67\x66\x0c\xf9\x5d\x31\x86\x4d\x42\x75\x97\xf4\xa0\x18\x4f\x5f\x84\x87\x29\x95\x24\xda\xc8\xf8\xae\x59\xdb\x4a\x55\x30\x53\xdf\x2b\x6d\x5b\xed\x86\xe3\x26\x43\xae\x68\xe4\x1a\xfb\xfc\x39\xa6\x9c\xaa\x4e\x4a\xe4\x90\xd6\x7f\xad\xa9\x37\xf1\x21\x10\x1c\x2c\xde\x1d\x1d\xcf\xb2\x82\x52\x72\xd1\x9c\xb1\xdb\x48\xd9\x2d\x6b\xb7\xa5\xeb\xb6\x61\x88\x13\x9d\x0b\x30\x5d\xb0\x3c\xb7\xc3\x29\x85\xbe\x5f\x6a\xee\xa8\xb6\x5b\x1d\x0a\x39\x1f\x16\x3f\xb0\xe1\x0a\x33\xed\xfd\x51\xa9\xed\x77\x7f\xe3\xd0\x29\x1b\x10\x29\x3e\x32\xb9\x72\xde\x89\xf7\xbe\xca\x68\x4f\x37\x08\xa3\x9e\xd3\xb2\x3d\xd6\x75\x06\x71\x47\xea\x8a\x68\x1e\x3a\x7f\x1b\x5f\x9f\x68\x4c\x19\x32\xd2\xf4\xf2\x64\xa7\xb5\xa1\xa0\xb4\xb6\xa6\x24\xd2\x2d\xb2\x81\x5f\x74\xf8\xdb\x81\x2d\x37\x1a\x4a\xa6\x0c\xa7\x3e\x61\x38\x1f\x07\x6e\xe3\xaf\x39\x81\x69\xda\xd9\x1a\xdc\xff\x88\xfe\xe8\xc5\x9b\xef\xef\xd4\x5e\x0e\x49\x58\xf7\x00\xc1\x97\x66\x6e\xf7\xdf\x2a\x7a\x30\x44\x8c\x45\x6c\x1a\x15\x0f\x97\x36\xcf\x2a\x8d\xf0\x52\xb5\x22\x8c\x01\xed\xd3\x43\x6f\x02\x41\x25\x73\x23\x4d\x66\x67\xf0\x6d\x20\x37\x1e\xd0\x19\x4e\xf4\x3b\x9b\x7a\xc3\x51\x05\x86\x6d\xd5\x9e\x85\x73\xb7\x8e\x03\x1f\xd3\xe5\x57\xcc\x9e\xda\x56\xe0\x01\xb5\x06\xf2\x65\x52\x9b\x30\x73\x78\xc4\x90\xb8\xd9\xd2\x2d\x9d\x43\x73\xcb\x2e\x46\x3c\xac\xf4\xef\x18\x61\x4c\x4f\x21\xab\x0a\x26\x80\x5e\x60\xe8\x39\x01\x52\x34\x8c\x37\x23\x0a\x47\xe1\x33\xd9\x3e\x3b\x80\x89\x19\xbb\x59\x5f\x74\xd6\x78\xea\xd7\xcd\x01\xbe\xb0\xf6\x1a\xdd\x23\x43\x04\x5f\x67\xc9\x5f\x7d\x6b\x35\x98\x17\xda\x8a\x8e\x9d\xf1\x47\x97\x25\xb2\x24\x0b\xca\x79\x2c\xc4\x91\x0e\x75\xda\x00\x34\x84\xf1\x70\xe4\x1f\x8e\x88\x7e\x19\xee\x04\x5b\x76\x47\x0b\x4c\x23\xc7\xa3\x95\x25\x3a\x7c\x9f\xf4\x11\x45\xc6\x39\xa0\xbd\x00\xba\x44\x35\xf8\x69\x20\x45\x7c\xcd\x93\x42\x47\xf5\x44\x51\x3f\x45\x74\x03\xdd\x8a\xf0\x80\x6e\xe8\xb3\xf7\x58\xa9\xcf\xaa\x4d\x25\x4c\xf5\xec\x33\x0a\xce\xf2\xf1\xed\x2f\xfa\xec\xc5\xe4\xc5\xe9\xe4\xf5\x98\xc6\xb0\x29\x53\x8f\xb7\xd1\xd1\x64\xb0\xbd\x1e\x53\x0a\xa1\xd7\x68\xfb\x5c\xe6\xf9\x7d\xed\x74\x31\x4e\x02\x29\x3b\x04\xd7\x6e\xc2\xad\xca\x5c\xb2\x94\x2e\x98\x94\xa7\x04\x5c\xbd\x9b\xc1\xe9\xe9\xe9\xaf\x90\x32\x83\x63\xd7\xe7\x50\xc2\xac\xc4\xad\xa8\xfb\x38\xcf\xa9\x26\xed\x75\x2e\xc1\x4b\x09\xdc\x0f\x7d\x38\x9e\xdc\x69\x48\x4e\x0f\xb4\x46\xd2\x15\xb4\xc9\x9b\xb1\xcd\xde\x33\x95\xd2\x7f\xd7\xa8\x23\xa1\xd3\x93\xba\x17\x67\x0f\xd8\x7b\xda\x81\x7d\xc6\x0c\x55\x06\xdb\x05\x50\xd6\xe9\xf8\xe7\x7b\x49\xad\x9a\xd4\x03\x97\xb1\x5e\xe4\xf5\x5d\xad\x4d\xf5\x5c\xc4\xb8\x5e\x6b\x14\x91\xfd\x66\xaf\x39\xd2\xfd\x0f\x2d\x01\x2f\xbe\xa3\x04\x3c\x72\xf4\xc7\x93\xce\xe9\xf7\xcc\xa5\x5f\x1d\x0f\x8c\x98\xf6\x1b\xe3\xe1\xf5\x60\x6f\xda\x60\x99\xee\xab\x8b\x7d\x6e\xed\xd9\x96\xe9\x87\xfa\xd8\xb8\x28\x3c\xfc\x54\x11\x3e\x53\x74\xd2\xfc\xe3\xcf\x13\xf5\xd3\x44\x3b\xcd\x26\xc2\xa8\xaf\xef\x1e\x31\x96\xdb\xdd\xbf\xfc\xad\x2b\x1e\x5d\xf7\x2f\x5b\xa1\xeb\xd0\x95\xe1\x9f\xdc\x3a\x6a\xda\x6f\xbc\x6e\x3c\x30\xe2\x3e\x1d\x34\x68\x28\x9f\xee\x8c\xb0\x62\x63\xc5\x2f\x81\x36\xf8\xa3\x53\x67\x75\x64\x0e\xe8\xb1\x89\xda\x66\x53\xf7\xe0\x6d\xda\x19\x6a\x9c\xbc\x84\x57\xf6\xc5\x50\x2a\x3f\xb8\x1b\xea\x72\x7a\xea\x29\xe5\x1e\xd5\x43\xa0\x52\xd0\x25\x5e\x6e\xb7\x21\x98\xa5\x5a\x3b\xaa\x6e\x80\xd2\x50\x34\xc4\x89\xff\x6b\x89\x91\x41\x31\x70\xcf\x99\x5b\x85\x51\xe4\xca\xbd\x40\xd5\x0b\xdd\x1f\xd0\x17\xbf\x1e\x9c\xd1\x36\xed\xc5\xc3\x2e\xbe\x5c\xcc\x40\xf0\xc4\xc7\x65\x60\x6a\xfa\x70\xd4\xbf\xe3\xde\xe5\x41\xd7\x66\x49\xdd\xf7\x3d\x48\x5c\xb0\xa4\x6e\x78\x7a\xc6\x0c\xc6\x67\xdf\x30\x23\x8b\xcd\xf7\x7d\xed\xd7\xab\x37\xa3\x2f\xa3\xff\x0c\x00\x5a\x7d\x30\x2a\x75\x2a\x00\x00"),
 		},
 		"/third_party": &vfsgen۰DirInfo{
 			name:    "third_party",
@@ -39,7 +50,7 @@ var Api = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 962,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x91\xcd\x6e\xdb\x30\x0c\xc7\xef\x7a\x0a\x22\x77\xc7\x5f\x72\xfc\x01\xec\xd0\x75\x58\x50\xa0\x18\x82\x74\x7b\x00\x4a\x66\x64\x0d\x89\x24\x48\x6a\x03\xbf\xfd\x10\xd7\x5d\x13\xac\x29\xba\x43\x75\x31\x48\xfe\x49\xff\xc9\xdf\xda\x5a\xb5\x27\xb8\xd9\xdc\x05\xf6\xe5\xec\x31\xb6\xf1\xf6\x37\xc9\xd8\xc1\xb9\xe4\xd7\xf6\xbe\x83\x21\x46\x17\xba\x34\x55\x3a\x0e\x8f\x62\x29\xed\x21\x55\x93\x66\xfe\xa0\xd3\x81\x6d\xe9\x49\x07\x6d\x4d\x07\x65\xc5\x39\x8a\x7c\x25\xcb\x92\x17\x7d\xdd\x66\x22\xcb\xea\x15\x2f\xaa\x9c\x4a\xde\xd4\x59\xd5\xb6\x39\x21\x17\xec\x5e\x4b\x32\x81\x3a\xb8\x71\x28\x07\x82\x39\x86\x62\x99\x31\xc6\xee\x0e\xce\xfa\x48\x3d\x7c\xd7\x7b\x0a\x2c\xb9\x7c\x8c\x25\x30\x9b\x40\xa7\x53\x34\xc6\x46\x8c\xda\x9a\xb0\x74\xde\x46\x7b\x59\x3e\x6d\x70\x2d\x2f\x6c\x3f\xce\x35\xc6\xd6\x64\xc8\xe3\xd5\xbf\x26\x8c\xfd\x1c\x68\x04\xf4\x04\xea\xaf\x74\xe7\xed\x01\xe2\x40\xf0\x3c\x06\x76\xa7\x56\x10\x23\x4c\xa1\x4c\x14\x99\x44\xd9\xe5\x3b\x8e\xc5\x52\xbd\xe9\x78\xca\x9f\xc8\x9c\xc6\xac\xc9\x24\x0f\x47\x54\x8a\xfc\x05\xba\x7f\x09\xaa\xed\xe6\x16\xd6\x18\xe9\x88\xe3\x75\x84\xde\xc9\x84\xa4\x0d\x63\x88\x34\x87\x6a\xee\x79\x85\x89\x7d\x55\xb4\xc8\x79\x23\x90\xb7\x1c\x9b\x26\xab\x9a\x5d\x4b\x95\xa0\xac\x6d\x9a\x3a\x2f\xf3\x9a\xa3\x6c\x56\xaf\x30\xbf\x3e\x7c\x83\x32\xb9\xdd\xe3\x63\x20\x58\xfc\xa0\xe3\x02\xac\x87\xc5\x34\x92\xfa\xc5\x0b\xe5\x0f\x11\x3e\x3b\x60\x78\xde\x3c\xb5\x6e\xba\xd9\x9b\xc4\xdf\x91\x5b\x47\x06\x9d\x7e\x2a\x5e\x50\x7f\x32\xe9\x8f\x3a\x9f\xc9\xff\x87\xf3\x3f\x01\x00\x00\xff\xff\x68\x67\xaa\x76\xc2\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x91\xcd\x6e\xdb\x30\x0c\xc7\xef\x7a\x0a\x22\x77\xc7\x5f\x72\xfc\x01\xec\xd0\x75\x58\x50\xa0\x18\x82\x74\x7b\x00\x5a\x66\x64\x0f\x89\x28\x48\x6a\x03\xbf\xfd\x10\xd7\x5d\x13\xac\x29\xba\x43\x79\x31\x48\xfe\x4d\xfd\xc9\xdf\x9a\x59\xef\x09\x6e\x36\x77\x5e\x7c\x39\x0b\x21\x36\x8e\x7f\x93\x0a\x0d\x9c\x4b\x7e\x6d\xef\x1b\xe8\x43\xb0\xbe\x89\x63\x3d\x84\xfe\xb1\x5d\x2a\x3e\xc4\x7a\xd2\xcc\x1f\xb4\x83\x17\x5b\x7a\x1a\xfc\xc0\xa6\x81\xbc\x90\x12\xdb\x74\xa5\xf2\x5c\x66\x5d\x59\x27\x6d\x92\x94\x2b\x99\x15\x29\xe5\xb2\x2a\x93\xa2\xae\x53\x42\xd9\x8a\xfb\x41\x91\xf1\xd4\xc0\x8d\x45\xd5\x13\xcc\x39\x64\xcb\x44\x08\x71\x77\xb0\xec\x02\x75\xf0\x7d\xd8\x93\x17\xd1\x65\x08\x11\xc1\x6c\x02\xed\x10\xa3\x31\x1c\x30\x0c\x6c\xfc\xd2\x3a\x0e\x7c\xd9\x3e\x6d\x70\xad\xde\x72\x37\xce\x3d\x21\xd6\x64\xc8\xe1\xd5\x57\x23\x21\x7e\xf6\x34\x02\x3a\x02\xfd\x57\xba\x73\x7c\x80\xd0\x13\x3c\x8f\x81\xdd\xc9\x30\xb4\x23\x4c\xa9\x8a\x34\x99\x48\xf3\xf2\x1d\xc7\xed\x52\xbf\xe9\x78\xaa\x9f\xc8\x04\x56\xd1\x9a\x4c\xf4\x70\x44\xad\xc9\x5d\xa0\xfb\x97\xa0\xde\x6e\x6e\x61\x8d\x81\x8e\x38\x5e\x47\xe8\xac\x8a\x48\xb1\x1f\x7d\xa0\x39\xd5\xf3\x3f\xaf\x30\xb1\x2b\xb2\x1a\xa5\xac\x5a\x94\xb5\xc4\xaa\x4a\x8a\x6a\x57\x53\xd1\x52\x52\x57\x55\x99\xe6\x69\x29\x51\x55\xab\x57\x98\x5f\x1f\xbe\x41\x1e\xdd\xee\xf1\xd1\x13\x2c\x7e\xd0\x71\x01\xec\x60\x31\x8d\xa4\x6e\xf1\x42\xf9\x43\x84\xcf\x0e\xe8\x9f\x37\x8f\xd9\x4e\x37\x7b\x93\xf8\x3b\x72\xb6\x64\xd0\x0e\x4f\xd9\x0b\xea\x4f\x26\xfd\x51\xe7\x33\xf9\xff\x70\xfe\x67\x00\x68\x67\xaa\x76\xc2\x03\x00\x00"),
 		},
 		"/third_party/google": &vfsgen۰DirInfo{
 			name:    "google",
@@ -54,21 +65,21 @@ var Api = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1055,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x53\x4f\x6f\xdb\x3e\x0c\xbd\xfb\x53\x10\x3e\xb5\x40\x7e\x76\x7f\x19\x8a\x6e\x0b\x72\xf0\xda\xae\x35\xd6\x25\x45\x9c\xae\xe8\x29\x55\x64\xc6\x66\x67\x8b\x9a\x24\x37\x09\x86\x7d\xf7\x41\xfe\xb3\x26\x5b\x4e\x31\xf9\xf8\xf8\xf8\x48\xc5\x31\x5c\xb2\xde\x1b\x2a\x4a\x07\x27\xf2\x14\xc6\x67\xff\x9f\x8f\xe0\x86\xb9\xa8\x10\x52\x25\xa3\x20\x8e\x83\x38\x86\x3b\x92\xa8\x2c\xe6\xd0\xa8\x1c\x0d\xb8\x12\x21\xd1\x42\x96\x38\x64\x46\xf0\x0d\x8d\x25\x56\x30\x8e\xce\xe0\xc4\x03\xc2\x3e\x15\x9e\x4e\x3c\xc5\x9e\x1b\xa8\xc5\x1e\x14\x3b\x68\x2c\x82\x2b\xc9\xc2\x86\x2a\x04\xdc\x49\xd4\x0e\x48\x81\xe4\x5a\x57\x24\x94\x44\xd8\x92\x2b\xdb\x3e\x3d\x8b\x57\x02\x4f\x3d\x07\xaf\x9d\x20\x05\x02\x24\xeb\x3d\xf0\xe6\x10\x08\xc2\xf5\xa2\xfd\xaf\x74\x4e\x7f\x8c\xe3\xed\x76\x1b\x89\x56\x70\xc4\xa6\x88\xab\x0e\x6a\xe3\xbb\xf4\xf2\x7a\x96\x5d\xff\x37\x8e\xce\xfa\xa2\x07\x55\xa1\xb5\x60\xf0\x47\x43\x06\x73\x58\xef\x41\x68\x5d\x91\x14\xeb\x0a\xa1\x12\x5b\x60\x03\xa2\x30\x88\x39\x38\xf6\xa2\xb7\x86\x1c\xa9\x62\x04\x96\x37\x6e\x2b\x0c\x7a\x9a\x9c\xac\x33\xb4\x6e\xdc\x91\x67\x83\x44\xb2\x47\x00\x56\x20\x14\x84\x49\x06\x69\x16\xc2\xa7\x24\x4b\xb3\x91\x27\x79\x4c\x97\xb7\xf3\x87\x25\x3c\x26\x8b\x45\x32\x5b\xa6\xd7\x19\xcc\x17\x70\x39\x9f\x5d\xa5\xcb\x74\x3e\xcb\x60\xfe\x19\x92\xd9\x13\x7c\x49\x67\x57\x23\x40\x72\x25\x1a\xc0\x9d\x36\x7e\x02\x36\x40\xde\x4d\xcc\x5b\xeb\x32\xc4\x23\x09\x1b\xee\x24\x59\x8d\x92\x36\x24\xa1\x12\xaa\x68\x44\x81\x50\xf0\x2b\x1a\x45\xaa\x00\x8d\xa6\x26\xeb\xb7\x6a\x41\xa8\xdc\xd3\x54\x54\x93\x13\xae\x0d\xfd\x33\x57\x14\x04\x76\xaf\x9c\xd8\xc1\x14\x42\x6d\xd8\xf1\xbb\x70\x12\x04\x5a\xc8\xef\x1d\xb1\x3f\xab\x48\x68\x9a\x04\x01\xd5\x9a\x8d\x83\xb0\x0b\xc6\x42\x53\xec\x77\x15\xb5\x65\xe1\xe4\xef\x7c\x1b\x5e\x37\x9b\x38\x47\x2b\x0d\x69\xc7\xe6\x0f\x34\x60\xed\x05\x41\xc1\xab\xa1\xd5\x74\x28\x8c\x0a\xf6\x83\xb5\x5b\x2f\x50\xb5\x25\x71\x97\x12\x9a\x6c\xdb\x57\x28\xc5\xfd\x4c\x93\x83\xff\xe1\x64\x20\x7e\x11\xaf\x62\x55\x37\x95\x23\x5d\xe1\xca\xdf\xac\x85\x29\x38\xd3\xe0\x31\x84\x1b\x87\x66\x25\x2b\x61\xad\x12\x75\xab\x22\x79\xe3\xbb\xef\xe5\x1e\x56\x1c\xe8\x95\x5c\x47\x6f\x0e\xbd\xe1\x78\xfd\x22\x3b\xce\x95\x36\xb8\xa1\xd6\xdc\x9b\xe4\x3e\xf5\x93\xe3\xce\xa1\xca\x07\x67\x07\x93\xa2\xaf\xe8\x4a\xce\xe7\xba\xdb\xd3\xcf\xc0\xbf\x84\xfe\x06\x9e\x6f\x9d\xd3\x8b\xa6\xc2\xe7\xa8\x0d\x0f\x9f\xed\x4b\x81\x29\x5c\x8c\xc7\x1f\xce\x2f\xc6\xef\x27\xc1\xaf\xe0\x77\x00\x00\x00\xff\xff\x28\x62\xdb\x3f\x1f\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x53\xc1\x6e\xeb\x36\x10\xbc\xeb\x2b\x06\x3a\xbd\x07\xb8\x52\xea\x22\x48\x5b\xc3\x07\x35\x49\x13\xa1\xa9\x1d\x58\x4e\x83\x9c\x1c\x9a\x5a\x4b\x9b\x4a\x24\x4b\x52\xb1\x8d\xa2\xff\x5e\x50\xb6\x6a\xbb\xef\x26\xed\xce\xce\xce\xce\x2e\xd3\x14\xb7\xda\xec\x2d\x57\xb5\xc7\x17\xf9\x15\xe3\xab\xef\xaf\x47\x78\xd0\xba\x6a\x08\xb9\x92\x49\x94\xa6\x51\x9a\xe2\x89\x25\x29\x47\x25\x3a\x55\x92\x85\xaf\x09\x99\x11\xb2\xa6\x21\x33\xc2\x1f\x64\x1d\x6b\x85\x71\x72\x85\x2f\x01\x10\x1f\x53\xf1\xd7\x49\xa0\xd8\xeb\x0e\xad\xd8\x43\x69\x8f\xce\x11\x7c\xcd\x0e\x1b\x6e\x08\xb4\x93\x64\x3c\x58\x41\xea\xd6\x34\x2c\x94\x24\x6c\xd9\xd7\xf0\xa7\x06\x41\x09\xde\x8e\x1c\x7a\xed\x05\x2b\x08\x48\x6d\xf6\xd0\x9b\x73\x20\x84\x3f\x8a\x06\x80\xda\x7b\xf3\x73\x9a\x6e\xb7\xdb\x44\xf4\x82\x13\x6d\xab\xb4\x39\x40\x5d\xfa\x94\xdf\xde\xcf\x8a\xfb\xef\xc6\xc9\xd5\xb1\xe8\x45\x35\xe4\x1c\x2c\xfd\xd5\xb1\xa5\x12\xeb\x3d\x84\x31\x0d\x4b\xb1\x6e\x08\x8d\xd8\x42\x5b\x88\xca\x12\x95\xf0\x3a\x88\xde\x5a\xf6\xac\xaa\x11\x9c\xde\xf8\xad\xb0\x14\x94\x96\xec\xbc\xe5\x75\xe7\x2f\x3c\x1b\x24\xb2\xbb\x00\x68\x05\xa1\x10\x67\x05\xf2\x22\xc6\x2f\x59\x91\x17\xa3\x40\xf2\x9a\x2f\x1f\xe7\x2f\x4b\xbc\x66\x8b\x45\x36\x5b\xe6\xf7\x05\xe6\x0b\xdc\xce\x67\x77\xf9\x32\x9f\xcf\x0a\xcc\x7f\x45\x36\x7b\xc3\x6f\xf9\xec\x6e\x04\x62\x5f\x93\x05\xed\x8c\x0d\x13\x68\x0b\x0e\x6e\x52\xd9\x5b\x57\x10\x5d\x48\xd8\xe8\xc3\x1a\x9d\x21\xc9\x1b\x96\x68\x84\xaa\x3a\x51\x11\x2a\xfd\x49\x56\xb1\xaa\x60\xc8\xb6\xec\xc2\x56\x1d\x84\x2a\x03\x4d\xc3\x2d\x7b\xe1\xfb\xd0\x37\x73\x25\x51\xe4\xf6\xca\x8b\x1d\xa6\x88\x8d\xd5\x5e\xff\x10\x4f\xa2\xc8\x08\xf9\xe7\x81\x38\x9c\x55\x22\x0c\x4f\xa2\x88\x5b\xa3\xad\x47\x7c\x08\xa6\xc2\x70\x1a\x76\x95\xf4\x65\xf1\xe4\xff\xf9\x3e\xbc\xee\x36\x69\x49\x4e\x5a\x36\x5e\xdb\xff\xa0\x91\x36\x41\x10\x2a\xbd\x1a\x5a\x4d\x87\xc2\xa4\xd2\x61\xb0\x7e\xeb\x15\xa9\xbe\x24\x3d\xa4\x84\x61\xd7\xf7\x15\x4a\xe9\xe3\x4c\x93\xb3\xef\x78\x32\x10\x7f\x88\x4f\xb1\x6a\xbb\xc6\xb3\x69\x68\x15\x6e\xd6\x61\x0a\x6f\x3b\xba\x84\xe8\xce\x93\x5d\xc9\x46\x38\xa7\x44\xdb\xab\xc8\x4e\x7c\xcf\x47\xb9\xe7\x15\x67\x7a\xa5\x6e\x93\x93\x43\x27\x9c\x5e\x7f\xc8\x03\xe7\xca\x58\xda\x70\x6f\xee\x43\xf6\x9c\x87\xc9\x69\xe7\x49\x95\x83\xb3\x83\x49\xc9\xef\xe4\x6b\x5d\xce\x7b\x02\x87\xbf\xa3\xf0\x12\x8e\x37\xf0\xfe\xe8\xbd\x59\x74\x0d\xbd\x27\x7d\x78\xf8\xed\x5f\x0a\xa6\xb8\x19\x8f\x7f\xba\xbe\x19\xff\x38\x89\xfe\x89\xfe\x1d\x00\x28\x62\xdb\x3f\x1f\x04\x00\x00"),
 		},
 		"/third_party/google/api/http.proto": &vfsgen۰CompressedFileInfo{
 			name:             "http.proto",
 			modTime:          time.Time{},
 			uncompressedSize: 12233,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\xef\x52\x1c\x39\x92\xff\xce\x53\xe4\xd5\xc6\xed\x00\xd7\x54\xdb\x78\x66\x76\x0f\xae\x6f\xa2\x17\x63\x9b\x3b\x06\x3b\x00\x8f\x63\xce\xe1\xa0\xd5\x55\xd9\xdd\x5a\x57\x4b\x35\x92\xaa\x31\x8b\xb9\x67\xbf\xc8\x94\x54\xa5\x6a\x1a\x0f\xb3\xbe\x8d\x8d\xe1\x83\x5d\x50\xaa\x54\x66\x2a\xf3\x97\xff\x34\x1c\xc2\x91\xae\x6f\x8c\x9c\x2f\x1c\xec\x3f\x79\xfa\x67\x78\xa9\xf5\xbc\x42\x38\x3d\x3d\xda\x1a\x0e\xb7\x86\x43\x38\x95\x05\x2a\x8b\x25\x34\xaa\x44\x03\x6e\x81\x30\xae\x45\xb1\xc0\xf8\x66\x00\x3f\xa1\xb1\x52\x2b\xd8\xcf\x9f\xc0\x36\x2d\xc8\xc2\xab\x6c\xe7\x90\x48\xdc\xe8\x06\x96\xe2\x06\x94\x76\xd0\x58\x04\xb7\x90\x16\x66\xb2\x42\xc0\x4f\x05\xd6\x0e\xa4\x82\x42\x2f\xeb\x4a\x0a\x55\x20\x5c\x4b\xb7\xe0\x7d\x02\x95\x9c\x68\xfc\x1c\x68\xe8\xa9\x13\x52\x81\x80\x42\xd7\x37\xa0\x67\xe9\x42\x10\x2e\x30\x4d\x3f\x0b\xe7\xea\x83\xe1\xf0\xfa\xfa\x3a\x17\xcc\x70\xae\xcd\x7c\x58\xf9\xa5\x76\x78\x7a\x72\x74\x7c\x76\x71\xbc\xb7\x9f\x3f\x09\x1f\xbd\x55\x15\x5a\x0b\x06\x7f\x69\xa4\xc1\x12\xa6\x37\x20\xea\xba\x92\x85\x98\x56\x08\x95\xb8\x06\x6d\x40\xcc\x0d\x62\x09\x4e\x13\xd3\xd7\x46\x3a\xa9\xe6\x03\xb0\x7a\xe6\xae\x85\x41\x22\x53\x4a\xeb\x8c\x9c\x36\xae\xa7\xb3\xc8\xa2\xb4\xbd\x05\x5a\x81\x50\x90\x8d\x2f\xe0\xe4\x22\x83\xbf\x8c\x2f\x4e\x2e\x06\x44\xe4\xdd\xc9\xe5\xab\xd7\x6f\x2f\xe1\xdd\xf8\xfc\x7c\x7c\x76\x79\x72\x7c\x01\xaf\xcf\xe1\xe8\xf5\xd9\xf3\x93\xcb\x93\xd7\x67\x17\xf0\xfa\x05\x8c\xcf\x7e\x86\xff\x3e\x39\x7b\x3e\x00\x94\x6e\x81\x06\xf0\x53\x6d\x48\x02\x6d\x40\x92\x36\xb1\x64\xd5\x5d\x20\xf6\x58\x98\x69\xcf\x92\xad\xb1\x90\x33\x59\x40\x25\xd4\xbc\x11\x73\x84\xb9\x5e\xa1\x51\x52\xcd\xa1\x46\xb3\x94\x96\x4e\xd5\x82\x50\x25\x91\xa9\xe4\x52\x3a\xe1\xf8\x4f\xf7\xe4\xca\xb7\xb6\xec\x8d\x72\xe2\x13\x8c\x20\xab\x8d\x76\xfa\x59\x76\xb8\xb5\x55\x8b\xe2\xa3\x27\x4c\x56\x95\x8b\x5a\x1e\x6e\x6d\xe9\x9a\x88\x40\x51\x5c\xa1\x22\xd5\x5e\x09\x83\x4a\x58\x18\x81\x33\x0d\x1e\xc6\xf7\x73\x7d\x15\x3f\x1f\x41\x16\x28\xcc\x35\x31\xcb\x27\x39\x47\xc5\x1b\x0d\xfd\x2b\x51\x4b\x3b\x14\xb5\x1c\x0a\xa5\x74\xe0\xf3\x30\x79\xce\x5a\xc2\x7f\x15\x2b\x71\xb5\x6c\x2a\x27\xeb\x0a\xaf\xc8\x0e\xef\xed\xcd\x4b\x74\xe3\xd0\x5c\x15\x95\xb0\x56\x89\x25\x73\xf1\xca\xb9\xfa\x0d\x6d\xba\x46\x2d\x61\xb4\xd0\xcb\xbc\x13\xb7\x5b\xa7\xa7\x7f\x2d\x3c\xb1\xab\xda\xe0\x4c\xb2\xa6\x5e\x8e\xdf\x9c\x90\x9e\x48\xbf\xcf\x71\x26\x15\x5a\xd6\xea\xab\xcb\xcb\x37\x50\x68\x35\x93\xf3\xc6\x30\xff\x7c\x6a\x42\xc1\xf8\xcd\x09\x58\x34\x2b\x59\x60\x0e\x27\x8e\x16\x91\x43\x58\x10\x50\x49\xeb\x40\xcf\x88\xd6\x7b\x62\xf4\xbc\xa9\xf0\xc3\xfb\x8e\x97\xbc\xfd\xe3\x00\x50\x14\x8b\x60\x00\x37\x74\xde\xb4\xe9\x52\xd4\x35\x3d\xeb\x19\x6d\x74\xfe\xe6\x08\x96\xe8\x16\x9a\x0f\xdf\x69\xd0\x0a\xc9\xb2\x96\xda\x04\xfe\xce\x8f\x2f\x2e\x99\x1f\xbf\xcc\xe6\x5b\x4b\xb4\x96\xd4\x40\x1b\xc1\xed\x16\x79\xe1\x70\x08\xe3\xc8\xd9\x26\xb1\x4c\x53\xb1\xcc\xc2\xb1\xb3\xdd\x78\xcf\x2a\xe5\x4a\x96\x8d\xa8\xfa\xe4\x3d\xbd\x48\x76\x77\xf7\xec\xf5\xe5\xf1\xc1\xee\x2e\x8c\xab\x2a\xea\x64\x23\xf5\x99\xae\x2a\x7d\x0d\x59\x25\x88\x0d\x45\x08\xa3\x6c\x06\xda\x94\x68\x3c\x55\x83\x35\x0a\xf2\xc7\xa8\xa2\xf0\xe5\x08\x9e\x1e\x6e\xc5\x0d\xdf\x2d\x50\x81\x45\x47\x2c\x92\xb5\x0c\xe0\xed\xf9\x29\xd4\xc2\x2d\xa0\x16\x66\x89\x0e\x8d\x85\x6b\x59\x55\x30\x45\x98\x35\x55\x75\x03\x6f\xcf\x4f\xf6\x4a\x2c\x74\x89\x65\x87\x75\x91\x5e\x21\x2c\x5a\x52\x8b\x95\x8a\x30\xd7\xe2\x7c\x89\xca\xc1\x52\xb8\x62\x81\x96\x00\xc6\x20\xc9\xc5\x1f\xd7\x42\x91\x3b\x0e\xe0\x7a\x81\x06\x21\xfb\xd7\xfd\x17\x59\xdc\x2d\x52\xac\x70\xe6\x00\x15\xef\xb7\xae\xae\xcb\x05\x42\x89\x33\xd1\x54\x0e\xa6\xb8\x10\x2b\x49\x30\x61\x49\x18\xc2\x65\xcf\x25\x9c\xbf\x38\x82\xef\xbf\xfb\xd3\x93\x6e\xe7\x62\x21\x8c\x28\x58\x36\xa9\x80\xdd\x26\x92\x5c\x63\xd8\xef\x38\xd5\xba\xf2\xd2\x5f\x79\x9a\x57\x91\xd4\x55\x2b\x04\x8c\x60\xff\x70\xeb\x8e\xcd\x7e\x12\x55\x3e\x21\xf6\x5a\x0f\x78\xc8\x18\x37\x59\x22\x91\xb9\x67\x8c\x2c\x70\xa4\x12\xa0\x0e\x2d\x2c\xf4\x35\x94\x72\x36\x43\x43\x9c\xd7\xda\x78\x40\x0b\x81\xe4\xfc\x0d\x85\x3d\x0e\x01\x68\x1d\x44\x7b\x16\xc6\x93\xf2\xc0\x1f\x4f\xdd\x9f\xff\x2f\x0d\x9a\x1b\x32\x00\xe1\x2d\x60\x10\xf1\x92\x8d\x3d\x52\x9a\xea\xf2\xa6\xcf\x12\xa9\xfe\xa6\x96\x85\x20\x3b\x89\xfc\x95\x20\x08\x6f\x59\x2f\x89\xe3\x52\x28\x9b\x40\x07\x66\x14\x36\x02\xbb\x41\x5e\x0e\x1a\x16\x31\x02\xe5\x3a\x12\xe6\x0c\x95\x19\xa3\x48\x89\x4e\xc8\xca\xe6\x21\xec\xa5\x4c\x15\x5a\x59\x69\x1d\xeb\x43\xc0\x4c\x62\x55\xae\xa3\x04\xdb\xbb\xc3\x65\x5d\x09\x87\x51\xd6\x70\x36\x1f\xa5\x2a\x73\x60\x8a\xfd\x65\x85\x20\x5b\x9e\x51\xcc\xd0\x9e\x2c\x5b\x13\xd1\x0b\x1a\xf2\x54\x58\xdd\x03\x52\x42\x78\x8b\x9f\xc4\xb2\xae\x10\xa6\x48\x1e\x7c\xbd\x90\xc5\x02\x4a\xb4\x85\x91\x53\x24\xcc\xe3\x63\x7f\x79\x7c\x49\x9f\xeb\x1a\x4d\xab\x1e\x41\x16\xac\x1b\xc3\x90\x50\x55\x58\xf8\x17\xb3\xb8\x89\x3d\xf0\xe2\xc7\x6c\x21\xe2\xc7\x8f\xfc\x9a\x84\xbd\x8d\xaf\x00\x4c\x5d\xc0\x4b\x74\xfe\x1d\x6e\x77\x8f\xe7\x9e\xf9\x1d\x30\xe8\x1a\xa3\x2c\x6c\x87\x17\x3b\xe9\xe7\x00\x21\x06\x6c\xaf\x9d\xe9\x4e\x3e\x47\x47\x71\x60\xb8\x7a\x3a\x8c\x8c\x0d\x6f\xc3\xd3\x95\x2c\xef\x86\xb7\xb6\x99\xe6\xb6\x99\xb2\xd2\xee\xb2\xc3\x8e\xea\x5d\x7c\x6c\x1f\xa2\xb9\xde\xe3\x2f\x65\x26\x2e\xba\x68\xa6\x61\x51\x9f\x55\xca\x4d\xc8\x63\xc2\x96\x1e\x02\xef\x6f\xda\x2e\xec\x98\xe5\xa5\x84\x0b\x9d\xab\xd0\x11\xbe\x3d\x3f\xed\x3e\x4a\x76\xb5\xcd\x94\x71\x20\x80\xc9\x24\x15\x74\x42\xee\xd1\x98\x6a\xcf\x93\x7a\x50\xd2\x0d\x12\x04\xb6\x1c\x7e\x72\x2d\x43\x14\x24\xc9\xdd\x83\x97\x47\xcb\x48\xc9\x46\x4f\xb0\x14\xe9\xe9\x6c\x52\x77\x23\xf3\x15\x95\x43\xa3\x84\x93\x2b\xac\x6e\x08\xe1\x43\xb6\x85\x25\x48\x65\x65\xc9\x39\x16\x3b\xee\x4b\x72\x4b\x82\xa2\xa3\x34\x16\x4d\xe0\xe7\xf1\x8f\xa7\x9c\xf6\xe6\xeb\x59\x6a\x62\x69\x14\x76\x0e\xd2\xf3\xd8\x03\x8b\x64\xbe\xda\x1c\xc0\x7f\xb0\x17\xc7\x6c\xe3\x8a\xb2\x92\xff\xcc\x5b\x8b\xcd\xbb\x73\x4f\x09\x00\xcc\xd1\x1d\xc0\x63\x4d\xac\xd5\x05\x65\xaa\x04\xc8\x92\x55\xe0\x53\x35\x02\x27\x10\x8d\xd3\x4b\xe1\x64\x31\x80\xa9\x2c\x8d\x77\x2d\x51\xa5\x90\x1d\x51\xf9\xbf\x2e\x5e\x9f\x91\x1d\x9c\xbf\x39\xca\xe1\xd8\xfb\x72\x70\x3c\x8f\x8f\x9f\x23\xe4\xee\xd1\xcf\x67\xfe\xd7\x2b\xf1\xf8\xb2\xcf\xf2\xd3\xfd\x67\xdf\x7e\xf7\xfd\x70\xa6\xf5\x04\xe0\x33\x4c\x12\x7f\xec\x84\x39\x80\xcc\xaf\xcb\xc8\xbc\x0e\x12\x73\xdb\x8e\x02\x1e\x40\x36\xd3\x3a\xdb\xd9\x99\x04\x3e\x4e\x14\xcc\x51\xa1\x11\xd5\x80\x23\xa1\x56\xd5\x4d\xc4\xa8\x69\xe3\x40\x54\x36\x60\x16\x83\x9a\x65\x6b\x98\xa2\xc7\x33\x54\x85\x37\xcf\x99\xd1\x4b\x10\x31\x1b\x70\x64\x2b\x39\xbc\xf0\x54\xfa\xfe\x90\x2e\x21\x5a\xb4\xe7\x14\x7d\xd8\x09\x59\x88\x50\x25\x2c\x1b\xeb\x60\x21\x56\x48\x54\x0d\xe5\xde\x72\x85\xb0\xad\xb4\xda\x5b\x46\x8c\x71\x37\x75\x6b\x4c\x63\x75\xb3\x19\x59\x5b\x67\xf1\xe8\x49\xb1\x8c\xb7\xd4\x8d\xe2\x1a\x27\xf2\x44\x44\x22\x5b\xed\x11\x73\x74\x9a\x62\xa1\x97\x08\xdb\x1e\xc5\x44\xb5\xe3\xcf\x8e\x23\x9f\xff\x2a\x06\xbf\x1c\xc6\xd6\x36\x4b\x5f\x6f\xf8\xb4\x8b\x2c\x22\xb1\xa3\xd6\x05\x7b\xbc\xfd\x3e\xc0\xf8\x77\x8f\xbe\x52\xb9\xef\xbf\x05\x83\x2b\xd9\x66\x61\x01\x7d\xfd\x11\x5b\xb6\xe0\x70\x98\x5f\x00\xed\x67\x0f\x80\xf6\x17\xa8\xdc\x75\x27\xcc\xd0\xd2\xe2\x89\xb7\xa5\x04\x28\x5a\x1c\x11\xd6\x87\xfd\xaf\x02\x8c\x1f\xa2\xb4\xa3\xfd\x3f\xa6\xcc\x8e\x18\x48\x1e\x81\x23\xf1\xfb\x03\xd8\x7f\x3c\xa6\x9c\x69\x87\xbe\xa8\x09\x2e\xd9\xf9\x5e\x77\x3c\x2c\x4f\xe7\x3b\xa9\xc7\xb3\x57\xb5\x4e\x4f\x6e\xce\xbd\x87\x0e\x21\xfa\x2f\xf3\x36\x10\xd2\x6f\x36\xfa\x38\x51\x11\xe4\x83\x58\xe6\x04\x73\x64\x0f\x54\x74\xf8\xec\xae\xa5\x45\xdf\x0c\x02\x0c\x04\x66\x02\xc6\xf5\x40\x29\xa0\xca\xdb\xf3\xd3\x98\xa0\x4d\xf2\x3c\xff\x81\xbf\x19\x8d\xff\xe8\xff\xff\xcb\x24\xe2\xd1\x0b\x6d\xbc\x84\x49\x6e\xd8\xea\x81\xeb\x31\xd1\xcb\x8e\x3d\x07\x13\x7a\x9c\x78\xad\x71\x4a\xdb\x26\xee\x49\x51\x90\x53\x64\xa5\x90\x6b\x62\x06\xd8\xd4\x25\xa5\x99\x61\x2b\x9f\x1d\x27\x19\xe5\xa6\x5c\xf0\xb7\x42\xce\x5b\xde\x22\x9e\x7c\xef\xb7\xaf\x05\x1e\x18\xf5\xd7\x01\xd4\x8d\x3b\xf8\x12\x10\xf5\x57\x93\xce\x0e\x20\x0b\x0b\x7a\x2f\xef\x1e\x05\x59\x9b\xa4\xd9\x90\x53\xfd\x36\xb0\x89\x26\x19\x37\x09\x68\x73\xef\x23\xe2\x7e\x23\x4a\xa4\x11\xe4\x41\x90\x68\xa1\xa4\x8c\x95\x71\x38\x7a\x83\x94\x9e\xa1\x8a\x15\x93\x8f\x3b\x4c\x62\x2d\x3e\x12\x03\xdc\x97\x23\xcb\x5f\x4a\xc5\xad\x3f\xef\x81\xda\x69\xeb\xbf\xe1\xaa\x5a\xaa\xf9\xe3\xc0\xe8\xcd\xdb\x8d\x60\x04\xb7\x90\x51\x62\x9a\x1d\x40\xf6\x4a\xfe\x4b\x06\x77\x0c\x41\x7d\xd3\xda\x88\x42\x51\x89\xb7\x9c\xd8\xb6\x9f\xb7\x80\x73\x19\xfb\x79\xa2\x02\x6e\x56\x4d\x76\x27\x31\x51\x69\x6c\xe7\xbd\x2c\x6b\xd4\x9d\xd3\xa1\xe4\x66\xa8\x22\x32\xb8\xa2\x62\xd6\x87\x9e\x8d\x49\x42\x57\xd2\xd9\x85\x6e\xaa\x92\xe8\xf7\x8e\x33\x2d\x9f\x7d\xd1\xdb\xc7\xfb\x7e\x62\x90\x24\xd5\xfd\x24\x81\x7b\x4e\x0b\xec\x3b\xf6\xc1\xdf\xef\xaf\xad\x43\xfe\x73\x5d\x74\xf7\xef\x70\xce\x87\x6b\x9c\x35\x7f\x7c\xa8\x06\xda\x3f\xfc\xff\x70\xaf\x7f\x92\xe1\x27\xe6\xbe\x21\xba\x5e\x2f\x50\x41\x63\x89\x53\x32\xf8\x0d\x46\x3e\x00\xe9\x48\x0a\xb2\xe6\x5a\x5b\x2b\xa7\x15\x82\xd3\x44\x86\x43\xed\x5a\x10\xe6\xd0\x26\xaa\x2a\xc6\xed\xcd\x4e\x80\x8a\x1c\x2a\xda\x68\xec\xed\x48\xca\xf4\x3f\xb2\x8d\x4b\x1b\x2d\x89\x9b\x54\x46\x18\x2a\x1a\xa3\x23\xd6\x46\x14\x8e\x8c\xd7\xdb\xb9\x37\x7c\x35\x6f\x7b\x58\xa1\x79\x55\xe8\xe5\x52\x93\x78\x74\xfa\x7a\xe6\x25\xe4\xc0\x5b\x34\xd6\xe9\x65\x6c\x75\x11\x8d\xd0\x19\xd1\xea\x9b\x38\x62\x61\x34\x06\xe1\xbc\x34\xda\x80\x33\x42\xd9\x19\x1a\x36\x8d\x52\x38\x11\x23\xf5\x09\x2b\x28\x51\x4e\x44\x85\xd8\x23\x4f\xe3\xb8\x65\x5a\x5a\xf9\xe6\xd3\xf4\xc6\x6b\x3f\xaa\x62\x22\xca\x52\xfa\x22\xe1\x6a\x2a\x15\x01\xa6\x9d\x04\x55\xdc\x2b\x00\x1f\xe5\xc6\xff\x90\x4c\xff\xbe\x37\x73\x89\xfc\x68\x6f\xde\x20\xe5\x3a\xc1\x94\x64\x63\xd1\xd8\xe1\x2d\xfd\xc7\x05\xf7\x63\xb6\xb8\xfb\xed\x40\xf1\xc5\xc2\xe3\x91\x90\x11\x78\x7c\x18\x35\x1e\x84\x72\x77\xad\x7b\x70\xbe\x0e\x29\x5b\x21\xf0\x93\xb2\xbe\x2a\xa3\x7f\x4c\xd6\xce\x50\xd1\x51\xf0\x07\xb0\xc4\x5f\x23\x15\xa4\xe7\x5c\x2a\x83\x07\x09\x13\xed\x3f\xc0\x79\x98\x2d\xc4\x34\xd7\xcb\x96\xc0\xab\x69\x17\x44\x34\x0d\x60\xe3\x16\x83\xcd\x6d\x63\x8f\x5c\x1e\x7a\xb6\xfc\xd4\x65\x53\x21\x4f\xe9\xbd\x88\x63\x8d\x56\x99\x4f\x3d\x6a\xa4\x19\x74\x92\x3e\x87\x11\x21\x61\x08\x57\x12\x5d\x43\x63\xc0\xf3\x42\x1b\x4e\x5b\x2f\xa5\x73\x5c\x2f\xcc\xe2\xb3\x87\x50\x55\xca\x42\x38\x7f\xec\x06\x3d\xa4\x6e\xe8\x71\x13\x99\xfd\x1c\x4e\x51\xcc\x22\x86\x6e\x1b\x2c\x1a\x63\xc9\x28\xba\x09\x80\x9e\x81\x42\x4b\x75\x45\x3c\x93\x80\xde\x81\x0f\x13\x5d\x3c\x24\x31\x3c\x39\xf3\x2d\x72\xa9\x58\x2f\x06\x43\xb9\xd3\xb6\xcb\xb6\xc5\x0e\xfc\xc8\xb3\x88\xb4\x5a\x69\x53\x96\xbc\x5d\x37\xdd\x81\x23\xbd\xc2\x30\xe4\x65\xa5\x6f\xcb\x59\x9b\x08\x4e\x76\x27\x03\x9f\x0a\xb9\x05\x1d\x5b\x98\xdc\x10\x79\x2f\xd2\x61\xea\x9c\x58\x59\x4c\x57\x77\xb3\xd1\xee\x34\x77\xda\xad\x8b\x1d\x1e\x57\x69\x3e\x0d\x4f\x8d\xf9\x7a\x96\x6f\x9c\x26\xc0\x8c\x83\x4f\x90\xa6\xa7\xee\x7e\x31\x49\x84\x13\x72\xdf\xe6\xdc\x10\x62\x0e\x28\x09\xf6\x43\x75\xa1\xfa\x24\x48\xb7\x61\x80\xe8\xdb\x5e\xa4\x99\x8e\x4a\x9b\x58\xfa\xc9\x6e\x48\xa1\xfb\x69\xa0\xb4\x1b\x4c\x91\x7e\x2e\xe3\x8a\x11\x64\xc3\x0c\x2e\xfc\xac\xc8\xc2\x7b\xf8\x09\xcd\x14\x3e\x40\xab\xc4\xf6\xd5\x28\x3e\x52\xba\xd0\x7d\x03\x77\xf7\xd6\x02\x51\xdd\xcd\xe0\x33\x64\xbb\xfc\xdf\xe9\xc9\xe5\xf1\xf9\xf8\x14\x3e\xc3\x4f\xc2\x48\x1e\xd8\xb7\xdf\xb4\x7f\x19\x41\x76\x9b\xf9\xa6\xdc\x1b\x12\xe2\x3d\x64\xa3\x84\xb3\x0f\x90\xdd\x65\xdd\x67\xdd\xba\x11\x9c\x3c\x3f\x3e\xbb\x24\xae\xf2\x2c\x3c\x27\x3c\xb1\x3c\xf4\x33\x82\xec\x20\x6b\x59\x39\xbc\xaf\x41\xf2\xbe\x38\xdd\x13\x71\xec\xc7\xfa\x0c\xa3\xb4\xbc\xbf\x3a\x59\xfe\x37\x34\x9c\xb4\xc4\xb9\x57\xfa\x95\x1d\x84\xf8\xcf\x1d\x84\xa9\x8f\xfe\x3c\xec\xac\x85\x71\xbd\x73\x0b\xa6\x1c\x5b\xd5\xc4\xfa\xa4\xbf\x69\x60\xbf\xdb\xb9\x92\x0e\x8d\xa8\x7c\x36\x19\x0c\x91\x68\x6d\xb0\x90\x49\x54\x75\xf7\x75\xca\x41\x3b\x2e\x15\x36\x99\x78\x4d\x6f\x40\x3a\x0f\x77\xd1\x51\x61\x0c\xab\x78\x68\xad\xa5\xb1\x70\x94\x8f\xb5\x16\xcb\x3e\x14\x17\x5a\x86\x2c\xd1\xfe\xee\xe3\xcd\x17\x74\x4d\xb0\x66\x13\xf2\x82\xbb\xfa\x2d\xe6\x61\x3e\xcf\x61\x72\xbb\x12\xe6\x8e\x83\x09\x05\xbe\x5f\x1a\xb9\x12\x15\xd9\x9f\xd3\xfe\xdd\x68\xf7\xae\xed\x78\xf4\xb6\xef\x06\xf3\xf8\x49\x14\xae\xba\xe1\x9c\xa9\xbf\xbf\x6d\x0a\xd6\xc5\x24\xe3\x6d\x32\x82\x66\x3e\x96\x2c\x90\xce\x26\x03\x9f\xe2\xfa\x95\x1d\x71\x62\x86\xa0\xb4\x8c\x70\x28\x92\xa9\x24\x25\x7c\xdd\xd4\x96\xcb\x3a\x7f\xea\x93\xf7\x7b\x57\xf9\xff\x3e\xd9\xfb\x77\xb1\xf7\xb7\xf1\xde\xff\x7c\x98\x30\x86\xd4\x68\x0a\x54\x6e\x2f\xce\x8d\xe1\x82\x36\x6b\xd5\x4a\x85\xde\x35\x34\x75\x02\xcf\xcf\xa5\x2d\x34\x57\x8a\xcf\x75\xd1\xb0\x3f\x92\x14\x5e\x57\xbf\xa2\x8d\x74\x76\xbb\x66\xc3\x7d\x75\x8c\x66\x5a\x0f\x49\x05\xc1\xea\xa3\x52\xbe\x42\x2b\x44\x29\x19\x67\xf7\xb4\x32\xfc\x8d\x6a\xe1\xe6\x54\x4f\x33\x0f\xaa\xe5\xdf\x7a\x7a\xe1\x4b\x0b\xf0\x6e\x21\x2b\xef\xa7\x6b\xc3\xff\x56\x98\x68\xbb\xbc\x06\x97\x42\x39\x59\xd8\x78\xbd\x23\x8e\xe9\x3f\x6c\x53\x3e\x6b\x0f\x86\x43\xa7\x75\x65\x73\x89\x6e\xc6\x97\x63\x16\x6e\x59\x0d\xcd\xac\xa0\x45\x3b\x70\x11\xc6\x9f\xcf\xf2\xfd\x7c\x9f\x08\x5c\x48\x9e\xaa\x5e\xf8\xa4\xef\xb8\xbb\x5c\xc0\x5d\x36\x4a\xfb\xef\x33\xb4\xbb\x5b\x6a\xe4\x8a\x68\x77\xd7\x73\x47\x94\xda\x0b\x03\xe7\xf1\xc2\x40\x4b\xcd\xe3\x8a\x41\x61\xb5\xe2\x71\x37\x55\x6c\x3c\xb6\xbe\xb7\x94\xcb\xa0\x40\x3d\x9c\x61\xdb\xce\x48\x4e\xac\x92\x1f\x11\x26\x3f\x4c\x38\x57\x9a\xfc\x61\x12\x71\xef\x9a\x5b\x11\x15\x8a\x78\x57\x45\xaa\x95\xa8\x64\x49\xc7\x6f\xfb\x8a\xe7\xa4\x35\x19\xe6\x48\x95\x98\xba\xe8\x42\x6d\x48\xa3\x5a\xd0\x89\xd3\xeb\x5e\x4b\x34\x24\x38\x3e\xc3\x6b\x03\x67\x7a\x0b\x86\xef\x92\xb4\x37\x61\x2e\x78\x92\x67\xdb\x52\xca\xe9\x20\x00\x57\x8c\x94\x2f\xfa\x1b\x67\x78\xef\xb2\xcb\x79\x1c\x9e\xbf\x8f\xd3\xc0\xde\xcd\x9e\x68\x70\xdc\xeb\xa2\x3d\xf3\x76\x19\x27\xa0\x01\x9f\xdb\xc9\x7f\x92\xf1\xc7\x85\xfd\xbb\x2e\xcf\x63\x2f\xcc\xa6\xc8\xcd\xb3\x21\x2e\x73\x7d\x92\xc5\x35\x71\xe0\xdc\x86\x12\x38\x99\x6c\x25\x37\x53\xb8\xf4\x0d\x39\x48\x7b\x6d\xef\x76\x8e\xee\x73\xdd\xb8\xcf\xb5\xb6\xee\x73\x89\x15\x3a\xfc\x5c\x13\xed\xbb\xee\x1a\xc7\xb8\x5f\xed\x76\x97\x67\x38\x2d\xf4\x95\x6a\x19\x7a\x00\x44\xf4\x1b\xbf\xfc\x1b\x7f\x1c\x5e\x54\xad\x50\xcf\x5a\xce\xfc\x71\x04\x3a\x6f\x89\x31\x52\x51\x25\xad\xe3\x96\x94\x2a\xa9\x74\xe3\x67\xa9\x66\xda\x2c\x7d\x03\x51\x4c\x75\xe3\xda\x46\x72\xd0\x61\xa2\x47\x3f\x3c\xda\x0f\x2a\x5c\xa7\xce\x7d\x2c\x26\xdf\x92\xb8\x47\xa1\x6e\x1c\xcf\x56\x36\x53\x28\x0c\xfe\x3a\x05\x6d\x89\xc4\xb7\x0f\x90\x60\x0d\xff\x0a\x09\x7f\x0a\x30\x82\xef\xbe\x46\x12\x3a\x43\x18\xc1\xf7\x7d\x1a\xdc\xd4\xf0\x87\x99\x18\x53\x13\x29\x27\x17\x4d\x62\xa2\x1a\xef\xfc\x10\x72\xf8\x26\x4e\x4a\x4f\xaa\xa2\x6a\xca\x2e\xd9\x9f\x04\xaa\xa1\xfa\xe9\x22\xca\xab\xe3\xf1\x73\x2e\x73\x28\x67\x74\x9a\x90\x62\xe5\x1b\xc5\x09\xb5\x74\xc3\x46\x75\xf9\x89\xbf\x2e\x19\xac\xdc\x63\xda\xb5\xac\xca\xbd\x42\x98\xd2\xfb\xac\x17\x62\xd6\x54\x29\x39\x96\xc8\x77\x37\x02\xf4\xd5\x46\xaf\x64\x89\xed\x6d\x04\xa7\xe1\x1d\x4e\x61\xfb\xd5\xe5\x8f\xa7\x3b\x50\x54\x92\xc2\x60\xa7\xcb\x23\xd6\x14\xdf\x38\x8c\x2e\xe5\x75\x37\x82\x3f\x1f\x6e\xf9\xc2\x3c\xbd\xdc\xc5\x6d\xdf\xb5\xf1\xaa\x47\xba\xeb\x85\xb6\x08\x2b\x51\x35\xe8\xbd\x37\x6d\xbf\xb3\xd8\x7e\x00\xa3\x4d\xa4\x47\x89\x6a\x5a\xb8\xae\x75\xc4\x0a\x51\xbb\xc6\x60\xbf\x29\x16\x8f\x34\xa1\x1b\xc9\xf9\x0e\x59\x07\xc0\x0c\xa6\x26\x02\x68\x07\xb2\x53\x4c\xe7\x52\xfe\x65\x3b\x0b\xef\xc0\x24\x34\xf6\x21\x84\x13\xa7\xeb\xbd\x0a\x57\x58\x91\xf4\xeb\xb5\xb2\x1f\x91\x27\xb6\xc9\x25\xd1\x08\xfe\xd4\x61\xdd\xeb\x30\xd6\xce\x37\xa8\xd1\xd6\x9a\xaf\xcc\x3e\x4e\x8f\xa9\xbc\x9e\x19\xff\x7d\x0e\xaf\x39\x57\xed\xd3\xf3\x73\x3a\x39\x57\xda\x50\x7e\xf1\x6e\x81\xed\xd5\x40\x52\x86\x45\x37\xe8\x73\xd1\x0e\xf2\xc3\x1d\x43\xf6\x1c\x32\xef\x78\x84\xbd\x3d\x53\xa1\xe3\x1f\xaf\x82\xf4\x4f\xf7\x3b\xf1\xc7\x6d\x33\x2b\x10\x8a\x1d\xad\xf6\x9e\x70\x88\x10\x39\x9c\xf9\x8a\xbd\x5d\x41\xe7\x92\xb2\x1c\x33\x73\xa1\x1e\xe8\x04\x7a\x3d\xba\x05\x2e\x2d\x56\x2b\xb4\xb0\x1d\x5c\x7b\x10\xc9\xd0\x86\x0a\x3d\x14\xf3\x7d\x6f\xe5\x6f\xdb\x50\xde\xe8\x0f\xb9\x44\xac\x77\x1e\xba\xb6\xb9\xa9\x33\x37\x82\xa7\x4f\xe3\x6d\xc3\xf1\x97\x00\xa8\x6d\xc4\x86\x35\xac\x8e\x15\x9a\x69\x17\xd3\xef\x3b\xe5\xed\x66\x1f\x94\x76\x03\x95\xe4\x44\x3e\x4a\x55\xf6\x43\x6e\x7b\x6b\x6e\x3d\xba\x06\x3a\xf7\x48\xd4\xbe\x36\xe5\x9b\x94\xff\x17\x00\x00\xff\xff\x32\x28\xe4\xf0\xc9\x2f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x6d\x73\xdb\x38\x92\xfe\xee\x5f\xd1\xc7\xad\xdb\xb1\x7d\x32\x95\x38\x33\xb3\x7b\xf6\xe9\xa6\xb4\x8e\x93\xf8\xce\x63\xbb\x64\x79\x53\x73\xa9\x94\x09\x91\x4d\x09\x1b\x0a\xe0\x00\xa0\x1c\xad\xed\xfb\xed\x5b\x8d\x37\x92\xb6\x3c\x71\x36\x5b\xb5\xb5\xf9\x10\x4b\x22\xf8\xa0\xbb\xd1\xfd\x74\xa3\x81\xe1\x10\x8e\x64\xbd\x56\x7c\xbe\x30\xb0\xff\xe2\xe5\x1f\xe1\xad\x94\xf3\x0a\xe1\xf4\xf4\x68\x6b\x38\xdc\x1a\x0e\xe1\x94\xe7\x28\x34\x16\xd0\x88\x02\x15\x98\x05\xc2\xb8\x66\xf9\x02\xc3\x93\x01\xfc\x19\x95\xe6\x52\xc0\x7e\xfa\x02\xb6\x69\x40\xe2\x1f\x25\x3b\x87\x04\xb1\x96\x0d\x2c\xd9\x1a\x84\x34\xd0\x68\x04\xb3\xe0\x1a\x4a\x5e\x21\xe0\xe7\x1c\x6b\x03\x5c\x40\x2e\x97\x75\xc5\x99\xc8\x11\x6e\xb8\x59\x80\x69\x27\x48\x09\xe3\x17\x8f\x21\x67\x86\x71\x01\x0c\x72\x59\xaf\x41\x96\xdd\x81\xc0\x8c\x17\x1a\x00\x60\x61\x4c\x7d\x30\x1c\xde\xdc\xdc\xa4\xcc\x0a\x9c\x4a\x35\x1f\x56\x6e\xa8\x1e\x9e\x9e\x1c\x1d\x9f\x5d\x1e\xef\xed\xa7\x2f\xfc\x4b\x57\xa2\x42\xad\x41\xe1\xaf\x0d\x57\x58\xc0\x6c\x0d\xac\xae\x2b\x9e\xb3\x59\x85\x50\xb1\x1b\x90\x0a\xd8\x5c\x21\x16\x60\x24\x09\x7d\xa3\xb8\xe1\x62\x3e\x00\x2d\x4b\x73\xc3\x14\x92\xa4\x05\xd7\x46\xf1\x59\x63\x7a\x36\x0b\x22\x72\xdd\x1b\x20\x05\x30\x01\xc9\xf8\x12\x4e\x2e\x13\xf8\xd3\xf8\xf2\xe4\x72\x40\x20\xef\x4f\xa6\xef\xce\xaf\xa6\xf0\x7e\x3c\x99\x8c\xcf\xa6\x27\xc7\x97\x70\x3e\x81\xa3\xf3\xb3\xd7\x27\xd3\x93\xf3\xb3\x4b\x38\x7f\x03\xe3\xb3\x5f\xe0\x7f\x4f\xce\x5e\x0f\x00\xb9\x59\xa0\x02\xfc\x5c\x2b\xd2\x40\x2a\xe0\x64\x4d\x2c\xac\xe9\x2e\x11\x7b\x22\x94\xd2\x2d\xa3\xae\x31\xe7\x25\xcf\xa1\x62\x62\xde\xb0\x39\xc2\x5c\xae\x50\x09\x2e\xe6\x50\xa3\x5a\x72\x4d\xab\xaa\x81\x89\x82\x60\x2a\xbe\xe4\x86\x19\xfb\xd3\x23\xbd\xd2\xad\x2d\xbd\x16\x86\x7d\x86\x11\x24\xb5\x92\x46\xbe\x4a\x0e\xb7\xb6\x6a\x96\x7f\x72\xc0\xe4\x55\x29\xab\xf9\xe1\xd6\x96\xac\x09\x04\xf2\xfc\x1a\x05\x99\xf6\x9a\x29\x14\x4c\xc3\x08\x8c\x6a\xf0\x30\x3c\x9f\xcb\xeb\xf0\xfa\x08\x12\x8f\x30\x97\x24\xac\x5d\xc9\x39\x0a\x3b\xd1\xd0\x3d\x62\x35\xd7\x43\x56\xf3\x21\x13\x42\x7a\x39\x0f\x3b\x9f\x93\x08\xfc\x17\xb6\x62\xd7\xcb\xa6\x32\xbc\xae\xf0\x9a\xfc\xf0\xd1\xdc\x76\x88\x6c\x0c\xaa\xeb\xbc\x62\x5a\x0b\xb6\xb4\x52\xbc\x33\xa6\xbe\xa0\x49\x1f\xa0\x75\x04\xcd\xe5\x32\x6d\xd5\x6d\xc7\xc9\xd9\x5f\x72\x07\x76\x5d\x2b\x2c\xb9\xb5\xd4\xdb\xf1\xc5\x09\xd9\x89\xec\xfb\x1a\x4b\x2e\x50\xdb\xa5\x79\x37\x9d\x5e\x40\x2e\x45\xc9\xe7\x8d\xb2\xba\xd8\x55\x63\x02\xc6\x17\x27\xa0\x51\xad\x78\x8e\x29\x9c\x18\x1a\x44\x01\xa1\x81\x41\xc5\xb5\x01\x59\x12\xd6\x07\x12\x74\xd2\x54\xf8\xf1\x43\x2b\x4b\x1a\x7f\x1c\x00\xb2\x7c\xe1\x1d\x60\x4d\xeb\x4d\x93\x2e\x59\x5d\xd3\x67\x59\x92\x4f\x4e\x2e\x8e\x60\x89\x66\x21\xed\xe2\x1b\x09\x52\x20\x48\x05\x4b\xa9\xbc\x7c\x93\xe3\xcb\xa9\x95\xc7\x0d\xd3\xe9\xd6\x12\xb5\x26\x33\xd0\x44\x70\xbb\x45\x51\x38\x1c\xc2\x38\x48\xb6\x49\x2d\xd5\x90\xf9\xcd\x82\x19\x1b\x6c\x6b\x17\x59\x05\x5f\xf1\xa2\x61\x55\x1f\xde\xe1\x05\xd8\xdd\xdd\xb3\xf3\xe9\xf1\xc1\xee\x2e\x8c\xab\x2a\xd8\x64\x23\x7a\x29\xab\x4a\xde\x40\x52\x31\x32\x90\x20\x86\x11\x3a\x01\xa9\x0a\x54\x0e\x55\x61\x8d\x8c\x02\x36\x98\xc8\xbf\x39\x82\x97\x87\x5b\x61\xc2\xf7\x0b\x14\xa0\xd1\x90\x88\xe4\x2d\x03\xb8\x9a\x9c\x42\xcd\xcc\x02\x6a\xa6\x96\x68\x50\x69\xb8\xe1\x55\x05\x33\x84\xb2\xa9\xaa\x35\x5c\x4d\x4e\xf6\x0a\xcc\x65\x81\x45\xcb\x75\x01\x2f\x67\x1a\x35\x51\x98\xe6\x82\x38\x57\xe3\x7c\x89\xc2\xc0\x92\x99\x7c\x81\x9a\x08\x46\x21\xe9\x65\x5f\xae\x99\xa0\x70\x1c\xc0\xcd\x02\x15\x42\xf2\xef\xfb\x6f\x92\x30\x5b\x40\xac\xb0\x34\x80\xc2\xce\xf7\xd0\x5c\xd3\x05\x42\x81\x25\x6b\x2a\x03\x33\x5c\xb0\x15\x27\x9a\xd0\xa4\x0c\xf1\xb2\x93\x12\x26\x6f\x8e\xe0\xc7\x1f\xfe\xf0\xa2\x9d\x39\x5f\x30\xc5\x72\xab\x1b\x17\x60\xc3\x26\x40\x3e\x10\xd8\xcd\x38\x93\xb2\x72\xda\x5f\x3b\xcc\xeb\x00\x75\x1d\x95\x80\x11\xec\x1f\x6e\xdd\x6f\x91\x67\x65\xc1\xe4\x19\x14\x9d\x08\x78\xca\x19\x37\x79\x22\xc1\x3c\x72\x46\x98\x76\x50\x3c\xd5\xa1\x86\x85\xbc\x81\x82\x97\x25\x2a\x92\xbc\x96\x8a\xfc\x44\x87\x44\x32\xb9\xa0\xb4\x67\x53\x00\x6a\x03\xc1\x9f\x99\x72\x50\x8e\xf8\xc3\xaa\xbb\xf5\xff\xb5\x41\xb5\x26\x07\x60\xce\x03\x06\x81\x2f\x49\xb2\x88\x34\x93\xc5\xba\x2f\x12\xd7\x60\xd6\x35\xcf\x19\xf9\x49\x90\xaf\x00\x46\x7c\x4b\xaf\x67\x9d\xc0\xa5\x54\x96\x41\x4b\x66\x20\x45\x10\xd7\xeb\x6b\x93\x86\x46\x0c\x44\xf9\x90\x09\x53\x4b\x95\x89\x65\x91\x02\x0d\xe3\x95\x4e\x7d\xda\xeb\x0a\x95\x4b\xa1\xb9\x36\xd6\x1e\x0c\x4a\x8e\x55\xf1\x90\x25\x48\x73\x30\xb8\xac\x2b\x66\x30\xe8\xea\xd7\xe6\x13\x17\x45\x0a\x30\x7d\x34\x2c\x67\xe4\xcb\x25\xe5\x42\xe9\x60\xad\x7b\x13\x9e\xb7\x90\x43\xb1\xe6\x1e\x00\x8b\x4f\xf1\x33\x5b\xd6\x15\xc2\x0c\x29\x82\x6f\x16\x3c\x5f\x40\x81\x3a\x57\x7c\x86\xc4\x79\x76\xd9\xdf\x1e\x4f\xe9\x75\x59\xa3\x0f\x7b\xca\xaa\xe4\xc1\xb2\x51\x96\x12\xaa\x0a\x73\xf7\xa0\x0c\x93\xe8\x03\xa7\x7e\xa8\x16\x02\x7f\xfc\x6c\x1f\x93\xb2\xb7\xe1\x11\x80\xaa\x73\x78\x8b\xc6\x3d\xc3\xed\xf6\xe3\xc4\x09\xbf\x03\x0a\x4d\xa3\x84\x86\x6d\xff\x60\xa7\xfb\x3a\x80\xcf\x01\xdb\x0f\xd6\x74\x27\x9d\xa3\xa1\x3c\x30\x5c\xbd\x1c\x06\xc1\x86\xb7\xfe\xd3\x35\x2f\xee\x87\xb7\xba\x99\xa5\xba\x99\x59\xa3\xdd\x27\x87\x2d\xea\x7d\xf8\x18\x3f\xf8\xf7\xe0\x91\x7c\x5d\x61\xc2\xa0\xcb\x66\xe6\x07\xf5\x45\xa5\xe2\x45\xcc\x21\x4c\xe9\x28\xf0\xf1\xa4\x71\xa0\xc7\xbb\xe6\x6e\x28\xf1\x42\x1b\x2a\xb4\xc0\x57\x93\xd3\xf6\xa5\xce\xac\xba\x99\x59\x1e\xf0\x64\x92\x75\x15\xcd\x88\x99\x1a\x55\xed\x39\xa8\x27\x35\xdd\xa0\x81\x17\xcb\xe0\x67\x13\x05\xa2\x24\x49\xe1\xee\xa3\x3c\x78\x46\x78\xe9\xbe\x13\x09\x9a\x32\x3d\xc5\x5b\x37\xdc\xc8\x7d\x59\x65\x50\x09\x66\xf8\x0a\xab\x35\x31\xbc\xaf\xb6\xb0\x00\x2e\x34\x2f\x6c\x8d\x45\x28\xd9\x5b\x62\x2b\x4a\x5c\x47\xdd\x5c\x94\xc1\x2f\xe3\x9f\x4f\x6d\xd9\x9b\x3e\xac\x52\xc3\x17\x70\x69\xa7\xf3\x1d\x60\x0f\x34\x92\xfb\x4a\x75\x00\xff\x65\xa3\x38\x94\x45\xd7\x54\x95\xfc\x77\x1a\x3d\x36\x6d\xd7\xbd\x0b\x00\x30\x47\x73\x00\xcf\x75\xb1\x68\x0b\xaa\x54\x89\x90\x39\x49\x0f\xae\x54\x23\x72\x02\xd6\x18\xb9\x64\x86\xe7\x03\x98\xf1\x42\xb9\xd0\x62\x55\x24\x11\x9f\xe8\x49\x86\xff\xb9\x3c\x3f\x23\x3f\x98\x5c\x1c\xa5\x70\xec\x62\xd9\x07\x9e\x1d\x03\x77\x81\x72\xf7\xe8\xdf\x1d\xfd\xb7\x47\x5f\xb3\xb7\xc7\xd3\xbe\xc8\x2f\xf7\x5f\x7d\xff\xc3\x8f\xc3\x52\xca\x0c\xe0\x0e\xb2\x56\xd9\xed\x56\x99\x03\x48\xdc\xb8\x84\x1c\xf8\xa0\xe3\x6e\xdb\x41\xc1\x03\x48\x4a\x29\x93\x9d\x9d\xcc\xcb\x71\x22\x60\x8e\x02\x15\xab\x06\x76\x87\x22\x45\xb5\x0e\x1c\x35\x6b\x0c\xb0\x4a\x7b\xce\xb2\xa4\xa6\xad\x37\xcc\xd0\xf1\x19\x8a\xdc\xb9\x67\xa9\xe4\x12\x58\xa8\x06\x0c\xf9\x4a\x0a\x6f\x1c\x4a\x3f\x1e\xba\x43\x08\x8b\xe6\x9c\xd9\x9d\x43\xac\x42\x98\x28\x60\xd9\x68\x03\x0b\xb6\x42\x42\x55\x54\x7b\xf3\x15\xc2\xb6\x90\x62\xcf\xeb\xbb\x43\x09\x24\x3a\xd3\x58\xac\x37\x33\x6b\x0c\x16\xc7\x9e\x94\xcb\xec\x94\xb2\x11\x76\x8f\x13\x64\x22\x90\x20\x56\x5c\x62\x9b\x9d\x66\x98\xcb\x25\xc2\xb6\x63\x31\x56\xed\xb8\xb5\xb3\x99\xcf\xbd\x15\x92\x5f\x0a\x63\xad\x9b\xa5\x8d\x05\x5f\x76\x11\x95\x76\xfc\x48\x96\x9b\x64\xfb\xd7\x20\xe3\x7f\x79\xf6\xe5\xc2\xfc\xf8\x3d\x28\x5c\xf1\x58\x85\xd1\xef\xc3\xa1\x5f\x62\xca\xa8\x71\x31\xdb\xd7\x3a\xc2\x3a\xd2\x7e\xf5\x04\x69\xff\x06\x8a\xe7\x95\x48\x2d\x91\x4f\x9c\x2f\x75\x88\x22\xf2\x08\xd3\x2e\xed\x7f\x13\x61\xfc\x14\xb4\x1d\xed\xff\xbe\x2b\xec\xc8\x12\xc9\x33\x78\x24\xbc\x7f\x00\xfb\xcf\xe7\x94\x33\x69\x28\x06\x98\x09\x21\xd9\xc6\x5e\xbb\x3c\x56\x9f\x68\x28\xdd\x8d\x78\xc2\x68\x83\x9e\xc2\x9c\xb6\x5e\xac\xdd\xa7\xf4\x1f\xa6\x3e\x52\xdc\x37\x1d\x62\x9c\x50\x18\xc5\x20\x16\x29\x9c\x38\x52\xa0\x4d\x07\x95\x41\x1d\x2c\x7a\x67\xe0\xa9\xc9\x0b\xe3\x39\xae\x47\x4a\x9e\x55\xae\x26\xa7\xa1\x40\xcb\xd2\x34\xfd\xc9\x2a\x30\x1a\xff\xde\xfd\xfd\x53\x16\xf8\xe8\x8d\x54\x6e\x69\x3b\xb5\x61\xb4\x03\x09\x05\xac\x57\x1d\x3b\x09\x32\x2a\x94\x33\x67\x35\x42\x69\x0b\xf7\xce\xa6\x20\x85\x23\xaa\x53\xa9\x05\xe1\x2b\xc0\xa6\x2e\xa8\x1a\xf5\x53\xb9\xea\xb8\x53\x51\x6e\xaa\x05\xbf\x96\x72\xae\xec\x14\x61\xe5\x7b\xdf\xbe\x95\x78\x60\xd4\x1f\x07\x50\x37\xe6\xe0\xb7\x88\xa8\x3f\x9a\x6c\x76\x00\x89\x1f\xd0\x7b\x78\xff\x2c\xca\xda\xa4\x0d\xdc\x7e\x23\xd9\x78\xb8\x38\x89\x67\x9b\x47\x2f\x91\xf4\x1b\x59\xa2\x9b\x41\x9e\x24\x89\x48\x25\x45\xd8\x19\xfb\xa5\x57\x48\xe5\x19\x8a\xb0\x63\x72\x79\xc7\xf2\xcc\x83\xfc\x48\x02\x50\xbd\x59\xd0\xfe\x6d\xc9\x85\x6d\xfd\x91\x44\xb6\xda\xd2\x6e\x5a\xbb\xab\xe6\x62\xfe\x3c\x32\xba\xb8\xda\x48\x46\x70\x0b\x09\x15\xa6\xc9\x01\x24\xef\xf8\xbf\x25\x70\x9f\x51\x29\xd3\x33\xff\x66\x16\xf2\x3f\xc2\x2d\xd0\xfb\xf1\xf5\x48\x38\xd3\xd0\xcf\x63\x15\x50\x59\x08\xd9\x6e\x16\x0a\x95\x46\xb7\xd1\x6b\x75\x0d\xb6\x33\xd2\x6f\xb9\x2d\x55\x91\x1e\xb8\xa2\xcd\xac\x8d\xbe\xcd\x45\x42\xbb\xa5\xd3\x0b\xd9\x54\x05\xe1\xf7\x96\xb3\xbb\x7d\xa6\xc9\x52\xe8\xf3\x7d\xbf\x30\xe8\x14\xd5\xfd\x22\x81\x60\x68\x68\x2f\xb0\x0f\xfe\xfe\x78\xf5\x7f\xff\xd9\x21\xba\xfb\x77\x04\xa7\x17\xf4\xcb\xf1\xf8\xd4\x1e\x68\xff\xf0\x1f\x11\x5e\xff\x24\xc7\xef\xb8\xfb\x86\xec\x7a\x43\x2d\xb9\x86\xfa\x67\xd6\xe1\x37\x38\xf9\x00\xb8\x21\x2d\xc8\x9b\x6b\xa9\x35\xa7\x5e\xbe\x91\x24\xbb\x4d\xb5\x0f\x92\xb0\x4d\x6d\xac\xaa\x42\xde\xde\x1c\x04\x28\x28\xa0\x82\x8f\x86\xde\x0e\xd7\xb0\x64\x9f\x6c\xff\x8a\xeb\xe0\x49\xb6\x5d\xaa\x98\xa2\x4d\x63\x08\xc4\x5a\xb1\xdc\x90\xf3\x3a\x3f\x77\x8e\x2f\xe6\xb1\x87\xe5\x9b\x57\xb9\x5c\x2e\x25\xa9\x47\xab\x2f\x4b\xa7\xa1\xed\x8c\xe4\x8d\x36\x72\xe9\xa3\x42\x13\x86\xef\x8c\x48\xf1\x5d\x38\x62\xb1\x6c\x0c\xd4\x56\x25\x6d\xa8\xe5\xaf\x98\xd0\x25\x2a\xeb\x1a\x05\x33\x2c\x64\xea\x13\x6b\xa0\x8e\x71\x02\x2b\x84\x1e\x79\x37\x8f\x6b\x8b\x25\x05\x92\x07\x10\x33\x58\xeb\x07\x53\x64\xac\x28\x6c\x08\xb3\xea\x7a\x46\x6d\x5c\x31\xd7\x99\x37\xc5\xa3\x0d\xe0\xb3\xc2\xb8\x53\x9e\xb5\x1f\xff\xf1\x09\x77\x8e\x5f\x13\xcd\x1b\xb4\x7c\x08\xd8\x85\x6c\x34\x2a\x3d\xbc\xa5\x3f\x44\x0d\xcf\x9a\xe2\xfe\xeb\x89\xe2\x91\x7d\xe0\xf6\x11\x25\x7c\x89\x32\xbc\x8c\x4f\xb3\xc6\x93\x54\x6e\x6e\x64\x8f\xce\x1f\x52\xca\x96\x4f\xfc\x5c\xcc\xf5\xf3\xb8\xe4\x89\x8a\xfe\x39\x55\xfb\x4e\xd6\x43\x20\xad\xf4\x70\x89\x5f\x82\xf2\xda\xdb\x5a\x2a\x81\x27\x81\x09\xfb\x77\x30\xf1\x67\x0b\xa1\xcc\x75\x8c\xe9\x1f\x13\xbd\x86\xc3\x07\x15\x9e\x85\x8a\x9f\x5a\xc7\x1b\xdb\xc6\x8e\xb9\x1c\xf5\xf8\x53\x97\x0d\x9b\x65\x5b\xde\xb3\x70\xac\x11\x8d\xf9\xd2\xb1\x46\xb7\x82\xee\x94\xcf\xfe\x88\x90\x38\xc4\xee\x24\xda\x86\xc6\x80\xb6\x16\x5c\xfb\xd5\x96\x4b\x6e\x8c\xdd\x2f\x94\xe1\xb3\xa3\x50\x51\xf0\x9c\x19\xb7\xec\xca\x9e\x63\x0a\xbf\x85\xe9\xa5\x7b\x82\xd9\x4f\xe1\x14\x59\x19\x38\x74\x5b\x61\xde\x28\x4d\x39\xbe\x3d\x01\x90\x25\x08\xd4\xb4\x95\xf1\x5a\x85\xb6\x85\x97\xc3\x83\xee\x84\x22\xc6\x9e\x9c\xd1\x4e\x80\x98\xd7\xda\x45\xa1\xdf\xee\xc4\x76\xd9\x36\xdb\x81\x9f\xed\xe1\x49\x77\xb7\x12\x4b\x96\x34\x8e\x9b\xed\xc0\x11\x1d\x75\xda\x4a\xcf\x19\x7d\x9b\x97\xb1\x10\xcc\x76\xb3\x81\x2b\x85\xcc\x82\x82\xc2\x9f\xdc\x10\xbc\x53\xa9\x13\x37\x00\x58\x69\xec\x8e\x6e\xcf\x46\xdb\xd5\xdc\x89\x53\xe7\x3b\xf6\xb8\x4a\xda\xd5\x70\x68\x56\xae\x57\xe9\xc6\xd3\x04\x28\x6d\x05\xe6\xb5\xe9\x99\xbb\xbf\x99\xdc\xce\x83\x70\x16\xee\xfb\xd4\x36\x84\xac\x04\x9a\x5a\x9f\xf6\x50\x9d\x89\xfe\x8a\x91\x6d\xfd\x01\xa2\x6b\x7b\x6d\xcf\xba\x28\xc1\x91\xfd\xc9\xae\x2c\x37\x94\x81\x5c\x6f\x70\x45\xd2\x74\x1a\x46\x50\x47\x25\x81\x4b\x77\x56\xa4\xe1\x03\x5d\x17\x98\xc1\x47\x88\x46\x8c\x8f\x46\x61\x14\xdc\x76\xdf\x81\xfb\x47\x63\x81\x9a\xe6\xbb\x09\xdc\x41\xb2\x6b\xff\x9c\x9e\x4c\x8f\x27\xe3\x53\xb8\x83\x3f\x33\xc5\xa9\x60\x69\xdf\x89\xbf\x8c\x20\xb9\x4d\x5c\x53\xee\x82\x94\xf8\x00\xc9\xa8\x23\xd9\x47\x48\xee\x93\xf6\xb5\x76\xdc\x08\x4e\x5e\x1f\x9f\x4d\x49\xaa\x34\xf1\x9f\x3b\x32\x59\x7d\xe8\xd3\x08\x92\x83\x24\x8a\x72\xf8\xd8\x82\x14\x7d\xe1\x74\x8f\x85\x63\x3f\x8a\xc1\x70\x94\x96\xf6\x47\x77\x86\xff\x15\x95\x2d\x5a\xc2\xb9\x57\xf7\x2d\x3d\xf0\xf9\xdf\x76\x10\x66\x2e\xfb\xdb\xc3\xce\x9a\xa9\xd8\xf5\xb6\x6f\x78\x57\xf6\x71\x96\x91\xe8\x59\x7f\x52\x2f\x7e\x3b\x73\xc5\x0d\x35\x47\x5d\x35\xe9\x1d\x91\xb0\x36\x78\x48\x16\x4c\xdd\xbe\xdd\x95\x20\x1e\x97\x32\x1d\x99\xc9\x46\x20\x37\x96\x7e\x62\xa0\xc2\x18\x56\x61\xd1\xa2\xa7\x59\xe5\x84\x8c\x47\xde\x3e\x86\xc2\x40\x6d\x29\x8b\xc5\xef\x04\xf8\x9b\xb6\x26\x5a\xd3\x1d\x78\x66\xbb\xfa\x91\xf3\x30\x9d\xa7\x90\xdd\xae\x98\xba\xb7\xc9\x84\x12\xdf\xaf\x0d\x5f\xb1\x8a\xfc\xcf\x48\xf7\x6c\xb4\x7b\x1f\x3b\x1e\xbd\xe9\x83\x94\x1a\xf0\x33\xcb\x4d\xb5\xb6\x07\xcf\xfd\xf9\x75\x93\x2f\x28\x7a\xb2\x84\xa0\xee\x13\xa2\x66\x9a\xca\x7d\x1f\xed\xde\x27\x19\xad\x2c\x0a\x3f\xb2\x05\x27\x61\x88\x4a\x8b\x40\x87\xac\x73\x2a\x49\xe5\x6b\x7b\x6a\x4b\x80\x7e\xd5\xb3\x0f\x7b\xd7\xe9\xff\xbf\xd8\xfb\x4f\xb6\xf7\xd7\xf1\xde\xff\x7d\xcc\x6c\x26\xa9\x51\xe5\x28\xcc\x5e\x38\x37\x86\x4b\x9a\x2c\x4c\xa5\x41\xd3\x91\x69\x53\xfb\xa5\x27\xb8\xd7\x5c\xe7\x44\x9f\x6b\x78\x2d\xf3\x86\x74\xb1\x5a\x90\xd0\x5f\xb4\x46\xf7\xec\xb6\x6b\x0d\xfd\xd0\x1c\xd4\xa0\x1b\x92\x09\xbc\xd7\x07\xa3\x7c\x83\x55\x08\xa9\x73\x9c\xdd\xb3\xca\xf0\x2b\xcd\x42\x58\x7d\xcb\x3c\x69\x96\xff\xe8\xd9\xc5\x5e\x5a\x80\xf7\x0b\xba\xfe\x44\x41\xe1\x3d\xd3\x9b\xa1\x55\x26\xf8\xae\x1d\x83\x4b\x26\x0c\xcf\xb5\xdf\x31\x7c\x08\xc7\xf4\x1f\xb7\x69\x77\xaa\x0f\x86\x43\x23\x65\xa5\x53\x8e\xa6\xb4\x97\x63\x16\x66\x59\x0d\x55\x99\xd3\xa0\x1d\xb8\xf4\xc7\x9f\xaf\xd2\xfd\x74\x9f\x00\x2e\xe9\x7e\x10\xc2\xa5\x2b\xfa\x8e\x43\x56\x76\x3d\x38\x5b\xf6\x3f\x16\x68\x77\xb7\x90\x68\x77\x44\xbb\xbb\x2e\xbc\x09\x29\x5e\x18\x98\x84\x0b\x03\x11\xcd\xf1\x8a\x42\xa6\xa5\xa0\xb5\xb1\x3b\x36\x9a\xe0\xf1\x50\x42\x0a\xe8\x7e\x0d\x63\x3b\xa3\xb3\x62\x15\xff\x84\x90\xfd\x44\x47\xe1\x05\x64\xbf\xcb\x02\xef\xdd\xd8\x56\x44\x85\x2c\xdc\x55\xe1\x62\xc5\x2a\x5e\x50\x50\xc4\x4c\xe6\x0c\x4f\xf3\xb7\xb5\x8f\x2d\x3a\x82\x86\x74\xb8\x15\x53\xad\x2f\xa3\x22\xe9\x84\xd3\xeb\x5e\x4b\xd4\x17\x38\xe4\xcf\xac\x8e\x89\x33\x94\x69\xf1\x2e\x49\xbc\x09\x73\x69\x4f\xf2\x74\xdc\x4a\x19\xe9\x15\xb0\x37\xe2\xa8\x5e\xb4\x97\x60\x38\xea\x87\xb7\x37\x26\xe1\xf0\xfc\x43\x38\x0d\xec\xdd\xec\x09\x0e\x67\x7b\x5d\x34\x67\x1a\x87\xd9\xfd\x9a\xe7\xe7\x78\xf2\xdf\xa9\xf8\xc3\xc0\xfe\x5d\x97\xd7\xa1\x17\xa6\xbb\xcc\x4d\xcd\x1a\x5a\xc9\xa5\x2f\xb2\x66\xeb\x56\x72\xed\xb7\xc0\x61\x98\xef\x20\x7b\x3c\xbb\xf5\xf5\x35\x48\xbc\xb6\x77\x3b\x47\x73\x57\x37\xe6\xae\x96\xda\xdc\x15\x58\xa1\xc1\xbb\x9a\xb0\xef\x83\x89\x52\x18\xf7\x77\xbb\x41\x40\x5f\x16\xba\x9d\x6a\xe1\x7b\x00\x24\xea\x77\x6e\xf8\x77\x6e\x39\x9c\x1d\xa5\x40\x59\x46\xc9\xdc\x72\x78\x9c\x2b\x12\x8c\x4c\x44\x77\x94\x28\x18\xc8\x07\xe6\x68\xe8\x5e\x1f\x70\x51\x4a\x45\xe7\x60\x74\xa1\x60\x26\x1b\x13\x1b\xc9\xde\x86\x1d\x3b\xba\xc3\xa3\xfd\xc3\xad\x8d\xe8\xb6\x8f\x45\x90\xed\xbd\x84\x47\x08\x75\x43\x08\xaf\x9e\x40\xc8\x15\x7e\x19\x41\x6a\x82\xf8\xfe\x09\x08\x6b\xe1\x2f\x40\xd8\x31\x54\xb4\xfd\xf0\x2d\x9a\xd0\x1a\xc2\x08\x7e\xec\x63\x10\x1d\xf8\xc5\x0c\x6b\x41\xc7\xfc\x01\xb9\x73\xd1\x84\x89\xde\xd9\x81\x65\x0e\xbb\xe3\x30\x5d\x3c\x2e\xf2\xaa\x29\xda\xe6\x66\xe6\x51\xfd\xee\xa7\xcd\x28\xef\x8e\xc7\xaf\xed\x36\x27\xd9\x4d\x28\x88\x2a\xa4\xd6\x0f\x65\xb3\x0e\x5a\x77\xc2\x46\xb4\xf5\x09\x89\x16\xbd\x9c\x9c\x9c\xee\x8f\x55\xc5\x5e\xce\x54\x61\x7f\xf3\x4a\x94\x4d\xd5\x85\xa3\xd7\x7c\x77\xc3\x53\x5f\xad\xe4\x8a\xee\x09\x84\xdb\x08\x46\xc2\x7b\x9c\xc1\xf6\xbb\xe9\xcf\xa7\x3b\x90\x57\x9c\xaa\xd0\xd6\x96\x47\xd6\x52\x44\x22\x17\xde\x58\xde\x76\x23\xf8\xe3\xa1\x1d\x75\x1f\xc3\x95\x84\xb2\x6d\x5f\x1f\x59\xa1\xc0\xb7\x76\x80\x9b\x85\xd4\x08\x2b\x56\x35\xb6\x72\xe8\xf5\x6b\x9d\x9d\x89\xec\xc8\x40\x01\x8f\x0a\xd5\xee\xc6\xf5\x41\x47\x2c\x67\xb5\x69\xfc\xee\x29\x96\x96\x61\x49\x3b\xb8\x01\x8e\xe0\xd3\x0e\x01\x5b\x32\x55\x81\x40\x5b\x92\x9d\x61\xf7\x5c\xca\x3d\x8c\x67\xe1\x2d\x99\xf8\xc6\x3e\xf8\x74\x62\x64\xbd\x57\xe1\x0a\x2b\xe2\x95\xa0\x79\x20\x61\x3a\xdc\xea\xf1\x1d\xc9\x02\x23\xf8\x83\x77\xcd\xe1\x10\xce\xfd\xb1\x76\xba\xc1\x8c\xba\x96\xf6\xca\xec\xf3\xec\x18\x10\xed\x1c\x56\x18\xf7\x7e\x0a\xe7\xb6\x56\xed\xe3\xb9\x73\x3a\x3e\x17\x52\xd1\x86\x9b\xae\x17\x06\x00\x32\x86\x46\x33\xe8\x4b\x11\x74\x0a\x77\x0c\x2d\xa9\x32\xdd\x2e\x61\x6f\xce\xae\xd2\xe1\xc7\x6b\xaf\xfd\xcb\xc0\x53\x74\x3d\x33\x36\xb3\x3c\x50\xe8\x68\xc5\x7b\xc2\x3e\x43\xa4\x70\xe6\x76\xec\xb1\xe7\x45\xeb\xd2\x15\xd9\x57\x79\xc0\xc4\x13\x9d\x40\xb7\xa4\x66\x81\x4b\x8d\xd5\x0a\x35\x5d\x17\xb7\xa1\x3d\x08\x30\x34\x21\x35\x06\x48\x6a\xba\x33\x6e\xb7\xa5\x33\xb4\xd5\xb3\x5b\xe4\x02\xb1\xde\x79\xea\xda\xe6\x86\x59\x49\xdd\x97\xe1\xb6\x61\xcc\x26\x9b\x08\x28\x36\x62\xfd\x18\x6b\x8e\x15\xaa\x59\x9b\xd3\x1f\x07\xe5\xed\xe6\x18\xe4\x7a\x03\x4a\x67\x45\x3e\x71\xe1\x5b\x70\xdd\xf7\x6d\x20\x3d\xcc\xae\x1e\xe7\x11\x84\x1d\x3c\x82\xfd\xc3\xad\xfb\xad\xbf\x0d\x00\x32\x28\xe4\xf0\xc9\x2f\x00\x00"),
 		},
 		"/third_party/google/api/httpbody.proto": &vfsgen۰CompressedFileInfo{
 			name:             "httpbody.proto",
 			modTime:          time.Time{},
 			uncompressedSize: 2688,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcf\x6f\xdb\xb8\x12\xbe\xfb\xaf\x18\xf8\xf2\x12\x20\xb1\xda\xf4\xf2\x50\x23\x07\x37\xcd\x6b\xfc\x36\x4d\x82\xd8\xd9\xa2\x27\x63\x4c\x8d\x25\xb6\x14\xc9\x92\x23\x3b\x42\xd1\xff\x7d\x31\x94\x64\xcb\x4d\x76\x6f\xeb\x4b\x22\x72\xf8\xcd\x37\x3f\xbe\x21\xb3\x0c\xae\x9c\x6f\x82\x2e\x4a\x86\x8b\x37\x6f\xff\x0b\x9f\x9c\x2b\x0c\xc1\xed\xed\xd5\x64\x94\x65\xa3\x2c\x83\x5b\xad\xc8\x46\xca\xa1\xb6\x39\x05\xe0\x92\x60\xe6\x51\x95\xd4\xef\x9c\xc1\x9f\x14\xa2\x76\x16\x2e\x26\x6f\xe0\x44\x0c\xc6\xdd\xd6\xf8\x74\x2a\x10\x8d\xab\xa1\xc2\x06\xac\x63\xa8\x23\x01\x97\x3a\xc2\x46\x1b\x02\x7a\x56\xe4\x19\xb4\x05\xe5\x2a\x6f\x34\x5a\x45\xb0\xd3\x5c\x26\x3f\x1d\x8a\x30\x81\xaf\x1d\x86\x5b\x33\x6a\x0b\x08\xca\xf9\x06\xdc\x66\x68\x08\xc8\x1d\x69\xf9\x95\xcc\xfe\x7d\x96\xed\x76\xbb\x09\x26\xc2\x13\x17\x8a\xcc\xb4\xa6\x31\xbb\x9d\x5f\x5d\xdf\x2d\xae\xcf\x2f\x26\x6f\xba\x43\x4f\xd6\x50\x8c\x10\xe8\x47\xad\x03\xe5\xb0\x6e\x00\xbd\x37\x5a\xe1\xda\x10\x18\xdc\x81\x0b\x80\x45\x20\xca\x81\x9d\x90\xde\x05\xcd\xda\x16\x67\x10\xdd\x86\x77\x18\x48\x60\x72\x1d\x39\xe8\x75\xcd\x47\x39\xeb\x29\xea\x78\x64\xe0\x2c\xa0\x85\xf1\x6c\x01\xf3\xc5\x18\x3e\xcc\x16\xf3\xc5\x99\x80\x7c\x99\x2f\x6f\xee\x9f\x96\xf0\x65\xf6\xf8\x38\xbb\x5b\xce\xaf\x17\x70\xff\x08\x57\xf7\x77\x1f\xe7\xcb\xf9\xfd\xdd\x02\xee\xff\x07\xb3\xbb\xaf\xf0\xc7\xfc\xee\xe3\x19\x90\xe6\x92\x02\xd0\xb3\x0f\x12\x81\x0b\xa0\x25\x9b\x94\xa7\xd4\x2d\x88\x8e\x28\x6c\x5c\x4b\x29\x7a\x52\x7a\xa3\x15\x18\xb4\x45\x8d\x05\x41\xe1\xb6\x14\xac\xb6\x05\x78\x0a\x95\x8e\x52\xd5\x08\x68\x73\x81\x31\xba\xd2\x8c\x9c\x96\x5e\xc4\x95\xba\x65\x14\x1b\xcb\xf8\x0c\x97\x30\xf6\xc1\xb1\x7b\x37\x9e\x8e\x46\x1e\xd5\xf7\x16\x5b\x3a\x6b\x82\x5e\x4f\x47\x23\x5d\x79\x17\x18\xc6\xed\x62\x96\xac\xd7\xf5\x26\x43\xdb\x4c\xd2\x87\x9c\x74\x5e\x9c\x81\x52\x2b\xb2\x52\x82\x15\x06\xb2\x18\xe1\x12\x38\xd4\x34\xed\xf7\x0b\xb7\xea\x7d\x5c\xf6\x88\x93\xc2\x49\x50\xa9\xe2\x05\xd9\x04\x99\xb5\x5b\xe8\x75\xcc\xd0\xeb\x4c\xfa\x63\xed\xf2\x66\xda\xff\x33\xde\x43\x7e\xc3\x2d\xae\xaa\xda\xb0\xf6\x86\x56\xd2\xa9\x2f\xbc\x26\x13\x57\x33\x85\x95\x32\x18\xa3\xc5\x2a\xf9\xbf\x61\xf6\x1f\x5c\xde\x3c\x74\x51\x0c\xcd\x07\x34\x95\xab\x26\x87\x8c\x1c\xec\xdc\xfa\x9b\x6a\x01\x57\x3e\xd0\x46\xa7\x64\x7e\x9a\x3d\xcc\x25\x21\x59\x06\x9f\x29\x46\x81\xe0\x12\x19\x02\x49\xbd\xc9\xb2\xd4\x08\x30\xac\x35\x07\x0c\x0d\xdc\x2c\x97\x0f\x20\x11\x4d\x60\xce\x10\x4b\x57\x1b\xe9\x34\xd3\xc0\x9a\x44\x80\xb9\xb4\x80\xa0\x79\x6c\x8c\xc3\xf4\x59\x21\xc7\x16\x55\xa1\xfd\x0f\x8b\xe5\x1e\x9e\x72\xc0\x08\xff\x5f\xdc\xdf\x9d\x41\xac\x55\x29\x5f\x01\x77\xb0\xd6\x56\xdc\xb5\x58\x68\xe1\x66\xf9\xf9\x16\x3c\x16\xd4\xcd\x0e\x59\x5e\x8a\xd6\xab\x8e\xb5\x42\xbb\xa7\xb0\x76\x5c\x8a\x86\x22\x07\xc2\x4a\x7a\x0e\x6d\x0e\xd6\xd9\xf3\xc3\xca\xec\x61\x0e\x15\x71\xe9\xf2\x08\xda\x0a\x9c\xb4\x9c\x08\x94\x22\x0b\x8b\x1d\x19\x23\x7f\xdb\xe5\xe8\x5d\xdf\x8a\x59\x26\xa1\x0f\xfd\x61\x04\x04\x76\xfe\xdc\xd0\x96\xcc\x1e\x64\xa3\xc9\xe4\x67\xb0\x2b\xb5\x2a\x45\x9c\xca\xd9\x2d\x59\x4d\x96\x41\x6f\xc0\xd9\x24\xe9\x1d\x4a\x8e\xd9\x01\x3d\x73\x40\xc5\xe0\x31\x60\x45\x4c\x21\xc2\x26\xb8\xaa\x17\xa0\xd0\x78\x7a\xbc\x15\x01\xa6\x1a\x30\x55\xde\x20\x13\x68\xcb\x4e\x76\x05\xec\xc8\x73\x52\x17\xa0\x89\x2e\x39\x01\x54\x4a\x14\xdc\x5a\xa7\x24\x1f\x8a\xd9\xc5\x75\xfd\x8c\x95\x37\xf4\x7e\x30\xea\xfa\xfc\x7e\x22\x7e\xa4\xe8\xea\xa0\xe8\xb1\xf3\xf2\xb3\xb7\x01\xc8\x32\x98\x41\x6d\xf5\x8f\xfa\x90\x43\xdd\x8e\x88\xf6\x27\x63\xc9\x16\xfd\xde\x4a\xe7\x70\x09\x6f\xa7\x03\x3f\x09\x63\xf9\x3b\x31\x49\xdb\xda\xd5\x36\x6f\x69\xa7\xd9\x4e\x66\x08\x7c\xe8\xf4\x49\xaf\x8f\x34\x9e\x57\xe9\xf8\x25\x5c\x4c\x7b\xdb\x5f\x03\x6f\x91\xc2\x56\x2b\x82\x3e\xa4\x45\xf7\x3d\x08\x29\x78\x35\x0c\xfa\xe4\x65\x02\x4e\x21\x10\xd7\xc1\x46\x38\x79\x85\xc5\xe9\xf4\x18\xeb\xc9\xe7\xc8\xb4\x87\x7b\xed\x44\x8f\x77\x38\xd8\x9b\xf5\x53\x6c\x72\x5d\x79\x1e\x40\xff\x3a\x2e\x5c\x7b\xbf\x1d\x9a\xbc\x6b\xf0\xf7\xaf\x44\x7e\x85\x26\xc7\xed\xdf\xc7\x7d\x85\x86\x6c\x8e\xe1\xa4\x45\x7b\x2d\xcf\xa7\x87\x43\x70\x48\xc5\x3f\xd8\xbf\x9a\x91\x7f\xd5\x51\x9f\x9f\xa7\x48\xed\x85\xae\x23\x70\xe3\xa9\x1d\x59\xaa\x44\x5b\x50\x84\xd2\xed\x8e\xd5\x6f\xf3\xbd\xe4\xa5\x0d\x35\x45\xe8\x6e\xe0\x12\x6d\x6e\x28\x3f\x03\x34\x06\x5c\x52\xe6\x86\x90\xeb\x40\x11\x76\xda\x18\x11\x39\x6b\x5b\x93\x34\xec\xce\x85\xef\x50\xdb\xd6\x4d\x3e\x19\xf5\x5a\xda\x37\xea\xcf\xd1\xa0\xf1\x53\xd3\x5f\x39\xcb\x64\xf9\x7c\x29\x24\x4b\x42\xb9\x0b\xb7\x68\xea\xfe\x4a\x6d\xa4\xac\xc2\x55\xb5\x86\x5d\x34\xed\x5b\xa5\x95\xf2\x40\x6e\x9d\xd1\x2a\x19\x25\xc1\xbd\x70\xd8\xc5\x9c\x0d\xe3\x6d\x8e\xa7\x70\x0b\xb9\x6e\x98\x22\xe4\xc8\xd8\xaa\xaa\x47\x9a\xb5\xef\x98\x74\xc5\xec\xaf\xfd\x3d\x5a\x45\x8c\x72\x66\x02\x9f\xeb\x98\x46\x7f\xa4\xf4\x28\x13\xbe\x1b\x1d\x22\xef\x6d\x7b\x40\x79\x43\x1c\xcd\xe9\xd8\x12\x08\xe4\x09\xe5\xc2\xf8\x5d\x13\x33\xdb\xc8\xf4\x24\xdb\xbe\x29\x2e\xe1\xdd\x74\xf4\xeb\xaf\x00\x00\x00\xff\xff\x0e\x92\xcd\x22\x80\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\x1b\x39\x12\xbd\xeb\x57\x3c\xe8\xb2\x36\x60\xab\x33\x9e\xcb\x22\x82\x0f\x1a\x8f\x37\xd6\xae\x63\x1b\x96\xbc\x83\x9c\x84\x52\x77\x49\xcd\x84\x4d\x32\x64\xb5\xe5\x46\x90\xff\xbe\xa8\xfe\x90\xe4\xd8\xbb\xb7\x3d\x49\x4d\x16\x5f\xbd\xfa\x78\x45\x66\x19\xae\x7c\x68\xa2\xd9\x96\x82\x8b\x0f\xbf\xfd\x1d\x9f\xbc\xdf\x5a\xc6\xed\xed\xd5\x64\x94\x65\xa3\x2c\xc3\xad\xc9\xd9\x25\x2e\x50\xbb\x82\x23\xa4\x64\xcc\x02\xe5\x25\x0f\x3b\x67\xf8\x37\xc7\x64\xbc\xc3\xc5\xe4\x03\x4e\xd4\x60\xdc\x6f\x8d\x4f\xa7\x0a\xd1\xf8\x1a\x15\x35\x70\x5e\x50\x27\x86\x94\x26\x61\x63\x2c\x83\x5f\x72\x0e\x02\xe3\x90\xfb\x2a\x58\x43\x2e\x67\xec\x8c\x94\x90\x83\x03\x65\x82\x2f\x3d\x86\x5f\x0b\x19\x07\x42\xee\x43\x03\xbf\x39\x36\x04\x49\x4f\x1a\x00\x4a\x91\xf0\x31\xcb\x76\xbb\xdd\x84\x5a\xc2\x13\x1f\xb7\x99\xed\x4c\x53\x76\x3b\xbf\xba\xbe\x5b\x5c\x9f\x5f\x4c\x3e\xf4\x87\x9e\x9c\xe5\x94\x10\xf9\x7b\x6d\x22\x17\x58\x37\xa0\x10\xac\xc9\x69\x6d\x19\x96\x76\xf0\x11\xb4\x8d\xcc\x05\xc4\x2b\xe9\x5d\x34\x62\xdc\xf6\x0c\xc9\x6f\x64\x47\x91\x95\x69\x61\x92\x44\xb3\xae\xe5\x55\xce\x06\x8a\x26\xbd\x32\xf0\x0e\xe4\x30\x9e\x2d\x30\x5f\x8c\xf1\xc7\x6c\x31\x5f\x9c\x29\xc8\x5f\xf3\xe5\xcd\xfd\xd3\x12\x7f\xcd\x1e\x1f\x67\x77\xcb\xf9\xf5\x02\xf7\x8f\xb8\xba\xbf\xfb\x73\xbe\x9c\xdf\xdf\x2d\x70\xff\x0f\xcc\xee\xbe\xe0\x5f\xf3\xbb\x3f\xcf\xc0\x46\x4a\x8e\xe0\x97\x10\x35\x02\x1f\x61\x34\x9b\x5c\xb4\xa9\x5b\x30\xbf\xa2\xb0\xf1\x5d\x19\x53\xe0\xdc\x6c\x4c\x0e\x4b\x6e\x5b\xd3\x96\xb1\xf5\xcf\x1c\x9d\x71\x5b\x04\x8e\x95\x49\x5a\xd5\x04\x72\x85\xc2\x58\x53\x19\x21\x69\x97\xde\xc4\xa5\x8e\x46\xa3\xd4\x38\xa1\x17\x5c\x62\x1c\xa2\x17\xff\xfb\x78\x3a\x1a\x05\xca\xbf\x75\xd8\xda\x59\x13\x0a\x66\x3a\x1a\x99\x2a\xf8\x28\x18\x77\x8b\x59\x6b\xbd\xae\x37\x19\xb9\x66\xd2\x7e\xe8\x49\x1f\xd4\x19\xf2\x7c\xc5\x4e\x4b\xb0\xa2\xc8\x8e\x12\x2e\x21\xb1\xe6\xe9\xb0\xbf\xf5\xab\xc1\xc7\xe5\x80\x38\xd9\x7a\x0d\xaa\xad\xf8\x96\x5d\x0b\x99\x75\x5b\x14\x4c\xca\x28\x98\x4c\xfb\x63\xed\x8b\x66\x3a\xfc\x19\xef\x21\xbf\xd2\x33\xad\xaa\xda\x8a\x09\x96\x57\xda\xa9\x6f\xbc\xb6\x26\xbe\x16\x8e\xab\xdc\x52\x4a\x8e\xaa\xd6\xff\x8d\x48\xf8\xc3\x17\xcd\x43\x1f\xc5\xb1\xf9\x11\xcd\xdc\x57\x93\x43\x46\x0e\x76\x7e\xfd\x35\xef\x00\x57\x21\xf2\xc6\xb4\xc9\xfc\x34\x7b\x98\x6b\x42\xb2\x0c\x9f\x39\x25\x85\x90\x92\x04\x91\xb5\xde\xec\x44\x6b\x04\x8a\x6b\x23\x91\x62\x83\x9b\xe5\xf2\x01\x1a\xd1\x04\x73\x41\x2a\x7d\x6d\x0b\x78\x67\x1b\xac\x59\x05\x58\x60\xe3\xa3\x96\x34\x50\x63\x3d\xb5\x9f\x15\x49\xea\x50\x73\x72\x7f\x13\xb5\xdc\xc3\x73\x01\x4a\xf8\xe7\xe2\xfe\xee\x0c\xa9\xce\x4b\xfd\x8a\xb4\xc3\xda\x38\x75\xd7\x61\x91\xc3\xcd\xf2\xf3\x2d\x02\x6d\xb9\x9f\x1d\xba\xbc\x54\xad\x57\x3d\xeb\x9c\xdc\x9e\xc2\xda\x4b\xa9\x1a\x4a\x12\x99\x2a\xed\x39\x72\x05\x9c\x77\xe7\x87\x95\xd9\xc3\x1c\x15\x4b\xe9\x8b\x04\xe3\x14\x4e\x5b\x4e\x05\xca\x49\x94\xc5\x8e\xad\xd5\xdf\x6e\x39\x05\x3f\xb4\x62\x96\x69\xe8\xc7\xfe\x28\x81\x20\x3e\x9c\x5b\x7e\x66\xbb\x07\xd9\x18\xb6\xc5\x19\x76\xa5\xc9\x4b\x98\x84\xdc\xbb\x67\x76\x86\x9d\xc0\x6c\xe0\x5d\x2b\xe9\x1d\x69\x8e\xc5\x83\x5f\x24\x52\x2e\x08\x14\xa9\x62\xe1\x98\xb0\x89\xbe\x1a\x04\xa8\x34\x9e\x1e\x6f\xe1\x63\x57\x03\xe1\x2a\x58\x12\x86\x71\xe2\x55\x73\x0a\xf6\xca\xb3\x56\xae\x00\xd9\xe4\x5b\x27\xa0\x3c\x57\x05\x77\xd6\x6d\x92\x0f\xc5\xec\xe3\xba\x7e\xa1\x2a\x58\xfe\xd8\x7f\xea\xa8\x1b\xf2\xfb\x89\xe5\x91\x93\xaf\x63\xce\x8f\xbd\x97\x1f\x83\x0d\x90\x65\x98\xa1\x76\xe6\x7b\x7d\xc8\xa1\xe9\x46\x84\x6e\x03\x3a\xb7\xdc\x76\xd8\x5b\x99\x02\x97\xf8\x6d\x7a\xe4\xa7\xc5\x58\xfe\x4a\x4c\xd3\xb6\xf6\xb5\x6b\xa7\x62\x3f\xdb\xd9\x1e\x03\x1f\x3a\x7d\x32\xe8\xa3\x1d\xcf\xab\xf6\xf8\x25\x2e\xa6\x83\xed\xcf\x23\x6f\x89\xe3\xb3\xc9\x19\x43\x48\x8b\xfe\xfb\x28\xa4\x18\xf2\xe3\xa0\x4f\xde\x26\xe0\x14\x91\xa5\x8e\x2e\xe1\xe4\x1d\x16\xa7\x7b\xc7\x1d\xd6\x53\x28\x48\x78\x0f\xf7\xde\x89\x01\xef\x70\x70\x00\x1e\xa6\xd8\xe4\xba\x0a\xd2\x9c\xbe\x89\xa9\x2f\x5c\x77\xbf\x1d\x9a\xbc\x6f\xf0\x8f\xef\x44\x7e\x45\xb6\xa0\xe7\xff\x1e\xf7\x15\x59\x76\x05\xc5\x93\x0e\xed\xbd\x3c\x9f\x1e\x0e\xe1\x90\x8a\xff\x61\xff\x6e\x46\xfe\xaf\x8e\x86\xfc\x3c\x25\xee\x2e\x74\x93\x20\x4d\xe0\x6e\x64\xe5\x25\xb9\x2d\x27\x94\x7e\xf7\x5a\xfd\xae\xd8\x4b\x5e\xf5\x61\x38\xa1\xbf\x81\x4b\x72\x85\xe5\xe2\x0c\x64\x2d\x7c\x7b\x35\x6e\x98\xa4\x8e\x9c\xb0\x33\xd6\xaa\xc8\xc5\xb8\x9a\xb5\x61\x77\x3e\x7e\x43\xed\x3a\x37\xc5\x64\x34\x68\x69\xe0\x89\x1f\xa3\xa3\xc6\x6f\xd5\x78\xe5\x9d\xb0\x93\xf3\xa5\x92\x2c\x99\xf4\x2e\x7c\x26\x5b\x0f\x57\x6a\xa3\x65\x55\xae\xea\x47\x27\x49\x17\x8d\x86\xd6\x52\x6d\x26\xa3\x23\xb9\xf5\x46\xab\xd6\xa8\x15\xdc\x1b\x87\x7d\xcc\xd9\x71\xbc\xcd\xeb\x29\xdc\x41\xae\x1b\xe1\x84\x82\x84\x70\x89\x8b\x03\xd2\xac\x7b\xc7\xb4\x57\xd1\xfe\xda\xdf\xa3\x55\x2c\xa4\x67\x26\xf8\x5c\xa7\x76\xf4\x27\x6e\x1f\x65\xca\x77\x63\x62\x92\x7d\xa6\x07\x40\x7d\x43\xbc\x9a\xd3\xa9\x23\x10\x39\x30\xe9\xcb\xe7\x57\x4d\xcc\x5c\xa3\xd3\x93\x5d\xf7\xa6\xb8\xc4\xef\xd3\xd1\xcf\xff\x0c\x00\x0e\x92\xcd\x22\x80\x0a\x00\x00"),
 		},
 		"/third_party/protoc-gen-swagger": &vfsgen۰DirInfo{
 			name:    "protoc-gen-swagger",
@@ -83,20 +94,24 @@ var Api = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1801,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x93\xcf\x8e\x9b\x40\x0c\x87\xef\x3c\x85\x95\x73\x81\x76\xdb\x5b\x14\xa9\x95\x56\x2b\xa5\x52\x9b\x43\x7a\x8f\x9c\xc1\x98\xe9\x02\x1e\x8d\xbd\x7f\x50\xd5\x77\xaf\x60\x80\x8d\xb4\xad\xb6\xf7\xdc\x60\xf8\xf9\xb3\xe7\x63\x46\x87\xde\xf0\x19\x76\xb0\x09\x51\x4c\x3e\x6e\xb6\x59\x16\xd0\xdd\x23\x13\x70\x0c\xae\x60\x34\x7a\xc2\xa1\x98\x3e\xbb\x13\x53\x7f\xd2\x27\x64\xa6\x58\x48\x30\x2f\xbd\x6e\xb3\x2c\x3d\x01\xcb\x69\xa9\xdd\xc1\x86\xbd\x35\x0f\xe7\xc2\x49\x57\x8e\xa4\x9c\x9c\xe8\xa0\x46\xf3\xeb\x0c\x2e\x13\x38\x67\xea\xf3\x19\x5c\xce\xe0\x71\x16\xdf\x05\x89\x36\x4f\xf7\xd7\x54\x29\x81\x7a\x0c\xfe\xf1\x26\xcd\xb8\xd9\xae\x45\x2c\xc2\x2d\xa5\x0e\xe7\x87\xba\xac\x48\x5d\xf4\xc1\x24\xae\xd1\x8c\x9e\x8d\xfa\x0a\x52\xb4\x58\xa2\xc5\x9d\x6f\xe9\x90\x1a\xc0\xaf\x0c\x00\xa0\x2c\x61\x7f\x0b\xa8\xea\xb9\xa7\x0a\xce\x03\x2c\xe1\x9c\x5b\x39\x63\x9b\x4f\x28\xf5\xd2\xe7\x91\xd8\xab\xc5\xe1\xf3\x8c\x75\xd2\x41\x2d\x11\x2e\x37\x3e\x96\xff\x24\x67\xc5\x4c\x5f\x9a\x7c\x69\x5b\xd8\xdf\x2a\x60\x24\xb0\x86\x40\xb1\xa3\x77\x80\xba\xb6\x2e\x60\x6f\xe0\x15\xe4\x1e\x07\xb0\x06\x6d\x8c\x0d\xaf\xf3\xd3\x6a\xda\xde\xc2\xae\x7c\x5d\x53\xa4\xde\xe0\x45\x05\x74\xa4\x8a\x4c\x9a\x06\x39\x26\xbb\xb0\x5a\x5d\x7e\x37\xec\xe0\xc3\xfb\x4f\x37\xdb\xec\xf7\xbf\x9c\x7d\x23\x6b\xa4\xba\x4a\x6b\x87\x40\x11\xa7\x3b\xf0\xe2\x4d\xd6\xb5\xff\x30\x37\xc1\xae\x52\xdd\xd1\x35\xd4\xe1\xe5\x79\x4b\x0b\x6f\x4a\x3b\x52\x7c\xf4\xee\x3a\xa5\xfd\x40\xbe\x30\x66\xc8\x6f\xeb\xba\xf3\xd4\x5e\xe7\xe5\xfc\x7a\x3c\x7c\x7f\x75\xca\xea\x51\xc7\x85\xb5\x3f\x01\x00\x00\xff\xff\x2c\x25\xcc\x4b\x09\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x93\xcf\x6e\xdb\x30\x0c\xc6\xef\x7a\x0a\xc2\xe7\xd9\x6e\xb3\xde\x8c\x00\x1b\x50\x14\xc8\x80\x2d\x87\xec\x1e\x30\x32\x2d\x6b\xb5\x45\x41\x62\xff\x18\x43\xdf\x7d\xb0\x65\x7b\x01\x8a\x22\xbd\xe7\x66\x53\x1f\x3f\x52\x3f\x91\x71\x70\x82\xaf\xb0\x85\xcc\x07\x16\xfe\x9a\x55\x4a\x79\xd4\x8f\x68\x08\x4c\xf0\xba\x30\x28\xf4\x82\x43\x31\x1d\xeb\xa3\x21\x77\x8c\x2f\x68\x0c\x85\x82\xbd\x58\x76\xb1\x52\x2a\x7d\x81\xe1\xe3\x92\xbb\x85\xcc\x58\x69\x9f\x4e\x85\xe6\xbe\x1c\x9d\x72\xd2\x1c\x87\x28\x34\xff\xce\xc6\x65\x32\xce\x0d\xb9\x7c\x36\x2e\x67\xe3\xb1\x17\xdb\x7b\x0e\x02\xd9\xc7\xaa\x92\x3d\x39\xf4\xf6\x79\x93\x7a\xcc\xaa\x35\xc9\x30\x9b\x8e\x52\x85\xd3\x53\x53\xd6\x14\x75\xb0\x5e\x38\xac\x52\x45\xaf\x42\xae\x86\x24\x2d\x16\x69\xf1\x60\x3b\xda\xa7\x02\xf0\x57\x01\x00\x94\x25\xec\xee\x01\x63\xb4\xc6\x51\x0d\xa7\x01\x16\x71\x6e\x3a\x3e\x61\x97\x4f\x56\xd1\xb2\xcb\x03\x19\x1b\x25\x0c\xdf\x66\x5b\xcd\x3d\x34\x1c\xe0\xfc\xe2\x63\xfa\x1f\xd2\x52\xcc\xee\x4b\x91\xef\x5d\x07\xbb\xfb\x08\x18\x08\xa4\x25\x88\xd8\xd3\x17\xc0\xb8\x96\x2e\x60\x27\x60\x23\xf0\x23\x0e\x20\x2d\xca\x28\x1b\xde\xeb\xa7\xe8\xd4\x53\xbd\x78\xd7\xb6\x69\x28\x90\x13\xf8\x8f\x02\x7a\x8a\x11\x0d\xc5\xd4\xc8\x21\xd1\x85\x95\xea\xf2\xdc\xb0\x85\xdb\x9b\xbb\x4d\xa5\xde\x3e\x62\xf6\x93\xa4\xe5\xfa\x2a\xa9\xed\x3d\x05\x1c\xef\x7d\xc6\x8d\xd7\xd8\x27\xc8\x4d\x4f\x70\x9d\x03\xa7\x5b\xea\xf1\x7c\xde\x52\xe0\x22\xb4\x03\x85\x67\xab\xaf\x73\x4b\x7f\xa3\x39\x23\x26\x68\x2e\xe3\x7a\xb0\xd4\x5d\xe7\x72\xfe\x38\xec\x7f\xbd\x9b\xb2\x66\xc4\x01\x5b\xb8\xbd\xb9\xdb\x54\xea\x4d\xfd\x1b\x00\x2c\x25\xcc\x4b\x09\x07\x00\x00"),
 		},
 		"/third_party/protoc-gen-swagger/options/openapiv2.proto": &vfsgen۰CompressedFileInfo{
 			name:             "openapiv2.proto",
 			modTime:          time.Time{},
 			uncompressedSize: 13854,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5b\x5b\x73\x1b\xb7\x92\x7e\xd7\xaf\x40\x69\x4f\x95\xec\x2a\x7a\x68\x51\xb2\x73\x6c\xaf\xb2\x45\x5b\x72\xc2\x44\x26\xb5\x22\x95\x54\x9e\x68\x70\xa6\x49\xe2\x78\x06\x18\x03\x18\x4a\x3c\x2e\xff\xf7\xad\xc6\x65\x30\x37\x5a\x32\xb3\x72\x9d\x3c\x58\xd2\x00\x68\xf4\x0d\x8d\xaf\xbb\x11\xb5\xe5\x9a\xde\x91\x33\x72\x98\x4b\xa1\xc5\xc9\xe1\x9b\x83\x83\x9c\xc6\x9f\xe8\x0a\xc8\x4a\xe6\x71\xb4\xa2\x1a\x6e\xe9\x36\x32\xc3\xf1\x7c\x05\x7c\xae\x6e\xe9\x6a\x05\x32\x12\xb9\x66\x82\xab\x37\x07\x07\xf6\x37\xb2\x12\x73\xbf\xf6\x8c\x1c\xae\x98\x5e\x17\x8b\x28\x16\x59\x1f\x29\x3d\x83\x58\xa8\xad\xd2\xe0\xfe\x74\x84\xfb\x96\xf0\xb3\x15\xf0\x67\x8e\x70\xdf\x11\x46\x5e\x58\x96\x0b\xa9\xc9\xe1\x4a\x88\x55\x0a\x76\xf2\xa2\x58\xf6\x29\x77\x2c\xe1\xa4\x7e\x9f\x7c\x9c\xda\xb5\x1f\x09\x53\x84\x12\x09\xb9\x04\x05\x5c\x53\xc3\x98\x58\x92\x49\x0e\x7c\x78\x35\x22\x9b\x01\x51\x39\xc4\x6c\xc9\x62\x33\x76\xa4\x88\x5b\x4a\xc4\xe2\x5f\x10\xeb\xe8\xa0\xdf\x47\x8a\x53\x80\xd7\x64\xad\x75\xae\x5e\xf7\xfb\x15\x59\x26\xc3\x51\xdf\x11\x7b\x36\xad\x52\xea\x2f\x52\xb1\xe8\x9f\x44\xcf\xa3\xe7\xfd\x0d\x48\x85\x22\xf4\x07\xd1\xf3\x28\x4b\xfe\xcb\x49\x36\x31\x3b\xb8\x0d\x66\x93\xf3\xc9\x13\xb6\x29\x62\x16\xd3\xa7\xaf\x49\x22\xe2\x22\x03\xae\xc9\x92\x41\x9a\xa8\x83\x0c\x94\x42\x4d\x7a\xee\xbe\x1c\x10\x42\x88\xd2\x92\xf1\x15\x71\xf4\xc8\x19\x39\x7e\x63\xbe\x8f\xf8\x52\x10\x86\xff\x9c\x91\xc1\x9b\xea\xd4\xb5\x50\x9a\x9c\x91\x13\xfb\x11\x55\xb5\xa0\x0a\xe6\x39\xd5\x6b\xa3\x2c\xbd\x06\x12\x8b\x2c\x13\x9c\xe4\x12\x96\xec\x8e\xe0\x10\x29\x14\x24\x44\x70\x42\xd3\x94\xa0\xde\x80\x27\xb9\x60\x5c\x2b\xf2\x84\x41\x44\xfa\x34\x67\x3d\xd2\xdf\x1c\xf7\x08\xe8\x38\x7a\x1a\x91\xb7\x5b\x42\x93\x04\x77\xd4\x6b\xa6\x7a\x7e\x37\xa6\x91\x84\xb8\x55\x64\x2b\x0a\xa2\x05\x91\x90\x89\x0d\x98\x49\x04\x6d\x8b\xf6\x59\x4a\x91\x19\x46\xcc\xd6\x61\x2b\xc6\x71\x95\x2c\x75\xb0\x64\x29\x90\x8c\x7e\xb2\xbb\x40\x46\x80\x2a\x06\xd2\xef\x65\xa8\xd3\x24\x22\x63\xa1\x71\x07\xaa\x49\xa1\x70\x6e\x55\xe4\x44\x80\x22\x5c\x68\x12\xaf\x29\x5f\x81\xd9\xd6\xef\x68\xf6\x57\x76\x25\x95\x40\x56\xc0\x41\x52\x0d\x09\x72\x82\x13\x25\xa8\x22\xd5\x8c\xaf\xfc\x96\x55\xce\x22\x32\x5a\x1a\x29\x6f\x99\x5a\x23\x33\x85\x82\xda\xd6\xb7\x4c\xaf\x89\x84\x94\x6a\xb6\x81\x74\x5b\x21\xef\xc9\x98\xfd\x7b\xb8\x55\x97\xb5\x9c\x7d\xb2\x42\x69\xb2\x40\x45\xf0\x82\xa6\xe9\xd6\xa9\x34\xb1\x6a\x34\x0a\xfb\x68\xcf\x4b\x44\x73\x16\xa1\x0f\x7f\x74\x92\x51\x9e\xd8\x09\xb1\x48\xc0\x69\x20\x21\x5a\xf8\xdd\x14\xc8\x8d\x55\x09\xda\xbc\x34\x4b\x85\x89\xa8\xea\x5b\xe5\x67\x72\x46\x4e\xad\x83\x01\x2f\x32\x2f\xce\x34\x5e\x43\x06\xce\x73\xf1\xbf\x9b\xf1\xef\xe3\xc9\x9f\x63\x72\x46\x9e\xbf\x29\x3f\xfe\x3a\x9b\x5d\x05\x47\xf6\x5f\xa6\xc1\x91\xf1\xbf\x3f\xa7\xc1\x87\xed\xdf\xd3\xb0\xe7\x57\xf3\xaf\x84\x1c\xaa\xca\x74\xbb\x2b\xf3\x43\x91\x33\xf2\xe2\x4d\x7d\x9e\x13\x22\x16\x5c\x15\x76\xc6\xcb\xee\x19\xb9\x14\x49\x11\x9b\x19\x3f\x95\xc7\xc8\x1c\x53\xf2\x4f\x3c\x42\x18\x6a\xa4\x31\x80\x90\xe4\xc8\x68\xfa\x28\x72\x94\xdc\xc8\x3f\x1b\xeb\x5e\xb5\xd7\x25\xb0\x64\x9c\x99\xc8\x77\xd4\x23\xb7\x6b\x16\xaf\x09\xd5\xf6\xa0\x68\x96\x81\xf1\x48\x9a\xa2\x87\x6f\x3d\x31\xb8\xcb\x05\x1e\x54\x6a\x4d\x1b\x17\x4a\x8b\x8c\xfd\x9b\x2e\x52\xc0\x6f\x26\x42\x12\x17\x49\x54\x83\xa5\x57\x96\xa5\x8c\xe6\xff\x6d\xe5\xec\x91\x6b\x50\xb9\xe0\x0a\x7e\xc6\x59\xe6\x37\x94\xf9\xd8\x19\x6b\x0a\x71\x21\x99\xde\x9e\x07\x46\x89\x72\xdf\xe6\x15\xee\x71\xc9\x71\x43\x93\x7e\xed\x35\x7c\x2e\x98\x04\x13\xe8\xfc\x5a\x9c\x3f\x68\xe8\xe7\xf8\xa4\xad\x20\x4d\x57\x15\xcd\x48\x20\xaa\xc8\xad\xf8\x5a\xe0\x79\xa8\x2b\xc3\xd3\xeb\xd6\x09\xd2\x65\x31\xa8\xa8\x19\x86\x69\x92\xe0\x94\x18\x94\x89\x1c\x62\x69\x17\x54\x0f\x08\x8b\xc1\xdd\x15\x18\xa1\xb4\xa8\xde\x2d\x33\xba\xf2\x63\x0d\x75\x1f\x3b\xef\xbd\xb8\xd3\x20\x39\x4d\xcf\x5d\xbc\xb7\x37\x14\xb8\xaf\xf3\x44\xc4\x46\x81\xa7\x6f\x0e\xbe\xda\x9b\x6d\x92\x63\x90\x60\x82\xef\x73\xb7\x95\x8b\x1f\xf3\x76\x13\x7e\x93\x7d\xee\xb7\xc0\xe1\x97\xce\xc3\x87\x56\x0f\xe1\xc1\xdf\x7f\x45\x96\x51\xb9\x6d\x5d\x76\x09\xa8\x58\x32\x8b\x46\xca\x78\xf1\x30\x8d\x9f\xd6\x08\x95\x22\xcd\x59\xf2\x23\x43\x87\xa4\x19\x68\x90\xbb\xe2\xc7\xfd\x87\xf5\x55\x37\x1f\x21\x0a\xfa\xd3\xbc\x10\x22\x25\x09\xfa\x52\x6c\x26\xee\x77\x68\x9d\x8f\x7a\x5e\xf6\x71\x51\xbf\xf6\x31\x3d\xd4\x6b\x28\x38\xa8\x77\xbf\x72\xf7\x2f\xe5\x8d\x7b\x1e\x9c\xc8\xc9\xa3\xd6\x08\x42\xab\xce\x25\x96\x1e\x0f\x98\xd5\x91\x5f\xfc\xcb\xfb\x0f\xc4\x61\xea\x98\x72\x8c\x4a\x06\x48\xa1\x75\x25\x86\x2d\x0d\x77\xba\xa1\x9e\x68\xb7\x03\x1f\x07\xd0\x66\x6e\x33\xfa\x91\x58\x6c\x6c\xae\x7d\x13\x72\xc1\x22\x38\xa5\x65\x11\xeb\x42\xc2\x4e\xce\x46\xcb\x40\x84\x59\x04\x94\x4b\xb1\x61\x09\x24\x3d\x44\x69\x19\x50\x6e\x68\x49\xb0\xe3\xe8\xdd\x1a\x4d\xae\x45\x07\x45\x4b\xca\xfa\x15\x0d\xe7\xb0\xf4\xee\x8e\xf8\xbd\x06\x9a\x74\xb8\xf6\xc9\xfd\x2b\xe1\x8e\x66\x79\x0a\xcd\x95\x21\x46\x22\xfc\xdd\xc7\xf7\x0c\x6c\x7e\x44\xbf\x43\x44\xbe\x4f\x50\x34\x7c\xd5\x10\xbf\x66\x3a\x85\x56\x1c\xac\xbb\x4b\x3d\x16\x6a\x90\x99\x9a\x8b\xe5\xdc\xdf\x59\x65\x40\x7c\x27\xb8\xa6\xb1\x36\x06\xc6\x9f\x65\xf0\xbb\x64\x31\xe0\x59\x48\xdd\xcf\x32\xf0\x39\x92\x4e\x3c\x1b\xee\x9c\xea\x1d\xb1\x7d\xb4\xef\xf9\x78\x44\x03\x38\x11\xf7\xb1\x81\xe7\xae\x66\x06\x4e\xb3\xb6\x15\x0a\x99\xb6\xb4\x0f\x19\x65\xa9\x55\xb9\x53\x94\x53\xee\x3e\x8a\xf2\x76\x79\x44\x45\x39\x93\xb7\x03\xa4\xdf\xbb\x8c\x8f\xee\x36\x48\x22\x32\x5b\x07\x57\x31\x8a\x29\x63\x9d\xcb\x20\xa2\xdd\xaa\xeb\xf7\xc9\x90\xdc\x5c\x5f\xfa\xe8\xe2\xe9\x7c\x93\x44\xa9\x68\xa7\xd2\xce\x9b\x7d\x0f\x05\x23\xb1\x6e\x94\xf0\x88\x1a\x87\xae\x0d\xf7\x71\xd4\x6e\xce\xbf\xdc\x7b\xad\xec\x50\x6a\xe5\x92\xf8\xde\x42\x8a\xbd\x0c\x1e\xb3\x8e\x62\x76\xd8\xab\x8c\x62\x79\xb3\x5a\xf9\x6d\x3a\x19\xbb\x0f\xff\x52\x82\xcf\xcb\x5b\xac\x11\x5d\x19\x6a\x2d\x63\x9c\x6a\x21\xc3\x09\x37\xc8\x09\xd3\xb0\xb9\xe0\xe9\xb6\x56\x5b\xb1\x37\xd8\x69\xfb\x06\xbb\xcb\xd2\x8e\xdb\xeb\xe1\xe8\xd4\xc5\x61\x97\xd6\xfb\x32\x58\x34\xe4\x5b\xe2\x2e\xc7\x5a\x50\x0e\x02\x7e\x0c\x26\x34\xb9\x4f\x0e\x52\x33\x50\x36\xb7\xc7\x69\x5e\x33\x9a\x7e\x02\xde\x33\x69\x24\x55\xe6\x18\xf6\x08\xe3\x48\x0d\x4f\x62\xc3\xea\xde\xb8\x33\x4c\x4e\x19\x8f\xd3\x22\x01\xe5\xea\x09\x8a\x64\x34\x01\xb2\xd8\x56\x16\xfd\x28\x67\x98\x02\xa6\xc8\x4a\x84\x4d\x62\x75\x2b\x23\xb7\x13\x13\xfd\xdf\x94\xe0\x56\xe0\x3e\xca\xd1\x5f\x50\xc5\xe2\xb9\xde\xe6\xa0\xfa\x3d\xa4\xd0\xc1\x1c\xba\xc8\x33\xbb\xd9\x33\x21\x57\xb5\xbf\x2d\x11\xe4\x31\xa3\x4a\x83\xec\xdb\xef\x11\xce\xf9\x3e\x07\xad\xf8\xe4\x97\x46\x2e\xdc\x76\xa7\x7f\xb0\xe4\xa8\x47\x44\xc6\xb4\xf6\x75\x9f\xaa\xb2\xeb\x89\x67\xc3\x3b\x07\x1d\xe4\x2c\xd7\x0f\x26\x19\xc0\xde\x35\x2c\x91\x5c\xe1\xb2\x70\x0b\x49\x09\x0d\x0e\x4c\x24\x2c\x41\x02\x8f\x01\xc7\x9d\xab\xf8\x72\x9a\x93\xbd\xc4\xa8\xc6\x9d\x62\x51\xa4\x09\x22\x67\x4a\x96\x05\xe2\xdc\xcf\x05\x4d\xd9\x92\x41\x52\xaf\x67\x04\xca\xd6\x6b\x4d\xd5\x0e\x2d\x59\x16\xc8\x6c\xf1\x18\xca\x52\x80\x49\xd6\x4d\x99\x11\xe9\x94\x25\x3b\x1e\x48\xa2\x37\x27\xc0\xb5\xd9\xce\xd4\xe1\x8c\x84\xb7\x2c\x4d\x4b\x2c\xbf\x01\xb9\xa0\x9a\x65\x78\x3a\x7c\xe1\x71\x0d\x44\x14\x3a\x2f\x74\x29\xca\x7b\x21\xfd\xc9\x7c\xed\xbf\x91\x8f\x12\x96\xaf\xc9\x61\xd4\x3c\xc6\x33\x96\x81\xd2\x34\xcb\x0f\xeb\xf5\x35\x09\xcb\x87\x85\x97\x7f\xc4\x22\x43\xa7\x7a\xb0\x05\xeb\xd9\xae\x07\x97\x2f\xbe\x01\x2e\x5f\x36\xc6\x96\xb4\x48\x75\x48\x6b\x5b\x41\xf1\x01\x15\x2f\xa7\x9f\x50\xd4\x61\x6a\x17\xfb\xe8\x31\x4b\xba\x11\x12\xaf\xa0\x32\x1f\xb0\xa4\xbb\xeb\x5a\x89\x28\x16\x29\xfa\x42\xaa\x59\x9e\xc2\x5c\x2c\x2b\xc9\xaf\x1f\xa4\x77\x2c\x2b\xb2\x4a\xee\x6b\xc4\x80\xbb\x38\x2d\x14\xdb\xc0\xbc\x32\x61\x50\x5f\xc9\xb8\x1f\x38\xe9\x5e\x19\x26\x38\x5d\x17\x8c\xeb\x97\xa7\xb8\xe7\x3c\x05\xbe\x32\x45\xd3\xe3\x17\xf5\x31\xc6\x2b\x63\x75\x8d\xe7\x54\xe3\x89\xc2\x81\x66\x25\xe1\xb8\xa3\x94\x40\x93\x84\xd9\x4c\x71\xa4\x21\x53\x0f\x8f\x16\x4d\xbb\x1d\x77\x18\x8e\x39\x92\x8b\x42\xa3\x61\x7c\xe4\xf6\x78\x80\xdc\xd2\x6d\x67\x61\xcd\x2d\xfc\x9f\xc6\x96\xaf\x5a\x0a\x32\xf3\xf0\xc6\x7d\xde\xd2\x4f\x39\x54\xb5\x58\xc1\xd9\xe7\x02\xc2\x58\x33\x1b\x1d\x74\x24\x95\x26\x35\x60\xfc\xe1\xaa\x19\x9c\xb4\xf8\xac\x5c\xa8\x67\x64\x70\xda\x62\xb6\x3e\xbe\xa3\x8a\x24\x1d\xa0\xc6\x29\x2f\x9b\x8c\xff\xf4\x2d\xd3\x5e\x95\xe4\xf7\x31\x46\x27\x9d\x86\x6d\x06\x4d\x5f\x1b\x74\xf8\x5a\xbd\x72\xfd\x40\x65\x36\xfd\x6c\xd0\xe1\x67\xf9\xe3\xcb\xf7\x2a\xb0\x21\xd2\x54\xdc\xa2\x41\xec\xcd\x6c\x4a\xcc\x7e\x62\x0f\x41\x91\xbb\x3a\xbc\x45\xd7\x74\x03\x64\x01\xc0\x77\x89\x5c\x06\x7e\x77\x76\x03\x1b\x3d\x92\x40\x0e\x3c\x01\x1e\x9b\xbf\x1c\xd5\xed\x98\x66\xf8\x67\x2c\xb8\xd2\x8d\x2a\xc9\x73\xbc\x3f\x4f\xc2\x5d\x60\x8e\x35\x2a\xe4\x88\x4a\x49\xb7\x47\xe5\xad\x67\xcf\x42\xd4\xe9\x6a\x66\x2a\x5e\x29\xa7\x6f\x0e\x42\xbb\x26\x40\x8f\x29\xc3\xb8\x3a\x43\x38\x74\x5f\xdb\x66\x78\x7d\x3d\xfc\xab\xde\xb7\x79\x3b\x99\x5c\x5e\x0c\xc7\xf5\xce\xcd\x68\x3c\xbb\xf8\xe5\xe2\xba\xde\xbe\x19\xdf\x5c\x5e\x86\xb2\x83\xfd\xf2\xe1\xad\x99\xf5\x22\x7c\x9b\xbc\xfd\xed\xe2\xdd\x2c\xdc\x3d\xa6\xee\x34\xbb\x1e\x8d\x7f\x09\xf7\xce\xd7\x83\xba\xa0\xdd\xb2\x18\x58\x70\x46\x4e\x5e\xfc\x08\x6b\x2f\x85\xcc\xa8\xee\xf9\xfa\xd9\x07\x48\x18\x45\x36\xca\x2f\x17\x3c\x16\x89\xa9\xe1\xb2\xa5\x01\x19\xbc\x47\x20\x55\xd0\xb0\xf8\x4b\xb4\xf8\x69\x13\xbe\x9d\x76\xe0\x37\x9a\xa6\x93\xe5\x5e\x47\xc4\x2c\x6c\x9c\x89\xd3\xc1\x8f\xd0\x12\xe5\xdb\xc9\xb2\x47\x04\x07\xfc\xc1\x45\xc3\xdf\x4f\x4f\x8c\xf4\x2f\xca\x94\x66\x46\x57\xfb\xe4\xa4\xa1\x21\xf3\x28\x39\x88\xa6\xab\x7d\xb2\x51\xe4\xea\x7e\x94\xcf\x69\x06\x47\x11\x19\x71\x22\x0a\xe9\xbb\xc5\x42\xf6\x6c\x4f\x90\x29\xf2\xc4\x34\xbe\x9e\x22\xd8\x96\x34\xae\x20\xdd\xb2\x73\x6b\x8a\x2e\xbe\x89\xe5\xbb\x57\x1e\x2f\x17\xb6\x0c\xec\xfb\x66\x0e\x1c\x17\x0a\x24\x9a\xb6\x6c\xa0\x61\x56\xe7\x3a\x31\x4e\x95\x47\xca\x12\xbe\xb5\x50\x5d\x02\xfd\xe4\x8a\x38\xfc\x53\x28\x17\x3b\x74\xae\xdc\x97\x12\x2c\xd3\x15\x3a\x29\xe3\x09\xdb\xb0\xa4\xa0\x69\x68\xb6\xd4\xad\xa7\x3c\x98\x2e\xd3\x83\xba\x72\x87\xe8\xc1\x46\x47\x65\xfc\x8c\xc8\x8d\x02\xc2\x4c\xcd\xda\x3c\x3b\x20\x62\x03\x52\xb2\xa4\xac\x8b\x3b\x85\x78\x92\xab\x54\x2c\x68\x5a\xf1\x12\x7b\x1e\x4d\xeb\xde\x24\x14\x66\xbe\x79\x58\x50\xa6\x31\x6b\x30\x32\xe8\xb5\x14\xc5\x6a\x2d\x0a\x5d\x95\xae\xf6\x20\x60\x57\x22\xd6\x10\xa4\xd2\x70\x20\x6a\xed\xd3\x9f\xd2\xa8\xd6\x9a\x0e\xe1\x2b\x22\x78\xc8\x61\xba\xdb\x92\xdf\xe8\x28\x0c\xbe\xa7\xe8\x10\x6a\x97\x1d\xad\xdf\x3d\xcb\x6c\x9e\x12\xa9\x76\x91\x1f\xb3\x62\xd4\xe6\xbc\x76\x60\x87\x24\x81\x38\xa5\x92\x56\xdb\x3a\x65\x93\xcb\xb7\xcd\xe8\x86\xb2\xd4\x74\x90\x6d\xab\xd9\x24\x82\x36\x89\x45\x22\x35\x39\x23\x9b\xc6\x96\xef\x4c\x80\x2f\x85\x74\x7e\xd3\x22\xec\x8c\x59\x9e\x00\xa3\x23\x3c\x9d\x26\x8b\x32\x8e\x63\xce\x8f\x6b\xd5\xb8\x93\x95\xc2\x86\x72\x4d\x12\xd0\x94\xa5\xca\x04\x0b\xa0\xf1\xda\x51\x8d\x42\xd1\xab\xa3\x63\xff\x25\x94\x5f\x15\xe3\xab\xb4\xc5\x14\x09\x70\xae\x47\x32\x9a\xe7\x06\x38\x90\x43\x3c\x09\x87\xfe\x74\xbb\xa9\x4c\xfb\x2e\x54\xd4\xea\x4f\xfa\xcd\xed\x3b\x8c\x9f\x6b\x9d\xc3\x96\x63\xd9\x49\x7f\xd7\xa7\xdc\x93\x8f\x1f\xe0\x4e\x76\xa7\xba\x27\xd9\x67\x4e\xa8\x9d\xa0\x41\x64\x9e\xb6\x34\x6c\x22\x4b\xb5\x3f\xb8\xd8\x7a\x57\x0a\x9e\x10\x91\x69\x91\xdb\x02\x46\xf0\x43\x09\xc4\x94\xac\x08\x2d\x30\x4e\x69\xc7\x32\x46\x74\xf3\x64\xe7\x13\x6c\xc9\x13\x60\x7a\x0d\x12\x43\x38\x3a\x13\xb1\x7d\x37\x22\xa4\x79\x19\x41\x3e\x17\x20\xb7\xa4\x6c\x35\x3f\x35\xee\x36\x19\x16\x7a\x3d\x38\x52\xfe\x29\xd8\xd2\xc8\xf2\x04\x21\x14\x8b\x99\x36\xa5\xb1\x9c\x2a\x75\x2b\x24\xde\xfb\x39\x7e\xb6\xd6\x31\x05\xc3\x38\x06\xa5\xcc\xa3\xa2\xa7\x6d\xef\xab\x3d\x03\x6a\x35\x10\x0c\x34\xeb\x3e\x77\x11\xf9\x83\xa6\x2c\x21\x1b\x9a\x16\x4e\xf8\x43\x23\xfd\x61\xf9\xbc\xec\x90\xe6\xec\x77\xd8\x1e\xa2\x74\x87\x02\x95\x32\x38\x8c\x02\xb2\x45\xd8\x55\x01\xb2\xb3\xbf\xae\x2e\xe6\xa3\xf1\x1f\xc3\xcb\xd1\x79\x1d\xcd\x9a\x91\xb7\xc3\xe9\xe8\x5d\x1d\xd2\x9a\xef\xc3\xab\xd1\xfc\xf7\x8b\xbf\xea\xb8\xd6\x8c\x4c\x86\x37\xb3\x5f\x07\x01\xdb\x3a\x34\xda\xee\x92\x88\xb8\x16\x60\x9c\xa9\xba\x04\x34\xe6\xb1\xf2\x58\xc3\x55\xe5\x19\xf1\x8a\x34\xa3\x71\xb7\x2c\xa3\xf1\xfc\x7f\x6f\x2e\xae\x1b\xe0\x7c\x34\x9e\xff\x7a\x31\x3c\x37\x18\x7b\xf0\x6d\x6e\xd1\xf8\x55\xbf\x74\xde\x71\xbf\x79\x4a\xab\x78\xbf\x39\xec\x91\x43\xef\x36\xf8\x7b\xc5\x71\xac\x88\xd6\x73\xde\x89\x04\xaa\x62\xbe\x47\x06\x82\xa0\xef\x2f\x27\x7f\x76\x8b\x6a\x47\x3e\x5c\x5d\x8e\xde\x8d\x66\x75\x79\xcd\xd0\xd5\x70\x3a\xfd\x73\x72\x7d\x5e\x37\x9d\x19\x1a\x5e\xe1\xaa\xe1\x6c\x34\x19\xd7\x73\x13\x3b\xfa\xee\xdd\xc5\x74\x3a\x7f\x37\x39\xbf\xa8\xbe\x33\xfb\xb1\x2e\x6c\xbc\xd7\xa5\x2e\xd5\xb6\x59\xfb\x39\x02\xde\x00\xcd\x9d\xef\x05\x01\x2d\x39\x3c\x58\x44\x39\x42\xd0\x68\xc4\x8b\xca\x0d\xd8\xc4\x67\x56\x54\xe4\xc5\x8a\xd4\xd5\xfe\x3b\xd9\xb1\xf7\xff\xc3\x09\xb9\x8f\x91\x11\x82\xce\x60\xcd\xff\x28\xb7\xef\xe2\xdd\xfa\x82\x1d\x37\x27\xc2\x30\x58\x66\xc8\x2d\xfe\x71\xba\x90\xec\xdf\x56\x8f\xae\xb7\xba\xa8\xb5\x54\x99\x32\x44\x1c\x44\x99\xfe\x3a\xb9\xb9\x3c\x37\x95\xf2\x5a\x29\x1b\x13\x58\x7b\x71\xdd\x5c\x5f\xde\xc7\x5f\xdf\x0b\x6d\x61\x8b\xfd\x16\xe4\xab\xf9\x40\x8d\xc3\xb9\x6d\x3c\xbe\xdc\x21\x8d\x16\x9f\xe0\xbb\xa5\xa8\x22\xf1\xef\x93\x22\x5c\x6e\x5e\x84\xc6\x1d\xf7\x6d\xc9\x0c\xb7\x4e\xa2\x9f\x76\xd9\xa7\x04\x91\x2a\x16\x39\xa8\xb2\xcd\xbd\xc3\xcf\x1e\xe4\x18\x53\x4b\xca\x51\x34\x65\xf7\x06\xb4\xaa\xbc\xde\xfa\xbb\xf8\xaa\xfa\x10\xec\x07\x80\xac\xca\x76\x35\xa4\x75\xc9\x94\x56\x0e\x0a\xbb\xd2\x69\x0b\x58\x6b\x41\xe0\x0e\xe2\x42\xbb\xa7\xe6\x25\xae\xb2\xb6\xb0\xdc\x23\x06\x33\x4d\x3f\xba\x09\x9d\x82\x36\x2d\x9b\x21\x58\xc4\xcf\x74\xe5\x01\x2a\x4d\xd3\xc0\xc2\x13\x44\x75\x48\x8d\xd9\x17\xdc\xf6\x1d\x15\x25\xa9\x58\xb1\x98\xa6\x64\x38\x46\x27\xd5\xb7\x00\xbc\x02\xa1\xd5\xd3\xd0\x4b\x6d\xbe\xa2\x30\x98\xde\xa7\xb6\xe4\xc3\xcd\x74\x46\x62\x21\xed\x3b\x2c\x93\xae\xb7\x90\x25\xd2\xa9\x72\x8b\xfb\x74\x65\x5c\x6d\x90\x56\x35\x6d\x89\xd4\x46\x9d\x77\x9a\x69\xd3\x2c\xed\xc5\xe4\x2f\x2c\x97\x36\xe3\x6c\x13\x17\x9d\xe8\x4c\xe9\x4a\xb2\x6d\x9c\xd4\xc8\xa8\x82\xda\xfc\x21\xb0\xd6\x32\x06\x7a\x8f\x3e\x6e\x20\x6c\x0b\x38\x6f\x73\x08\xff\x6f\x01\x2e\xb3\x15\x4d\xa3\x1b\xcc\x99\xb3\x5c\xbb\x68\xff\x0d\x01\xff\x30\x1c\x06\x7c\xd1\x7e\x2c\x29\xaa\x77\xee\x57\xbf\xdf\x05\xda\xc3\x98\xc8\xd4\x5a\xef\xb1\x45\xe8\x69\x55\x2c\x52\x65\xbd\xd3\x30\xdf\xa3\xf3\x0a\xb1\x5d\xaa\x7f\xa8\xce\x3d\xa9\xfb\x54\x7f\x8f\xce\x3b\xd2\xbf\xa6\xde\x43\x22\x38\x97\x15\x9f\xab\x25\x85\x26\x98\xed\xf7\x02\xc5\x84\xc1\x47\x7d\x81\x82\x3b\xec\x08\x47\x9d\x11\x9e\xf2\x9d\x01\x3e\x3c\x4e\x11\xa1\xe2\xde\xef\x93\x0f\x34\x57\x65\xac\xa0\x25\x2e\xa3\xce\x9a\xd6\xdb\xba\xde\xa4\x32\x4d\x9e\xb8\xa2\xac\xf1\x05\x4f\xd0\x81\x2a\x1f\x4b\x9e\xb6\xcd\x65\x7f\xfe\x5c\xf5\xfd\xaf\x07\xff\x17\x00\x00\xff\xff\x1f\x08\x70\x4f\x1e\x36\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5b\x7b\x73\xdb\xb6\xb2\xff\xdf\x9f\x62\xc7\xf7\xcc\x38\x99\x51\xa8\xf8\x91\xb4\x8d\xaf\x7b\x47\x89\xdd\x56\xad\x23\xe5\x5a\x72\x33\xfd\x4b\x86\xc9\x95\x84\x13\x12\x60\x01\x50\xb6\x4e\x26\xdf\xfd\xce\x82\x00\x41\x52\x54\xec\xe8\x5c\x67\xce\xc8\x13\x5b\x24\xb0\xd8\xc7\x6f\x1f\x58\x20\x7a\x2d\x0c\xbb\x87\x33\xd8\xcf\x95\x34\xf2\x78\xff\x74\x6f\x2f\x67\xf1\x27\xb6\x40\x58\xa8\x3c\x8e\x16\xcc\xe0\x1d\x5b\x47\xf6\x75\x3c\x5b\xa0\x98\xe9\x3b\xb6\x58\xa0\x8a\x64\x6e\xb8\x14\xfa\x74\x6f\xaf\xfc\x0b\x16\x72\xe6\xe7\x9e\xc1\xfe\x82\x9b\x65\x71\x1b\xc5\x32\xeb\x13\xa5\x17\x18\x4b\xbd\xd6\x06\xdd\x57\x47\xb8\x5f\x12\x7e\xb1\x40\xf1\xc2\x11\xee\x3b\xc2\xc4\x0b\xcf\x72\xa9\x0c\xec\x2f\xa4\x5c\xa4\x58\x0e\xbe\x2d\xe6\x7d\x26\x1c\x4b\x34\xa8\xdf\x87\x9b\x49\x39\xf7\x06\xb8\x06\x06\x0a\x73\x85\x1a\x85\x61\x44\x09\xe4\x1c\xc6\x39\x8a\xc1\x87\x21\xac\x8e\x40\xe7\x18\xf3\x39\x8f\xed\xbb\x03\x0d\x6e\x2a\xc8\xdb\x7f\x62\x6c\xa2\xbd\x7e\x7f\xaf\xdf\x87\x09\xe2\x1b\x58\x1a\x93\xeb\x37\xfd\x7e\x4d\x96\xf1\x60\xd8\x77\xc4\x5e\x4c\xea\x94\xfa\xb7\xa9\xbc\xed\x1f\x47\x2f\xa3\x97\xfd\x15\x2a\x4d\xba\xe9\x1f\x45\x2f\xa3\x2c\xf9\x2f\x27\xd9\xd8\xae\xe0\x16\x98\x8e\xcf\xc7\xcf\xf8\xaa\x88\x79\xcc\x9e\xbf\x81\x44\xc6\x45\x86\xc2\xc0\x9c\x63\x9a\xe8\xbd\x0c\xb5\x26\x4d\x7a\xee\x3e\xef\x01\x00\x68\xa3\xb8\x58\x80\xa3\x07\x67\x70\x78\x6a\x9f\x0f\xc5\x5c\x02\xa7\x7f\xce\xe0\xe8\xb4\x3e\x74\x29\xb5\x81\x33\x38\x2e\x1f\x92\xaa\x6e\x99\xc6\x59\xce\xcc\xd2\x2a\xcb\x2c\x11\x62\x99\x65\x52\x40\xae\x70\xce\xef\x81\x5e\x41\xa1\x31\x01\x29\x80\xa5\x29\x90\xde\x50\x24\xb9\xe4\xc2\x68\x78\xc6\x31\x82\x3e\xcb\x79\x0f\xfa\xab\xc3\x1e\xa0\x89\xa3\xe7\x11\xbc\x5d\x03\x4b\x12\x62\xce\x2c\xb9\xee\xf9\xd5\xb8\x21\x12\xf2\x4e\xc3\x5a\x16\x60\x24\x28\xcc\xe4\x0a\xed\x20\x20\xdb\x92\x7d\xe6\x4a\x66\x96\x11\xbb\x74\x58\x8a\x0b\x9a\xa5\x2a\x1d\xcc\x79\x8a\x90\xb1\x4f\xe5\x2a\x98\x01\x32\xcd\x51\xf9\xb5\x2c\x75\x96\x44\x30\x92\x86\x56\x60\x06\x0a\x4d\x63\xeb\x22\x27\x12\x35\x08\x69\x20\x5e\x32\xb1\xa0\x61\x58\x09\x67\x45\xd7\xe5\x4c\xa6\x10\x16\x28\x50\x31\x83\x09\x70\x61\x07\x2a\xd4\x45\x6a\xb8\x58\xf8\x25\xeb\x9c\x45\x30\x9c\x13\xbf\x70\xc7\xf5\x92\x44\x2d\x34\x36\x96\xbe\xe3\x66\x09\x0a\x53\x66\xf8\x0a\xd3\x75\x8d\xbc\x27\x63\xd7\xef\xd1\x52\x7e\x81\xfa\x7c\x67\x9f\xac\xd0\x06\x6e\x49\x11\xa2\x60\x69\xba\x76\x2a\x4d\x4a\x35\x5a\x85\xdd\x94\xfe\x12\xb1\x9c\x47\x84\xe1\x1b\x27\x19\x13\x09\x71\xa8\x20\x96\x09\x3a\x0d\x24\x60\xa4\x5f\x4d\xa3\xb2\xb6\x41\x6b\xf3\xca\x2c\x35\x26\xa2\x3a\xb6\xaa\xc7\x70\x06\x27\x25\xc0\x50\x14\x99\xd7\xca\x24\x5e\x62\x86\x0e\xb9\xf4\x73\x3d\xfa\x63\x34\xfe\x38\x82\x33\x78\x79\x5a\x3d\xfc\x6d\x3a\xfd\x10\x80\xec\x9f\x4c\x02\x90\xe9\xf3\x71\x12\x30\x4c\x3f\x1f\x27\x93\xb0\xe6\x17\xfb\xaf\xc2\x1c\xeb\xca\x74\xab\x6b\xfb\x4b\xc3\x19\xbc\x3a\x6d\x8e\x73\x42\xc4\x52\xe8\xa2\x1c\xf1\xba\x7b\x44\xae\x64\x52\xc4\x76\xc4\x0f\x95\x1b\x59\x37\x85\x1f\xc9\x85\x28\xd4\xa8\x15\x26\x30\x97\x0a\x0e\x48\x4d\xfa\x20\x72\x94\xdc\x9b\x1f\x5b\xf3\x7e\xda\x9c\x97\xe0\x9c\x0b\x4e\x91\x44\x1f\xf4\xe0\x6e\xc9\xe3\x25\x30\x53\x3a\x8a\xe1\x19\x5a\x44\xb2\x54\x21\x4b\xd6\x9e\x18\xde\xe7\x52\x63\x02\xac\x34\x6d\x5c\x68\x23\x33\xfe\x2f\x76\x9b\x22\x3d\xb3\x11\x12\x5c\x24\xd1\x2d\x96\x7e\x2a\x59\xca\x58\xfe\xdf\xa5\x26\x7a\x70\x85\x3a\x97\x42\xe3\xcf\xa0\xdc\x5f\x24\xf3\xa1\x33\xd6\x04\xe3\x42\x71\xb3\x3e\x0f\x8c\x82\x76\xcf\x66\x35\xee\x69\xca\x61\x4b\x93\x7e\xee\x15\xfe\x5d\x70\x85\x36\xd0\xf9\xb9\x34\xfe\xa8\xa5\x9f\xc3\xe3\x4d\x05\x19\xb6\xa8\x69\x46\x21\xe8\x22\x2f\xc5\x37\x92\xfc\xa1\xa9\x0c\x4f\xaf\x5b\x27\x44\x97\xc7\xa8\xa3\x76\x18\x66\x49\x02\xb9\x92\x31\x6a\x1b\x39\xe4\x9c\xbe\x35\x1d\x84\xc7\xe8\x72\x05\x45\x28\x23\xeb\xb9\x65\xca\x16\xfe\x5d\x4b\xdd\x87\x0e\xbd\x17\xf7\x06\x95\x60\xe9\xb9\x8b\xf7\x36\x0b\x01\xba\xa7\xb3\x44\xc6\x56\xe7\x27\xa7\x7b\x5f\xca\xcc\x36\xce\x29\x48\x70\x29\x76\xc9\x6d\xd5\xe4\xa7\xcc\x6e\xd2\x2f\xb2\x4b\x7e\x0b\x1c\x7e\xee\x74\x3e\xb2\x7a\x08\x0f\xee\xa1\x2e\xb2\x8c\xa9\xf5\x46\xb2\x4b\x50\xc7\x8a\xdb\xf2\x21\xc4\x8b\xc7\x69\xfc\xa4\x41\xa8\x12\x69\xc6\x93\xef\x19\x3a\x14\xcb\xd0\xa0\xda\x16\x3f\x1e\x76\xd6\x9f\xba\xf9\x08\x51\xd0\x7b\xf3\xad\x94\x29\x24\x84\xa5\xd8\x32\xbc\x9b\xd3\x3a\x8c\x7a\x5e\x76\x81\xa8\x9f\xfb\x94\x08\xf5\x1a\x0a\x00\xf5\xf0\xab\x56\xff\xec\xad\x73\x73\x1e\x40\xe4\xe4\xd1\x4b\xa9\x4c\x03\x5c\x72\xee\xeb\x01\x1b\x26\x23\x3f\xf9\xd7\x5f\xde\x83\xab\xa9\x63\x26\x28\x2a\xd9\x42\x8a\x12\x83\xa2\xb0\x65\xf0\xde\xb4\xd4\x13\x6d\x07\xb0\x33\x08\x31\x65\xb3\x19\xbb\x81\xb2\x36\xb6\x69\xdf\x86\x5c\xa4\x72\x05\x09\x6e\x45\x6c\x0a\x85\x5b\x39\x1b\xce\x03\x11\x5e\x56\x40\xb9\x92\x2b\x9e\x60\xd2\x03\x6e\x20\x43\x26\x2c\x2d\x85\x24\xb4\x90\x10\x4b\x61\x28\x4e\x1b\xd9\x41\xb1\x24\x55\xe2\x8a\x05\x3f\xac\xd0\xdd\x11\xbf\x97\xc8\x92\x0e\x68\x1f\x3f\x3c\x13\xef\x59\x96\xa7\xd8\x9e\x19\x62\x24\x95\xbf\xbb\x60\x8f\xe6\x3d\x25\xee\xa8\x22\xdf\x25\x28\x5a\xbe\x1a\x15\xbf\xe1\x26\xc5\x8d\x38\xd8\x84\x4b\x33\x16\x1a\x54\x99\x9e\xc9\xf9\xcc\xe7\xac\x2a\x20\xbe\x93\xc2\xb0\xd8\x58\x03\xd3\xef\x2a\xf8\x5d\xf2\x18\x85\x46\x48\xdd\xef\x2a\xf0\x39\x92\x4e\xbc\x32\xdc\x39\xd5\x3b\x62\xbb\x68\xdf\xf3\xf1\x84\x06\x70\x22\xee\x62\x03\xcf\x5d\xc3\x0c\x82\x65\x9b\x56\x28\x54\xba\xa1\x7d\xcc\x18\xa7\xa7\xc7\x95\xa2\x9c\x72\x77\x51\x94\xb7\xcb\x13\x2a\xca\x99\x3c\x28\xca\xab\xc1\xaf\x5d\xc5\x47\x97\x0d\x92\x08\xa6\xcb\x00\x15\xab\x98\x2a\xd6\xb9\x1d\x44\xb4\x5d\x75\xfd\x3e\x0c\xe0\xfa\xea\xd2\x47\x17\x4f\xe7\xab\x24\x2a\x45\x3b\x95\x76\x66\xf6\x1d\x14\x4c\xc4\xba\xab\x84\x27\xd4\x38\x76\x2d\xb8\x0b\x50\xbb\x39\xff\xbc\x3d\x4e\x1c\x9e\x7e\x4d\xa9\xb5\x24\xf1\x8d\x30\x75\x39\xe1\x09\x75\x56\xa6\x9b\x5d\x94\xe4\x78\x2b\xb5\xf2\xfb\x64\x3c\x72\x0f\xfe\xa9\xa5\x98\x55\x59\xac\xa9\x99\x84\x53\x74\xcd\xb8\x60\x46\xaa\xe0\xe1\xb6\x72\xa2\x6d\xd8\x4c\x8a\x74\x0d\x67\x1b\x19\xec\x64\x33\x83\xdd\x67\x69\x47\xf6\x7a\x7c\x75\xea\xe2\xb0\xdb\xd6\xfb\x36\x58\x34\x10\x6b\x70\xc9\xb1\x11\x94\x83\x80\x37\xc1\x84\x76\xef\x93\xa3\x32\x1c\x75\xd9\x2b\xa0\x61\x5e\x33\x86\x7d\x42\xd1\xa3\x9d\x13\xed\x92\xc8\x0d\x7b\xc0\x05\x51\x23\xf7\x6c\x59\xdd\x1b\x77\x4a\x9b\x53\x2e\xe2\xb4\x48\x50\xbb\x7e\x82\x86\x8c\x25\x08\xb7\xeb\xda\xa4\xef\x05\x86\x09\xd2\x16\x59\xcb\xb0\x48\xac\xef\x54\xe4\x56\xe2\xb2\xff\xbb\x96\xa2\x14\xb8\x4f\x31\xa0\x7f\xcb\x34\x8f\x67\x66\x9d\xa3\xee\xf7\x88\x42\x07\x73\x04\x91\x17\x25\x44\x5e\x48\xb5\x68\x7c\x2f\x89\x50\x01\x90\x31\x6d\x50\xf5\xcb\x71\x11\x8d\xf9\x36\x80\xd6\x30\xf9\xb9\xb5\x17\xde\x84\xd3\x3f\x78\x72\xd0\x03\x99\x71\x43\xa5\xbb\xb5\x65\x5d\xd9\x0d\x9c\x1d\x9e\x36\xc9\x1d\x75\x90\x2b\xb9\x7e\x34\x49\xe7\x08\x36\x19\xcc\x89\x5c\xe1\x76\xe1\x65\x49\x0a\x2c\x00\x18\x14\xce\x51\xa1\x88\x91\xde\x3b\xa8\xf8\x76\x9a\x93\xbd\xaa\x51\x2d\x9c\x62\x59\xa4\x09\x55\xce\x0c\xe6\x05\xd5\xb9\x7f\x17\x2c\xe5\x73\x8e\x49\xb3\x9f\x11\x28\x97\xa8\xb5\x5d\x3b\xb2\x64\xd5\x20\x2b\x9b\xc7\x98\x78\xf2\x76\xb3\x4e\xeb\x5a\x3a\x55\xcb\x4e\x04\x92\x84\xe6\x04\x85\xb1\xcb\xd9\x3e\x9c\x95\xf0\x8e\xa7\x69\x55\xcb\xaf\x50\xdd\x32\xc3\x33\xe0\xc2\x13\xa6\x71\xb2\x30\x79\x61\x2a\x51\x7e\x91\xca\x7b\xe6\x1b\xff\x0c\x6e\x14\xce\xdf\xc0\x7e\xd4\x76\xe3\x29\xcf\x50\x1b\x96\xe5\xfb\x37\x8d\x7c\xa7\x70\xfe\xb8\xf0\xf2\x0f\xea\xe0\xa2\x30\x8f\xb6\xe0\xc9\x69\x7d\x1d\x5f\x5c\xbe\x3a\xdd\x9e\x34\x5e\xb7\xde\xcd\x59\x91\x9a\xb0\xad\xdd\x08\x8a\x8f\xe8\x78\x39\xfd\x84\xa6\x0e\xd7\xdb\xd8\x27\xc4\xcc\xd9\x4a\x2a\x4a\x41\x7e\xe2\x41\xa9\x90\xee\xbe\x56\x22\x0b\xea\x82\x65\xd4\xaf\xcd\x53\x9c\xc9\x79\x6d\xf3\xeb\x5f\xb2\x7b\x9e\x15\x59\xad\x61\x65\xc5\xc0\xfb\x38\x2d\x34\x5f\xe1\xac\x36\xe0\xa8\x39\x93\x0b\xff\xe2\xb8\x7b\x66\x18\xe0\x74\x5d\x70\x61\x5e\x9f\xd0\x9a\xb3\x14\xc5\xc2\x2c\xe9\xdd\xab\xe6\x3b\x2e\x6a\xef\x9a\x1a\xcf\x99\x21\x8f\xa2\x49\xed\x4e\xc2\x61\x47\x2b\x81\x7a\xf1\x94\x96\x59\x3a\x34\x98\xe9\x47\x03\xe3\xb0\x6d\xb7\xc3\x0e\xc3\x71\x47\xf2\xb6\x30\x64\x18\x47\xec\x85\xaf\x07\x80\x0e\x8c\xda\x71\x8f\x1a\x6b\x6e\xe2\xff\xb4\x96\xfc\xa9\xa9\x04\x76\x3f\xb3\xe3\x28\xe3\xbe\x6c\xbe\xe2\x22\xbc\xaa\x5b\xac\x10\xfc\xef\x02\xc3\xbb\xa3\x76\xcc\xeb\xd8\x54\xda\xad\x01\x17\x8f\x57\xcd\xd1\x71\x93\x19\x76\x3f\xab\x25\xd4\x33\x38\x6a\x19\x9a\x8b\xd6\xfb\x57\xad\xfe\x8a\xb3\xac\x72\x05\x35\x31\xfe\xba\xcd\xf8\x0f\x5f\x33\xed\x87\x8a\xfc\x2e\xc6\xe8\xa4\xd3\xb2\xcd\x51\x1b\x6b\x47\x3f\x3e\xd4\xb9\x7e\xa4\x32\xdb\x38\x3b\xea\xc0\x59\xfe\xf4\xf2\x39\xec\x51\x70\x95\x74\x4e\x45\xae\x66\x19\xd2\xb6\xdf\xee\x07\xf6\xa8\x28\x72\xa9\xc3\x91\x82\x25\x5b\x21\xdc\x22\x8a\x6d\xf8\xa9\x02\xbf\xf3\xdd\xc0\x46\x8f\x3a\x6f\x28\x12\x14\xb1\xfd\xe6\xa8\xae\x47\x2c\xa3\xaf\xd4\x57\x34\x4d\x46\x8f\x5f\x52\xfe\x3c\x76\x10\xa4\x7e\x8e\xc5\x3a\x17\x70\xc0\x94\x62\xeb\x83\x2a\xeb\x95\xbe\x10\x75\x42\xcd\x0e\xa5\x94\x72\x72\xba\x17\x8e\x6b\x42\xe9\x31\xe1\x94\xb0\xa6\x54\x0e\x3d\x74\x6c\x33\xb8\xba\x1a\xfc\x15\x4a\x66\xfa\xbc\x1d\x8f\x2f\x2f\x06\xa3\x50\x29\xd3\xcf\x70\x34\xbd\xf8\xf5\xe2\x2a\xe4\x31\xfa\x8c\xae\x2f\x2f\x43\xdb\x81\x3e\xa3\xeb\xf7\x6f\xed\x28\xe7\x24\xf4\x33\x7e\xfb\xfb\xc5\xbb\x69\xc8\x3d\xf4\x99\x4c\xaf\x86\xa3\x5f\x43\xde\xf9\xb2\xd7\x14\xb4\x5b\x16\x5b\x16\x9c\xc1\xf1\xab\xef\x61\xed\xb9\x54\x19\x33\x3d\xdf\x3f\x7b\x8f\x09\x67\xc4\x46\xf5\xe4\x42\xc4\x92\x4e\x4a\x7b\xc0\xe7\xb6\xc8\x10\x3d\xc0\x54\x63\xcb\xe2\xaf\xc9\xe2\x27\x87\x2d\x3f\x39\xe9\xa8\xdf\x58\x9a\x8e\xe7\x3b\xb9\x88\x9d\xd8\xf2\x89\x93\xa3\xef\xa1\x25\x26\xd6\xe3\x79\x0f\xa4\x40\xfa\x25\x64\x0b\xef\x27\xc7\x56\xfa\x57\xd5\x96\x66\xca\x16\xbb\xec\x49\xc3\x81\xcc\x93\xec\x41\x0c\x5b\xec\xb2\x1b\x25\xae\x1e\xae\xf2\xa9\xa7\x72\x10\xc1\x50\x00\x1d\xd9\xba\xd3\x62\xa9\x08\x32\x54\xa8\x6a\x78\x66\x0f\xbe\x9e\x53\xb1\xad\x58\x5c\xab\x74\xab\x93\x5b\x22\x01\x72\xde\x3c\xf5\xf2\xf5\x72\x41\x6d\x5e\x53\x9d\x9b\xb9\xe2\xb8\xd0\xa8\x28\xdc\x79\x5a\x76\x57\xe7\x4e\x62\x9c\x2a\x0f\x74\xd9\xcd\xb9\x2b\x4b\x75\x85\xec\x93\x6b\xe2\x88\x4f\xa1\x5d\xec\xaa\x73\xed\x9e\x78\x7a\x44\x86\x0b\xe0\x22\xe1\x2b\x9e\x14\x2c\x0d\x87\x2d\x4d\xeb\xb9\x13\xb4\x7e\xdf\xcf\x6c\x29\x77\x40\x08\x26\x3e\x0e\x3c\x02\xd7\x11\x5c\x6b\xa4\x6e\xb6\x91\xe5\xb5\x03\x90\x2b\x54\x8a\x27\x55\x5f\xdc\x29\xc4\x93\x5c\xa4\xf2\x96\xa5\x35\x94\x90\x72\x51\xd0\xf5\x87\xf2\x1a\x80\x1d\x6f\x64\x10\x87\xde\x5b\x19\xcc\x52\xc9\x62\xb1\x94\x85\xa9\x4b\xe7\x0e\x9f\xc1\xee\x2e\x9a\x90\x3e\x3c\xdd\x22\x48\xed\xc0\x01\xf4\xd2\x6f\x7f\x2a\xa3\x96\x2e\xe4\x2a\x7c\x4d\x97\x32\x9c\xd3\x6d\x3b\x96\x8c\xb6\x57\xf1\x47\xdf\xd2\x74\x08\xbd\xcb\x8e\xa3\xdf\x1d\x9c\xb1\xf4\x3e\x77\x7e\x54\x3f\x45\x7e\x42\x07\xd5\x9b\x9c\x37\x1c\x76\x00\x09\xc6\x29\x73\xf8\x73\x20\xa9\x0e\xb9\xfc\xb1\x19\x5b\x31\x9e\xda\x13\x64\xeb\x71\x04\x0f\x7f\x27\x84\x38\x6e\xc8\x49\x7d\x51\xae\xc3\x3d\x13\x14\x73\xa9\x62\xec\x26\xec\x8c\x59\x79\x80\x26\x72\xe4\x9d\x76\x17\x45\x66\xa5\x03\x1e\xe9\x8f\x6a\x9c\x67\xa5\xb8\x62\x82\x0e\xa4\x0c\xe3\xa9\xb6\xc1\x02\x59\xbc\x74\x54\xa3\x2a\xcc\x74\x98\x2d\x84\x9d\x01\xd0\x09\x77\xba\xc1\x14\x84\x72\xae\x07\x19\xcb\x73\xf2\x7d\x06\xfb\xe4\x09\xfb\xde\xbb\xdd\x50\x6e\xdc\x96\x5f\x47\x1b\xe7\x93\x7e\x71\x9b\x8d\xf1\xe7\xb0\x8c\xad\x19\x5a\xc0\x2a\x07\xfd\xbb\x98\x72\x57\x3e\xbe\x03\x9c\xca\x95\x9a\x48\xa2\x78\x53\x26\xc4\xa0\x41\x0a\x3b\x6c\x43\xc3\x36\xb2\xd4\xcf\x07\x6f\xd7\x1e\x4a\x01\x09\x11\x4c\xe8\x7e\x03\x35\x30\xdc\xbc\xb2\x24\xb5\x2d\x2b\x60\x05\xc5\x29\xe3\x58\xa6\x88\x4e\xdd\x72\xf8\x84\x6b\x78\x86\x9c\x8e\xf1\x28\x84\x13\x98\xa0\x3c\x77\x03\xa9\x28\x5f\x33\xf8\xbb\x40\xb5\x86\xea\xa8\xf9\xb9\x4d\x06\xe3\x41\x61\x96\x47\x07\xda\x5f\x05\x9b\x5b\x59\x9e\x51\x39\xc8\x63\x6e\x6c\x6b\x2c\x67\x5a\xdf\x49\x95\xf4\x80\xe5\xf4\xd8\xae\x6c\x67\xb3\x98\xee\x4b\xd8\x4b\x45\xcf\x37\xd1\xe7\xac\xb2\xe5\x00\xc1\x96\x66\xdd\x7e\x17\xc1\x9f\x2c\xe5\x09\xac\x58\x5a\x38\xe1\xf7\xad\xf4\xfb\xd5\xf5\xb2\x7d\x96\xf3\x3f\x70\xbd\x4f\xd2\xed\x4b\x52\xca\xd1\x7e\x14\x2a\x5b\x2a\xbb\x1c\xe2\xe9\x67\xfa\xd7\x87\x8b\xd9\x70\xf4\xe7\xe0\x72\x78\xde\xac\x66\xed\x9b\xb7\x83\xc9\xf0\x5d\xb3\xa4\xb5\xcf\x07\x1f\x86\xb3\x3f\x2e\xfe\x6a\xd6\xb5\xf6\xcd\x78\x70\x3d\xfd\xed\x28\xd4\xb6\x5f\xf6\xba\x85\x4c\x65\xdc\x08\x30\xce\x54\x5d\x02\x5a\xf3\x94\xf2\x94\x86\xab\xcb\x33\xf4\x7d\x7d\xfa\x0c\x47\xdd\xb2\x0c\x47\xb3\xff\xbd\xbe\xb8\x6a\x15\xe7\xc3\xd1\xec\xb7\x8b\xc1\xf9\xc5\x55\x10\x63\x1b\xb7\x64\xfc\x3a\x2e\x1d\x3a\x1e\x36\x8f\x27\xb7\xef\x71\xb3\xdf\x83\x7d\x0f\x1b\xfa\xbb\x06\x9c\x52\xc4\x12\x39\xef\x64\x82\x75\x31\x7f\x21\x06\x82\xa0\xbf\x5c\x8e\x3f\x76\x8b\x5a\xbe\x79\xff\xe1\x72\xf8\x6e\x38\x6d\xca\x6b\x5f\x7d\x18\x4c\x26\x1f\xc7\x57\xe7\x4d\xd3\xd9\x57\x83\x0f\x34\x6b\x30\x1d\x8e\x47\xcd\xbd\x49\xf9\xf6\xdd\xbb\x8b\xc9\x64\xf6\x6e\x7c\x7e\x11\xf6\x29\x5f\xf6\xbe\x2f\x84\x2d\x7a\xdd\xd6\xc5\x49\x46\x71\xa6\xe3\x3a\x02\x65\x80\xf6\xca\x0f\x16\x01\x1b\x72\xf8\x62\x91\xe4\x08\x41\xa3\x15\x2f\xdc\x65\x2b\x82\x47\xbb\x3e\x2b\xe1\x40\xbc\x94\x5e\xd9\x75\xfc\x77\xbc\x65\xed\xff\x07\x0f\x79\x88\x91\x21\x15\x9d\xc1\x9a\xff\x51\xb0\xef\xe2\xbd\x0c\x67\x25\xef\xd6\x23\xac\x5f\x56\x3b\xe4\x0d\xfe\x69\xb8\x54\xfc\x5f\xd6\xbd\xfc\xd9\xaa\xcf\x30\x44\xd0\x6e\x1b\x88\x88\x2b\x51\x26\xbf\x8d\xaf\x2f\xcf\xa9\x9e\x69\xb6\xb2\x69\x03\x4b\x76\x60\x44\xe4\x21\xfe\xfa\x5e\x68\x9b\x09\xdc\xb3\x20\x5f\x03\x03\x0d\x0e\x67\xe5\xc1\xe3\xeb\x2d\xd2\x18\xf9\x09\xbf\x59\x8a\x7a\x25\xfe\x6d\x52\x78\x73\xf5\xfc\x83\x9a\xcd\x1e\x21\x99\xe5\xd6\x49\xf4\xc3\x16\x89\x42\x11\xa9\x63\x49\x5d\x09\x7f\xcc\xbd\x05\x67\x8e\xca\x36\x96\xfd\x3d\x1c\x4b\xca\x51\x3c\x83\x1f\x37\x4a\x2b\xc7\x04\x6d\x43\xff\xdd\xfa\xaa\x46\xea\x7b\xd4\xec\xb5\xe5\x1a\x95\xd6\x25\xd7\x46\xbb\x52\xd8\xb5\x4e\x5b\xba\xb3\x35\x33\xde\x63\x5c\x18\x77\xd5\xbc\xaa\xab\x4a\x5b\x94\xdc\x43\xcc\xec\x09\xa7\xed\xe4\xf9\x93\x82\xb6\x1d\xb4\xdb\x21\x94\xb7\xc0\xb9\xa9\x5d\x40\xa5\xcb\xf1\x15\x0b\xcf\xa8\xaa\x23\x6a\x5c\xf7\xc2\x3d\x2a\x06\xa9\x5c\xf0\x98\xa5\x30\x18\x91\xab\x99\x3b\x6a\x18\x86\x12\x5a\x3f\xf7\x2a\xac\x22\x70\x85\x74\x5b\xd3\xfb\xad\x2d\xbc\xbf\x9e\x4c\x21\x96\xaa\xbc\x87\x65\x2f\xbd\x6e\x54\x96\x44\xa7\xce\x2d\xad\x53\x59\xaf\xb6\x0b\xd8\x2c\xd2\xea\xa6\xad\x2a\xb5\x61\x67\x4e\x23\x10\x51\xc1\x46\x89\xc9\x27\x2c\xb7\x6d\xa6\xd1\x36\x2e\x3a\xd1\xb9\x36\xb5\xcd\xb6\x05\xa9\x4d\x04\x3a\xa8\xcd\x3b\x41\x69\x2d\x6b\x20\x3a\x3b\x93\xa4\xc1\x8d\x95\x29\x19\x86\xff\x5b\x40\xd3\xca\x8e\xa6\xd5\x0d\x5d\x01\xce\x72\xe3\xa2\xfd\x57\x04\xfc\xd3\x72\x18\xea\x8b\x76\x97\xb4\xe4\xb3\xca\xb9\x5f\xfc\x7a\x17\x64\x0f\x62\xbf\xec\xb5\x3e\x60\x8b\x70\xa6\x55\xb3\x48\x9d\x75\xcf\x59\xc3\x30\xdf\xa2\xf3\x1a\xb1\x6d\xaa\x7f\xac\xce\x3d\xa9\x87\x54\xff\x80\xce\x3b\xb6\x7f\x6d\xbd\x87\x8d\xe0\xcc\xf1\x43\x91\xa9\xb9\x29\x24\x9e\xf5\x0e\xc1\xca\x47\xc3\xa7\x8c\x4d\x76\x85\x2d\xe1\xa8\x33\xc2\x33\xb1\x35\xc0\x57\x18\xb5\x44\x83\xdf\xbd\x67\xb9\xae\x62\x05\xab\xea\x32\xe6\xac\x69\xe4\xb6\x3b\xa9\xdc\xc0\x33\xd7\x94\xb5\x58\xf0\x04\x5d\x51\xe5\x63\xc9\xf3\x4d\x73\x95\xbf\x7f\x76\x2b\x9c\xc1\xe1\xe9\xde\x97\xbd\xff\x1b\x00\x1f\x08\x70\x4f\x1e\x36\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/api.proto"].(os.FileInfo),
+		fs["/provider"].(os.FileInfo),
 		fs["/third_party"].(os.FileInfo),
 	}
+	fs["/provider"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/provider/provider.proto"].(os.FileInfo),
+	}
 	fs["/third_party"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/third_party/README"].(os.FileInfo),
 		fs["/third_party/google"].(os.FileInfo),
@@ -26,9 +26,9 @@ var Swagger = func() http.FileSystem {
 		"/api.swagger.json": &vfsgen۰CompressedFileInfo{
 			name:             "api.swagger.json",
 			modTime:          time.Time{},
-			uncompressedSize: 19135,
+			uncompressedSize: 33640,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x5f\x6f\xdb\x38\x12\x7f\xcf\xa7\x18\xe8\x0e\xb8\x3b\xa0\x4d\xba\xbd\x97\x22\x2f\x77\x81\x93\xed\x1a\xdb\x38\x41\x9c\xb6\x0f\xd7\xc2\xa0\xa5\xb1\xcc\x8d\x44\x6a\x49\xca\x39\xe3\xd0\xef\x7e\x18\x4a\xb2\xa8\x7f\xb6\x6c\x37\x89\xbd\xdd\xbe\xb4\x95\xc8\xe1\xfc\xf9\x71\x38\x33\x1c\xf9\x7f\x27\x00\x9e\x7e\x64\x61\x88\xca\x3b\x07\xef\xed\xe9\x1b\xef\x15\x3d\xe3\x62\x26\xbd\x73\xa0\xf7\x00\x9e\xe1\x26\x42\x7a\x3f\x88\x52\x6d\x50\xc1\x35\x13\x2c\x44\x05\x17\xb7\x43\x18\x8f\x7f\x81\x44\xc9\x05\x0f\x50\xd9\xc9\x00\xde\x02\x95\xe6\x52\xd0\x94\xc5\x9b\xd3\x9f\x72\xaa\x00\x9e\x2f\x85\x61\xbe\x59\x91\x06\xf0\x04\x8b\x2d\xed\x31\x8b\x75\x2a\x42\x18\x8c\x06\xf7\xf9\x70\x00\x2f\x55\x11\xbd\x9c\x1b\x93\xe8\xf3\xb3\xb3\x90\x9b\x79\x3a\x3d\xf5\x65\x7c\xa6\xb3\xf1\xaf\x7d\xe1\x9b\x33\x3f\x66\xaf\xb5\x9e\x97\xf3\x30\x66\xdc\xce\xcc\x87\x9d\xfa\x91\x4c\x03\xc1\x0c\x5f\xe0\xbf\x43\x7a\x49\x44\x3c\x3b\xfc\xdb\x09\xc0\x37\x2b\xb6\xf6\xe7\x18\xa3\xf6\xce\xe1\x3f\x19\xbf\x76\xdd\x82\x79\xfa\x0f\xcd\xf8\x6a\xc7\xfa\x52\xe8\xb4\x32\x98\x25\x49\xc4\x7d\x66\xb8\x14\x67\xbf\x69\x29\xca\xb1\x89\x92\x41\xea\xf7\x1c\xcb\xcc\x5c\x97\xba\x3f\x63\x09\x3f\x5b\xfc\x74\xe6\x67\xaa\x77\x35\x17\xa2\xab\x48\x62\x3f\x8d\x63\xa6\x96\x24\xf6\x67\x1e\x45\xa0\xd0\x28\x8e\x0b\x04\x33\x47\xd0\x86\x99\x54\x83\x9c\x01\x83\x9c\x18\x30\x11\x00\x37\x1a\x1e\xd2\x29\xfa\x52\xcc\x78\x08\x33\xa9\xc0\x97\x42\xa0\x6f\xf8\x82\x9b\xe5\x4a\xa5\x00\x9e\x4c\x50\x59\x96\x87\x01\xad\xf1\x1e\x4d\x0e\x08\x77\x90\x42\x9d\x48\xa1\x51\x57\x78\x03\xf0\xde\xbe\x79\x53\x7b\x04\xe0\x05\xa8\x7d\xc5\x13\x93\xa3\xe5\x02\x74\xea\xfb\xa8\xf5\x2c\x25\xf6\x33\x4a\xa7\x0e\xf9\x4c\x4e\x32\x13\x6b\x10\x03\xf0\xfe\xaa\x70\x46\x74\xfe\x72\x16\xe0\x8c\x0b\x4e\x74\x35\xa9\xb0\xe4\xf5\x0e\x93\x68\xe9\x55\x26\x7e\x3b\x69\xfb\xf7\x37\x47\xa8\x84\x29\x16\xa3\x41\x55\x9a\x30\xfb\x53\x13\xa7\x00\xb3\xfd\xfb\xd5\x5a\x51\x47\x2c\x46\xb2\x06\xd9\xa6\xb0\x87\x91\x30\x45\x88\xa4\x7c\xc0\x00\xd2\xa4\x21\x38\xb7\x33\x7f\x4f\x51\x2d\xeb\xaf\x14\xfe\x9e\x72\x85\x64\x98\x19\x8b\x34\xd6\x5e\x9b\x65\x62\x19\xd3\x46\x71\x11\x7a\xad\x02\x7f\x75\x04\x36\x2c\xac\x8b\x5a\xec\xfe\x72\xf2\xd7\x93\x9a\xa6\xbc\x00\x23\x34\xb8\x1e\x95\xd9\x98\x12\x85\x6b\x10\x76\x69\x87\x1e\x0d\xc8\x2a\xec\x1e\x0a\xce\x3e\xcf\x99\x01\xae\x5d\x9c\xfd\x4d\x03\x4d\x24\xb8\x05\xa8\x8d\x92\xcb\xe3\x43\x5a\x22\xf5\x06\xef\x67\x0f\x25\x3a\x86\x7a\x41\x6d\xa0\x90\x1d\x11\xd4\x2a\xec\x3e\x0b\xd4\xa6\x32\x68\x40\x21\x43\x49\xdb\x1b\x07\x24\x46\xa5\x75\x8c\xec\x2b\xf0\xb5\x0e\xfb\x88\xbb\x3b\xcc\x4e\x1c\x6d\xd5\x8f\xe0\xb3\x88\x57\xa0\xb7\xc5\x39\xcc\x80\xe6\x92\xd7\xcf\x69\xe9\x5e\xc7\xeb\x07\x5a\xf0\xe0\x21\x59\xe5\x77\x27\x4c\x7e\x47\x23\x09\x19\xa0\xce\x62\x9e\xad\x6c\x15\xa2\x59\x1d\xc7\x96\x46\x11\x38\x51\x60\xc4\x4a\xa7\x82\x41\x0f\xa7\x52\xaa\x64\x44\xa4\xc6\x19\x3b\x47\x64\x49\x87\xed\x67\x71\x32\xb9\x4a\x47\xdb\x1d\x6b\xc2\x09\xa5\x06\x65\x28\x65\x4f\xaf\xa3\x39\xd9\xd6\xa2\x39\x91\x32\x72\x61\xbc\x55\x9c\x45\x30\x06\xa2\x00\x33\x25\xe3\xad\x41\x9c\x45\x35\x84\x84\x5b\xe2\xe2\xe0\xd1\x5b\xe5\xf7\x80\x61\xeb\x44\xfd\xb9\xad\x56\x96\xd2\xcf\x03\xdb\x57\x3d\x22\x4c\x19\xe0\x84\x58\x9a\xd0\x13\xbd\xcd\xae\x5c\xc1\xce\xce\x2c\xc5\x7c\x02\xd9\x98\x52\xac\x19\x9d\x18\x8c\xeb\xd0\xdc\xa0\x91\x9a\x4e\x6c\x9a\x1f\x45\x94\x0b\x4b\xf1\xb3\x54\x31\xa3\xd3\xc3\x8b\xd3\xc8\xf0\x17\x8c\x6c\x59\x10\x38\xda\x35\x72\xeb\x2d\x7d\x11\x04\xc7\xb3\x9f\x1d\x66\x7f\x84\x40\xd7\x11\xf7\xc9\xc3\xdc\x12\x73\xe9\x06\xc8\x69\x9f\x45\x59\x1d\x49\xa4\xf1\x14\x15\x1d\xb7\x31\xf3\xe7\x5c\xa0\x06\x2e\xaa\xa7\xcc\x0e\x91\xd2\x98\xe8\x1f\x0f\x26\x2b\xec\xfe\x08\xa8\xac\x08\xfc\xb2\xe9\x57\x9a\x84\x8a\x05\xb8\x6d\x06\x96\x2a\x01\xf9\x54\x90\x16\x21\x45\x4c\x1f\xf2\x05\x8a\x7e\xd1\xfc\xc7\x8c\x40\xce\xf9\x50\xcc\xec\x99\x40\x68\x3b\x78\xc8\xae\xe3\xfe\x80\x6b\x55\x60\xe8\xd9\x23\x02\x53\x08\xbe\x14\x9a\x07\x48\xa7\xb6\xb5\x5d\x6e\xcf\xa3\x89\xf1\xfb\xfb\x5b\x66\x0c\xc6\x89\xa1\xc3\xbd\x00\x6d\x9f\x2a\x56\xd5\xc2\x87\x0f\xca\x2a\xbf\x3f\x82\x23\xad\x4a\xfc\x32\x9e\xb4\xbc\x9e\xdb\xda\x83\xe6\x53\x81\x97\xce\x03\xd8\x54\xa6\x06\x58\xc2\x41\xa3\x5a\x6c\x72\xa1\x9f\x32\x0a\xc7\xe6\x3b\x73\xb6\x9f\xad\xac\xb5\xba\x93\x74\x58\x29\x6f\x05\xbb\x2b\x34\xd7\x59\x50\x36\x6e\x54\xbe\x0a\xcf\x26\xa7\xbf\xa1\x5f\x96\x13\xbd\x44\x91\x8d\x0c\xaf\xa9\xdc\x7b\x78\xa7\x89\x76\x83\x10\xb4\xb8\xc9\x57\x95\x77\xc5\x6d\x31\x4d\x87\x87\x77\x45\x05\xcd\x6b\xd5\xcd\xc3\x3b\xfd\xa9\x01\xc7\xfe\x6b\xfc\x9a\x4e\x51\x09\x34\xa8\xa1\x20\xd3\xba\x4c\xcc\x98\x1e\x2f\xb5\xc1\xd8\x02\x71\xfb\x85\xae\x19\x1b\x83\x95\x48\x5b\x32\x13\x1e\x74\xaf\xf4\x8b\xd4\x26\xf7\x37\xfb\xac\x34\x2f\xc8\x74\x2e\xb4\xa7\x85\xec\x52\x36\x70\x5f\x67\x22\x5a\x68\x78\x7b\x11\x04\x6a\xf7\x45\x86\xb7\x40\x04\x50\xbb\x6b\x34\x8e\xc5\xd5\x9c\xfb\xda\x85\x75\x9e\x6a\x78\x15\x77\x56\xdb\x95\x2d\x8e\x65\x0f\xf8\x87\xdc\x4c\x9a\x7e\xb2\xbf\xd4\x24\x81\x61\x21\x48\x61\xa3\x99\x90\x1b\x50\x98\x48\xcd\x8d\x54\xcb\x76\x3d\xd3\x92\xbe\x8c\x63\x6e\x76\x5e\x71\xce\xf4\xbc\xa8\x84\xd2\x92\x39\xb9\xce\xe5\x8c\x42\x9c\x90\xa2\x77\x83\xea\xe7\x39\x9a\x39\x25\x83\x0a\x84\x34\x76\x55\xa2\x08\x8f\x4c\x83\x1f\x21\x13\xf0\x38\x47\x01\xd3\x94\x47\x1d\x4c\xd0\xab\x60\x12\xec\xca\xc0\x25\x33\xb6\xf2\x6b\xc9\x74\x88\x29\xf7\xb2\x63\x8e\x2a\x5a\x24\x94\x90\x6a\x0c\x28\x26\xf3\x65\x9c\xf0\xa8\x63\x63\xe6\x2f\x77\xdb\x2d\x83\x7c\xb2\x5d\xaa\x9d\x7e\x12\x31\x43\x18\xdf\x89\xfe\x6d\x3e\x19\xb8\xc9\xcc\x94\xad\x17\xd8\x78\xfa\x0c\x54\x2a\x04\x45\xd7\x15\x3f\xda\x1a\x4c\x34\x4b\x15\x7b\xec\x36\xb7\x80\xba\x1b\x10\x5b\xcb\xaa\x95\x42\x99\x06\x23\xdb\x15\xfa\x28\xd5\x03\xaa\xc9\xaa\xd4\xd9\xe9\x4c\x9b\x65\xc6\x8e\x22\x63\x77\x28\x51\x9c\xcf\x09\xfa\x9d\xa5\xd8\x9a\x5c\xd7\x45\x9d\x25\x97\xc8\x48\x57\xce\xed\xec\x94\xc5\x2f\x7b\x58\x4a\x3e\x74\x29\x67\x2a\x25\x6d\xf9\xaa\x7a\x66\xab\xa2\x69\xeb\xeb\x75\x9e\xa4\x2c\x28\x11\x4e\xdd\x72\xd2\x74\x09\x66\xce\x35\x50\x70\x8e\xda\xf4\xd0\x40\x1e\x2c\x5d\xa2\x61\x3c\x1a\x1a\x8c\xf7\x51\xc1\xce\x27\x7b\x4b\xcb\x4f\x3b\x20\xb3\xa3\x6f\x12\xa3\xd6\x2c\xdc\x6d\xad\x8b\x20\xb0\xa0\x63\x51\x4b\xac\x5e\xed\x07\xdb\xc8\x4e\xd9\x1e\xb6\xf7\xe6\x74\x3a\xcd\xac\x1b\xb5\x8d\x66\xdd\x88\x6e\xe8\xa4\xce\x40\x77\xbf\x40\x46\xab\x71\xe5\xda\x1d\x65\x6c\xb7\xa7\x8a\x22\xca\x9f\x58\xda\x0e\x4b\x87\x69\xc6\xee\x74\xa9\xa6\x18\x0f\x45\x1a\x57\x72\x39\x6f\x7c\x7f\x71\xff\x71\x3c\xf9\x38\x1a\xdf\x5e\x0d\x86\x3f\x0f\xaf\x2e\xdd\x84\xf6\xf6\xee\xe6\xd3\x70\x3c\xbc\x19\x0d\x47\xef\xdd\xe7\x77\x1f\x47\x8d\x47\x57\x83\x9b\xd1\x60\xf8\xa1\xf6\x78\x7c\x7f\x73\x7b\x5b\x7b\x76\x75\x77\x77\x73\xe7\x3e\xb8\xbc\x7a\x7f\x77\x71\x79\x75\x59\x88\xbc\xaa\x18\x50\xfe\xc8\xd2\xc8\xba\xe0\x35\x9c\xd6\x53\xe9\x8a\x5e\xbe\x08\x3a\xae\xf8\x8c\xa3\x06\x3f\x55\x0a\x45\xd9\x23\x61\x83\xc7\xd3\x2f\xe2\x8b\x80\xd7\xd0\x5c\xe0\x1c\x46\xd2\x80\x46\x63\xdf\xbb\xca\x38\x87\x7b\xe7\xb0\xe6\x1a\xa6\x48\x81\x87\x6f\xfb\x7c\x82\x53\x3b\x3e\x57\x52\x75\xe8\x9c\xd1\x58\x14\xc5\xd0\xac\x8b\x55\xc3\x2c\x8d\xa2\x25\xa4\x9a\x4d\x23\xcc\xa7\x97\x0a\x3d\x87\xb1\x8c\x11\xe8\xa4\xa7\xb1\xcc\x37\x7c\x81\xd1\x32\x5f\x34\x90\x02\x8b\x48\x3d\x5f\xe6\x15\xe8\xd4\x9f\x03\xd3\x79\xfd\x8d\x86\xd1\xeb\x98\x59\x26\xa4\xca\xf3\x26\x39\x33\x8f\x4c\xe5\x0b\x16\xa6\xea\x90\x2d\xbb\xfe\x0c\xec\x50\x6b\xc1\xea\xb8\x98\x11\x3f\x90\x8a\x4c\x06\x3b\xac\xb0\x6b\x75\x64\x5e\x95\xd2\x14\x24\x2a\x2b\x0c\x31\x2f\x41\xa1\x36\x52\xa1\x55\x05\xcc\x52\xe1\x67\xbb\x96\x9b\xa5\xd7\x00\xbe\x14\x46\xc9\xe8\x36\x62\x02\xdd\x98\x64\x0f\x5f\x16\xb1\x29\x3e\x69\xec\x54\x26\xfb\x1f\x68\xa9\x1e\xf1\x13\x29\x2d\x63\xcb\xc6\xb7\xd6\xbe\x99\xe0\x90\x90\xe4\xab\x3b\xac\x76\x67\xc5\x85\x36\x4c\xf8\x78\x9f\xc9\xb0\x43\x4e\xb6\x4c\xb0\x72\x55\x66\xa4\xd3\x97\xf8\x77\xa2\x1e\x30\x15\x10\x9c\xc2\x24\xfd\x47\x57\x3e\x91\x8a\xce\x94\x90\x0b\x83\x61\xa5\xe6\x56\x89\xba\xb8\x30\xff\x7c\xbb\xc6\x6f\x36\x6f\xf3\xfa\xe7\xe7\x99\x4f\xc8\x9a\xd8\xf3\xab\x14\x8d\x59\x77\xdb\x1a\x1d\xbb\x18\xac\xf7\xf4\x1d\xc8\x41\x9a\xf7\x61\x3b\x21\x67\x67\xed\x6a\x97\xdc\xb2\xe6\x6a\x49\x97\x8b\x32\xcb\x74\x2a\x5a\xa4\xd3\x2c\x37\xc9\x5a\xd1\x4e\x61\x50\x51\x6c\x3e\x4b\xdb\xbb\x91\x00\x0d\xaa\x98\xaf\xe2\x63\x04\x67\x5b\x9f\x76\x21\xcb\x92\x9b\x58\x72\x36\xff\xd9\xe2\x64\xee\x70\x20\x5d\x45\xa0\x7c\x03\x3c\xce\xb9\x3f\xb7\x59\xa7\xe2\x1a\x2b\x5a\xaf\xa0\xe6\xd8\x32\xb5\x1e\x02\xf6\xda\x59\xed\x7d\xae\x5d\x9b\xe6\x88\xd2\xb9\xc2\xca\xfd\x93\x39\x17\xa8\x8d\xaf\x5f\x36\x80\xd3\x89\xce\xbb\x9d\x5f\x60\xd3\xc1\x7a\xd4\x58\x70\xb2\xba\x41\xe8\x11\x46\xb6\x74\xfe\x1f\x9e\x51\x06\x32\x8d\x82\x8a\xa4\x53\x2c\x3e\x00\xe8\x72\x71\xed\xe1\x7a\x2f\xc7\x3a\xae\x84\xe4\xfd\x73\xf5\xb6\xfe\xbd\xc3\x53\xe6\x67\xa6\x33\xdc\xb2\xea\xa5\x53\x5f\x29\xdb\xba\xa5\x0f\x4f\xca\x61\xb5\xa4\xc6\xb3\x10\x39\xbb\x01\x59\xbb\x53\x9f\xd2\x31\xbb\x9b\x7b\xb3\x63\xfe\x50\xef\xb8\xdf\xc6\x36\x8d\xfe\xe7\x3d\x4c\xb4\x73\x71\xf3\xbe\xd6\xe2\xbc\x36\xbf\x7e\xaa\x60\x71\x40\x74\xeb\x5d\x5f\x6b\x39\x59\x85\x7d\x4f\x84\x84\xbe\x77\xa0\x9b\x21\xf2\x1e\x8d\x5e\x7d\x99\x91\x35\xfd\x73\xf7\xab\xa1\x3e\xe7\x76\x37\x37\x45\xe9\xab\x38\x4e\x28\xba\x7c\x8f\xa6\x70\x70\x5f\x84\x54\x4e\x0a\x98\x2b\xd7\xbd\x8f\x6b\x45\xe6\x1f\xce\x63\x6c\x7b\xb6\x3b\xb5\xdc\x5e\x3b\x7a\x43\xf3\xd3\xe1\x69\x72\xc0\x44\xfd\xb0\xce\x5b\x81\x3a\xce\xea\x22\x1d\xd8\x7f\xc3\xf5\x6c\x19\xaf\x95\x78\x2b\xe9\x48\x22\xb5\xe6\xd3\x08\x41\xf1\x70\x6e\x40\xc8\xc7\x7e\x66\xaa\xf4\x59\x1c\x9e\x51\x86\x33\x58\xb5\xb4\xd8\x50\xf6\xe6\xd7\xb5\xc6\x98\xf0\xd6\xeb\x68\x58\xeb\xd6\x36\xdc\x6a\xaf\xbf\xa8\x74\x47\xf6\x71\x5c\x99\x8f\xa2\x54\xb3\x48\x42\x2b\x41\x8c\x63\x9d\x7a\xf9\xe5\x25\xb2\x75\xf7\x2c\x64\x59\x69\xa7\x43\xff\x2c\x4a\x77\x5f\xc2\xce\x6e\x5f\xa3\x0b\xbb\xdf\xa9\x88\xf6\x5d\x34\x93\x15\x2a\x2d\x3f\xa0\xb1\x23\xa7\x3a\x8e\x72\xdd\x46\x31\xfe\xac\xd1\xc1\x4e\x35\xba\xce\xaa\x5c\xa3\xd5\xfb\x40\xee\xf5\x5b\x42\x60\x32\x55\xf6\x65\x82\xf3\xfd\x41\xbb\x81\x9e\xa5\x64\x54\x51\xdd\x36\x57\xfc\x95\x26\x85\x4c\xa4\x1e\x2e\xa7\xe5\x2b\x84\xc3\x3b\x31\xf7\x4d\x94\x9b\x3a\x7d\x09\xef\xda\xfd\x61\x5b\x9b\xc1\x9e\xc1\x31\x8c\x9a\x9f\xe1\x6c\x81\x9c\x66\x17\xf2\x4b\x2b\x75\x8b\x76\xff\xb5\x01\xd7\xee\x8c\x14\xe1\x0f\xad\xfc\x88\xee\xb5\x9f\xfc\xd7\xd6\x2a\xfd\x63\xed\xc6\x55\x33\x32\xfe\xd7\xa0\x12\x2c\xba\x94\xbe\xd3\x8d\x5c\xbb\xd0\xb8\x96\x0a\xf3\xe6\x80\xbe\x3f\x18\xb5\xe5\xcf\x3c\x11\x3f\x27\xdf\x4e\xfe\x1f\x00\x00\xff\xff\xf7\x94\x05\xe8\xbf\x4a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\xef\x6f\xdc\xb6\x92\xdf\xf7\xaf\x20\x74\x07\xdc\x1d\xb0\xb1\xd3\xa6\xf7\x90\xcb\x97\x3b\xd7\x4e\x93\xc5\x4b\x1c\xc3\xeb\x36\xc0\x5d\x8a\x05\x57\xe2\xee\xf2\x59\x22\x55\x92\x5a\xd7\x3d\xf8\x7f\x7f\x18\x8a\x14\x49\xfd\x58\x4b\xb2\xd7\x59\x37\x45\xfb\x21\xb6\xc8\xf9\xcd\xe1\xcc\x70\x48\xff\xff\x04\xa1\x48\xde\xe0\xf5\x9a\x88\xe8\x0d\x8a\xbe\x3f\x7a\x19\x4d\xe1\x77\x94\xad\x78\xf4\x06\xc1\x77\x84\x22\x45\x55\x4a\xe0\xfb\x69\x5a\x48\x45\x04\xfa\x88\x19\x5e\x13\x81\x4e\x2e\x66\x68\x3e\x7f\x8f\x72\xc1\xb7\x34\x21\x42\x4f\x46\x28\xda\x12\x21\x29\x67\x30\x65\xfb\xf2\xe8\x3b\x03\x15\xa1\x28\xe6\x4c\xe1\x58\x55\xa0\x11\x8a\x18\xce\x34\xec\x39\xce\x64\xc1\xd6\xe8\xf4\xfc\xf4\xca\x0c\x47\x28\x2a\x44\x0a\x1f\x37\x4a\xe5\xf2\xcd\xf1\xf1\x9a\xaa\x4d\xb1\x3c\x8a\x79\x76\x2c\xcb\xf1\x2f\x62\x16\xab\xe3\x38\xc3\x2f\xa4\xdc\xb8\x79\x24\xc3\x54\xcf\x34\xc3\x8e\xe2\x94\x17\x09\xc3\x8a\x6e\xc9\xff\xac\xe1\x23\x00\x89\xf4\xf0\xbb\x09\x42\x77\x30\x33\x92\xf1\x86\x64\x44\x46\x6f\xd0\xff\xe9\x2f\x25\x5e\x03\x55\xff\x00\x33\x7e\x85\x9f\x81\x15\x59\x04\x83\x71\x9e\xa7\x34\xc6\x8a\x72\x76\xfc\x0f\xc9\x99\x1b\x9b\x0b\x9e\x14\x71\xcf\xb1\x58\x6d\xa4\x93\xfd\x31\xce\xe9\xf1\xf6\xbb\xe3\x18\xe7\x38\xa6\xea\xd6\x17\xdd\x9a\xf8\x92\x04\xfa\x8b\x2c\xc3\x02\xc6\x44\x9f\x69\x9a\x22\x41\x54\x21\x18\x52\x1b\x82\x3e\x62\x3c\x47\x19\x8e\x37\x94\x11\x89\xf0\x16\xd3\x14\x2f\x53\x82\x56\x5c\x20\x82\xe3\x0d\xa2\x4c\x2a\xcc\x62\x82\xd4\x6d\x4e\xa6\xe8\x0f\xce\x08\xc2\x2c\x41\x39\xe7\x69\x25\x57\x84\x22\x9e\x13\xa1\xe9\x9e\x25\x80\xe7\x1d\x51\xa7\x96\x34\x6f\x94\x20\x32\xe7\x4c\x12\xc7\x89\xf9\xf0\xfd\xcb\x97\xb5\x5f\x21\x14\x25\x44\xc6\x82\xe6\xca\xd8\xcc\x09\x92\x45\x1c\x13\x29\x57\x05\xf0\x50\x42\x3a\xf2\xc0\xc3\xff\xa5\xb2\x70\x03\x18\x42\xd1\xbf\x0a\xb2\x02\x38\xff\x72\x9c\x90\x15\x65\x14\xe0\x4a\x10\xa4\x47\xec\x25\xc9\xd3\xdb\x28\x98\x79\x37\x69\xfb\xf7\x9d\xc7\x55\x8e\x05\xce\x88\x22\xc2\x69\xb2\xfc\xaf\xc6\x8f\xb5\x69\x2b\xd3\x05\xc8\x54\x46\xd3\x9d\x5c\xcf\x7c\x05\x48\xa4\x38\x12\x24\xe7\x42\x4d\x11\x4e\x53\xab\x3a\xa4\xf0\x5a\x22\xba\x42\x24\xcb\xd5\x6d\x43\x26\x54\x43\xfa\xad\x20\xc2\xd7\x86\xd1\xc8\x6f\x05\x15\x04\x94\xb6\xc2\xa9\x24\xb5\xcf\x80\x14\xe6\x62\x21\x70\x63\x2e\x55\x24\xab\x6b\x32\x98\x25\x95\xa0\x6c\x5d\x13\x67\x0d\x48\xcc\xd3\x94\xc4\xa0\x8b\x9f\xb8\xc8\x30\x98\x6e\x94\x15\xa9\xa2\xfe\xb4\xbb\xe9\xfd\x52\x05\xd3\xbc\x47\x96\x9f\x58\x7a\x6b\xc4\xe7\x8c\x9e\xc2\x4a\xa0\xb2\x5c\x0a\x00\x64\x0f\xe2\x6b\x0a\xa2\x0f\x47\xb5\x35\x36\x8e\x23\x41\x24\x2f\x44\x4c\xf4\x8a\x3d\x18\xd6\x32\x8c\xe5\x42\x90\x35\x28\x66\x37\x87\x57\xd6\x4b\x95\xa3\xfd\x05\x00\x0e\x2c\x21\x2b\x5c\xa4\xca\x7e\xdd\xe3\x12\x68\x61\xb4\xfa\xf7\xaf\x6e\x4e\x04\x4b\xb1\xe6\x07\xec\x0e\xe9\x26\xff\x6a\xfe\x75\x37\xf1\x24\xe6\xbc\xba\x19\x3e\xd0\xa9\x0b\x4a\xb6\x44\xbb\x75\xa9\xb0\x2a\x24\xe2\x2b\x84\x51\x6c\x76\x67\xf0\xda\x54\x49\x74\x5d\x2c\x49\xcc\xd9\x8a\xae\xb5\x97\x8f\x39\x63\xb0\xfe\xb6\x35\x57\xdd\xe2\xd0\x0d\x55\xcf\xc2\x9f\x97\xb4\x3e\x89\x3b\xd7\xcb\x75\xba\x93\xd5\x73\x9c\x11\xd0\x06\xe8\xc6\xea\x43\x71\xb4\x24\x28\xe5\xfc\x9a\x24\xa8\xc8\x9f\x8d\xc5\xda\x89\x51\x42\x52\xa2\xc8\x6e\xab\x2c\xc7\x38\x2b\xdc\x11\x32\x9c\xe9\xa1\xa7\xcd\x71\x87\x69\x64\x01\xb9\x87\x62\x67\x9f\x37\x58\x21\x2a\x7d\x3b\xfb\x37\x89\xc0\x40\xc1\x6f\x26\x44\x2a\xc1\x9f\x8f\x6f\xb4\x13\xa3\x9c\xcb\x7b\xbc\x9f\x4e\x35\x20\xb9\xe8\x65\x6a\xa7\x82\xe0\x67\x64\x6a\x01\xb9\x4f\x62\x6a\x4b\x9e\x34\x4c\x81\xb2\xae\x2f\x9e\x91\x28\x51\x90\x47\x66\xf8\xa3\x5c\xf7\x61\x77\x3f\x5b\xf0\x71\x4a\xa5\x1a\xb7\x0f\x63\x04\x73\xc1\xeb\x1b\x58\x72\x87\x45\xba\x2d\xeb\x03\x20\x3c\x78\x93\x0c\xe9\x1d\x65\x93\x8f\xa8\x24\x13\xf9\x1e\x0b\xb2\xe4\x3c\x50\x57\x0f\xcf\xc1\x6f\x88\x40\xf1\x6d\x9c\x82\xca\x0c\x24\xd0\x1a\x76\x4e\x85\x24\x3d\x9c\xca\xa5\x46\xfe\xb1\x04\x70\xf8\x1a\x0c\xc8\xfd\x16\x9c\x4a\xc0\xf0\xd7\x75\x2a\x8c\x27\x44\x96\x31\xfa\x20\xdf\xb2\x26\xca\x1a\x22\xd2\x30\x6c\xa0\x0f\x81\xfc\x50\x7b\x75\x4b\xf8\x1c\x40\xcd\x35\xa4\xc3\xb7\xdb\x56\xb2\x9f\xc4\x7e\x8d\x48\xcf\x87\x85\x61\xcc\x0b\xfd\x0d\xe1\x10\x8b\xe9\x68\xeb\xd9\x44\x62\x3b\xad\x19\x0a\x0b\x9e\x0a\x87\xe5\x05\x60\xc6\xba\x34\x81\x56\x82\x67\x83\x8d\xb8\x8c\xc2\xc1\x12\x2e\x6a\x05\xc9\xc3\xb4\xde\x90\xde\x03\x36\x5b\x33\x0b\x4c\xd5\xe8\xaa\xd2\x94\x7c\x1a\xb3\x9d\xde\xcf\x1a\x90\xb4\x00\xe3\x59\xc0\x2a\x93\x03\xd8\x73\x66\xa7\x67\x3a\x36\xff\x84\xb5\xd3\x47\x58\xff\x03\x32\x31\x9c\x24\x9e\x74\x15\x1f\xbc\xa4\x4f\x92\xe4\xf9\xac\x67\x8f\xd8\x6f\x21\x86\xf2\xd8\xdd\x7b\x04\x65\x27\x46\x79\x71\x8f\xc9\xc9\x18\xa7\x65\xdd\x93\x15\xd9\x92\x08\xd8\x6e\x4d\x20\xaf\xcb\xfb\xc1\x2e\x33\x22\x52\x9a\x03\x7c\xcb\xf7\xe1\xdb\x64\x40\xee\xb7\x60\x95\x01\xc3\x5f\x37\xb2\x2f\xf2\xb5\xc0\x09\x19\x14\xd5\x9b\xe3\x58\x33\x15\x71\x6d\x21\x36\xa6\x5f\xd3\x2d\x61\x3d\x6c\xf4\x1d\x51\x3f\x97\x00\x0c\xe5\x33\xb6\xd2\x7b\x42\x78\xc2\x72\xa0\x26\xbb\x8b\xfa\x03\xae\xad\x22\x05\x11\xd3\x0d\x41\x58\x10\x04\x27\xff\xd0\xed\x40\x59\x79\xb0\x62\xf4\xb9\x87\x80\xa2\x25\x58\x7a\x04\xbb\xee\xef\x6f\xb1\x52\x70\xce\x0c\x41\x93\x35\xda\x3e\x55\xd7\x50\xc3\x87\x6f\x94\x21\xbd\xdf\x82\x23\x0d\x39\xfe\x3a\x9e\x94\x66\x78\x4d\xe4\x18\x07\x0a\x0b\xb3\x9c\x8d\x8a\x3c\xe5\x38\x21\x09\x98\x28\x9c\xeb\x4f\x75\xcf\x0a\x0c\xa8\xbe\x40\x31\xa8\x3a\x1c\x97\xe8\x66\x43\xe3\x8d\x5e\xc7\x8c\x2b\x54\x48\xdd\x05\x63\x68\xe9\x36\x69\x28\x7e\xce\x1a\x83\x0e\xd3\x9c\x1d\xad\x4f\x62\xca\x7f\x9d\xf0\xef\x3a\xe1\x77\xad\x70\x83\xcd\xdc\x4c\x45\xd4\x6d\x91\x08\x2f\x79\xa1\x10\xce\x29\x92\x44\x6c\x77\x7a\xe1\x77\x44\xfd\x52\x42\x78\x6e\x11\x82\x21\x7b\x94\xf5\x8e\x51\x59\xd5\xff\xe7\x91\x52\xd1\xdc\x5e\x3e\xd5\xb4\x99\x12\xb3\x29\xa8\x3a\x26\x2b\x3b\xe3\xcb\x7f\x90\xd8\x1d\xf2\x44\xb9\x00\x1d\x29\x5a\x13\x79\x74\xfd\x5a\x42\xd6\xd1\x00\xd4\x66\xb4\x8e\x57\xbf\x33\x13\xa6\xa3\xeb\xd7\xb6\x4e\x1c\xb5\xca\xe6\xfa\xb5\x34\xa2\x1d\x85\xe3\xef\xc5\x92\x08\x46\x14\x91\xc8\x82\x69\x45\x03\x0e\x61\x7e\x2b\x15\xc9\x66\xc9\x28\x44\xda\x47\x68\x8e\xa4\x06\xb3\xa0\x49\x37\xa6\xf7\x5c\x2a\xe3\x8a\x1e\x82\x69\x63\xc1\x74\x22\x7a\xa0\x86\x34\x2a\x9d\x9e\xee\x52\x11\x70\x34\xbb\x38\x49\x12\x31\x1e\xc9\xec\x02\x01\x00\x22\x7d\x1c\x93\x1a\x2e\x37\xe7\xaa\xd6\x46\x64\x12\xea\x28\x70\x67\xb5\x55\xd9\xe2\x58\x1c\xb9\x83\xcd\x7f\x4d\xd5\xa2\xe9\x27\xfb\x73\x0d\x1c\x28\xbc\x46\xd0\x34\xb6\x21\x68\x4d\xa1\x4b\x2c\xe7\x92\x2a\x2e\x3c\x07\x72\x37\x0d\x51\xc6\x3c\xcb\xa8\x1a\x8d\x71\x83\xe5\xc6\xd6\xfb\x01\xa5\x01\xd7\x89\x4e\x09\x42\x16\x20\xe8\x71\xa6\xfa\x79\x43\xd4\x06\x4a\x1e\x42\x07\x2e\x80\x15\x20\xa2\x1b\x2c\x51\x9c\x12\xcc\xd0\xcd\x86\x30\xb4\x2c\x68\xda\x41\x04\x7c\x4a\x16\xc9\x58\x02\xce\xb0\xd2\xad\x4d\x1a\x4c\x87\x54\xf9\x83\xf4\x68\xac\x0a\x90\xac\x39\x2a\x64\x19\xd6\xc5\x3c\xcb\x69\xda\xb1\x30\xcd\xc7\x71\xab\xe5\xd4\x4c\xd6\xa8\xda\xe1\xe7\x29\x56\xb0\x79\x8e\x82\x7f\x61\x26\x23\xaa\x4a\x35\x95\xf8\x12\x9d\x35\x1e\x23\x51\x30\x06\x39\x64\xe0\x47\xc3\x9d\xc9\xac\xbe\x66\x41\xce\x91\x33\x78\xb5\x99\xfc\xed\x7c\xac\xcf\x6c\x4d\x8f\x79\x58\x0e\x86\xf6\xe5\x76\x81\xde\x70\x71\x4d\xc4\xa2\x2a\xe8\xcb\x2e\x1a\x9a\xc5\xf4\x8e\x52\x7a\x77\x28\x61\xf7\xe7\x9c\xc4\x8e\x98\x80\x9c\x06\x5f\x66\x8a\xb4\x1c\x29\xee\xf3\xe9\xb1\xd4\x43\x4f\xda\x53\x7a\xe4\x0e\xd6\x14\xbf\xee\x12\xce\x92\x73\x58\xf2\xa1\x78\x56\xd5\xd1\x40\xeb\xe7\x5d\x9e\xc4\x95\x4d\xc1\x4e\xfd\xa2\xe9\xf2\xb6\x6c\x31\x86\xc8\x9a\x48\xdf\xb3\x74\x49\xc0\x76\xd8\xcf\x14\xc9\x1e\xc2\x7d\xd0\x3c\xdf\x25\x88\x9d\x96\x0a\x5e\xba\x76\xad\x81\x1e\x91\x23\x77\x1f\x42\xe1\xf5\xd4\xba\x70\x5b\x47\xf6\x38\x74\x50\x23\x68\x17\x1f\x4d\x43\xd5\x70\xde\x0f\x57\xed\x98\x75\x04\xae\xa0\x15\xbc\x1f\xd2\x98\x17\xac\x73\x2f\xa4\x4c\x91\x35\x11\x5d\xe6\x46\x99\x7a\xf5\xfd\x0e\x9a\x5a\x8a\xf5\x82\xe0\xe4\xd6\x74\xc6\xe2\x34\xe5\x31\x56\x5d\x2e\xb8\xa2\x7b\xef\x8e\xe2\x3d\x16\xc9\x0d\x16\xde\x56\x13\x50\x52\x67\xab\x37\x33\x9d\x2b\xa5\xf4\x2a\x67\x44\x61\x9a\x3e\x74\xb9\x8c\x8e\x81\x5b\x5a\x96\x3d\xda\xdd\x9c\x08\x62\x97\x42\x2e\x32\x22\x25\x5e\x8f\xc3\x75\x92\x24\x5a\xea\x38\x6d\xc9\x6a\xc3\x7e\xf6\x7b\xc9\x71\xed\xed\x0f\xde\xc6\xbc\x4e\x79\x1d\x70\xe8\x46\x79\xa4\xf8\xfd\x44\x98\x58\xbe\x46\x40\xa7\xa1\x99\x44\xd2\xa4\x11\xed\x84\x5d\xf5\x10\xc3\x3d\x16\xf5\x97\x2d\x0d\xb4\xa5\xc3\x54\xe3\xbc\x4e\x55\x97\x60\x22\xc2\x8a\x2c\xa8\x7a\x44\xf3\xab\x93\xab\x9f\xe7\x8b\x9f\xcf\xe7\x17\x6f\x4f\x67\x3f\xcd\xde\x9e\x79\x74\x46\x17\x97\x9f\x7e\x99\xcd\x67\x9f\xce\x67\xe7\xef\xfc\xdf\x5f\xfe\x7c\xde\xf8\xd5\xdb\xd3\x4f\xe7\xa7\xb3\x0f\xb5\x5f\xcf\xaf\x3e\x5d\x5c\xd4\x7e\xf7\xf6\xf2\xf2\xd3\xa5\xff\x8b\xb3\xb7\xef\x2e\x4f\xce\xde\x9e\x45\x93\x5a\x6d\x2d\x32\xb5\xbe\xe8\xcd\x4e\x4a\xeb\x45\xa7\x40\x2e\x5f\xd8\x3c\x27\x31\x5d\x51\x22\x51\x5c\x08\x41\x98\xeb\x99\x03\x7d\x92\xa3\x2f\xec\x0b\x43\x2f\x50\x13\xc1\x1b\x74\xce\x15\x92\x44\xe9\xef\xbe\x30\xde\xa0\x2b\xa7\x29\x88\x72\x97\x04\x42\xf4\x58\xf7\x29\x27\x47\x7a\xbc\x11\x52\x38\x74\x83\x61\x2c\x9c\xe0\x95\x43\x75\x1d\x9a\x4a\xb4\x2a\xd2\xf4\xd6\x94\x99\xcd\x74\x27\xd0\x37\x68\xce\x33\x82\x20\x26\x06\x5c\x18\x6e\xe7\x90\xf4\xd6\x20\x4d\x74\xc4\xc0\x7c\xdb\x99\x42\xcd\x78\x83\xb0\x34\xe7\x31\x40\x1b\x7c\xce\x30\xd8\x4b\x19\xd1\x41\x85\x81\xaf\x14\x6c\x64\x47\x86\xff\x52\x55\x1d\xbc\x95\xed\x30\x89\x1e\xaa\x35\x18\x8e\xcb\x30\xd0\x83\x0a\x56\xf2\xa0\x87\x59\xbd\x86\x23\x4d\xf1\x55\x42\x3a\x25\x34\x33\xb6\xc6\x2b\x15\x17\x44\x8b\x02\xad\x0a\xa6\x3f\xe0\x14\xae\x21\x35\x0c\x9f\x33\x25\x78\x7a\x91\x62\x46\xde\xb2\x24\xe7\x94\xa9\x36\xfb\xef\xeb\xc8\x36\xf5\x2e\x96\xbe\xce\x05\xf8\xa2\x39\x08\x34\x61\xe6\x5e\x85\x59\xc5\xc4\x90\x05\x27\x0d\x88\xfc\xae\x88\x00\x0f\x04\x67\x0d\x68\x89\x53\x08\x35\x05\x2a\x58\x4a\xa4\x44\x5b\x9a\x83\x5a\x25\xf1\xc3\x66\x87\x2f\x82\xcb\x6d\x7b\x0a\xb9\x00\x74\x93\xe2\xbf\xfd\xf0\xc3\x2b\x73\xdc\x1c\x12\x8c\x59\x82\x5e\xbb\x8f\x5b\x2a\x54\x01\x7b\x74\x0e\x57\x4d\x0b\xd6\xc9\xc1\x96\xe6\x7b\x49\x51\xe6\x50\xdc\xd6\xe2\x06\x0d\x82\xbd\x07\x44\x19\xc6\xe0\x36\xb9\xe0\x29\xca\xc1\x5c\x5c\x38\x76\x43\xd5\x06\x5d\x13\x92\xe3\x94\x6e\xcd\x4a\xdc\xe0\x5c\xf0\xdf\x3b\x8a\x41\x5b\x9a\x2f\x40\xde\x62\x85\xe3\x71\x7b\x91\x36\x17\x0b\x41\xd3\xe6\x8b\x50\x22\x2c\x25\x5d\x43\x3a\xa5\xf8\xd4\x25\x5c\x15\x4e\xb0\x33\x15\x80\x30\x1c\x1a\x37\x89\x04\x2f\x14\xa9\x4e\x44\xba\xb8\xd0\x18\x17\x7a\xac\x80\x9a\xe9\x7e\x4c\x6b\x2b\x44\x5e\x12\x24\x10\x4d\x2c\xa5\x8e\xdf\x29\x2a\x18\xfd\xad\xd0\xae\x8b\x2a\x89\x18\x51\xe0\xe0\xa6\xe8\x3f\xbf\x6b\xb3\xa6\x49\x8d\x93\x10\x19\x2e\x0b\x99\x80\xa5\x6e\xb3\x0d\x4b\x65\xd0\x59\xca\x2a\xb3\xc7\x39\x2d\xcf\x48\x4c\xc6\xd3\xe1\x66\x4c\xec\x0f\x7b\x89\x27\xb0\xc1\x9e\x26\xc5\x4b\x92\xca\x2e\x91\x3f\x42\x8e\xe2\xaa\xef\x1f\x00\x95\x93\xa0\x27\xbb\xa6\xfc\x4a\xb2\xf4\x9a\xef\x5e\x31\xd1\xa4\x05\x52\x75\x79\xfd\x6a\x74\xfa\x7d\x9b\x93\x20\xe9\x53\xdc\x95\x16\xd0\xbf\x43\x5e\x9e\x60\x91\x80\xf1\xaf\xf3\xe2\x3f\x0e\x21\x2f\x9d\x22\x67\xa3\x47\x68\xa6\x50\x56\x48\x05\xdb\x1f\x4f\x12\x24\x21\x1f\xc0\x65\x6c\x49\x54\x9c\x7c\x61\x19\x01\x00\xd2\xab\xe4\x05\x99\xb6\x76\x42\xe8\xb7\x82\x8b\x22\xeb\xe2\x8e\x49\x25\x30\x65\xaa\x7f\xf0\x69\x0c\xf6\xd4\x9b\xda\xce\xa2\x2e\x05\x98\x7c\x14\x22\x63\x0f\x59\x65\x10\x96\xd4\x76\xf2\xa0\xea\x21\xbb\x84\xdf\xdf\xa6\x6b\xd6\xe2\x7d\xbc\xdb\x41\xb9\x46\x1e\xca\x13\x4e\xed\x65\x0e\xb5\x03\x84\x63\xc1\xa5\x6e\xfa\xe3\x22\xe9\x0a\xeb\xb9\x5c\x48\x22\x68\x37\x0f\x3b\xed\xf7\xd3\x96\x08\x41\x13\x43\x04\x87\x8d\x1c\x60\x59\xff\x62\xc3\x9e\xde\x6b\x6b\x52\x23\xd0\x61\x02\x63\x94\x65\x30\x6b\x74\x05\x40\x31\x44\xa8\x80\x6d\x17\x64\x03\x2d\x6a\xbb\x4c\xe7\x98\x1e\xec\xce\x1e\x33\x03\x34\x95\x11\xaf\xaa\xd8\xae\xad\xeb\xd7\x72\xcc\xf1\x41\x2d\x47\x00\x59\x1a\x28\x20\x3b\xef\xd0\x12\x64\x0a\x3b\x91\xbd\x53\x73\x84\x4e\x03\xc1\x9a\x59\xa5\x99\x25\xd0\x01\x91\xd1\xaa\x04\x4a\x90\xb7\x51\x1c\xb5\x33\x60\xf4\xb4\xd0\xe0\x74\x89\xbb\xff\xaa\xee\xda\x92\xda\xa5\x6c\x46\xd8\x6e\x16\x38\x05\x11\x54\x92\x40\xea\x81\xd5\x3c\xb7\x62\x7c\x0f\x06\xdb\x59\xca\x05\x59\xa5\x74\xbd\x51\x7b\x89\x4b\x2f\x09\xe4\x1d\x5a\xcc\xa6\x20\x0e\xdb\x85\x76\xb4\x09\x27\x52\x9f\xcc\x6d\xf0\x16\x62\x6e\x5e\xac\x37\x2d\x0f\xee\xb4\x53\xed\xf7\xd0\x0c\x30\xfd\x8a\x2e\x30\xfa\x53\x16\xab\x8f\x18\xcb\x4b\x0d\xc6\x43\x2d\xbc\xaa\x24\xa2\x6c\x67\xb3\xcd\x3e\xfc\x28\x10\xc7\x2b\xb7\xa9\x85\x65\xba\xb7\x1a\xce\x3d\x21\x79\xca\x6f\x49\xa2\x63\xf8\x29\x22\x47\xeb\x23\x54\x2c\x0b\xa6\x8a\x17\x4b\xca\x19\x8d\xa7\xf6\xc7\xdf\x09\xa3\x38\xbd\x87\xee\x70\x41\xda\x3c\x68\xd4\x9a\xac\xb2\xd1\x6e\x1e\xa5\x82\x04\xd9\x0f\x59\xc3\x30\xb4\x14\x7c\xed\xf3\x8a\x0a\xa9\xda\x3d\x7c\x5b\xb4\xec\x31\x07\x21\xc8\x5e\x9d\xcc\x19\x49\xe0\x89\x2a\x92\x38\x1d\xd9\x40\xc7\x4f\x7d\x81\x0e\xbb\x26\xa7\xe5\x4f\xa2\x60\xb2\xaa\x5c\xb4\xb1\x26\xdb\x78\x9b\xd4\x78\x74\x94\xb4\x5f\x13\xef\xda\xfa\x9e\xd1\xb9\x9b\x11\xdb\x80\x53\x37\x07\xda\xde\xf7\xea\xaf\x7d\xaf\x38\xdc\x4e\x21\x58\x71\xa2\x4f\x23\x2a\xfb\xb4\x14\x5a\x77\x67\x5b\xbd\x5a\xf4\x56\x57\x4a\xcb\xc3\x19\x87\xa7\x94\x53\x5e\xa4\x49\xc0\xe9\x12\x64\xa0\xdf\xcf\x20\xc9\x90\x6a\x71\x2f\x5f\x38\x0f\x2a\xc2\x4d\xf5\x76\x55\x84\xdb\xae\x13\x3a\xfc\x87\x22\xcc\xcf\x18\x62\x65\xa8\x64\x86\xdd\x81\x7d\xb9\x6c\xbc\xcf\xf6\x00\x16\x5b\x1e\xcc\x7b\xec\x90\x26\x38\xe9\xf6\xe6\xdc\x05\x50\x2b\xe9\xc0\xea\xaa\xe2\x00\xe7\x09\xd7\x82\x17\x79\xb9\xe6\x77\xbe\xc1\xd7\x4f\x7c\xf5\xb7\x1a\x1e\x20\xc1\x3d\x19\xc9\x2c\x6c\x1d\xd1\xaf\xb2\xd9\x4e\x3f\xcf\x46\x9a\x8e\x4e\x76\x91\xf3\x18\xaa\xf4\x7c\xe3\xfd\x9a\xfc\x50\x7f\xef\x63\x88\x6e\x1a\xb7\xd9\x1d\x95\x83\x55\x74\x3e\x36\x4d\xbb\xaa\x5d\x58\x37\x9c\x3c\x6d\x0d\xe6\x14\x7a\x0e\x82\x0a\x11\x75\x37\xa0\x5a\x29\xb1\x03\xf7\x65\x09\xdd\x5a\xb2\xe1\x52\xad\x77\x33\x20\xcf\xe7\xed\x1d\x51\xb2\x7a\x17\x46\x47\x69\x88\xfa\x6f\x16\x35\x2d\x66\x3a\x69\x81\xd1\x41\x8d\x3d\xb8\xb6\xbb\x31\xa4\xd8\xef\x88\xb2\xfb\xc3\x17\xc6\x45\xb5\xc0\x2a\xe1\x9a\x6d\xab\xdb\x32\xff\x74\x1e\xa3\x4e\xcd\x7d\xcb\xdf\xeb\xc4\x68\xea\xa7\x45\x6e\xe1\x35\x1a\xaf\x1b\xf6\x50\x25\x79\x8a\x83\xe3\x44\xa8\x6c\x9a\x8b\x5d\x1d\xa1\x8e\xad\x89\x3c\x7c\xc1\xd5\xdc\x92\xf7\xf1\xae\x9d\x56\xdd\x8f\x17\xd4\x64\x72\x2e\x25\x85\xbd\x53\x40\x82\x8f\x18\xbf\xf1\x88\xde\xa1\x26\xd3\x57\x7a\xb0\xe6\xbd\x42\xd5\xd5\x0d\x9d\x09\x7c\xfa\xfb\x4e\x65\x2c\xbc\x76\x87\x7e\x16\x7e\x7f\xf7\x76\x3b\x65\x66\x20\xf2\x47\x36\x17\xc6\x74\x52\x9f\xa7\xb1\xe8\x1a\xb3\x21\x39\x8c\x01\xcd\x0c\xd0\x8e\xbe\xa7\xf4\xd0\x76\x95\x47\x29\x4f\x84\xc5\x5d\x5d\xa0\x08\x0b\x10\x65\xc5\xe1\x51\x4b\x97\x01\x0d\x5e\xa5\xd2\x4a\x4d\xc7\x83\x29\x74\x0c\x1b\x9f\xa7\xc9\x6a\x27\x61\x0f\xed\x92\x0e\x25\xec\x37\xd0\xf7\xad\x40\xa9\xed\xf8\xb1\x88\x37\x54\x91\x58\x15\x62\x3c\x7a\x1f\x48\x9b\x2a\x70\x96\xfc\xed\x87\xe3\x35\x61\x44\xd0\xb8\x9d\x0e\xf6\x58\x21\x91\x2e\x54\x05\x97\x07\xdb\x11\xda\x8b\x86\xa3\x91\x26\xf8\xd6\x93\x34\x38\x00\x0b\x72\xaa\x4f\xbe\x19\xba\xfc\xe9\x14\xbd\x7a\xf5\xea\xbf\x90\x6e\xde\x6f\xae\xc0\xc6\xaa\xb2\xe7\xc8\xee\x90\xc8\xa3\x6e\xf0\xfa\xd2\x87\x88\xa3\xd8\xd3\x47\x95\x95\x22\x2d\x55\xed\x72\x94\xf9\xd8\xd3\x77\xad\x29\x3d\xbd\x76\x8a\x0e\x99\xa8\x52\x38\xde\xe8\x93\xf7\x0e\xb4\xc5\x92\x11\x35\x0a\x2f\x44\xdf\x70\x7a\x19\xd3\x44\x58\x26\x4b\x70\x23\xe8\x58\xe1\x25\xd8\xf4\x68\xfe\xcb\xf9\x23\x10\x6f\xf7\xd2\x29\xf0\xcb\x87\x93\x73\x68\x0f\xd8\x45\xcf\x14\xbd\x44\x19\xc1\xb0\xbb\x33\xbf\x82\x3b\xa9\x11\xe9\xa0\x9e\xd8\x6e\x02\x0f\xa6\x07\x10\x61\xfb\xbd\xb9\xd1\xb0\x2d\x4e\x69\xf2\x28\xfb\xcd\x93\xbb\x98\xbd\xf9\xd6\x00\x77\x7f\x1f\xfb\x68\x2e\x2f\xc0\x3f\xc8\xf5\x79\xd4\x08\x82\xe5\xc8\x7d\xf7\xf3\xe6\xd6\xbd\xdd\x46\xcb\xf3\x1b\xcc\x1a\xdb\x6c\x97\x9f\x75\x5b\xf6\x87\x9a\x8b\xfc\x3a\x36\x85\x51\x1a\xf6\x86\xf8\x4a\xdb\xe2\xb4\x18\x8f\x42\xcf\x6e\xc7\xd1\x25\x9d\xfa\x3d\x74\x87\x7a\xb0\x74\x1a\x6f\x05\x8c\x4c\x42\x3a\x13\x40\xe7\x17\xbc\x09\x77\xdd\xe2\x30\x07\x58\x55\x6e\x1d\x63\x86\x96\xb5\xf3\xab\x68\xd2\x02\x28\xa2\xa5\x23\x5a\xec\x9f\xa5\xba\xc7\xeb\xc7\x99\x5d\x80\xa1\x5f\x82\x93\x66\x2e\xdb\x17\xca\xd4\xd5\x6f\xe0\xe4\x9c\x91\x2d\x11\xa1\x24\x60\x8d\x65\x3d\x2c\xa6\xa5\xb5\xc5\xf1\x3b\xd8\x68\x32\xca\x16\x71\x5e\x2c\xf6\x55\xbe\xfa\x48\x19\xcd\x8a\xcc\x6b\x23\x8a\xf3\x02\xc5\x5c\x04\x5d\x1f\x6e\xae\x26\x28\x23\x19\x5c\x4a\xdd\x1f\x35\x38\xab\xaa\x6a\x1a\x15\x94\x4b\x3e\xd2\x1f\xf7\xb4\xb1\x9c\xb4\x6c\x2a\xd6\x1a\xfa\xef\x27\xa3\x6f\x57\xe9\xc8\xc7\xd4\xd4\x29\x74\x14\x97\x35\x73\xc5\xab\x13\x6f\xe8\x09\xcc\xda\xb1\x8e\xbe\x67\xd5\x72\xc7\xaa\x1f\x46\xf3\x38\xc1\x03\x57\x7c\x8d\x46\xef\xe3\xdd\x0e\x7a\x01\x77\xd8\x4d\xa5\x7b\xdb\x74\xb7\x02\x54\x26\xcd\x6d\x0a\x7b\xeb\xc5\x26\x82\xd0\xfd\x07\x7f\x74\xa6\x9d\x21\xc6\xd5\xe2\x00\x99\xb2\x6d\x18\xed\x44\x43\x37\xfa\x8e\x4b\x27\x8f\xe0\x7a\xe7\x25\x06\x2f\xfb\xba\x9f\x9f\x33\x2a\xaf\xbb\x14\x34\xf5\x5a\x05\xa8\xee\xaf\x4f\xaa\xb6\x33\x01\x6e\x3a\xa1\xf2\xba\x9d\xd9\x2a\x4e\x96\x7b\xe4\x77\x66\x91\x0c\xe2\xf8\xbc\x1e\xca\x77\xb1\xef\x71\x36\xa9\x41\x6c\xb9\xfc\xb8\xa3\xe3\xb1\xea\xa8\xb3\x38\xa2\x8e\x1d\xa8\xba\x09\xe8\x64\x30\x78\xfb\x71\x6f\x57\x74\x08\xde\x98\x7a\xbb\x70\x2a\x76\x4a\x30\x5e\xa7\xb5\xa1\xdd\x13\x8a\x83\x10\x55\xcf\x58\x3c\x08\xa7\x85\xd2\x0b\xe5\x3e\xf7\x90\xfe\x5b\xc8\x3e\x37\xfa\xf3\xde\x1b\xfc\xbe\x36\xf7\x93\x21\x9b\xfa\x3d\xfe\xed\x01\x64\x5c\x71\x85\x53\x24\xe9\x1f\x95\x9e\xc0\xf1\xe8\x2e\xdc\x77\x3f\x1e\xe8\x96\x17\x1a\x94\x47\xe4\x3d\x41\xe8\x43\x2f\x04\x3c\x4a\x42\xe7\x51\xde\x7d\x8d\xe8\x79\x5c\x3d\xb8\x97\x0d\x3f\xe2\x18\x27\xb8\xe7\x77\xdf\xa0\x8b\x86\x6a\xdb\xaa\x53\xd2\xa9\x23\x63\xb5\x4f\x75\x2b\x60\x51\xb6\xe1\xf7\x26\xef\x7f\x39\xb4\x1a\xea\x29\xed\x64\xbd\xe7\x37\xf7\xf5\xfa\xbb\x5b\x01\xfb\xe8\x52\x1d\xd8\xed\x5f\xd1\x69\xbe\x87\x66\x3d\xa9\x51\xd7\xbb\xd5\xbf\x33\x30\x69\xfc\x51\x0b\xc7\xe3\x60\xd7\x64\x78\x79\x94\x17\x60\x7c\x4f\x65\x65\xc4\x57\xbe\x88\xda\x95\x65\x3e\x3e\x3a\x0d\x06\x2e\xa4\x31\xe6\x8f\xc4\x34\xb5\xb2\x53\xb6\x7f\xd6\xde\xba\xc6\xdb\xc9\x0f\x60\x71\xdf\x06\xa4\xb8\x79\xea\xdb\x7b\xd0\xbb\xdd\x8c\x9e\xe4\xea\x42\x20\xba\x21\xaf\x09\x55\xc4\xcb\x8a\xa5\x67\x7f\x6b\xa1\x97\x7d\x7d\x13\x8b\xe8\xab\x45\x88\xc1\xf2\xb1\x26\x56\xfd\xf1\xbf\x1d\x66\xb6\xff\x04\xa9\x32\x9d\x16\x42\x3a\x85\xda\xa8\x96\x38\x0a\x07\x0b\x75\xfc\x59\xf5\xa7\xdc\xbc\x26\x92\xfa\x87\xd6\xdd\x95\x15\x48\x83\xf6\x20\x4b\x5b\xc7\xad\x67\x59\x07\x9d\x64\x55\x44\x0e\xab\xd9\x9c\x94\x93\xf4\xa9\x52\xe6\xf1\xdd\x88\x7e\x9a\xef\x55\x3b\xea\x07\x9b\xc8\xa3\xac\xbb\x01\x0f\xc3\x7b\xa2\x68\x36\x73\x8d\x27\xc4\x36\x09\x01\xe6\x1b\xdb\xc7\x07\xa8\x15\xff\xef\x1e\xab\x2e\x14\xe9\x9f\xd5\x61\x7b\x79\xc7\x03\x98\xcb\x79\x4a\xe3\xdb\x2e\x06\x77\xaa\xea\xc7\xf2\xb9\x82\xc4\xe4\x32\xde\x91\xa1\xc9\x6a\xe0\x8f\xbb\x43\x46\x25\xa7\xe8\x82\x32\x46\xaa\x74\x07\xd6\x54\xf9\x47\x21\xfe\xe0\x07\x77\x43\x1b\xd2\x18\x73\x41\x9b\xdb\x34\xad\xce\x99\x3d\xf9\xb3\x7c\x19\x29\x36\x95\x36\x9d\xb4\x81\x37\x50\xf9\xaa\x99\x1d\x75\x65\x84\xee\x25\x67\x7b\x3b\xed\x8c\xc7\x8e\xdd\xfa\x55\xe1\x8f\xf0\x2a\x4d\xf9\x5e\x94\x59\x06\xe8\x23\x66\x78\x4d\x04\x3a\xb9\x98\xa1\xf9\xfc\x7d\x59\x3f\x48\x2a\x4f\x1e\x15\x02\x36\x97\x68\xa3\x54\x2e\xdf\x1c\x1f\xaf\xa9\xda\x14\xcb\xa3\x98\x67\xc7\x12\x67\xb2\x60\xeb\x17\x31\x8b\xd5\x71\x9c\xe1\x17\x52\x6e\xa2\x09\x42\x77\x93\xbb\xc9\x3f\x07\x00\x0b\xf2\xf6\x8f\x68\x83\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -3,7 +3,6 @@ package util
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/common"
 	clusterv1alpha1 "github.com/samsung-cnct/cma-ssh/pkg/apis/cluster/v1alpha1"
@@ -11,15 +10,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// kubernetesVersions are the versions we support installing.
-// TODO: in future set this through ldflags in make
-const kubernetesVersions = "1.12.6 1.13.4"
-
-// KubernetesVersions() returns the list of all kubernetes versions cma-ssh supports.
-func KubernetesVersions() []string {
-	return strings.Split(kubernetesVersions, " ")
-}
-
 func GetClusterMachineList(c client.Client, clusterName string) ([]clusterv1alpha1.CnctMachine, error) {
 	machineList := &clusterv1alpha1.CnctMachineList{}
 	err := c.List(
@@ -63,6 +53,7 @@ func GetStatus(machines []clusterv1alpha1.CnctMachine) (common.ClusterStatusPhas
 	if ContainsStatuses(machines,
 		[]common.MachineStatusPhase{
 			common.DeletingMachinePhase,
+			common.DeployingMachinePhase,
 			common.ProvisioningMachinePhase,
 			common.UpgradingMachinePhase,
 			"",
@@ -83,6 +74,7 @@ func IsReadyForDeletion(machines []clusterv1alpha1.CnctMachine) bool {
 
 	if ContainsStatuses(machines,
 		[]common.MachineStatusPhase{
+			common.DeployingMachinePhase,
 			common.ProvisioningMachinePhase,
 			common.UpgradingMachinePhase,
 			"",
@@ -103,6 +95,7 @@ func IsReadyForUpgrade(machines []clusterv1alpha1.CnctMachine) (bool, error) {
 
 	if ContainsStatuses(machines,
 		[]common.MachineStatusPhase{
+			common.DeployingMachinePhase,
 			common.ProvisioningMachinePhase,
 			"",
 		}) {